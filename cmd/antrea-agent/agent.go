@@ -49,6 +49,7 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/agent/externalnode"
 	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/memberlist"
@@ -79,6 +80,7 @@ import (
 	ofconfig "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
+	commonquerier "antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -466,10 +468,13 @@ func run(o *Options) error {
 
 	var auditLoggerOptions *networkpolicy.AuditLoggerOptions
 	auditLoggerOptions = &networkpolicy.AuditLoggerOptions{
-		MaxSize:    int(o.config.AuditLogging.MaxSize),
-		MaxBackups: int(*o.config.AuditLogging.MaxBackups),
-		MaxAge:     int(*o.config.AuditLogging.MaxAge),
-		Compress:   *o.config.AuditLogging.Compress,
+		MaxSize:         int(o.config.AuditLogging.MaxSize),
+		MaxBackups:      int(*o.config.AuditLogging.MaxBackups),
+		MaxAge:          int(*o.config.AuditLogging.MaxAge),
+		Compress:        *o.config.AuditLogging.Compress,
+		Format:          o.config.AuditLogging.Format,
+		SyslogAddress:   o.config.AuditLogging.Syslog.Address,
+		SyslogTransport: o.config.AuditLogging.Syslog.Transport,
 	}
 
 	var gwPort, tunPort uint32
@@ -518,6 +523,9 @@ func run(o *Options) error {
 	if err != nil {
 		return fmt.Errorf("error creating new NetworkPolicy controller: %v", err)
 	}
+	if proxier != nil {
+		networkPolicyController.SetProxier(proxier)
+	}
 	var l7FlowExporterController *l7flowexporter.L7FlowExporterController
 	if l7FlowExporterEnabled {
 		l7FlowExporterController = l7flowexporter.NewL7FlowExporterController(
@@ -623,6 +631,11 @@ func run(o *Options) error {
 
 	var traceflowController *traceflow.Controller
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
+		// Avoid passing a typed nil pointer when the Multi-cluster Gateway is disabled.
+		var mcGatewayQuerier commonquerier.MulticlusterGatewayQuerier
+		if enableMulticlusterGW {
+			mcGatewayQuerier = mcDefaultRouteController
+		}
 		traceflowController = traceflow.NewTraceflowController(
 			k8sClient,
 			crdClient,
@@ -631,6 +644,7 @@ func run(o *Options) error {
 			ofClient,
 			networkPolicyController,
 			egressController,
+			mcGatewayQuerier,
 			ifaceStore,
 			networkConfig,
 			nodeConfig,
@@ -851,7 +865,16 @@ func run(o *Options) error {
 	// statsCollector collects stats and reports to the antrea-controller periodically. For now it's only used for
 	// NetworkPolicy stats and Multicast stats.
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController)
+		var peerStatsCollector *stats.PeerStatsCollector
+		if o.config.NetworkPolicyStats.EnablePeerStats {
+			connTrackDumper := connections.InitializeConnTrackDumper(nodeConfig, serviceCIDRNet, serviceCIDRNetv6, ovsDatapathType, o.enableAntreaProxy)
+			if connTrackDumper == nil {
+				klog.InfoS("Collecting NetworkPolicy stats by peer is not supported with the OVS datapath type", "ovsDatapathType", ovsDatapathType)
+			} else {
+				peerStatsCollector = stats.NewPeerStatsCollector(connTrackDumper, v4Enabled, v6Enabled, connectUplinkToBridge, networkPolicyController)
+			}
+		}
+		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController, peerStatsCollector)
 		go statsCollector.Run(stopCh)
 	}
 
@@ -60,6 +60,8 @@ const (
 	defaultAuditLogsMaxBackups     = 3
 	defaultAuditLogsMaxAge         = 28
 	defaultAuditLogsCompressed     = true
+	defaultAuditLogsFormat         = "text"
+	defaultAuditSyslogTransport    = "udp"
 	defaultPacketInRate            = 500
 )
 
@@ -151,6 +153,10 @@ func (o *Options) validate(args []string) error {
 		return err
 	}
 
+	if err := o.validateAuditLoggingConfig(); err != nil {
+		return fmt.Errorf("failed to validate auditLogging config: %v", err)
+	}
+
 	if config.ExternalNode.String() == o.config.NodeType && !features.DefaultFeatureGate.Enabled(features.ExternalNode) {
 		return fmt.Errorf("nodeType %s requires feature gate ExternalNode to be enabled", o.config.NodeType)
 	}
@@ -713,6 +719,29 @@ func (o *Options) setAuditLoggingDefaultOptions() {
 		compress := defaultAuditLogsCompressed
 		auditLogging.Compress = &compress
 	}
+	if auditLogging.Format == "" {
+		auditLogging.Format = defaultAuditLogsFormat
+	}
+	if auditLogging.Syslog.Transport == "" {
+		auditLogging.Syslog.Transport = defaultAuditSyslogTransport
+	}
+}
+
+func (o *Options) validateAuditLoggingConfig() error {
+	auditLogging := &o.config.AuditLogging
+	if auditLogging.Format != "text" && auditLogging.Format != "json" {
+		return fmt.Errorf("unsupported format %s, supported formats are text and json", auditLogging.Format)
+	}
+	if auditLogging.Syslog.Address == "" {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(auditLogging.Syslog.Address); err != nil {
+		return fmt.Errorf("syslog address %s is invalid: %v", auditLogging.Syslog.Address, err)
+	}
+	if auditLogging.Syslog.Transport != "udp" && auditLogging.Syslog.Transport != "tcp" {
+		return fmt.Errorf("unsupported syslog transport %s, supported transports are udp and tcp", auditLogging.Syslog.Transport)
+	}
+	return nil
 }
 
 func (o *Options) validateSecondaryNetworkConfig() error {
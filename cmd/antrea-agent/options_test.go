@@ -343,3 +343,58 @@ func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsValidateAuditLoggingConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		syslogAddress string
+		transport     string
+		expectedErr   string
+	}{
+		{
+			name:   "text format",
+			format: "text",
+		},
+		{
+			name:          "json format with syslog",
+			format:        "json",
+			syslogAddress: "10.10.0.1:514",
+			transport:     "tcp",
+		},
+		{
+			name:        "invalid format",
+			format:      "xml",
+			expectedErr: "unsupported format xml, supported formats are text and json",
+		},
+		{
+			name:          "invalid syslog address",
+			format:        "json",
+			syslogAddress: "10.10.0.1",
+			transport:     "udp",
+			expectedErr:   "syslog address 10.10.0.1 is invalid: address 10.10.0.1: missing port in address",
+		},
+		{
+			name:          "invalid syslog transport",
+			format:        "json",
+			syslogAddress: "10.10.0.1:514",
+			transport:     "tls",
+			expectedErr:   "unsupported syslog transport tls, supported transports are udp and tcp",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{}}
+			o.config.AuditLogging.Format = tc.format
+			o.config.AuditLogging.Syslog.Address = tc.syslogAddress
+			o.config.AuditLogging.Syslog.Transport = tc.transport
+
+			err := o.validateAuditLoggingConfig()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -105,6 +105,7 @@ var allowedPaths = []string{
 	"/mutate/anp",
 	"/mutate/namespace",
 	"/validate/tier",
+	"/validate/namespacedtier",
 	"/validate/acnp",
 	"/validate/annp",
 	"/validate/anp",
@@ -144,9 +145,12 @@ func run(o *Options) error {
 	eeInformer := crdInformerFactory.Crd().V1alpha2().ExternalEntities()
 	annpInformer := crdInformerFactory.Crd().V1beta1().NetworkPolicies()
 	tierInformer := crdInformerFactory.Crd().V1beta1().Tiers()
+	namespacedTierInformer := crdInformerFactory.Crd().V1beta1().NamespacedTiers()
 	tfInformer := crdInformerFactory.Crd().V1beta1().Traceflows()
+	tfProbeInformer := crdInformerFactory.Crd().V1alpha1().TraceflowProbes()
 	cgInformer := crdInformerFactory.Crd().V1beta1().ClusterGroups()
 	grpInformer := crdInformerFactory.Crd().V1beta1().Groups()
+	ipSetInformer := crdInformerFactory.Crd().V1alpha1().IPSets()
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
 	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 	externalNodeInformer := crdInformerFactory.Crd().V1alpha1().ExternalNodes()
@@ -177,7 +181,7 @@ func run(o *Options) error {
 	egressGroupStore := egressstore.NewEgressGroupStore()
 	groupStore := store.NewGroupStore()
 	groupEntityIndex := grouping.NewGroupEntityIndex()
-	groupEntityController := grouping.NewGroupEntityController(groupEntityIndex, podInformer, namespaceInformer, eeInformer)
+	groupEntityController := grouping.NewGroupEntityController(groupEntityIndex, podInformer, namespaceInformer, nodeInformer, eeInformer)
 	labelIdentityIndex := labelidentity.NewLabelIdentityIndex()
 	networkPolicyController := networkpolicy.NewNetworkPolicyController(client,
 		crdClient,
@@ -192,8 +196,10 @@ func run(o *Options) error {
 		adminNPInformer,
 		banpInformer,
 		tierInformer,
+		namespacedTierInformer,
 		cgInformer,
 		grpInformer,
+		ipSetInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
@@ -212,9 +218,24 @@ func run(o *Options) error {
 		bundleCollectionController = supportbundlecollection.NewSupportBundleCollectionController(client, crdClient, bundleCollectionInformer, nodeInformer, externalNodeInformer, bundleCollectionStore)
 	}
 
+	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
+	// aggregated data. For now it's only used for NetworkPolicy stats.
+	var statsAggregator *stats.Aggregator
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer)
+	}
+
 	var networkPolicyStatusController *networkpolicy.StatusController
+	var ruleAnalyzer *networkpolicy.RuleAnalyzer
+	var staleRuleDetector *networkpolicy.StaleRuleDetector
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, acnpInformer, annpInformer)
+		ruleAnalyzer = networkpolicy.NewRuleAnalyzer(networkPolicyController)
+		if o.config.StaleRuleDetection.Enable && features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+			// The window has been validated.
+			window, _ := time.ParseDuration(o.config.StaleRuleDetection.Window)
+			staleRuleDetector = networkpolicy.NewStaleRuleDetector(networkPolicyController, statsAggregator, window)
+		}
+		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, acnpInformer, annpInformer, ruleAnalyzer, staleRuleDetector)
 	}
 
 	endpointQuerier := networkpolicy.NewEndpointQuerier(networkPolicyController)
@@ -258,15 +279,10 @@ func run(o *Options) error {
 	}
 
 	var traceflowController *traceflow.Controller
+	var traceflowProbeController *traceflow.ProbeController
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
-		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, tfInformer)
-	}
-
-	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
-	// aggregated data. For now it's only used for NetworkPolicy stats.
-	var statsAggregator *stats.Aggregator
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer)
+		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, nodeInformer, tfInformer)
+		traceflowProbeController = traceflow.NewProbeController(crdClient, tfProbeInformer, tfInformer)
 	}
 
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
@@ -299,6 +315,7 @@ func run(o *Options) error {
 		eeInformer,
 		controllerQuerier,
 		endpointQuerier,
+		ruleAnalyzer,
 		networkPolicyController,
 		networkPolicyStatusController,
 		egressController,
@@ -378,10 +395,15 @@ func run(o *Options) error {
 
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
 		go traceflowController.Run(stopCh)
+		go traceflowProbeController.Run(stopCh)
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		go networkPolicyStatusController.Run(stopCh)
+		go ruleAnalyzer.Run(stopCh)
+		if staleRuleDetector != nil {
+			go staleRuleDetector.Run(stopCh)
+		}
 	}
 	if features.DefaultFeatureGate.Enabled(features.NodeIPAM) && o.config.NodeIPAM.EnableNodeIPAM {
 		clusterCIDRs, _ := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
@@ -493,6 +515,7 @@ func createAPIServerConfig(kubeconfig string,
 	eeInformer crdv1a2informers.ExternalEntityInformer,
 	controllerQuerier querier.ControllerQuerier,
 	endpointQuerier networkpolicy.EndpointQuerier,
+	ruleAnalyzer *networkpolicy.RuleAnalyzer,
 	npController *networkpolicy.NetworkPolicyController,
 	networkPolicyStatusController *networkpolicy.StatusController,
 	egressController *egress.EgressController,
@@ -567,6 +590,7 @@ func createAPIServerConfig(kubeconfig string,
 		controllerQuerier,
 		networkPolicyStatusController,
 		endpointQuerier,
+		ruleAnalyzer,
 		npController,
 		egressController,
 		externalIPPoolController,
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/klog/v2"
@@ -44,6 +45,8 @@ const (
 	ipamIPv6MaskLo      = 64
 	ipamIPv6MaskHi      = 126
 	ipamIPv6MaskDefault = 64
+
+	defaultStaleRuleDetectionWindow = 30 * 24 * time.Hour
 )
 
 type Options struct {
@@ -92,6 +95,12 @@ func (o *Options) validate(args []string) error {
 		klog.InfoS("Multicluster feature gate is disabled. Multicluster.EnableStretchedNetworkPolicy is ignored")
 	}
 
+	if o.config.StaleRuleDetection.Enable {
+		if err := o.validateStaleRuleDetectionOptions(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -161,6 +170,20 @@ func (o *Options) validateNodeIPAMControllerOptions() error {
 	return nil
 }
 
+func (o *Options) validateStaleRuleDetectionOptions() error {
+	window, err := time.ParseDuration(o.config.StaleRuleDetection.Window)
+	if err != nil {
+		return fmt.Errorf("staleRuleDetection.window %s is invalid: %w", o.config.StaleRuleDetection.Window, err)
+	}
+	if window <= 0 {
+		return fmt.Errorf("staleRuleDetection.window must be positive")
+	}
+	if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) || !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		klog.InfoS("AntreaPolicy or NetworkPolicyStats feature gate is disabled. StaleRuleDetection.Enable is ignored")
+	}
+	return nil
+}
+
 func (o *Options) loadConfigFromFile() error {
 	data, err := os.ReadFile(o.configFile)
 	if err != nil {
@@ -203,6 +226,9 @@ func (o *Options) setDefaults() {
 	if o.config.ClientConnection.Burst == 0 {
 		o.config.ClientConnection.Burst = defaultClientBurst
 	}
+	if o.config.StaleRuleDetection.Window == "" {
+		o.config.StaleRuleDetection.Window = defaultStaleRuleDetectionWindow.String()
+	}
 }
 
 func ptrBool(value bool) *bool {
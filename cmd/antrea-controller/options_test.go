@@ -35,6 +35,7 @@ func TestNewOptions(t *testing.T) {
 	assert.Equal(t, true, *op.config.IPsecCSRSignerConfig.AutoApprove)
 	assert.EqualValues(t, defaultClientQPS, op.config.ClientConnection.QPS)
 	assert.EqualValues(t, defaultClientBurst, op.config.ClientConnection.Burst)
+	assert.Equal(t, "720h0m0s", op.config.StaleRuleDetection.Window)
 }
 
 func TestValidateNodeIPAMControllerOptions(t *testing.T) {
@@ -176,3 +177,37 @@ func TestValidateNodeIPAMControllerOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStaleRuleDetectionOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		window      string
+		expectedErr string
+	}{
+		{
+			name:   "valid window",
+			window: "168h",
+		},
+		{
+			name:        "invalid window",
+			window:      "7d",
+			expectedErr: "staleRuleDetection.window 7d is invalid",
+		},
+		{
+			name:        "non-positive window",
+			window:      "0s",
+			expectedErr: "staleRuleDetection.window must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Options{config: &controllerconfig.ControllerConfig{StaleRuleDetection: controllerconfig.StaleRuleDetectionConfig{Enable: true, Window: tc.window}}}
+			err := o.validateStaleRuleDetectionOptions()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -61,7 +61,7 @@ MOCKGEN_TARGETS=(
   "pkg/agent/wireguard Interface testing mock_wireguard.go"
   "pkg/agent/util/winnet Interface testing mock_net_windows.go"
   "pkg/antctl AntctlClient ."
-  "pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier testing"
+  "pkg/controller/networkpolicy ConnectivityMatrixQuerier,EndpointQuerier,PolicyRuleQuerier testing"
   "pkg/controller/querier ControllerQuerier testing"
   "pkg/flowaggregator/exporter Interface testing"
   "pkg/ipfix IPFIXExportingProcess,IPFIXRegistry,IPFIXCollectingProcess,IPFIXAggregationProcess testing"
@@ -104,6 +104,7 @@ function generate_antrea_client_code {
     --plural-exceptions "AntreaClusterNetworkPolicyStats:AntreaClusterNetworkPolicyStats" \
     --plural-exceptions "ClusterGroupMembers:ClusterGroupMembers" \
     --plural-exceptions "GroupMembers:GroupMembers" \
+    --plural-exceptions "ConnectivityMatrix:ConnectivityMatrices" \
     --go-header-file hack/boilerplate/license_header.go.txt
 
   # Generate listers with K8s codegen tools.
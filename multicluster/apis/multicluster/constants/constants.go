@@ -23,6 +23,7 @@ const (
 	LabelIdentityKind              = "LabelIdentity"
 	ServiceImportKind              = "ServiceImport"
 	ClusterInfoKind                = "ClusterInfo"
+	TraceflowKind                  = "Traceflow"
 
 	ResourceExportFinalizer = "resourceexport.finalizers.antrea.io"
 
@@ -62,6 +62,8 @@ type ClusterInfo struct {
 	// PodCIDRs is the Pod IP address CIDRs.
 	PodCIDRs  []string       `json:"podCIDRs,omitempty"`
 	WireGuard *WireGuardInfo `json:"wireGuard,omitempty"`
+	// MulticlusterTraceflow indicates whether the member cluster traces multi-cluster Traceflows.
+	MulticlusterTraceflow bool `json:"multiclusterTraceflow,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -43,6 +43,23 @@ type LabelIdentityExport struct {
 	NormalizedLabel string `json:"normalizedLabel,omitempty"`
 }
 
+// TraceflowExport exports a multi-cluster Traceflow to the peer member clusters, or the results of
+// the Traceflow in a peer member cluster to the source member cluster.
+type TraceflowExport struct {
+	// SourceClusterID is the ID of the member cluster where the Traceflow is created.
+	SourceClusterID string `json:"sourceClusterID,omitempty"`
+	// DataplaneTag is the data plane tag of the Traceflow in the source member cluster.
+	DataplaneTag int8 `json:"dataplaneTag,omitempty"`
+	// Timeout is the timeout of the Traceflow in seconds.
+	Timeout int32 `json:"timeout,omitempty"`
+	// Phase of the Traceflow in the peer member cluster.
+	Phase v1beta1.TraceflowPhase `json:"phase,omitempty"`
+	// Reason of the Traceflow failure in the peer member cluster.
+	Reason string `json:"reason,omitempty"`
+	// Results reported by the Nodes of the peer member cluster.
+	Results []v1beta1.NodeResult `json:"results,omitempty"`
+}
+
 // RawResourceExport exports opaque resources.
 type RawResourceExport struct {
 	Data []byte `json:"data,omitempty"`
@@ -71,6 +88,8 @@ type ResourceExportSpec struct {
 	ClusterNetworkPolicy *v1beta1.ClusterNetworkPolicySpec `json:"clusterNetworkPolicy,omitempty"`
 	// If exported resource is LabelIdentity of a cluster.
 	LabelIdentity *LabelIdentityExport `json:"labelIdentity,omitempty"`
+	// If exported resource is Traceflow.
+	Traceflow *TraceflowExport `json:"traceflow,omitempty"`
 	// If exported resource kind is unknown.
 	Raw *RawResourceExport `json:"raw,omitempty"`
 }
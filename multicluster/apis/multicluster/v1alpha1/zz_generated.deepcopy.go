@@ -867,6 +867,11 @@ func (in *ResourceExportSpec) DeepCopyInto(out *ResourceExportSpec) {
 		*out = new(LabelIdentityExport)
 		**out = **in
 	}
+	if in.Traceflow != nil {
+		in, out := &in.Traceflow, &out.Traceflow
+		*out = new(TraceflowExport)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Raw != nil {
 		in, out := &in.Raw, &out.Raw
 		*out = new(RawResourceExport)
@@ -1096,6 +1101,28 @@ func (in *ServiceExport) DeepCopy() *ServiceExport {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowExport) DeepCopyInto(out *TraceflowExport) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]v1beta1.NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowExport.
+func (in *TraceflowExport) DeepCopy() *TraceflowExport {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WireGuardInfo) DeepCopyInto(out *WireGuardInfo) {
 	*out = *in
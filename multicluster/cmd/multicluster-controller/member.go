@@ -134,6 +134,15 @@ func runMember(o *Options) error {
 		return fmt.Errorf("error creating Node controller: %v", err)
 	}
 
+	traceflowReconciler := member.NewTraceflowReconciler(
+		mgrClient,
+		mgrScheme,
+		podNamespace,
+		commonAreaGetter)
+	if err = traceflowReconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("error creating Traceflow controller: %v", err)
+	}
+
 	staleController := member.NewStaleResCleanupController(
 		mgr.GetClient(),
 		mgr.GetScheme(),
@@ -82,6 +82,18 @@ func NewClusterInfoResourceExportName(clusterID string) string {
 	return clusterID + "-clusterinfo"
 }
 
+// NewTraceflowResourceExportName returns the name of the Traceflow kind of ResourceExport, which
+// exports the Traceflow tfName created in the member cluster sourceClusterID. The ResourceExport
+// of a peer member cluster clusterID, which reports the results in the peer member cluster, is
+// prefixed with clusterID.
+func NewTraceflowResourceExportName(clusterID, sourceClusterID, tfName string) string {
+	name := sourceClusterID + "-" + tfName + "-traceflow"
+	if clusterID != sourceClusterID {
+		name = clusterID + "-" + name
+	}
+	return name
+}
+
 func getClusterIDFromClusterClaim(c client.Client, clusterSet *mcv1alpha2.ClusterSet) (ClusterID, error) {
 	configNamespace := clusterSet.GetNamespace()
 
@@ -472,15 +472,16 @@ func (r *ResourceExportReconciler) deleteResourceExport(resExport *mcsv1alpha1.R
 func (r *ResourceExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Ignore status update event via GenerationChangedPredicate
 	generationPredicate := predicate.GenerationChangedPredicate{}
-	// Register this controller to ignore LabelIdentity kind of ResourceExport
-	labelIdentityResExportFilter := func(object client.Object) bool {
+	// Register this controller to ignore LabelIdentity kind of ResourceExport, and Traceflow kind
+	// of ResourceExport which is consumed by the member clusters directly.
+	resExportFilter := func(object client.Object) bool {
 		if resExport, ok := object.(*mcsv1alpha1.ResourceExport); ok {
-			return resExport.Spec.Kind != constants.LabelIdentityKind
+			return resExport.Spec.Kind != constants.LabelIdentityKind && resExport.Spec.Kind != constants.TraceflowKind
 		}
 		return false
 	}
-	labelIdentityResExportPredicate := predicate.NewPredicateFuncs(labelIdentityResExportFilter)
-	instance := predicate.And(generationPredicate, labelIdentityResExportPredicate)
+	resExportPredicate := predicate.NewPredicateFuncs(resExportFilter)
+	instance := predicate.And(generationPredicate, resExportPredicate)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcsv1alpha1.ResourceExport{}).
 		WithEventFilter(instance).
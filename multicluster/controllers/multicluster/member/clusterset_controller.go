@@ -282,6 +282,14 @@ func (r *MemberClusterSetReconciler) createRemoteCommonArea(clusterSet *mcv1alph
 	)
 	r.remoteCommonArea.AddImportReconciler(resImportReconciler)
 
+	traceflowResExportReconciler := newTraceflowResourceExportReconciler(
+		r.Client,
+		string(r.clusterID),
+		r.namespace,
+		r.remoteCommonArea,
+	)
+	r.remoteCommonArea.AddImportReconciler(traceflowResExportReconciler)
+
 	if r.enableStretchedNetworkPolicy {
 		labelIdentityImpReconciler := newLabelIdentityResourceImportReconciler(
 			r.Client,
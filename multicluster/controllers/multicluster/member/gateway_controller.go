@@ -230,6 +230,8 @@ func (r *GatewayReconciler) getClusterInfo(gateway *mcv1alpha1.Gateway) *mcv1alp
 				GatewayIP: gateway.GatewayIP,
 			},
 		},
+		// The TraceflowResourceExportReconciler always runs in member clusters.
+		MulticlusterTraceflow: true,
 	}
 	if gateway.WireGuard != nil && gateway.WireGuard.PublicKey != "" {
 		clusterInfo.WireGuard = &mcv1alpha1.WireGuardInfo{
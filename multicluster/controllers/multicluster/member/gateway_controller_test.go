@@ -215,6 +215,7 @@ func TestGetClusterInfo(t *testing.T) {
 		WireGuard: &mcv1alpha1.WireGuardInfo{
 			PublicKey: "key",
 		},
+		MulticlusterTraceflow: true,
 	}
 
 	assert.Equal(t, expectedClusterInfo, r.getClusterInfo(gw))
@@ -39,9 +39,10 @@ import (
 )
 
 // StaleResCleanupController will clean up ServiceImport, MC Service, ACNP, ClusterInfoImport and LabelIdentity
-// resources if no corresponding ResourceImports in the leader cluster and remove stale ResourceExports
-// in the leader cluster if no corresponding ServiceExport or Gateway in the member cluster when it runs in
-// the member cluster. StaleResCleanupController one-time runner will run only once in the member cluster
+// resources if no corresponding ResourceImports in the leader cluster, clean up Traceflows created for peer
+// member clusters if no corresponding ResourceExports in the leader cluster, and remove stale ResourceExports
+// in the leader cluster if no corresponding ServiceExport, Gateway or Traceflow in the member cluster when it
+// runs in the member cluster. StaleResCleanupController one-time runner will run only once in the member cluster
 // during Multi-cluster Controller starts, and it will retry only if there is an error.
 // StaleResCleanupController's reconciler will handle ClusterSet deletion event to clean up all
 // automatically created resources for the ClusterSet.
@@ -141,6 +142,11 @@ func (c *StaleResCleanupController) cleanUpStaleResourcesOnMember(ctx context.Co
 		klog.ErrorS(err, "Failed to cleanup stale imported LabelIdentities")
 		return err
 	}
+	// Clean up any Traceflows created for peer member clusters that do not have corresponding ResourceExport anymore
+	if err := c.cleanUpPeerTraceflows(ctx, commonArea); err != nil {
+		klog.ErrorS(err, "Failed to cleanup stale Traceflows created for peer member clusters")
+		return err
+	}
 	return nil
 }
 
@@ -162,6 +168,9 @@ func (c *StaleResCleanupController) cleanUpStaleResourceExportsOnLeader(ctx cont
 	if err := c.cleanUpLabelIdentityResourceExports(ctx, commonArea, resExpList); err != nil {
 		return err
 	}
+	if err := c.cleanUpTraceflowResourceExports(ctx, commonArea, resExpList); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -343,6 +352,71 @@ func (c *StaleResCleanupController) cleanUpLabelIdentityResourceExports(ctx cont
 	return nil
 }
 
+// cleanUpPeerTraceflows removes any Traceflows created for peer member clusters when there is no
+// corresponding Traceflow kind of ResourceExport of the source member cluster.
+func (c *StaleResCleanupController) cleanUpPeerTraceflows(ctx context.Context, commonArea commonarea.RemoteCommonArea) error {
+	tfList := &crdv1beta1.TraceflowList{}
+	if err := c.List(ctx, tfList, &client.ListOptions{}); err != nil {
+		return err
+	}
+	// Traceflows need to be listed before ResourceExports are listed, in case a Traceflow is created
+	// for a ResourceExport in between.
+	resExpList := &mcv1alpha1.ResourceExportList{}
+	if err := commonArea.List(ctx, resExpList, &client.ListOptions{Namespace: commonArea.GetNamespace()}); err != nil {
+		return err
+	}
+	stalePeerTraceflows := map[string]crdv1beta1.Traceflow{}
+	for _, tf := range tfList.Items {
+		if tf.Spec.RemoteSource != nil {
+			stalePeerTraceflows[tf.Name] = tf
+		}
+	}
+	for _, resExp := range resExpList.Items {
+		if resExp.Spec.Kind == constants.TraceflowKind {
+			delete(stalePeerTraceflows, common.AntreaMCSPrefix+resExp.Name)
+		}
+	}
+	for _, staleTF := range stalePeerTraceflows {
+		tf := staleTF
+		klog.InfoS("Cleaning up stale Traceflow created for peer member cluster", "traceflow", klog.KObj(&tf))
+		if err := c.Client.Delete(ctx, &tf, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanUpTraceflowResourceExports removes any Traceflow kind of ResourceExports of the local cluster
+// when there is no corresponding multi-cluster Traceflow or Traceflow created for a peer member cluster
+// in the local cluster.
+func (c *StaleResCleanupController) cleanUpTraceflowResourceExports(ctx context.Context, commonArea commonarea.RemoteCommonArea, resExpList *mcv1alpha1.ResourceExportList) error {
+	tfList := &crdv1beta1.TraceflowList{}
+	if err := c.List(ctx, tfList, &client.ListOptions{}); err != nil {
+		return err
+	}
+	staleResExpItems := map[string]mcv1alpha1.ResourceExport{}
+	for _, resExp := range resExpList.Items {
+		if resExp.Spec.Kind == constants.TraceflowKind && resExp.Labels[constants.SourceClusterID] == c.localClusterID {
+			staleResExpItems[resExp.Name] = resExp
+		}
+	}
+	for _, tf := range tfList.Items {
+		if tf.Spec.Multicluster {
+			delete(staleResExpItems, common.NewTraceflowResourceExportName(c.localClusterID, c.localClusterID, tf.Name))
+		} else if remoteSource := tf.Spec.RemoteSource; remoteSource != nil {
+			delete(staleResExpItems, common.NewTraceflowResourceExportName(c.localClusterID, remoteSource.ClusterID, remoteSource.Traceflow))
+		}
+	}
+	for _, r := range staleResExpItems {
+		re := r
+		klog.InfoS("Cleaning up stale ResourceExport", "ResourceExport", klog.KObj(&re))
+		if err := commonArea.Delete(ctx, &re, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // cleanUpClusterInfoResourceExports removes any ClusterInfo kind of ResourceExports when there is no
 // Gateway in the local cluster.
 func (c *StaleResCleanupController) cleanUpClusterInfoResourceExports(ctx context.Context, commonArea commonarea.RemoteCommonArea) error {
@@ -428,6 +502,9 @@ func cleanUpResourcesCreatedByMC(ctx context.Context, mgrClient client.Client) e
 	if err = cleanUpGateways(ctx, mgrClient); err != nil {
 		return err
 	}
+	if err = cleanUpPeerTraceflows(ctx, mgrClient); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -518,3 +595,21 @@ func cleanUpGateways(ctx context.Context, mgrClient client.Client) error {
 	}
 	return nil
 }
+
+func cleanUpPeerTraceflows(ctx context.Context, mgrClient client.Client) error {
+	tfList := &crdv1beta1.TraceflowList{}
+	if err := mgrClient.List(ctx, tfList, &client.ListOptions{}); err != nil {
+		return err
+	}
+	for _, tf := range tfList.Items {
+		if tf.Spec.RemoteSource == nil {
+			continue
+		}
+		tfTmp := tf
+		err := mgrClient.Delete(ctx, &tfTmp, &client.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,481 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// TraceflowReconciler is for member cluster only. It exports multi-cluster Traceflows to the
+// peer member clusters with Traceflow kind of ResourceExports in the leader cluster, and merges
+// the results reported by the peer member clusters into the Traceflows. It also reports the
+// phase and the results of the Traceflows created for peer member clusters.
+type TraceflowReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	commonAreaGetter commonarea.RemoteCommonAreaGetter
+	namespace        string
+}
+
+// NewTraceflowReconciler creates a TraceflowReconciler which will watch multi-cluster Traceflows
+// and the Traceflows created for peer member clusters.
+func NewTraceflowReconciler(
+	client client.Client,
+	scheme *runtime.Scheme,
+	namespace string,
+	commonAreaGetter commonarea.RemoteCommonAreaGetter) *TraceflowReconciler {
+	return &TraceflowReconciler{
+		Client:           client,
+		Scheme:           scheme,
+		namespace:        namespace,
+		commonAreaGetter: commonAreaGetter,
+	}
+}
+
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows/status,verbs=get;update
+
+func (r *TraceflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling Traceflow", "traceflow", req.Name)
+	commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
+	if commonArea == nil {
+		klog.V(2).InfoS("Skip reconciling Traceflow since there is no connection to the leader")
+		return ctrl.Result{}, nil
+	}
+
+	tf := &crdv1beta1.Traceflow{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tf); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		// The deleted Traceflow is either a multi-cluster Traceflow, or a Traceflow created
+		// for a peer member cluster, which is named after the ResourceExport of the source
+		// member cluster.
+		resExportNames := []string{common.NewTraceflowResourceExportName(localClusterID, localClusterID, req.Name)}
+		if sourceResExportName, ok := strings.CutPrefix(req.Name, common.AntreaMCSPrefix); ok {
+			resExportNames = append(resExportNames, localClusterID+"-"+sourceResExportName)
+		}
+		for _, name := range resExportNames {
+			if err := deleteTraceflowResourceExport(ctx, commonArea, name); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+	if tf.Spec.RemoteSource != nil {
+		return ctrl.Result{}, r.reportPeerTraceflow(ctx, commonArea, localClusterID, tf)
+	}
+	return ctrl.Result{}, syncMulticlusterTraceflow(ctx, r.Client, commonArea, localClusterID, r.namespace, tf.Name)
+}
+
+// reportPeerTraceflow reports the phase and the results of a Traceflow created for a peer member
+// cluster to the source member cluster.
+func (r *TraceflowReconciler) reportPeerTraceflow(ctx context.Context, commonArea commonarea.RemoteCommonArea,
+	localClusterID string, tf *crdv1beta1.Traceflow) error {
+	if tf.Status.Phase == "" {
+		// The Traceflow has not been started by the Antrea Controller.
+		return nil
+	}
+	remoteSource := tf.Spec.RemoteSource
+	sourceResExportName := types.NamespacedName{
+		Namespace: commonArea.GetNamespace(),
+		Name:      common.NewTraceflowResourceExportName(remoteSource.ClusterID, remoteSource.ClusterID, remoteSource.Traceflow),
+	}
+	if err := commonArea.Get(ctx, sourceResExportName, &mcv1alpha1.ResourceExport{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		// The source Traceflow is completed or deleted.
+		klog.InfoS("Deleting Traceflow since the source Traceflow is gone", "traceflow", klog.KObj(tf))
+		return client.IgnoreNotFound(r.Client.Delete(ctx, tf, &client.DeleteOptions{}))
+	}
+	return createOrUpdateTraceflowResourceExport(ctx, commonArea, newTraceflowResultsResourceExport(commonArea, localClusterID, tf.Spec, tf.Status))
+}
+
+// newTraceflowResultsResourceExport returns the ResourceExport reporting the phase and the results of
+// a Traceflow created for a peer member cluster to the source member cluster.
+func newTraceflowResultsResourceExport(commonArea commonarea.RemoteCommonArea, localClusterID string,
+	spec crdv1beta1.TraceflowSpec, status crdv1beta1.TraceflowStatus) *mcv1alpha1.ResourceExport {
+	remoteSource := spec.RemoteSource
+	return &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: commonArea.GetNamespace(),
+			Name:      common.NewTraceflowResourceExportName(localClusterID, remoteSource.ClusterID, remoteSource.Traceflow),
+			Labels: map[string]string{
+				constants.SourceKind:      constants.TraceflowKind,
+				constants.SourceName:      remoteSource.Traceflow,
+				constants.SourceClusterID: localClusterID,
+			},
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind:      constants.TraceflowKind,
+			ClusterID: localClusterID,
+			Name:      remoteSource.Traceflow,
+			Traceflow: &mcv1alpha1.TraceflowExport{
+				SourceClusterID: remoteSource.ClusterID,
+				DataplaneTag:    remoteSource.DataplaneTag,
+				Timeout:         spec.Timeout,
+				Phase:           status.Phase,
+				Reason:          status.Reason,
+				Results:         status.Results,
+			},
+		},
+	}
+}
+
+// syncMulticlusterTraceflow exports a multi-cluster Traceflow of the local member cluster to the
+// peer member clusters. The Traceflow is moved from the Pending phase to the Running phase when
+// all the peer member clusters have started tracing the packet with the data plane tag of the
+// Traceflow, and the results reported by the peer member clusters are merged into the Traceflow.
+// The peer member clusters which do not trace multi-cluster Traceflows are ignored, and the
+// Traceflow fails if it fails in a peer member cluster.
+func syncMulticlusterTraceflow(ctx context.Context, localClient client.Client, commonArea commonarea.RemoteCommonArea,
+	localClusterID, namespace, tfName string) error {
+	tf := &crdv1beta1.Traceflow{}
+	if err := localClient.Get(ctx, types.NamespacedName{Name: tfName}, tf); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !tf.Spec.Multicluster {
+		return nil
+	}
+	resExportName := common.NewTraceflowResourceExportName(localClusterID, localClusterID, tf.Name)
+	switch tf.Status.Phase {
+	case crdv1beta1.Pending, crdv1beta1.Running:
+	case crdv1beta1.Succeeded, crdv1beta1.Failed:
+		// Stop tracing the packet in the peer member clusters.
+		return deleteTraceflowResourceExport(ctx, commonArea, resExportName)
+	default:
+		// The data plane tag has not been allocated by the Antrea Controller.
+		return nil
+	}
+
+	resExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: commonArea.GetNamespace(),
+			Name:      resExportName,
+			Labels: map[string]string{
+				constants.SourceKind:      constants.TraceflowKind,
+				constants.SourceName:      tf.Name,
+				constants.SourceClusterID: localClusterID,
+			},
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind:      constants.TraceflowKind,
+			ClusterID: localClusterID,
+			Name:      tf.Name,
+			Traceflow: &mcv1alpha1.TraceflowExport{
+				SourceClusterID: localClusterID,
+				DataplaneTag:    tf.Status.DataplaneTag,
+				Timeout:         tf.Spec.Timeout,
+			},
+		},
+	}
+	if err := createOrUpdateTraceflowResourceExport(ctx, commonArea, resExport); err != nil {
+		return err
+	}
+
+	ciImportList := &mcv1alpha1.ClusterInfoImportList{}
+	if err := localClient.List(ctx, ciImportList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return err
+	}
+	resExportList := &mcv1alpha1.ResourceExportList{}
+	if err := commonArea.List(ctx, resExportList, client.InNamespace(commonArea.GetNamespace()),
+		client.MatchingLabels{constants.SourceKind: constants.TraceflowKind, constants.SourceName: tf.Name}); err != nil {
+		return err
+	}
+	peerExports := map[string]*mcv1alpha1.TraceflowExport{}
+	for i := range resExportList.Items {
+		peerResExport := &resExportList.Items[i]
+		if peerResExport.Spec.ClusterID != localClusterID && peerResExport.Spec.Traceflow != nil &&
+			peerResExport.Spec.Traceflow.SourceClusterID == localClusterID {
+			peerExports[peerResExport.Spec.ClusterID] = peerResExport.Spec.Traceflow
+		}
+	}
+	allPeersStarted := true
+	var failureReason string
+	var peerResults []crdv1beta1.NodeResult
+	for _, ciImport := range ciImportList.Items {
+		peerClusterID := ciImport.Spec.ClusterID
+		if peerClusterID == localClusterID {
+			continue
+		}
+		if !ciImport.Spec.MulticlusterTraceflow {
+			klog.V(2).InfoS("Peer member cluster does not trace multi-cluster Traceflows", "traceflow", klog.KObj(tf), "clusterID", peerClusterID)
+			continue
+		}
+		peerExport, ok := peerExports[peerClusterID]
+		if !ok || peerExport.Phase == "" {
+			allPeersStarted = false
+			continue
+		}
+		if peerExport.Phase == crdv1beta1.Failed && failureReason == "" {
+			failureReason = fmt.Sprintf("Traceflow failed in member cluster %s: %s", peerClusterID, peerExport.Reason)
+		}
+		for _, result := range peerExport.Results {
+			result.Cluster = peerClusterID
+			peerResults = append(peerResults, result)
+		}
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestTF := &crdv1beta1.Traceflow{}
+		if err := localClient.Get(ctx, types.NamespacedName{Name: tfName}, latestTF); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		updatedTF := latestTF.DeepCopy()
+		ongoing := updatedTF.Status.Phase == crdv1beta1.Pending || updatedTF.Status.Phase == crdv1beta1.Running
+		if ongoing && failureReason != "" {
+			klog.InfoS("Multi-cluster Traceflow failed in a peer member cluster", "traceflow", klog.KObj(tf), "reason", failureReason)
+			updatedTF.Status.Phase = crdv1beta1.Failed
+			updatedTF.Status.Reason = failureReason
+		} else if updatedTF.Status.Phase == crdv1beta1.Pending && allPeersStarted {
+			klog.InfoS("All peer member clusters are ready, starting multi-cluster Traceflow", "traceflow", klog.KObj(tf))
+			updatedTF.Status.Phase = crdv1beta1.Running
+		}
+		if ongoing {
+			var results []crdv1beta1.NodeResult
+			for _, result := range updatedTF.Status.Results {
+				if result.Cluster == "" {
+					results = append(results, result)
+				}
+			}
+			updatedTF.Status.Results = append(results, peerResults...)
+		}
+		if reflect.DeepEqual(latestTF.Status, updatedTF.Status) {
+			return nil
+		}
+		return localClient.Status().Update(ctx, updatedTF)
+	})
+}
+
+func createOrUpdateTraceflowResourceExport(ctx context.Context, commonArea commonarea.RemoteCommonArea,
+	resExport *mcv1alpha1.ResourceExport) error {
+	existingResExport := &mcv1alpha1.ResourceExport{}
+	err := commonArea.Get(ctx, types.NamespacedName{Namespace: resExport.Namespace, Name: resExport.Name}, existingResExport)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := commonArea.Create(ctx, resExport, &client.CreateOptions{}); err != nil {
+			return err
+		}
+		klog.InfoS("Created a Traceflow kind of ResourceExport", "resourceexport", klog.KObj(resExport))
+		return nil
+	}
+	if reflect.DeepEqual(existingResExport.Spec, resExport.Spec) && reflect.DeepEqual(existingResExport.Labels, resExport.Labels) {
+		return nil
+	}
+	existingResExport.Labels = resExport.Labels
+	existingResExport.Spec = resExport.Spec
+	return commonArea.Update(ctx, existingResExport, &client.UpdateOptions{})
+}
+
+func deleteTraceflowResourceExport(ctx context.Context, commonArea commonarea.RemoteCommonArea, name string) error {
+	resExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: commonArea.GetNamespace(),
+			Name:      name,
+		},
+	}
+	if err := commonArea.Delete(ctx, resExport, &client.DeleteOptions{}); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	klog.InfoS("Deleted a Traceflow kind of ResourceExport", "resourceexport", klog.KObj(resExport))
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TraceflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only reconcile multi-cluster Traceflows and the Traceflows created for peer member clusters.
+	traceflowFilter := func(object client.Object) bool {
+		if tf, ok := object.(*crdv1beta1.Traceflow); ok {
+			return tf.Spec.Multicluster || tf.Spec.RemoteSource != nil
+		}
+		return false
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&crdv1beta1.Traceflow{}).
+		WithEventFilter(predicate.NewPredicateFuncs(traceflowFilter)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		Complete(r)
+}
+
+// TraceflowResourceExportReconciler reconciles Traceflow kind of ResourceExports in the leader
+// cluster for a member cluster. It creates a Traceflow in the member cluster for each multi-cluster
+// Traceflow exported by a peer member cluster, and merges the results reported by the peer member
+// clusters into the multi-cluster Traceflows of the member cluster.
+type TraceflowResourceExportReconciler struct {
+	localClusterClient client.Client
+	localClusterID     string
+	namespace          string
+	remoteCommonArea   commonarea.RemoteCommonArea
+	// Saved Manager to indicate SetupWithManager() is done or not.
+	manager ctrl.Manager
+}
+
+func newTraceflowResourceExportReconciler(localClusterClient client.Client, localClusterID string,
+	namespace string, remoteCommonArea commonarea.RemoteCommonArea) *TraceflowResourceExportReconciler {
+	return &TraceflowResourceExportReconciler{
+		localClusterClient: localClusterClient,
+		localClusterID:     localClusterID,
+		namespace:          namespace,
+		remoteCommonArea:   remoteCommonArea,
+	}
+}
+
+func (r *TraceflowResourceExportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling Traceflow kind of ResourceExport", "resourceExport", req.NamespacedName)
+	resExport := &mcv1alpha1.ResourceExport{}
+	if err := r.remoteCommonArea.Get(ctx, req.NamespacedName, resExport); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Unable to fetch Traceflow kind of ResourceExport", "resourceExport", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.handleResourceExportDelete(ctx, req.Name)
+	}
+	tfExport := resExport.Spec.Traceflow
+	if tfExport == nil {
+		return ctrl.Result{}, nil
+	}
+	if tfExport.SourceClusterID == r.localClusterID {
+		if resExport.Spec.ClusterID != r.localClusterID {
+			// A peer member cluster reported the results of a multi-cluster Traceflow of the
+			// local member cluster.
+			return ctrl.Result{}, syncMulticlusterTraceflow(ctx, r.localClusterClient, r.remoteCommonArea,
+				r.localClusterID, r.namespace, resExport.Spec.Name)
+		}
+		return ctrl.Result{}, nil
+	}
+	if resExport.Spec.ClusterID != tfExport.SourceClusterID {
+		// The results of another peer member cluster.
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{}, r.handleSourceResourceExport(ctx, resExport)
+}
+
+// handleSourceResourceExport creates a Traceflow in the local member cluster to trace the packet of
+// a multi-cluster Traceflow exported by a peer member cluster.
+func (r *TraceflowResourceExportReconciler) handleSourceResourceExport(ctx context.Context, resExport *mcv1alpha1.ResourceExport) error {
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.AntreaMCSPrefix + resExport.Name,
+		},
+		Spec: crdv1beta1.TraceflowSpec{
+			RemoteSource: &crdv1beta1.TraceflowRemoteSource{
+				ClusterID:    resExport.Spec.Traceflow.SourceClusterID,
+				Traceflow:    resExport.Spec.Name,
+				DataplaneTag: resExport.Spec.Traceflow.DataplaneTag,
+			},
+			Timeout: resExport.Spec.Traceflow.Timeout,
+		},
+	}
+	existingTF := &crdv1beta1.Traceflow{}
+	err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: tf.Name}, existingTF)
+	if err == nil {
+		if reflect.DeepEqual(existingTF.Spec, tf.Spec) {
+			return nil
+		}
+		// The Traceflow was created for a previous multi-cluster Traceflow with the same name.
+		if err := r.localClusterClient.Delete(ctx, existingTF, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := r.localClusterClient.Create(ctx, tf, &client.CreateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to create Traceflow for peer member cluster", "traceflow", klog.KObj(tf),
+			"sourceClusterID", tf.Spec.RemoteSource.ClusterID)
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) || apierrors.IsBadRequest(err) {
+			// The Traceflow is rejected, e.g. because the Multicluster feature is not enabled in the
+			// Antrea Controller, report the failure to the source member cluster instead of retrying.
+			status := crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Failed, Reason: err.Error()}
+			return createOrUpdateTraceflowResourceExport(ctx, r.remoteCommonArea, newTraceflowResultsResourceExport(r.remoteCommonArea, r.localClusterID, tf.Spec, status))
+		}
+		return err
+	}
+	klog.InfoS("Created Traceflow for peer member cluster", "traceflow", klog.KObj(tf), "sourceClusterID", tf.Spec.RemoteSource.ClusterID)
+	return nil
+}
+
+// handleResourceExportDelete deletes the Traceflow created in the local member cluster for a
+// deleted source ResourceExport, together with the ResourceExport reporting its results.
+func (r *TraceflowResourceExportReconciler) handleResourceExportDelete(ctx context.Context, resExportName string) error {
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.AntreaMCSPrefix + resExportName,
+		},
+	}
+	if err := r.localClusterClient.Delete(ctx, tf, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete Traceflow for peer member cluster", "traceflow", klog.KObj(tf))
+		return err
+	}
+	return deleteTraceflowResourceExport(ctx, r.remoteCommonArea, r.localClusterID+"-"+resExportName)
+}
+
+func (r *TraceflowResourceExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.manager == mgr {
+		// SetupWithManager was called by a previous RemoteManager.StartWatch() call and already
+		// completed with no error.
+		return nil
+	}
+
+	// Ignore status update event via GenerationChangedPredicate
+	generationPredicate := predicate.GenerationChangedPredicate{}
+	// Only register this controller to reconcile Traceflow kind of ResourceExport
+	traceflowResExportFilter := func(object client.Object) bool {
+		if resExport, ok := object.(*mcv1alpha1.ResourceExport); ok {
+			return resExport.Spec.Kind == constants.TraceflowKind
+		}
+		return false
+	}
+	traceflowResExportPredicate := predicate.NewPredicateFuncs(traceflowResExportFilter)
+	instance := predicate.And(generationPredicate, traceflowResExportPredicate)
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&mcv1alpha1.ResourceExport{}).
+		WithEventFilter(instance).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		Complete(r)
+
+	if err == nil {
+		r.manager = mgr
+	}
+	return err
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+var (
+	peerClusterID = "cluster-b"
+
+	peerCIImport = &mcv1alpha1.ClusterInfoImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-default-clusterinfo",
+			Namespace: "default",
+		},
+		Spec: mcv1alpha1.ClusterInfo{
+			ClusterID:             peerClusterID,
+			ServiceCIDR:           "10.96.0.0/16",
+			MulticlusterTraceflow: true,
+		},
+	}
+
+	senderNodeResult = crdv1beta1.NodeResult{
+		Node: "node-a",
+		Observations: []crdv1beta1.Observation{
+			{Component: crdv1beta1.ComponentSpoofGuard, Action: crdv1beta1.ActionForwarded},
+			{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionForwardedToRemoteCluster},
+		},
+	}
+	receiverNodeResult = crdv1beta1.NodeResult{
+		Node: "node-b",
+		Observations: []crdv1beta1.Observation{
+			{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionDelivered},
+		},
+	}
+)
+
+func newMulticlusterTraceflow(phase crdv1beta1.TraceflowPhase) *crdv1beta1.Traceflow {
+	return &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source:       crdv1beta1.Source{Namespace: "default", Pod: "client"},
+			Destination:  crdv1beta1.Destination{Namespace: "default", Service: "antrea-mc-nginx"},
+			Multicluster: true,
+			Timeout:      30,
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase:        phase,
+			DataplaneTag: 1,
+			Results:      []crdv1beta1.NodeResult{senderNodeResult},
+		},
+	}
+}
+
+func newPeerTraceflowResourceExport(phase crdv1beta1.TraceflowPhase, results ...crdv1beta1.NodeResult) *mcv1alpha1.ResourceExport {
+	return &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-cluster-a-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+			Labels: map[string]string{
+				constants.SourceKind:      constants.TraceflowKind,
+				constants.SourceName:      "tf",
+				constants.SourceClusterID: peerClusterID,
+			},
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind:      constants.TraceflowKind,
+			ClusterID: peerClusterID,
+			Name:      "tf",
+			Traceflow: &mcv1alpha1.TraceflowExport{
+				SourceClusterID: common.LocalClusterID,
+				DataplaneTag:    1,
+				Timeout:         30,
+				Phase:           phase,
+				Results:         results,
+			},
+		},
+	}
+}
+
+func newTraceflowReconciler(fakeClient client.Client, commonArea commonarea.RemoteCommonArea) *TraceflowReconciler {
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+	mcReconciler.SetRemoteCommonArea(commonArea)
+	return NewTraceflowReconciler(fakeClient, common.TestScheme, "default", mcReconciler)
+}
+
+func TestTraceflowReconcilerMulticlusterTraceflow(t *testing.T) {
+	tf := newMulticlusterTraceflow(crdv1beta1.Pending)
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tf, peerCIImport).WithStatusSubresource(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowReconciler(fakeClient, commonArea)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf"}}
+	resExportName := types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-tf-traceflow"}
+
+	// The Traceflow is exported and stays in the Pending phase until the peer member cluster is ready.
+	_, err := r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	resExport := &mcv1alpha1.ResourceExport{}
+	require.NoError(t, fakeRemoteClient.Get(common.TestCtx, resExportName, resExport))
+	assert.Equal(t, mcv1alpha1.ResourceExportSpec{
+		Kind:      constants.TraceflowKind,
+		ClusterID: common.LocalClusterID,
+		Name:      "tf",
+		Traceflow: &mcv1alpha1.TraceflowExport{
+			SourceClusterID: common.LocalClusterID,
+			DataplaneTag:    1,
+			Timeout:         30,
+		},
+	}, resExport.Spec)
+	updatedTF := &crdv1beta1.Traceflow{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, req.NamespacedName, updatedTF))
+	assert.Equal(t, crdv1beta1.Pending, updatedTF.Status.Phase)
+
+	// The peer member cluster is ready.
+	require.NoError(t, fakeRemoteClient.Create(common.TestCtx, newPeerTraceflowResourceExport(crdv1beta1.Running)))
+	_, err = r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(common.TestCtx, req.NamespacedName, updatedTF))
+	assert.Equal(t, crdv1beta1.Running, updatedTF.Status.Phase)
+	assert.Equal(t, []crdv1beta1.NodeResult{senderNodeResult}, updatedTF.Status.Results)
+
+	// The results of the peer member cluster are merged.
+	peerResExport := &mcv1alpha1.ResourceExport{}
+	require.NoError(t, fakeRemoteClient.Get(common.TestCtx, types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-b-cluster-a-tf-traceflow"}, peerResExport))
+	peerResExport.Spec.Traceflow.Results = []crdv1beta1.NodeResult{receiverNodeResult}
+	require.NoError(t, fakeRemoteClient.Update(common.TestCtx, peerResExport))
+	_, err = r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(common.TestCtx, req.NamespacedName, updatedTF))
+	expectedPeerResult := receiverNodeResult
+	expectedPeerResult.Cluster = peerClusterID
+	assert.Equal(t, []crdv1beta1.NodeResult{senderNodeResult, expectedPeerResult}, updatedTF.Status.Results)
+
+	// The ResourceExport is deleted when the Traceflow is completed.
+	updatedTF.Status.Phase = crdv1beta1.Succeeded
+	require.NoError(t, fakeClient.Status().Update(common.TestCtx, updatedTF))
+	_, err = r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	err = fakeRemoteClient.Get(common.TestCtx, resExportName, resExport)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTraceflowReconcilerPeerFailure(t *testing.T) {
+	tf := newMulticlusterTraceflow(crdv1beta1.Running)
+	peerResExport := newPeerTraceflowResourceExport(crdv1beta1.Failed, receiverNodeResult)
+	peerResExport.Spec.Traceflow.Reason = "Traceflow timed out"
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tf, peerCIImport).WithStatusSubresource(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(peerResExport).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowReconciler(fakeClient, commonArea)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf"}}
+
+	// The failure in the peer member cluster is copied to the Traceflow.
+	_, err := r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	updatedTF := &crdv1beta1.Traceflow{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, req.NamespacedName, updatedTF))
+	assert.Equal(t, crdv1beta1.Failed, updatedTF.Status.Phase)
+	assert.Equal(t, "Traceflow failed in member cluster cluster-b: Traceflow timed out", updatedTF.Status.Reason)
+	expectedPeerResult := receiverNodeResult
+	expectedPeerResult.Cluster = peerClusterID
+	assert.Equal(t, []crdv1beta1.NodeResult{senderNodeResult, expectedPeerResult}, updatedTF.Status.Results)
+}
+
+func TestTraceflowReconcilerUnsupportedPeer(t *testing.T) {
+	tf := newMulticlusterTraceflow(crdv1beta1.Pending)
+	unsupportedCIImport := peerCIImport.DeepCopy()
+	unsupportedCIImport.Spec.MulticlusterTraceflow = false
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tf, unsupportedCIImport).WithStatusSubresource(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowReconciler(fakeClient, commonArea)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf"}}
+
+	// The Traceflow does not wait for the peer member cluster which does not trace multi-cluster Traceflows.
+	_, err := r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	updatedTF := &crdv1beta1.Traceflow{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, req.NamespacedName, updatedTF))
+	assert.Equal(t, crdv1beta1.Running, updatedTF.Status.Phase)
+}
+
+func TestTraceflowReconcilerDeleteTraceflow(t *testing.T) {
+	resExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-a-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(resExport).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowReconciler(fakeClient, commonArea)
+
+	_, err := r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf"}})
+	require.NoError(t, err)
+	err = fakeRemoteClient.Get(common.TestCtx, types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-tf-traceflow"}, resExport)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTraceflowReconcilerPeerTraceflow(t *testing.T) {
+	sourceResExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+		},
+	}
+	peerTF := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "antrea-mc-cluster-b-tf-traceflow"},
+		Spec: crdv1beta1.TraceflowSpec{
+			RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: peerClusterID, Traceflow: "tf", DataplaneTag: 1},
+			Timeout:      30,
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase:        crdv1beta1.Succeeded,
+			DataplaneTag: 1,
+			Results:      []crdv1beta1.NodeResult{receiverNodeResult},
+		},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: peerTF.Name}}
+
+	t.Run("report results", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(peerTF).Build()
+		fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(sourceResExport).Build()
+		commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+		r := newTraceflowReconciler(fakeClient, commonArea)
+
+		_, err := r.Reconcile(common.TestCtx, req)
+		require.NoError(t, err)
+		resExport := &mcv1alpha1.ResourceExport{}
+		require.NoError(t, fakeRemoteClient.Get(common.TestCtx, types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-cluster-b-tf-traceflow"}, resExport))
+		assert.Equal(t, common.LocalClusterID, resExport.Labels[constants.SourceClusterID])
+		assert.Equal(t, &mcv1alpha1.TraceflowExport{
+			SourceClusterID: peerClusterID,
+			DataplaneTag:    1,
+			Timeout:         30,
+			Phase:           crdv1beta1.Succeeded,
+			Results:         []crdv1beta1.NodeResult{receiverNodeResult},
+		}, resExport.Spec.Traceflow)
+	})
+
+	t.Run("source Traceflow is gone", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(peerTF).Build()
+		fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+		commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+		r := newTraceflowReconciler(fakeClient, commonArea)
+
+		_, err := r.Reconcile(common.TestCtx, req)
+		require.NoError(t, err)
+		err = fakeClient.Get(common.TestCtx, req.NamespacedName, &crdv1beta1.Traceflow{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestTraceflowResourceExportReconciler(t *testing.T) {
+	sourceResExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind:      constants.TraceflowKind,
+			ClusterID: peerClusterID,
+			Name:      "tf",
+			Traceflow: &mcv1alpha1.TraceflowExport{
+				SourceClusterID: peerClusterID,
+				DataplaneTag:    3,
+				Timeout:         30,
+			},
+		},
+	}
+	peerResExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-a-cluster-b-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(sourceResExport, peerResExport).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowResourceExportReconciler(fakeClient, common.LocalClusterID, "default", commonArea)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: common.LeaderNamespace, Name: sourceResExport.Name}}
+	tfName := types.NamespacedName{Name: "antrea-mc-cluster-b-tf-traceflow"}
+
+	// A Traceflow is created for the multi-cluster Traceflow of the peer member cluster.
+	_, err := r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	tf := &crdv1beta1.Traceflow{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, tfName, tf))
+	assert.Equal(t, crdv1beta1.TraceflowSpec{
+		RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: peerClusterID, Traceflow: "tf", DataplaneTag: 3},
+		Timeout:      30,
+	}, tf.Spec)
+
+	// The Traceflow and the ResourceExport reporting its results are deleted with the source ResourceExport.
+	require.NoError(t, fakeRemoteClient.Delete(common.TestCtx, sourceResExport))
+	_, err = r.Reconcile(common.TestCtx, req)
+	require.NoError(t, err)
+	err = fakeClient.Get(common.TestCtx, tfName, tf)
+	assert.True(t, apierrors.IsNotFound(err))
+	err = fakeRemoteClient.Get(common.TestCtx, types.NamespacedName{Namespace: common.LeaderNamespace, Name: peerResExport.Name}, peerResExport)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTraceflowResourceExportReconcilerPeerResults(t *testing.T) {
+	tf := newMulticlusterTraceflow(crdv1beta1.Pending)
+	resExport := newPeerTraceflowResourceExport(crdv1beta1.Running, receiverNodeResult)
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tf, peerCIImport).WithStatusSubresource(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(resExport).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowResourceExportReconciler(fakeClient, common.LocalClusterID, "default", commonArea)
+
+	_, err := r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: common.LeaderNamespace, Name: resExport.Name}})
+	require.NoError(t, err)
+	updatedTF := &crdv1beta1.Traceflow{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, types.NamespacedName{Name: "tf"}, updatedTF))
+	assert.Equal(t, crdv1beta1.Running, updatedTF.Status.Phase)
+	expectedPeerResult := receiverNodeResult
+	expectedPeerResult.Cluster = peerClusterID
+	assert.Equal(t, []crdv1beta1.NodeResult{senderNodeResult, expectedPeerResult}, updatedTF.Status.Results)
+}
+
+func TestTraceflowResourceExportReconcilerRejectedTraceflow(t *testing.T) {
+	sourceResExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-tf-traceflow",
+			Namespace: common.LeaderNamespace,
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind:      constants.TraceflowKind,
+			ClusterID: peerClusterID,
+			Name:      "tf",
+			Traceflow: &mcv1alpha1.TraceflowExport{
+				SourceClusterID: peerClusterID,
+				DataplaneTag:    3,
+				Timeout:         30,
+			},
+		},
+	}
+	rejectErr := apierrors.NewInvalid(schema.GroupKind{Group: "crd.antrea.io", Kind: "Traceflow"}, "antrea-mc-cluster-b-tf-traceflow",
+		field.ErrorList{field.Forbidden(field.NewPath("spec", "remoteSource"), "Multicluster feature is disabled")})
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return rejectErr
+		},
+	}).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(sourceResExport).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	r := newTraceflowResourceExportReconciler(fakeClient, common.LocalClusterID, "default", commonArea)
+
+	// The rejection of the Traceflow is reported to the source member cluster.
+	_, err := r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: common.LeaderNamespace, Name: sourceResExport.Name}})
+	require.NoError(t, err)
+	peerResExport := &mcv1alpha1.ResourceExport{}
+	require.NoError(t, fakeRemoteClient.Get(common.TestCtx, types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-cluster-b-tf-traceflow"}, peerResExport))
+	assert.Equal(t, &mcv1alpha1.TraceflowExport{
+		SourceClusterID: peerClusterID,
+		DataplaneTag:    3,
+		Timeout:         30,
+		Phase:           crdv1beta1.Failed,
+		Reason:          rejectErr.Error(),
+	}, peerResExport.Spec.Traceflow)
+}
@@ -170,6 +170,26 @@ func (r PodInterfaceResponse) SortRows() bool {
 	return true
 }
 
+// PolicyPacketCaptureResponse describes the response struct of policypacketcapture command.
+type PolicyPacketCaptureResponse struct {
+	Name       string `json:"name,omitempty"`
+	Packets    int32  `json:"packets"`
+	Size       int64  `json:"size"`
+	LastUpdate string `json:"lastUpdate,omitempty"`
+}
+
+func (r PolicyPacketCaptureResponse) GetTableHeader() []string {
+	return []string{"NAME", "PACKETS", "SIZE", "LAST-UPDATE"}
+}
+
+func (r PolicyPacketCaptureResponse) GetTableRow(_ int) []string {
+	return []string{r.Name, strconv.Itoa(int(r.Packets)), strconv.FormatInt(r.Size, 10), r.LastUpdate}
+}
+
+func (r PolicyPacketCaptureResponse) SortRows() bool {
+	return true
+}
+
 // ServiceExternalIPInfo contains the essential information for Services with type of Loadbalancer managed by Antrea.
 type ServiceExternalIPInfo struct {
 	ServiceName    string `json:"serviceName,omitempty" antctl:"name,Name of the Service"`
@@ -0,0 +1,73 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policypacketcapture
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// HandleFunc creates a http.HandlerFunc which uses an AgentNetworkPolicyInfoQuerier to query the
+// packet capture files of policy rules in current agent. Without the `name` parameter in URL, it
+// returns the list of the capture files. Otherwise it returns the content of the specific capture
+// file in pcap format.
+func HandleFunc(npq querier.AgentNetworkPolicyInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		if len(name) > 0 {
+			f, err := npq.OpenPolicyPacketCapture(name)
+			if err != nil {
+				if os.IsNotExist(err) {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			defer f.Close()
+			w.Header().Set("Content-Type", "application/vnd.tcpdump.pcap")
+			w.Header().Set("Content-Disposition", "attachment; filename="+name)
+			if _, err := io.Copy(w, f); err != nil {
+				klog.ErrorS(err, "Error when sending packet capture file", "name", name)
+			}
+			return
+		}
+		captures, err := npq.GetPolicyPacketCaptures()
+		if err != nil {
+			http.Error(w, "Failed to list packet captures: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp := []apis.PolicyPacketCaptureResponse{}
+		for _, capture := range captures {
+			resp = append(resp, apis.PolicyPacketCaptureResponse{
+				Name:       capture.Name,
+				Packets:    capture.Packets,
+				Size:       capture.Size,
+				LastUpdate: capture.LastUpdate.UTC().Format(time.RFC3339),
+			})
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policypacketcapture
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestListPolicyPacketCaptures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	npq.EXPECT().GetPolicyPacketCaptures().Return([]packetcapture.CaptureInfo{
+		{
+			Name:       "AntreaClusterNetworkPolicy_acnp1_rule1.pcap",
+			Packets:    2,
+			Size:       66,
+			LastUpdate: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, nil)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	HandleFunc(npq).ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var received []apis.PolicyPacketCaptureResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+	assert.Equal(t, []apis.PolicyPacketCaptureResponse{
+		{
+			Name:       "AntreaClusterNetworkPolicy_acnp1_rule1.pcap",
+			Packets:    2,
+			Size:       66,
+			LastUpdate: "2024-05-01T10:00:00Z",
+		},
+	}, received)
+}
+
+func TestGetPolicyPacketCapture(t *testing.T) {
+	tests := []struct {
+		name           string
+		file           io.ReadCloser
+		err            error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "existing capture",
+			file:           io.NopCloser(strings.NewReader("pcap")),
+			expectedStatus: http.StatusOK,
+			expectedBody:   "pcap",
+		},
+		{
+			name:           "missing capture",
+			err:            os.ErrNotExist,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid name",
+			err:            errors.New("invalid packet capture file name"),
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid packet capture file name\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+			npq.EXPECT().OpenPolicyPacketCapture("capture.pcap").Return(tt.file, tt.err)
+
+			req, err := http.NewRequest(http.MethodGet, "?name=capture.pcap", nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			HandleFunc(npq).ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, tt.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -56,6 +56,9 @@ const (
 	L7RedirectTargetPortName = "antrea-l7-tap0"
 	L7RedirectReturnPortName = "antrea-l7-tap1"
 	L7SuricataSocketPath     = "/var/run/suricata/suricata_eve.socket"
+	// L7SuricataRejectSocketPath is the socket on which the alert events of the requests rejected by Suricata are
+	// received, to respond to them.
+	L7SuricataRejectSocketPath = "/var/run/suricata/suricata_reject.socket"
 )
 
 const (
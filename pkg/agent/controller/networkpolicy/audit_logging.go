@@ -15,8 +15,10 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -41,6 +43,14 @@ const (
 	logfileSubdir   string = "networkpolicy"
 	logfileName     string = "np.log"
 	nullPlaceholder        = "<nil>"
+	// auditDispositionPrefix is prepended to the disposition of packets matching Drop or Reject rules of
+	// Antrea-native policies in Audit mode, which are not actually dropped or rejected.
+	auditDispositionPrefix = "Audit"
+	// defaultTierName is the Tier of the Antrea-native policies which do not specify one.
+	defaultTierName = "application"
+
+	AuditLogFormatText = "text"
+	AuditLogFormatJSON = "json"
 )
 
 // AuditLogger is used for network policy audit logging.
@@ -50,6 +60,12 @@ type AuditLogger struct {
 	clock            clock.Clock // enable the use of a "virtual" clock for unit tests
 	npLogger         *log.Logger
 	logDeduplication logRecordDedupMap
+	// format is the format of the log entries, AuditLogFormatText or AuditLogFormatJSON.
+	format string
+	// nodeName is the name of the Node, included in the JSON log entries.
+	nodeName string
+	// syslogWriter forwards the log entries to a syslog server, it is nil if forwarding is disabled.
+	syslogWriter *syslogWriter
 }
 
 type AuditLoggerOptions struct {
@@ -57,6 +73,13 @@ type AuditLoggerOptions struct {
 	MaxBackups int
 	MaxAge     int
 	Compress   bool
+	// Format is the format of the log entries, AuditLogFormatText or AuditLogFormatJSON.
+	Format string
+	// SyslogAddress is the address of the syslog server to forward the log entries to.
+	// Forwarding is disabled if it is empty.
+	SyslogAddress string
+	// SyslogTransport is the transport protocol used to forward the log entries, "udp" or "tcp".
+	SyslogTransport string
 }
 
 // logInfo will be set by retrieving info from packetin and register.
@@ -75,6 +98,45 @@ type logInfo struct {
 	destPort     string // destination port of the traffic logged
 	pktLength    string // packet length of packetin
 	protocolStr  string // protocol of the traffic logged
+	npUID        string // UID of the Network Policy, used to look up its Tier
+
+	// The following fields are only included in the JSON format.
+	tier             string // name of the Tier of the Antrea-native policy
+	srcPodNamespace  string // namespace of the source Pod
+	srcPodName       string // name of the source Pod
+	destPodNamespace string // namespace of the destination Pod
+	destPodName      string // name of the destination Pod
+	destService      string // Service accessed by the traffic logged, as <namespace>/<name>:<port>
+}
+
+// jsonLogEntry is the representation of a log entry in the JSON format. Unknown fields are
+// omitted instead of being replaced by placeholders.
+type jsonLogEntry struct {
+	Timestamp               string `json:"timestamp"`
+	Node                    string `json:"node,omitempty"`
+	Table                   string `json:"table"`
+	Policy                  string `json:"policy"`
+	Tier                    string `json:"tier,omitempty"`
+	Rule                    string `json:"rule,omitempty"`
+	Direction               string `json:"direction,omitempty"`
+	Disposition             string `json:"disposition"`
+	OFPriority              string `json:"ofPriority,omitempty"`
+	AppliedTo               string `json:"appliedTo,omitempty"`
+	SourceIP                string `json:"sourceIP"`
+	SourcePort              int    `json:"sourcePort,omitempty"`
+	SourcePodNamespace      string `json:"sourcePodNamespace,omitempty"`
+	SourcePodName           string `json:"sourcePodName,omitempty"`
+	DestinationIP           string `json:"destinationIP"`
+	DestinationPort         int    `json:"destinationPort,omitempty"`
+	DestinationPodNamespace string `json:"destinationPodNamespace,omitempty"`
+	DestinationPodName      string `json:"destinationPodName,omitempty"`
+	DestinationService      string `json:"destinationService,omitempty"`
+	Protocol                string `json:"protocol"`
+	PacketLength            int    `json:"packetLength"`
+	LogLabel                string `json:"logLabel,omitempty"`
+	// Packets is the number of duplicate packets logged by the entry, within Duration.
+	Packets  int64  `json:"packets"`
+	Duration string `json:"duration,omitempty"`
 }
 
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
@@ -82,6 +144,7 @@ type logDedupRecord struct {
 	count         int64            // record count of duplicate log
 	initTime      time.Time        // initial time upon receiving packet log
 	bufferTimerCh <-chan time.Time // 1 sec buffer for each log
+	ob            *logInfo         // log info of the first packet
 }
 
 // logRecordDedupMap includes a map of log buffers and a r/w mutex for accessing the map.
@@ -109,28 +172,43 @@ func (l *AuditLogger) terminateLogKey(logMsg string) {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	logRecord := l.logDeduplication.logMap[logMsg]
-	if logRecord.count == 1 {
-		l.npLogger.Printf(logMsg)
-	} else {
-		l.npLogger.Printf("%s [%d packets in %s]", logMsg, logRecord.count, time.Since(logRecord.initTime))
-	}
+	l.writeLog(logMsg, logRecord.ob, logRecord.count, time.Since(logRecord.initTime))
 	delete(l.logDeduplication.logMap, logMsg)
 }
 
 // updateLogKey initiates record or increases the count in logDeduplication corresponding to given logMsg.
-func (l *AuditLogger) updateLogKey(logMsg string, bufferLength time.Duration) bool {
+func (l *AuditLogger) updateLogKey(logMsg string, ob *logInfo, bufferLength time.Duration) bool {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	_, exists := l.logDeduplication.logMap[logMsg]
 	if exists {
 		l.logDeduplication.logMap[logMsg].count++
 	} else {
-		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength)}
+		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength), ob}
 		l.logDeduplication.logMap[logMsg] = &record
 	}
 	return exists
 }
 
+// writeLog writes the log entry of count packets received within duration, and forwards it
+// to the syslog server if configured.
+func (l *AuditLogger) writeLog(logMsg string, ob *logInfo, count int64, duration time.Duration) {
+	var entry string
+	if l.format == AuditLogFormatJSON {
+		entry = l.buildJSONLogMsg(ob, count, duration)
+		l.npLogger.Print(entry)
+	} else {
+		entry = logMsg
+		if count > 1 {
+			entry = fmt.Sprintf("%s [%d packets in %s]", logMsg, count, duration)
+		}
+		l.npLogger.Print(entry)
+	}
+	if l.syslogWriter != nil {
+		l.syslogWriter.enqueue(entry)
+	}
+}
+
 func buildLogMsg(ob *logInfo) string {
 	return strings.Join([]string{
 		ob.tableName,
@@ -150,15 +228,61 @@ func buildLogMsg(ob *logInfo) string {
 	}, " ")
 }
 
+// buildJSONLogMsg returns the log entry of ob in the JSON format.
+func (l *AuditLogger) buildJSONLogMsg(ob *logInfo, count int64, duration time.Duration) string {
+	valueOrEmpty := func(v string) string {
+		if v == nullPlaceholder {
+			return ""
+		}
+		return v
+	}
+	// Ports are placeholders for protocols without ports, in which case they are omitted.
+	srcPort, _ := strconv.Atoi(ob.srcPort)
+	destPort, _ := strconv.Atoi(ob.destPort)
+	pktLength, _ := strconv.Atoi(ob.pktLength)
+	entry := jsonLogEntry{
+		Timestamp:               l.clock.Now().UTC().Format(time.RFC3339Nano),
+		Node:                    l.nodeName,
+		Table:                   ob.tableName,
+		Policy:                  ob.npRef,
+		Tier:                    ob.tier,
+		Rule:                    valueOrEmpty(ob.ruleName),
+		Direction:               valueOrEmpty(ob.direction),
+		Disposition:             ob.disposition,
+		OFPriority:              valueOrEmpty(ob.ofPriority),
+		AppliedTo:               valueOrEmpty(ob.appliedToRef),
+		SourceIP:                ob.srcIP,
+		SourcePort:              srcPort,
+		SourcePodNamespace:      ob.srcPodNamespace,
+		SourcePodName:           ob.srcPodName,
+		DestinationIP:           ob.destIP,
+		DestinationPort:         destPort,
+		DestinationPodNamespace: ob.destPodNamespace,
+		DestinationPodName:      ob.destPodName,
+		DestinationService:      ob.destService,
+		Protocol:                ob.protocolStr,
+		PacketLength:            pktLength,
+		LogLabel:                valueOrEmpty(ob.logLabel),
+		Packets:                 count,
+	}
+	if count > 1 {
+		entry.Duration = duration.String()
+	}
+	// Marshalling cannot fail as all the fields are strings or integers.
+	b, _ := json.Marshal(entry)
+	return string(b)
+}
+
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
-	// Deduplicate non-Allow packet log.
+	// Deduplicate non-Allow packet log. The text message is used as the deduplication key for
+	// all formats.
 	logMsg := buildLogMsg(ob)
 	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
-		l.npLogger.Printf(logMsg)
+		l.writeLog(logMsg, ob, 1, 0)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
-		exists := l.updateLogKey(logMsg, l.bufferLength)
+		exists := l.updateLogKey(logMsg, ob, l.bufferLength)
 		if !exists {
 			// Go routine for logging when buffer timer stops.
 			go l.logAfterTimer(logMsg)
@@ -166,9 +290,17 @@ func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
 	}
 }
 
+// Run forwards the log entries to the syslog server, if configured, until stopCh is closed.
+func (l *AuditLogger) Run(stopCh <-chan struct{}) {
+	if l.syslogWriter == nil {
+		return
+	}
+	l.syslogWriter.run(stopCh)
+}
+
 // newAuditLogger is called while newing network policy agent controller.
 // Customize AuditLogger specifically for audit logging through agent configuration.
-func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
+func newAuditLogger(options *AuditLoggerOptions, nodeName string) (*AuditLogger, error) {
 	logDir := filepath.Join(logdir.GetLogDir(), logfileSubdir)
 	logFile := filepath.Join(logDir, logfileName)
 	_, err := os.Stat(logDir)
@@ -192,6 +324,15 @@ func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
 		clock:            clock.RealClock{},
 		npLogger:         log.New(logOutput, "", log.Ldate|log.Lmicroseconds),
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
+		format:           options.Format,
+		nodeName:         nodeName,
+	}
+	if options.Format == AuditLogFormatJSON {
+		// The JSON log entries include their own timestamp.
+		auditLogger.npLogger = log.New(logOutput, "", 0)
+	}
+	if options.SyslogAddress != "" {
+		auditLogger.syslogWriter = newSyslogWriter(options.SyslogTransport, options.SyslogAddress, nodeName)
 	}
 	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile, "options", options)
 	return auditLogger, nil
@@ -251,7 +392,9 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 	}
 
 	// Get K8s default deny action, if traffic is default deny, no conjunction could be matched.
-	if match = getMatchRegField(matchers, openflow.APDenyRegMark.GetField()); match != nil {
+	// Packets sent from the audit tables are never denied, but always match a conjunction.
+	isAudit := isAntreaPolicyAuditTable(tableID)
+	if match = getMatchRegField(matchers, openflow.APDenyRegMark.GetField()); match != nil && !isAudit {
 		apDenyRegVal, err := getInfoInReg(match, openflow.APDenyRegMark.GetField().GetRange().ToNXRange())
 		if err != nil {
 			return fmt.Errorf("received error while unloading deny mark from reg: %v", err)
@@ -278,6 +421,10 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 		return fmt.Errorf("networkpolicy not found for conjunction id: %v", conjID)
 	}
 	ob.npRef = npRef.ToString()
+	ob.npUID = string(npRef.UID)
+	if isAudit {
+		ob.disposition = auditDispositionPrefix + ob.disposition
+	}
 	ob.ofPriority = ofPriority
 	ob.ruleName = ruleName
 	ob.logLabel = logLabel
@@ -302,6 +449,47 @@ func getPacketInfo(packet *binding.Packet, ob *logInfo) {
 	}
 }
 
+// getWorkloadInfo fills in the Pods, Service and Tier of logInfo ob, which are only included in
+// the JSON format.
+func getWorkloadInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Controller, ob *logInfo) {
+	ob.srcPodNamespace, ob.srcPodName = c.GetPodByIP(packet.SourceIP)
+	ob.destPodNamespace, ob.destPodName = c.GetPodByIP(packet.DestinationIP)
+
+	if c.proxier != nil {
+		matchers := pktIn.GetMatches()
+		if getCTMarkValue(matchers)&openflow.ServiceCTMark.GetRange().ToNXRange().ToUint32Mask() == openflow.ServiceCTMark.GetValue() {
+			// The original destination of the connection is the Service IP and port.
+			if serviceIP := getCTNwDstValue(matchers); serviceIP.IsValid() {
+				serviceStr := fmt.Sprintf("%s:%d/%s", serviceIP, getCTTpDstValue(matchers), ob.protocolStr)
+				if servicePortName, exists := c.proxier.GetServiceByIP(serviceStr); exists {
+					ob.destService = servicePortName.String()
+				}
+			}
+		}
+	}
+
+	if ob.npUID != "" {
+		if policy := c.ruleCache.getNetworkPolicy(ob.npUID); policy != nil && v1beta2.IsSourceAntreaNativePolicy(policy.SourceRef) {
+			ob.tier = policy.Tier
+			if ob.tier == "" {
+				ob.tier = defaultTierName
+			}
+		}
+	}
+}
+
+// GetPodByIP returns the Namespace and name of the Pod with the IP. Pods running on the Node are
+// looked up in the interface store, other Pods among the AddressGroup members.
+func (c *Controller) GetPodByIP(ip net.IP) (string, string) {
+	if iface, ok := c.ifaceStore.GetInterfaceByIP(ip.String()); ok && iface.Type == interfacestore.ContainerInterface {
+		return iface.ContainerInterfaceConfig.PodNamespace, iface.ContainerInterfaceConfig.PodName
+	}
+	if pod := c.ruleCache.getPodByIP(ip); pod != nil {
+		return pod.Namespace, pod.Name
+	}
+	return "", ""
+}
+
 func fillLogInfoPlaceholders(logItems []*string) {
 	for i, v := range logItems {
 		if *v == "" {
@@ -326,6 +514,9 @@ func (c *Controller) logPacket(pktIn *ofctrl.PacketIn) error {
 		return fmt.Errorf("received error while retrieving NetworkPolicy info: %v", err)
 	}
 	getPacketInfo(packet, ob)
+	if c.auditLogger.format == AuditLogFormatJSON {
+		getWorkloadInfo(pktIn, packet, c, ob)
+	}
 
 	// Log the ob info to corresponding file w/ deduplication.
 	c.auditLogger.LogDedupPacket(ob)
@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -208,6 +209,146 @@ func TestRedirectPacketLog(t *testing.T) {
 	assert.Contains(t, actual, expected)
 }
 
+func TestJSONPacketLog(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2024, 3, 12, 8, 21, 34, 0, time.UTC))
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock)
+	auditLogger.format = AuditLogFormatJSON
+	auditLogger.nodeName = "node1"
+	auditLogger.npLogger = log.New(mockNPLogger, "", 0)
+
+	ob, _ := newLogInfo(actionAllow)
+	ob.direction = "Ingress"
+	ob.appliedToRef = "default/destPod"
+	ob.tier = "securityops"
+	ob.srcPodNamespace, ob.srcPodName = "default", "srcPod"
+	ob.destPodNamespace, ob.destPodName = "default", "destPod"
+	ob.destService = "default/svc:http"
+	auditLogger.LogDedupPacket(ob)
+	var entry jsonLogEntry
+	require.NoError(t, json.Unmarshal([]byte(<-mockNPLogger.logged), &entry))
+	assert.Equal(t, jsonLogEntry{
+		Timestamp:               "2024-03-12T08:21:34Z",
+		Node:                    "node1",
+		Table:                   openflow.AntreaPolicyIngressRuleTable.GetName(),
+		Policy:                  testANNPRef.ToString(),
+		Tier:                    "securityops",
+		Rule:                    "test-rule",
+		Direction:               "Ingress",
+		Disposition:             actionAllow,
+		OFPriority:              "0",
+		AppliedTo:               "default/destPod",
+		SourceIP:                "0.0.0.0",
+		SourcePort:              35402,
+		SourcePodNamespace:      "default",
+		SourcePodName:           "srcPod",
+		DestinationIP:           "1.1.1.1",
+		DestinationPort:         80,
+		DestinationPodNamespace: "default",
+		DestinationPodName:      "destPod",
+		DestinationService:      "default/svc:http",
+		Protocol:                "TCP",
+		PacketLength:            60,
+		LogLabel:                "test-label",
+		Packets:                 1,
+	}, entry)
+
+	// Duplicate Drop packets are logged in a single entry, and placeholders are omitted.
+	ob, _ = newLogInfo(actionDrop)
+	ob.srcPort, ob.destPort, ob.protocolStr, ob.logLabel = nullPlaceholder, nullPlaceholder, "ICMP", nullPlaceholder
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(time.Millisecond)
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(testBufferLength)
+	entry = jsonLogEntry{}
+	require.NoError(t, json.Unmarshal([]byte(<-mockNPLogger.logged), &entry))
+	assert.Equal(t, actionDrop, entry.Disposition)
+	assert.Equal(t, int64(2), entry.Packets)
+	assert.NotEmpty(t, entry.Duration)
+	assert.Zero(t, entry.SourcePort)
+	assert.Zero(t, entry.DestinationPort)
+	assert.Empty(t, entry.LogLabel)
+}
+
+func TestGetWorkloadInfo(t *testing.T) {
+	localIP := net.ParseIP("192.168.1.1")
+	remoteIP := net.ParseIP("192.168.2.1")
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("localPod", "ns1", "c1"),
+		IPs:                      []net.IP{localIP},
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "localPod", PodNamespace: "ns1", ContainerID: "c1"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 1},
+	})
+	ruleCache, _, _, _ := newFakeRuleCache()
+	ruleCache.addressSetByGroup["addressGroup1"] = v1beta2.NewGroupMemberSet(&v1beta2.GroupMember{
+		Pod: &v1beta2.PodReference{Name: "remotePod", Namespace: "ns2"},
+		IPs: []v1beta2.IPAddress{v1beta2.IPAddress(remoteIP)},
+	})
+	ruleCache.policyMap["uid1"] = &v1beta2.NetworkPolicy{
+		SourceRef: &v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaClusterNetworkPolicy, Name: "acnp1", UID: "uid1"},
+	}
+	ruleCache.policyMap["uid2"] = &v1beta2.NetworkPolicy{
+		SourceRef: &v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp1", UID: "uid2"},
+		Tier:      "securityops",
+	}
+	ruleCache.policyMap["uid3"] = &v1beta2.NetworkPolicy{
+		SourceRef: &v1beta2.NetworkPolicyReference{Type: v1beta2.K8sNetworkPolicy, Namespace: "ns1", Name: "np1", UID: "uid3"},
+	}
+	c := &Controller{ifaceStore: ifaceStore, ruleCache: ruleCache}
+	pktIn := &ofctrl.PacketIn{PacketIn: &openflow15.PacketIn{}}
+
+	tests := []struct {
+		name   string
+		packet *binding.Packet
+		npUID  string
+		wantOb *logInfo
+	}{
+		{
+			name:   "egress to remote Pod",
+			packet: &binding.Packet{SourceIP: localIP, DestinationIP: remoteIP},
+			npUID:  "uid1",
+			wantOb: &logInfo{
+				npUID:            "uid1",
+				tier:             "application",
+				srcPodNamespace:  "ns1",
+				srcPodName:       "localPod",
+				destPodNamespace: "ns2",
+				destPodName:      "remotePod",
+			},
+		},
+		{
+			name:   "ingress from external IP",
+			packet: &binding.Packet{SourceIP: net.ParseIP("10.0.0.1"), DestinationIP: localIP},
+			npUID:  "uid2",
+			wantOb: &logInfo{
+				npUID:            "uid2",
+				tier:             "securityops",
+				destPodNamespace: "ns1",
+				destPodName:      "localPod",
+			},
+		},
+		{
+			name:   "K8s NetworkPolicy",
+			packet: &binding.Packet{SourceIP: remoteIP, DestinationIP: localIP},
+			npUID:  "uid3",
+			wantOb: &logInfo{
+				npUID:            "uid3",
+				srcPodNamespace:  "ns2",
+				srcPodName:       "remotePod",
+				destPodNamespace: "ns1",
+				destPodName:      "localPod",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ob := &logInfo{npUID: tc.npUID}
+			getWorkloadInfo(pktIn, tc.packet, c, ob)
+			assert.Equal(t, tc.wantOb, ob)
+		})
+	}
+}
+
 func TestGetNetworkPolicyInfo(t *testing.T) {
 	prepareMockOFTablesWithCache()
 	generateMatch := func(regID int, data []byte) openflow15.MatchField {
@@ -259,6 +400,7 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 	})
 
 	antreaIngressRuleTableID := openflow.AntreaPolicyIngressRuleTable.GetID()
+	antreaIngressAuditRuleTableID := openflow.AntreaPolicyIngressAuditRuleTable.GetID()
 	tests := []struct {
 		name            string
 		tableID         uint8
@@ -419,6 +561,26 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 			},
 			tableIDInReg: &antreaIngressRuleTableID,
 		},
+		{
+			name:    "Antrea-native Policy Audit Drop from output table",
+			tableID: openflow.OutputTable.GetID(),
+			expectedCalls: func(mockClient *openflowtesting.MockClientMockRecorder) {
+				mockClient.GetPolicyInfoFromConjunction(gomock.Any()).Return(
+					true, testANNPRef, testPriority, testRule, testLogLabel)
+			},
+			dispositionData: dropCNPDispositionData,
+			wantOb: &logInfo{
+				tableName:    openflow.AntreaPolicyIngressAuditRuleTable.GetName(),
+				disposition:  auditDispositionPrefix + actionDrop,
+				npRef:        testANNPRef.ToString(),
+				ofPriority:   testPriority,
+				ruleName:     testRule,
+				direction:    "Ingress",
+				appliedToRef: "default/destPod",
+				logLabel:     testLogLabel,
+			},
+			tableIDInReg: &antreaIngressAuditRuleTableID,
+		},
 	}
 
 	for _, tc := range tests {
@@ -429,7 +591,7 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 			// Inject ingress/egress match when case is not K8s default drop.
 			if tc.expectedCalls != nil {
 				var regID int
-				if tc.wantOb.disposition == actionDrop {
+				if tc.wantOb.disposition == actionDrop || tc.wantOb.disposition == auditDispositionPrefix+actionDrop {
 					regID = openflow.APConjIDField.GetRegID()
 				} else if tc.wantOb.direction == "Ingress" {
 					regID = openflow.TFIngressConjIDField.GetRegID()
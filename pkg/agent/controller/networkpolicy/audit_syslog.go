@@ -0,0 +1,123 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// The audit log entries are sent with the local0 facility and the informational severity.
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "antrea-agent"
+	syslogMsgID    = "networkpolicy"
+	// syslogQueueSize is the maximum number of audit log entries waiting to be forwarded. The
+	// entries are dropped when the queue is full, so that a slow or unreachable syslog server
+	// never blocks the processing of packets.
+	syslogQueueSize = 1000
+	syslogTimeout   = 5 * time.Second
+)
+
+// syslogWriter forwards audit log entries to a syslog server in the RFC 5424 format. UDP
+// messages are sent as a single datagram, while TCP messages use the octet-counting framing
+// of RFC 6587.
+type syslogWriter struct {
+	transport string
+	address   string
+	hostname  string
+	clock     clock.Clock
+	dial      func(network, address string, timeout time.Duration) (net.Conn, error)
+	queue     chan string
+	conn      net.Conn
+}
+
+func newSyslogWriter(transport, address, hostname string) *syslogWriter {
+	if hostname == "" {
+		hostname = "-"
+	}
+	return &syslogWriter{
+		transport: transport,
+		address:   address,
+		hostname:  hostname,
+		clock:     clock.RealClock{},
+		dial:      net.DialTimeout,
+		queue:     make(chan string, syslogQueueSize),
+	}
+}
+
+// enqueue adds an audit log entry to the queue of entries to forward, unless the queue is
+// full.
+func (w *syslogWriter) enqueue(msg string) {
+	select {
+	case w.queue <- msg:
+	default:
+		klog.V(2).InfoS("Syslog queue is full, dropping audit log entry", "address", w.address)
+	}
+}
+
+// formatMessage returns the syslog message of an audit log entry, framed for the transport.
+func (w *syslogWriter) formatMessage(msg string) []byte {
+	// The structured data is always empty.
+	message := fmt.Sprintf("<%d>1 %s %s %s - %s - %s", syslogPriority, w.clock.Now().UTC().Format(time.RFC3339Nano),
+		w.hostname, syslogAppName, syslogMsgID, msg)
+	if w.transport == "tcp" {
+		message = fmt.Sprintf("%d %s", len(message), message)
+	}
+	return []byte(message)
+}
+
+func (w *syslogWriter) write(msg string) error {
+	if w.conn == nil {
+		conn, err := w.dial(w.transport, w.address, syslogTimeout)
+		if err != nil {
+			return err
+		}
+		w.conn = conn
+	}
+	// Deadlines are absolute times of the socket, independent of the clock of the writer.
+	w.conn.SetWriteDeadline(time.Now().Add(syslogTimeout))
+	if _, err := w.conn.Write(w.formatMessage(msg)); err != nil {
+		// Reconnect for the next entry.
+		w.conn.Close()
+		w.conn = nil
+		return err
+	}
+	return nil
+}
+
+// run forwards the queued audit log entries until stopCh is closed.
+func (w *syslogWriter) run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting forwarding audit logs to syslog server", "address", w.address, "transport", w.transport)
+	defer func() {
+		if w.conn != nil {
+			w.conn.Close()
+		}
+	}()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case msg := <-w.queue:
+			if err := w.write(msg); err != nil {
+				klog.ErrorS(err, "Failed to forward audit log entry to syslog server", "address", w.address)
+			}
+		}
+	}
+}
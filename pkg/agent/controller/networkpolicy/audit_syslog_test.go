@@ -0,0 +1,94 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newTestSyslogWriter(transport, address string) *syslogWriter {
+	w := newSyslogWriter(transport, address, "node1")
+	w.clock = clocktesting.NewFakeClock(time.Date(2024, 3, 12, 8, 21, 34, 123000000, time.UTC))
+	return w
+}
+
+func TestSyslogFormatMessage(t *testing.T) {
+	w := newTestSyslogWriter("udp", "")
+	assert.Equal(t, "<134>1 2024-03-12T08:21:34.123Z node1 antrea-agent - networkpolicy - msg", string(w.formatMessage("msg")))
+	w = newTestSyslogWriter("tcp", "")
+	assert.Equal(t, "72 <134>1 2024-03-12T08:21:34.123Z node1 antrea-agent - networkpolicy - msg", string(w.formatMessage("msg")))
+	w = newSyslogWriter("udp", "", "")
+	assert.Equal(t, "-", w.hostname)
+}
+
+func TestSyslogWriterUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	w := newTestSyslogWriter("udp", conn.LocalAddr().String())
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go w.run(stopCh)
+
+	w.enqueue("msg1")
+	w.enqueue("msg2")
+	buf := make([]byte, 1024)
+	for _, msg := range []string{"msg1", "msg2"} {
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "<134>1 2024-03-12T08:21:34.123Z node1 antrea-agent - networkpolicy - "+msg, string(buf[:n]))
+	}
+}
+
+func TestSyslogWriterTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	w := newTestSyslogWriter("tcp", listener.Addr().String())
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go w.run(stopCh)
+
+	w.enqueue("msg1")
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	length, err := reader.ReadString(' ')
+	require.NoError(t, err)
+	assert.Equal(t, "73 ", length)
+	buf := make([]byte, 73)
+	_, err = io.ReadFull(reader, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "<134>1 2024-03-12T08:21:34.123Z node1 antrea-agent - networkpolicy - msg1", string(buf))
+}
+
+func TestSyslogWriterQueueFull(t *testing.T) {
+	w := newTestSyslogWriter("udp", "127.0.0.1:514")
+	for i := 0; i < syslogQueueSize+1; i++ {
+		w.enqueue("msg")
+	}
+	assert.Len(t, w.queue, syslogQueueSize)
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 
@@ -100,6 +101,14 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// RateLimit of this rule. Only set when Action is RateLimit.
+	RateLimit *v1beta.RateLimit
+	// PacketCapture of this rule. Only set when Action is Drop or Reject.
+	PacketCapture *v1beta.RulePacketCapture
+	// RejectResponse of this rule. Only set when Action is Reject or for rules with L7Protocols.
+	RejectResponse crdv1beta1.RejectResponseType
+	// EnforcementMode of the NetworkPolicy to which this rule belongs. Empty means the rule is enforced.
+	EnforcementMode crdv1beta1.EnforcementMode
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -131,6 +140,12 @@ func (r *rule) Less(r2 *rule) bool {
 	return tierPriority1 > tierPriority2
 }
 
+// isAuditRule returns whether the rule belongs to an Antrea-native policy in Audit mode, in which case traffic matching
+// the rule is only logged and counted.
+func (r *rule) isAuditRule() bool {
+	return r.EnforcementMode == crdv1beta1.EnforcementModeAudit
+}
+
 // hashRule calculates a string based on the rule's content.
 func hashRule(r *rule) string {
 	hash := sha1.New() // #nosec G401: not used for security purposes
@@ -205,6 +220,10 @@ type ruleCache struct {
 	// addressSetByGroup stores the AddressGroup members.
 	// It is a mapping from group name to a set of GroupMembers.
 	addressSetByGroup map[string]v1beta.GroupMemberSet
+	// addressGroupPodsByIP indexes the Pods among the AddressGroup members by IP.
+	// It is a mapping from IP to the Pods with the IP, and for each Pod to the
+	// number of AddressGroups including it. It is protected by addressSetLock.
+	addressGroupPodsByIP map[string]map[v1beta.PodReference]int
 
 	policyMapLock sync.RWMutex
 	// policyMap is a map using NetworkPolicy UID as the key.
@@ -318,6 +337,20 @@ func (c *ruleCache) getEffectiveRulesByNetworkPolicy(uid string) []*rule {
 	return rules
 }
 
+// getRejectResponse returns the RejectResponse of the rule with the given
+// direction and name in the NetworkPolicy with the given UID, or an empty
+// response if there is no such rule.
+func (c *ruleCache) getRejectResponse(policyUID string, direction v1beta.Direction, ruleName string) crdv1beta1.RejectResponseType {
+	objs, _ := c.rules.ByIndex(policyIndex, policyUID)
+	for _, obj := range objs {
+		rule := obj.(*rule)
+		if rule.Direction == direction && rule.Name == ruleName {
+			return rule.RejectResponse
+		}
+	}
+	return ""
+}
+
 func (c *ruleCache) GetAddressGroups() []v1beta.AddressGroup {
 	var ret []v1beta.AddressGroup
 	c.addressSetLock.RLock()
@@ -336,6 +369,55 @@ func (c *ruleCache) GetAddressGroups() []v1beta.AddressGroup {
 	return ret
 }
 
+// getPodByIP returns the reference of the Pod with the IP among the AddressGroup members, or nil
+// if no Pod member has the IP.
+func (c *ruleCache) getPodByIP(ip net.IP) *v1beta.PodReference {
+	c.addressSetLock.RLock()
+	defer c.addressSetLock.RUnlock()
+	for pod := range c.addressGroupPodsByIP[ip.String()] {
+		return &pod
+	}
+	return nil
+}
+
+// updatePodIPIndexLocked adds the Pods among the provided AddressGroup members to
+// addressGroupPodsByIP when delta is 1, or removes them from it when delta is -1.
+// It must be called with addressSetLock held.
+func (c *ruleCache) updatePodIPIndexLocked(delta int, members ...*v1beta.GroupMember) {
+	for _, member := range members {
+		if member.Pod == nil {
+			continue
+		}
+		for _, memberIP := range member.IPs {
+			ip := net.IP(memberIP).String()
+			pods, exists := c.addressGroupPodsByIP[ip]
+			if !exists {
+				if delta < 0 {
+					continue
+				}
+				pods = make(map[v1beta.PodReference]int)
+				c.addressGroupPodsByIP[ip] = pods
+			}
+			pods[*member.Pod] += delta
+			if pods[*member.Pod] <= 0 {
+				delete(pods, *member.Pod)
+				if len(pods) == 0 {
+					delete(c.addressGroupPodsByIP, ip)
+				}
+			}
+		}
+	}
+}
+
+// updatePodIPIndexForGroupLocked adds the Pods among the members of an
+// AddressGroup to addressGroupPodsByIP when delta is 1, or removes them from it
+// when delta is -1. It must be called with addressSetLock held.
+func (c *ruleCache) updatePodIPIndexForGroupLocked(delta int, groupMemberSet v1beta.GroupMemberSet) {
+	for _, member := range groupMemberSet {
+		c.updatePodIPIndexLocked(delta, member)
+	}
+}
+
 func (c *ruleCache) GetAppliedToGroups() []v1beta.AppliedToGroup {
 	var ret []v1beta.AppliedToGroup
 	c.appliedToSetLock.RLock()
@@ -426,12 +508,13 @@ func newRuleCache(dirtyRuleHandler func(string), podUpdateSubscriber channel.Sub
 		},
 	)
 	cache := &ruleCache{
-		appliedToSetByGroup: make(map[string]v1beta.GroupMemberSet),
-		addressSetByGroup:   make(map[string]v1beta.GroupMemberSet),
-		policyMap:           make(map[string]*v1beta.NetworkPolicy),
-		rules:               rules,
-		dirtyRuleHandler:    dirtyRuleHandler,
-		groupIDUpdates:      serviceGroupIDUpdate,
+		appliedToSetByGroup:  make(map[string]v1beta.GroupMemberSet),
+		addressSetByGroup:    make(map[string]v1beta.GroupMemberSet),
+		addressGroupPodsByIP: make(map[string]map[v1beta.PodReference]int),
+		policyMap:            make(map[string]*v1beta.NetworkPolicy),
+		rules:                rules,
+		dirtyRuleHandler:     dirtyRuleHandler,
+		groupIDUpdates:       serviceGroupIDUpdate,
 	}
 	if nodeType == config.K8sNode {
 		// Subscribe Pod update events from CNIServer.
@@ -525,6 +608,7 @@ func (c *ruleCache) ReplaceAddressGroups(groups []*v1beta.AddressGroup) {
 	}
 
 	for key := range oldGroupKeys {
+		c.updatePodIPIndexForGroupLocked(-1, c.addressSetByGroup[key])
 		delete(c.addressSetByGroup, key)
 	}
 	return
@@ -555,6 +639,8 @@ func (c *ruleCache) addAddressGroupLocked(group *v1beta.AddressGroup) error {
 	if exists && oldGroupMemberSet.Equal(groupMemberSet) {
 		return nil
 	}
+	c.updatePodIPIndexForGroupLocked(-1, oldGroupMemberSet)
+	c.updatePodIPIndexForGroupLocked(1, groupMemberSet)
 	c.addressSetByGroup[group.Name] = groupMemberSet
 	c.onAddressGroupUpdate(group.Name)
 	return nil
@@ -572,9 +658,15 @@ func (c *ruleCache) PatchAddressGroup(patch *v1beta.AddressGroupPatch) (*v1beta.
 		return nil, fmt.Errorf("AddressGroup %v doesn't exist in cache, can't be patched", patch.Name)
 	}
 	for i := range patch.AddedGroupMembers {
+		if !groupMemberSet.Has(&patch.AddedGroupMembers[i]) {
+			c.updatePodIPIndexLocked(1, &patch.AddedGroupMembers[i])
+		}
 		groupMemberSet.Insert(&patch.AddedGroupMembers[i])
 	}
 	for i := range patch.RemovedGroupMembers {
+		if groupMemberSet.Has(&patch.RemovedGroupMembers[i]) {
+			c.updatePodIPIndexLocked(-1, &patch.RemovedGroupMembers[i])
+		}
 		groupMemberSet.Delete(&patch.RemovedGroupMembers[i])
 	}
 
@@ -598,6 +690,7 @@ func (c *ruleCache) DeleteAddressGroup(group *v1beta.AddressGroup) error {
 	c.addressSetLock.Lock()
 	defer c.addressSetLock.Unlock()
 
+	c.updatePodIPIndexForGroupLocked(-1, c.addressSetByGroup[group.Name])
 	delete(c.addressSetByGroup, group.Name)
 	return nil
 }
@@ -721,6 +814,10 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		RateLimit:       r.RateLimit,
+		PacketCapture:   r.PacketCapture,
+		RejectResponse:  r.RejectResponse,
+		EnforcementMode: policy.EnforcementMode,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
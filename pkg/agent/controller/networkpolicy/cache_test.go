@@ -22,12 +22,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -1135,6 +1137,52 @@ func TestRuleCachePatchAddressGroup(t *testing.T) {
 	}
 }
 
+func TestRuleCacheGetPodByIP(t *testing.T) {
+	c, _, _, _ := newFakeRuleCache()
+	pod1 := newAddressGroupPodMember("pod1", "ns1", "1.1.1.1", "fd00::1")
+	pod2 := newAddressGroupPodMember("pod2", "ns1", "2.2.2.2")
+	require.NoError(t, c.AddAddressGroup(&v1beta2.AddressGroup{
+		ObjectMeta:   metav1.ObjectMeta{Name: "group1"},
+		GroupMembers: []v1beta2.GroupMember{*pod1, *newAddressGroupMember("3.3.3.3")},
+	}))
+	require.NoError(t, c.AddAddressGroup(&v1beta2.AddressGroup{
+		ObjectMeta:   metav1.ObjectMeta{Name: "group2"},
+		GroupMembers: []v1beta2.GroupMember{*pod1},
+	}))
+	assert.Equal(t, pod1.Pod, c.getPodByIP(net.ParseIP("1.1.1.1")))
+	assert.Equal(t, pod1.Pod, c.getPodByIP(net.ParseIP("fd00::1")))
+	assert.Nil(t, c.getPodByIP(net.ParseIP("3.3.3.3")))
+
+	_, err := c.PatchAddressGroup(&v1beta2.AddressGroupPatch{
+		ObjectMeta:          metav1.ObjectMeta{Name: "group1"},
+		AddedGroupMembers:   []v1beta2.GroupMember{*pod2},
+		RemovedGroupMembers: []v1beta2.GroupMember{*pod1},
+	})
+	require.NoError(t, err)
+	// pod1 is still a member of group2.
+	assert.Equal(t, pod1.Pod, c.getPodByIP(net.ParseIP("1.1.1.1")))
+	assert.Equal(t, pod2.Pod, c.getPodByIP(net.ParseIP("2.2.2.2")))
+
+	require.NoError(t, c.DeleteAddressGroup(&v1beta2.AddressGroup{ObjectMeta: metav1.ObjectMeta{Name: "group2"}}))
+	assert.Nil(t, c.getPodByIP(net.ParseIP("1.1.1.1")))
+	assert.Nil(t, c.getPodByIP(net.ParseIP("fd00::1")))
+
+	c.ReplaceAddressGroups(nil)
+	assert.Nil(t, c.getPodByIP(net.ParseIP("2.2.2.2")))
+	assert.Empty(t, c.addressGroupPodsByIP)
+}
+
+func TestRuleCacheGetRejectResponse(t *testing.T) {
+	c, _, _, _ := newFakeRuleCache()
+	c.rules.Add(&rule{ID: "rule1", Name: "reject-http", Direction: v1beta2.DirectionIn, PolicyUID: "policy1", RejectResponse: crdv1beta1.RejectResponseHTTPForbidden})
+	c.rules.Add(&rule{ID: "rule2", Name: "reject-icmp", Direction: v1beta2.DirectionOut, PolicyUID: "policy1", RejectResponse: crdv1beta1.RejectResponseICMPHostUnreachable})
+
+	assert.Equal(t, crdv1beta1.RejectResponseHTTPForbidden, c.getRejectResponse("policy1", v1beta2.DirectionIn, "reject-http"))
+	assert.Equal(t, crdv1beta1.RejectResponseICMPHostUnreachable, c.getRejectResponse("policy1", v1beta2.DirectionOut, "reject-icmp"))
+	assert.Empty(t, c.getRejectResponse("policy1", v1beta2.DirectionIn, "reject-icmp"))
+	assert.Empty(t, c.getRejectResponse("policy2", v1beta2.DirectionIn, "reject-http"))
+}
+
 func TestRuleCacheUpdateNetworkPolicy(t *testing.T) {
 	networkPolicyRule1 := &v1beta2.NetworkPolicyRule{
 		Direction: v1beta2.DirectionIn,
@@ -21,10 +21,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/miekg/dns"
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -32,6 +34,7 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 const (
@@ -46,8 +49,18 @@ const (
 
 	protocolHTTP = "http"
 	protocolTLS  = "tls"
+	// gRPC requests are matched as HTTP/2 requests.
+	protocolGRPC = "http2"
+	protocolDNS  = "dns"
 
 	scCmdOK = "OK"
+
+	// The metadata keys of the policy and the rule in the rules rejecting traffic.
+	metadataKeyPolicy = "antrea_policy"
+	metadataKeyRule   = "antrea_rule"
+	// The metadata key of the reject response in the rules rejecting traffic, which is set when the response is sent
+	// by the rejectResponder instead of Suricata.
+	metadataKeyRejectResponse = "antrea_reject_response"
 )
 
 type scCmdRet struct {
@@ -92,8 +105,23 @@ outputs:
       xff:
         enabled: no
       types:
+        - alert:
+            metadata: yes
         - http:
             extended: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[4]s
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - alert:
+            metadata: yes
+            packet: yes
 af-packet:
   - interface: %[2]s
     threads: auto
@@ -118,7 +146,7 @@ af-packet:
 multi-detect:
   enabled: yes
   selector: vlan
-`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName)
+`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName, config.L7SuricataRejectSocketPath)
 )
 
 type threadSafeInt32Set struct {
@@ -146,8 +174,9 @@ func (g *threadSafeInt32Set) delete(key uint32) {
 
 type Reconciler struct {
 	// Declared as member variables for testing.
-	startSuricataFn func()
-	suricataScFn    func(scCmd string) (*scCmdRet, error)
+	startSuricataFn        func()
+	suricataScFn           func(scCmd string) (*scCmdRet, error)
+	startRejectResponderFn func()
 
 	suricataTenantCache        *threadSafeInt32Set
 	suricataTenantHandlerCache *threadSafeInt32Set
@@ -156,9 +185,13 @@ type Reconciler struct {
 }
 
 func NewReconciler() *Reconciler {
+	rejectResponder := newRejectResponder()
 	return &Reconciler{
 		suricataScFn:    suricataSc,
 		startSuricataFn: startSuricata,
+		startRejectResponderFn: func() {
+			go wait.Until(rejectResponder.listenAndAcceptConn, 5*time.Second, wait.NeverStop)
+		},
 		suricataTenantCache: &threadSafeInt32Set{
 			cached: sets.New[int32](),
 		},
@@ -168,7 +201,7 @@ func NewReconciler() *Reconciler {
 	}
 }
 
-func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string], enableLogging bool) *bytes.Buffer {
+func generateTenantRulesData(policyName, ruleName string, protoKeywords map[string]sets.Set[string], enableLogging bool, rejectResponse crdv1beta1.RejectResponseType) *bytes.Buffer {
 	rulesData := bytes.NewBuffer(nil)
 	sid := 1
 
@@ -179,11 +212,35 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 		tagKeyword = " tag: session, 30, seconds;"
 	}
 
-	// Generate default reject rule.
-	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established;%s sid: %d;`, policyName, tagKeyword, sid)
-	rule := fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
+	// The policy and the rule are added as the metadata of the reject rules, which is included in the alert events of
+	// the rejected traffic.
+	metadataKeyword := fmt.Sprintf(" metadata: %s %s", metadataKeyPolicy, policyName)
+	if ruleName != "" {
+		metadataKeyword += fmt.Sprintf(", %s %s", metadataKeyRule, ruleName)
+	}
+	udpMetadataKeyword := metadataKeyword + ";"
+
+	// Generate default reject rule. By default, Suricata resets the connections of the rejected traffic. For the
+	// HTTPForbidden reject response, only the connections to the servers are reset by Suricata, and the alert events
+	// of the rejected requests are handled by the rejectResponder, which responds to the clients.
+	rejectAction := "reject"
+	if rejectResponse == crdv1beta1.RejectResponseHTTPForbidden {
+		rejectAction = "rejectdst"
+		metadataKeyword += fmt.Sprintf(", %s %s", metadataKeyRejectResponse, rejectResponse)
+	}
+	metadataKeyword += ";"
+	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established;%s%s sid: %d;`, policyName, metadataKeyword, tagKeyword, sid)
+	rule := fmt.Sprintf("%s ip any any -> any any (%s)\n", rejectAction, allKeywords)
 	rulesData.WriteString(rule)
 	sid++
+	// A UDP flow is established only after packets are seen in both directions, generate a default reject rule for
+	// the requests of UDP flows as they could be DNS queries.
+	if _, ok := protoKeywords[protocolDNS]; ok {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server;%s%s sid: %d;`, policyName, udpMetadataKeyword, tagKeyword, sid)
+		rule = fmt.Sprintf("reject udp any any -> any any (%s)\n", allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
 
 	// Generate rules.
 	for proto, keywordsSet := range protoKeywords {
@@ -244,6 +301,44 @@ func convertContent(content string) string {
 	return fmt.Sprintf(`content:"%s";%s%s`, content, startsWith, endsWith)
 }
 
+// escapeOptionValue escapes the characters which are reserved in the option values of Suricata rules.
+func escapeOptionValue(pattern string) string {
+	return strings.NewReplacer(`"`, `\"`, `;`, `\;`).Replace(pattern)
+}
+
+// escapeContent escapes the characters which are reserved in the content option of Suricata rules, by replacing them
+// with their hexadecimal notation. The names of headers are validated by the Antrea Controller, but they are escaped
+// here so that an unexpected name can never change the generated rule.
+func escapeContent(content string) string {
+	return strings.NewReplacer(`"`, `|22|`, `;`, `|3b|`, `\`, `|5c|`, `|`, `|7c|`).Replace(content)
+}
+
+// convertStringMatch converts a string match to a PCRE pattern matching the whole value. The pattern is followed by
+// the given terminator unless it is a prefix match.
+func convertStringMatch(matchType crdv1beta1.StringMatchType, value, terminator string) string {
+	switch matchType {
+	case crdv1beta1.StringMatchPrefix:
+		return regexp.QuoteMeta(value)
+	case crdv1beta1.StringMatchRegex:
+		return fmt.Sprintf("(?:%s)%s", value, terminator)
+	default:
+		return regexp.QuoteMeta(value) + terminator
+	}
+}
+
+// convertHTTPHeader converts a header match to Suricata keywords. The http.header buffer contains one "Name: Value"
+// line for each header, and header names are matched case-insensitively.
+func convertHTTPHeader(header *v1beta.HTTPHeaderMatch) string {
+	pattern := fmt.Sprintf(`^(?i:%s): %s`, regexp.QuoteMeta(header.Name), convertStringMatch(header.Type, header.Value, `\r?$`))
+	return fmt.Sprintf(`http.header; content:"%s|3a 20|"; nocase; pcre:"/%s/m";`, escapeContent(header.Name), escapeOptionValue(pattern))
+}
+
+// convertHTTPQueryParam converts a query parameter match to Suricata keywords matching the query string of the URI.
+func convertHTTPQueryParam(param *v1beta.HTTPQueryParamMatch) string {
+	pattern := fmt.Sprintf(`[?&]%s=%s`, regexp.QuoteMeta(param.Name), convertStringMatch(param.Type, param.Value, `(?:&|$)`))
+	return fmt.Sprintf(`http.uri; pcre:"/%s/";`, escapeOptionValue(pattern))
+}
+
 func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	var keywords []string
 	if http.Path != "" {
@@ -255,6 +350,30 @@ func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	if http.Host != "" {
 		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
 	}
+	for i := range http.Headers {
+		keywords = append(keywords, convertHTTPHeader(&http.Headers[i]))
+	}
+	for i := range http.QueryParams {
+		keywords = append(keywords, convertHTTPQueryParam(&http.QueryParams[i]))
+	}
+	return strings.Join(keywords, " ")
+}
+
+// convertProtocolGRPC converts a gRPC protocol to Suricata keywords. gRPC requests are HTTP/2 requests with content
+// type "application/grpc" and path "/<service>/<method>", the metadata of which is sent as HTTP/2 headers.
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	keywords := []string{`http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`}
+	switch {
+	case grpc.Service != "" && grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/%s"; startswith; endswith;`, grpc.Service, grpc.Method))
+	case grpc.Service != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/"; startswith;`, grpc.Service))
+	case grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s"; endswith;`, grpc.Method))
+	}
+	for i := range grpc.Headers {
+		keywords = append(keywords, convertHTTPHeader(&grpc.Headers[i]))
+	}
 	return strings.Join(keywords, " ")
 }
 
@@ -266,13 +385,49 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// convertDNSRecordTypes converts the record types of DNS queries to Suricata keywords for a transport protocol. As there
+// is no keyword for the record type of queries, it is matched with the type of the first question following the
+// queried name, which starts after the 12-byte header of DNS messages. DNS messages over TCP are prefixed with a
+// 2-byte length.
+func convertDNSRecordTypes(recordTypes []string, ipProto uint8) string {
+	offset := 12
+	if ipProto == 6 {
+		offset += 2
+	}
+	var types []string
+	for _, recordType := range recordTypes {
+		t := dns.StringToType[strings.ToUpper(recordType)]
+		types = append(types, fmt.Sprintf(`\x%02x\x%02x`, t>>8, t&0xff))
+	}
+	return fmt.Sprintf(`ip_proto:%d; pcre:"/^.{%d}[^\x00]*\x00(?:%s)/s";`, ipProto, offset, strings.Join(types, "|"))
+}
+
+// convertProtocolDNS converts a DNS protocol to Suricata keywords. When record types are provided, there are separate
+// keywords for DNS over UDP and DNS over TCP.
+func convertProtocolDNS(dnsProtocol *v1beta.DNSProtocol) []string {
+	var nameKeywords string
+	if dnsProtocol.Name != "" {
+		nameKeywords = fmt.Sprintf("dns.query; %s nocase;", convertContent(dnsProtocol.Name))
+	}
+	if len(dnsProtocol.RecordTypes) == 0 {
+		return []string{nameKeywords}
+	}
+	var keywords []string
+	for _, ipProto := range []uint8{17, 6} {
+		// The record types must be matched before the sticky buffer dns.query, which applies to all the
+		// subsequent keywords.
+		keywords = append(keywords, strings.TrimSpace(convertDNSRecordTypes(dnsProtocol.RecordTypes, ipProto)+" "+nameKeywords))
+	}
+	return keywords
+}
+
 func (r *Reconciler) StartSuricataOnce() {
 	r.once.Do(func() {
 		r.startSuricata()
 	})
 }
 
-func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, enableLogging bool) error {
+func (r *Reconciler) AddRule(ruleID, policyName, ruleName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, enableLogging bool, rejectResponse crdv1beta1.RejectResponseType) error {
 	start := time.Now()
 	defer func() {
 		klog.V(5).Infof("AddRule took %v", time.Since(start))
@@ -297,12 +452,26 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 			}
 			protoKeywords[protocolTLS].Insert(tlsKeywords)
 		}
+		if protocol.GRPC != nil {
+			grpcKeywords := convertProtocolGRPC(protocol.GRPC)
+			if _, ok := protoKeywords[protocolGRPC]; !ok {
+				protoKeywords[protocolGRPC] = sets.New[string]()
+			}
+			protoKeywords[protocolGRPC].Insert(grpcKeywords)
+		}
+		if protocol.DNS != nil {
+			dnsKeywords := convertProtocolDNS(protocol.DNS)
+			if _, ok := protoKeywords[protocolDNS]; !ok {
+				protoKeywords[protocolDNS] = sets.New[string]()
+			}
+			protoKeywords[protocolDNS].Insert(dnsKeywords...)
+		}
 	}
 
 	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
 	// Write the Suricata rules to file.
 	rulesPath := generateTenantRulesPath(vlanID)
-	rulesData := generateTenantRulesData(policyName, protoKeywords, enableLogging)
+	rulesData := generateTenantRulesData(policyName, ruleName, protoKeywords, enableLogging, rejectResponse)
 	if err := writeConfigFile(rulesPath, rulesData); err != nil {
 		return fmt.Errorf("failed to write Suricata rules data to file %s for L7 rule %s of %s, err: %w", rulesPath, ruleID, policyName, err)
 	}
@@ -492,6 +661,8 @@ func (r *Reconciler) startSuricata() {
 		return
 	}
 
+	// Start the responder before Suricata, which connects to its socket to send the alert events.
+	r.startRejectResponderFn()
 	r.startSuricataFn()
 
 	// Wait Suricata command socket file to be ready.
@@ -22,11 +22,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 type fakeSuricata struct {
-	calledScCommands      sets.Set[string]
-	startSuricataFnCalled bool
+	calledScCommands             sets.Set[string]
+	startSuricataFnCalled        bool
+	startRejectResponderFnCalled bool
 }
 
 func newFakeSuricata() *fakeSuricata {
@@ -46,6 +48,10 @@ func (f *fakeSuricata) startSuricataFn() {
 	defaultFS.Create(suricataCommandSocket)
 }
 
+func (f *fakeSuricata) startRejectResponderFn() {
+	f.startRejectResponderFnCalled = true
+}
+
 func TestConvertProtocolHTTP(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -81,6 +87,39 @@ func TestConvertProtocolHTTP(t *testing.T) {
 			},
 			expected: `http.host; content:".foo.";`,
 		},
+		{
+			name: "with headers",
+			http: &v1beta.HTTPProtocol{
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "X-Tenant", Type: crdv1beta1.StringMatchExact, Value: "tenant.1"},
+					{Name: "Content-Type", Type: crdv1beta1.StringMatchPrefix, Value: "application/json;"},
+					{Name: "User-Agent", Type: crdv1beta1.StringMatchRegex, Value: "curl/[0-9.]+"},
+				},
+			},
+			expected: `http.header; content:"X-Tenant|3a 20|"; nocase; pcre:"/^(?i:X-Tenant): tenant\.1\r?$/m"; ` +
+				`http.header; content:"Content-Type|3a 20|"; nocase; pcre:"/^(?i:Content-Type): application/json\;/m"; ` +
+				`http.header; content:"User-Agent|3a 20|"; nocase; pcre:"/^(?i:User-Agent): (?:curl/[0-9.]+)\r?$/m";`,
+		},
+		{
+			name: "with header name containing reserved characters",
+			http: &v1beta.HTTPProtocol{
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: `X-A|B\"C;D`, Type: crdv1beta1.StringMatchExact, Value: "v"},
+				},
+			},
+			expected: `http.header; content:"X-A|7c|B|5c||22|C|3b|D|3a 20|"; nocase; pcre:"/^(?i:X-A\|B\\\"C\;D): v\r?$/m";`,
+		},
+		{
+			name: "with path and query parameters",
+			http: &v1beta.HTTPProtocol{
+				Path: "/api/*",
+				QueryParams: []v1beta.HTTPQueryParamMatch{
+					{Name: "tenant", Type: crdv1beta1.StringMatchExact, Value: "t1"},
+					{Name: "version", Type: crdv1beta1.StringMatchRegex, Value: "v[12]"},
+				},
+			},
+			expected: `http.uri; content:"/api/"; startswith; http.uri; pcre:"/[?&]tenant=t1(?:&|$)/"; http.uri; pcre:"/[?&]version=(?:v[12])(?:&|$)/";`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -115,6 +154,89 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`,
+		},
+		{
+			name:     "with service,method",
+			grpc:     &v1beta.GRPCProtocol{Service: "helloworld.Greeter", Method: "SayHello"},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith;`,
+		},
+		{
+			name:     "with service",
+			grpc:     &v1beta.GRPCProtocol{Service: "helloworld.Greeter"},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/"; startswith;`,
+		},
+		{
+			name:     "with method",
+			grpc:     &v1beta.GRPCProtocol{Method: "SayHello"},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/SayHello"; endswith;`,
+		},
+		{
+			name: "with service,headers",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+				Headers: []v1beta.HTTPHeaderMatch{{Name: "x-tenant", Type: crdv1beta1.StringMatchExact, Value: "tenant1"}},
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/"; startswith; ` +
+				`http.header; content:"x-tenant|3a 20|"; nocase; pcre:"/^(?i:x-tenant): tenant1\r?$/m";`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dns      *v1beta.DNSProtocol
+		expected []string
+	}{
+		{
+			name:     "without name,recordTypes",
+			dns:      &v1beta.DNSProtocol{},
+			expected: []string{""},
+		},
+		{
+			name:     "with name suffix",
+			dns:      &v1beta.DNSProtocol{Name: "*.svc.cluster.local"},
+			expected: []string{`dns.query; content:".svc.cluster.local"; endswith; nocase;`},
+		},
+		{
+			name: "with name,recordTypes",
+			dns:  &v1beta.DNSProtocol{Name: "foo.com", RecordTypes: []string{"A", "aaaa", "CAA"}},
+			expected: []string{
+				`ip_proto:17; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x01|\x00\x1c|\x01\x01)/s"; dns.query; content:"foo.com"; startswith; endswith; nocase;`,
+				`ip_proto:6; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x01|\x00\x1c|\x01\x01)/s"; dns.query; content:"foo.com"; startswith; endswith; nocase;`,
+			},
+		},
+		{
+			name: "with recordTypes",
+			dns:  &v1beta.DNSProtocol{RecordTypes: []string{"TXT"}},
+			expected: []string{
+				`ip_proto:17; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x10)/s";`,
+				`ip_proto:6; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x10)/s";`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolDNS(tc.dns))
+		})
+	}
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -128,8 +250,10 @@ func TestStartSuricata(t *testing.T) {
 	fs := newFakeSuricata()
 	fe.suricataScFn = fs.suricataScFunc
 	fe.startSuricataFn = fs.startSuricataFn
+	fe.startRejectResponderFn = fs.startRejectResponderFn
 
 	fe.startSuricata()
+	assert.True(t, fs.startRejectResponderFnCalled)
 
 	ok, err := afero.FileContainsBytes(defaultFS, antreaSuricataConfigPath, []byte(suricataAntreaConfigData))
 	assert.NoError(t, err)
@@ -144,10 +268,12 @@ func TestRuleLifecycle(t *testing.T) {
 	ruleID := "123456"
 	vlanID := uint32(1)
 	policyName := "AntreaNetworkPolicy:test-l7"
+	ruleName := "allow-l7"
 
 	testCases := []struct {
 		name                 string
 		l7Protocols          []v1beta.L7Protocol
+		rejectResponse       crdv1beta1.RejectResponseType
 		updatedL7Protocols   []v1beta.L7Protocol
 		expectedRules        string
 		expectedUpdatedRules string
@@ -168,9 +294,65 @@ func TestRuleLifecycle(t *testing.T) {
 					HTTP: &v1beta.HTTPProtocol{},
 				},
 			},
-			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
+			expectedRules: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; metadata: antrea_policy AntreaNetworkPolicy:test-l7, antrea_rule allow-l7; sid: 1;)
+pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol HTTP with HTTPForbidden reject response",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{
+						Path: "/public/*",
+					},
+				},
+			},
+			rejectResponse: crdv1beta1.RejectResponseHTTPForbidden,
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{},
+				},
+			},
+			expectedRules: `rejectdst ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; metadata: antrea_policy AntreaNetworkPolicy:test-l7, antrea_rule allow-l7, antrea_reject_response HTTPForbidden; sid: 1;)
+pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/public/"; startswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
+		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						Name: "*.cluster.local",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{},
+				},
+			},
+			expectedRules: `reject udp any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server; metadata: antrea_policy AntreaNetworkPolicy:test-l7, antrea_rule allow-l7; sid: 2;)
+pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; dns.query; content:".cluster.local"; endswith; nocase; sid: 3;)`,
+			expectedUpdatedRules: `pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "helloworld.Greeter",
+						Method:  "SayHello",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; sid: 2;)`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -187,9 +369,10 @@ func TestRuleLifecycle(t *testing.T) {
 			fs := newFakeSuricata()
 			fe.suricataScFn = fs.suricataScFunc
 			fe.startSuricataFn = fs.startSuricataFn
+			fe.startRejectResponderFn = fs.startRejectResponderFn
 
 			// Test add a L7 NetworkPolicy.
-			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.l7Protocols, false))
+			assert.NoError(t, fe.AddRule(ruleID, policyName, ruleName, vlanID, tc.l7Protocols, false, tc.rejectResponse))
 
 			rulesPath := generateTenantRulesPath(vlanID)
 			ok, err := afero.FileContainsBytes(defaultFS, rulesPath, []byte(tc.expectedRules))
@@ -206,7 +389,7 @@ func TestRuleLifecycle(t *testing.T) {
 			assert.Equal(t, expectedScCommands, fs.calledScCommands)
 
 			// Update the added L7 NetworkPolicy.
-			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.updatedL7Protocols, false))
+			assert.NoError(t, fe.AddRule(ruleID, policyName, ruleName, vlanID, tc.updatedL7Protocols, false, tc.rejectResponse))
 			expectedScCommands.Insert("reload-tenant 1 /etc/suricata/antrea-tenant-1.yaml")
 			assert.Equal(t, expectedScCommands, fs.calledScCommands)
 
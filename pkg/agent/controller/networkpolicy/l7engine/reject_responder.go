@@ -0,0 +1,326 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mdlayher/packet"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+const (
+	etherTypeIPv4  = 0x0800
+	etherTypeIPv6  = 0x86dd
+	etherTypeDot1q = 0x8100
+
+	ethernetHdrLen = 14
+	dot1qHdrLen    = 4
+	ipv4HdrLen     = 20
+	ipv6HdrLen     = 40
+	tcpHdrLen      = 20
+
+	ipProtocolTCP = 6
+
+	tcpFlagFIN = 0x01
+	tcpFlagRST = 0x04
+	tcpFlagPSH = 0x08
+	tcpFlagACK = 0x10
+
+	// maxRejectEventSize is the maximum size of an alert event, which includes the base64-encoded rejected packet.
+	maxRejectEventSize = 1 << 20
+)
+
+const httpForbiddenBody = "Request forbidden by network policy\n"
+
+// httpForbiddenResponse is the HTTP response sent for the HTTP requests rejected by the L7 NetworkPolicy rules with
+// the HTTPForbidden reject response.
+var httpForbiddenResponse = []byte(fmt.Sprintf("HTTP/1.1 403 Forbidden\r\nContent-Type: text/plain\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s",
+	len(httpForbiddenBody), httpForbiddenBody))
+
+// rejectEvent holds the fields of the Suricata alert events used to respond to the rejected requests.
+type rejectEvent struct {
+	EventType string   `json:"event_type"`
+	VLAN      []uint16 `json:"vlan"`
+	AppProto  string   `json:"app_proto"`
+	Alert     *struct {
+		Metadata map[string][]string `json:"metadata"`
+	} `json:"alert"`
+	// Packet is the rejected packet, including its Ethernet header.
+	Packet []byte `json:"packet"`
+}
+
+// rejectResponder responds to the requests rejected by the L7 NetworkPolicy rules with the HTTPForbidden reject
+// response. As Suricata can only reset the connections, the reject rules of these rules only reset the connections
+// to the servers, and the alert events of the rules, which include the rejected packets, are sent to the responder.
+// The responder then sends an HTTP 403 Forbidden response to the client of each rejected HTTP request, through the
+// return port of the L7 engine as if the response was sent by the server.
+type rejectResponder struct {
+	socketPath string
+	// Declared as a member variable for testing.
+	writeFrameFn func(frame []byte) error
+
+	connMutex sync.Mutex
+	conn      *packet.Conn
+}
+
+func newRejectResponder() *rejectResponder {
+	r := &rejectResponder{socketPath: config.L7SuricataRejectSocketPath}
+	r.writeFrameFn = r.writeFrame
+	return r
+}
+
+func (r *rejectResponder) listenAndAcceptConn() {
+	if err := os.Remove(r.socketPath); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove stale socket", "path", r.socketPath)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.socketPath), 0750); err != nil {
+		klog.ErrorS(err, "Failed to create directory", "dir", filepath.Dir(r.socketPath))
+		return
+	}
+	listener, err := net.Listen("unix", r.socketPath)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on Suricata reject socket")
+		return
+	}
+	defer listener.Close()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			klog.ErrorS(err, "Error accepting Suricata connection")
+			return
+		}
+		go r.handleConn(conn)
+	}
+}
+
+func (r *rejectResponder) handleConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRejectEventSize)
+	for scanner.Scan() {
+		if err := r.processEvent(scanner.Bytes()); err != nil {
+			klog.ErrorS(err, "Failed to respond to rejected request")
+		}
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, io.EOF) {
+		klog.ErrorS(err, "Error reading Suricata reject events")
+	}
+}
+
+func (r *rejectResponder) processEvent(data []byte) error {
+	var event rejectEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("error parsing Suricata event: %w", err)
+	}
+	if event.EventType != "alert" || event.Alert == nil || len(event.Packet) == 0 {
+		return nil
+	}
+	responses := event.Alert.Metadata[metadataKeyRejectResponse]
+	if len(responses) == 0 || responses[0] != string(crdv1beta1.RejectResponseHTTPForbidden) {
+		return nil
+	}
+	var vlanID uint16
+	if len(event.VLAN) > 0 {
+		vlanID = event.VLAN[0]
+	}
+	frame, err := buildRejectResponseFrame(event.Packet, vlanID, event.AppProto == protocolHTTP)
+	if err != nil {
+		return err
+	}
+	return r.writeFrameFn(frame)
+}
+
+// writeFrame writes the frame to the return port of the L7 engine.
+func (r *rejectResponder) writeFrame(frame []byte) error {
+	r.connMutex.Lock()
+	defer r.connMutex.Unlock()
+	if r.conn == nil {
+		iface, err := net.InterfaceByName(config.L7RedirectReturnPortName)
+		if err != nil {
+			return fmt.Errorf("failed to get interface %s: %w", config.L7RedirectReturnPortName, err)
+		}
+		// Only write to the socket, so no protocol is set to receive packets.
+		conn, err := packet.Listen(iface, packet.Raw, 0, nil)
+		if err != nil {
+			return fmt.Errorf("failed to open socket on interface %s: %w", config.L7RedirectReturnPortName, err)
+		}
+		r.conn = conn
+	}
+	if _, err := r.conn.WriteTo(frame, &packet.Addr{HardwareAddr: net.HardwareAddr(frame[:6])}); err != nil {
+		r.conn.Close()
+		r.conn = nil
+		return err
+	}
+	return nil
+}
+
+// buildRejectResponseFrame builds the frame sent to the client of a rejected TCP packet. If the packet carries an
+// HTTP request, the frame carries an HTTP 403 Forbidden response and closes the connection, otherwise it resets the
+// connection. The frame is tagged with the VLAN of the rejected packet, so that it is handled like the other packets
+// returned by the L7 engine.
+func buildRejectResponseFrame(request []byte, vlanID uint16, isHTTP bool) ([]byte, error) {
+	if len(request) < ethernetHdrLen {
+		return nil, errors.New("rejected packet is too short")
+	}
+	dstMAC, srcMAC := request[6:12], request[0:6]
+	offset := 12
+	etherType := binary.BigEndian.Uint16(request[offset:])
+	if etherType == etherTypeDot1q {
+		if len(request) < ethernetHdrLen+dot1qHdrLen {
+			return nil, errors.New("rejected packet is too short")
+		}
+		vlanID = binary.BigEndian.Uint16(request[offset+2:]) & 0x0fff
+		offset += dot1qHdrLen
+		etherType = binary.BigEndian.Uint16(request[offset:])
+	}
+	offset += 2
+
+	var srcIP, dstIP net.IP
+	var ipPayload []byte
+	switch etherType {
+	case etherTypeIPv4:
+		if len(request) < offset+ipv4HdrLen {
+			return nil, errors.New("rejected IPv4 packet is too short")
+		}
+		ipHdr := request[offset:]
+		hdrLen := int(ipHdr[0]&0x0f) * 4
+		totalLen := int(binary.BigEndian.Uint16(ipHdr[2:4]))
+		if ipHdr[9] != ipProtocolTCP {
+			return nil, fmt.Errorf("rejected packet has unsupported IP protocol %d", ipHdr[9])
+		}
+		if hdrLen < ipv4HdrLen || totalLen < hdrLen || len(ipHdr) < totalLen {
+			return nil, errors.New("rejected IPv4 packet is malformed")
+		}
+		dstIP, srcIP = net.IP(ipHdr[12:16]), net.IP(ipHdr[16:20])
+		ipPayload = ipHdr[hdrLen:totalLen]
+	case etherTypeIPv6:
+		if len(request) < offset+ipv6HdrLen {
+			return nil, errors.New("rejected IPv6 packet is too short")
+		}
+		ipHdr := request[offset:]
+		payloadLen := int(binary.BigEndian.Uint16(ipHdr[4:6]))
+		if ipHdr[6] != ipProtocolTCP {
+			return nil, fmt.Errorf("rejected packet has unsupported IPv6 next header %d", ipHdr[6])
+		}
+		if len(ipHdr) < ipv6HdrLen+payloadLen {
+			return nil, errors.New("rejected IPv6 packet is malformed")
+		}
+		dstIP, srcIP = net.IP(ipHdr[8:24]), net.IP(ipHdr[24:40])
+		ipPayload = ipHdr[ipv6HdrLen : ipv6HdrLen+payloadLen]
+	default:
+		return nil, fmt.Errorf("rejected packet has unsupported EtherType 0x%04x", etherType)
+	}
+
+	if len(ipPayload) < tcpHdrLen {
+		return nil, errors.New("rejected TCP segment is too short")
+	}
+	tcpHdrLenOfRequest := int(ipPayload[12]>>4) * 4
+	if tcpHdrLenOfRequest < tcpHdrLen || len(ipPayload) < tcpHdrLenOfRequest {
+		return nil, errors.New("rejected TCP segment is malformed")
+	}
+	requestSeq := binary.BigEndian.Uint32(ipPayload[4:8])
+	requestAck := binary.BigEndian.Uint32(ipPayload[8:12])
+	requestDataLen := uint32(len(ipPayload) - tcpHdrLenOfRequest)
+
+	// Respond to the HTTP request with the 403 response and close the connection. If no request data is carried by
+	// the packet, the connection is reset as the response cannot be associated with a request.
+	var data []byte
+	flags := uint8(tcpFlagRST | tcpFlagACK)
+	if isHTTP && requestDataLen > 0 {
+		data = httpForbiddenResponse
+		flags = tcpFlagFIN | tcpFlagPSH | tcpFlagACK
+	}
+	segment := make([]byte, tcpHdrLen+len(data))
+	copy(segment[0:2], ipPayload[2:4])
+	copy(segment[2:4], ipPayload[0:2])
+	binary.BigEndian.PutUint32(segment[4:8], requestAck)
+	binary.BigEndian.PutUint32(segment[8:12], requestSeq+requestDataLen)
+	segment[12] = (tcpHdrLen / 4) << 4
+	segment[13] = flags
+	binary.BigEndian.PutUint16(segment[14:16], 0xffff)
+	copy(segment[tcpHdrLen:], data)
+
+	frame := make([]byte, 0, ethernetHdrLen+dot1qHdrLen+ipv6HdrLen+len(segment))
+	frame = append(frame, dstMAC...)
+	frame = append(frame, srcMAC...)
+	if vlanID != 0 {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeDot1q)
+		frame = binary.BigEndian.AppendUint16(frame, vlanID)
+	}
+	frame = binary.BigEndian.AppendUint16(frame, etherType)
+	if etherType == etherTypeIPv4 {
+		ipHdr := make([]byte, ipv4HdrLen)
+		ipHdr[0] = 0x45
+		binary.BigEndian.PutUint16(ipHdr[2:4], uint16(ipv4HdrLen+len(segment)))
+		// Set the Don't Fragment flag.
+		binary.BigEndian.PutUint16(ipHdr[6:8], 0x4000)
+		ipHdr[8] = 64
+		ipHdr[9] = ipProtocolTCP
+		copy(ipHdr[12:16], srcIP)
+		copy(ipHdr[16:20], dstIP)
+		binary.BigEndian.PutUint16(ipHdr[10:12], checksum(ipHdr, 0))
+		frame = append(frame, ipHdr...)
+	} else {
+		ipHdr := make([]byte, ipv6HdrLen)
+		ipHdr[0] = 0x60
+		binary.BigEndian.PutUint16(ipHdr[4:6], uint16(len(segment)))
+		ipHdr[6] = ipProtocolTCP
+		ipHdr[7] = 64
+		copy(ipHdr[8:24], srcIP)
+		copy(ipHdr[24:40], dstIP)
+		frame = append(frame, ipHdr...)
+	}
+	binary.BigEndian.PutUint16(segment[16:18], checksum(segment, pseudoHeaderSum(srcIP, dstIP, len(segment))))
+	return append(frame, segment...), nil
+}
+
+// pseudoHeaderSum returns the sum of the TCP pseudo header used in the checksum of TCP segments.
+func pseudoHeaderSum(srcIP, dstIP net.IP, length int) uint32 {
+	var sum uint32
+	for _, ip := range []net.IP{srcIP, dstIP} {
+		for i := 0; i < len(ip); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(ip[i:]))
+		}
+	}
+	return sum + ipProtocolTCP + uint32(length)
+}
+
+// checksum returns the Internet checksum of the data, starting from the given sum.
+func checksum(data []byte, sum uint32) uint16 {
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i:]))
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	clientMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:01")
+	serverMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:02")
+)
+
+// buildTCPFrame builds an Ethernet frame carrying a TCP segment from the client to the server.
+func buildTCPFrame(clientIP, serverIP net.IP, vlanID uint16, seq, ack uint32, data []byte) []byte {
+	segment := make([]byte, tcpHdrLen+len(data))
+	binary.BigEndian.PutUint16(segment[0:2], 34567)
+	binary.BigEndian.PutUint16(segment[2:4], 80)
+	binary.BigEndian.PutUint32(segment[4:8], seq)
+	binary.BigEndian.PutUint32(segment[8:12], ack)
+	segment[12] = (tcpHdrLen / 4) << 4
+	segment[13] = tcpFlagPSH | tcpFlagACK
+	copy(segment[tcpHdrLen:], data)
+
+	frame := append([]byte{}, serverMAC...)
+	frame = append(frame, clientMAC...)
+	if vlanID != 0 {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeDot1q)
+		frame = binary.BigEndian.AppendUint16(frame, vlanID)
+	}
+	if clientIP.To4() != nil {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeIPv4)
+		ipHdr := make([]byte, ipv4HdrLen)
+		ipHdr[0] = 0x45
+		binary.BigEndian.PutUint16(ipHdr[2:4], uint16(ipv4HdrLen+len(segment)))
+		ipHdr[8] = 64
+		ipHdr[9] = ipProtocolTCP
+		copy(ipHdr[12:16], clientIP.To4())
+		copy(ipHdr[16:20], serverIP.To4())
+		frame = append(frame, ipHdr...)
+	} else {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeIPv6)
+		ipHdr := make([]byte, ipv6HdrLen)
+		ipHdr[0] = 0x60
+		binary.BigEndian.PutUint16(ipHdr[4:6], uint16(len(segment)))
+		ipHdr[6] = ipProtocolTCP
+		ipHdr[7] = 64
+		copy(ipHdr[8:24], clientIP)
+		copy(ipHdr[24:40], serverIP)
+		frame = append(frame, ipHdr...)
+	}
+	return append(frame, segment...)
+}
+
+func TestBuildRejectResponseFrame(t *testing.T) {
+	httpRequest := []byte("GET /admin HTTP/1.1\r\nHost: foo.com\r\n\r\n")
+	testCases := []struct {
+		name          string
+		clientIP      net.IP
+		serverIP      net.IP
+		frameVLANID   uint16
+		eventVLANID   uint16
+		data          []byte
+		isHTTP        bool
+		expectedFlags uint8
+		expectedAck   uint32
+		expectedData  []byte
+	}{
+		{
+			name:          "IPv4 HTTP request",
+			clientIP:      net.ParseIP("10.10.0.1").To4(),
+			serverIP:      net.ParseIP("10.10.0.2").To4(),
+			frameVLANID:   1,
+			data:          httpRequest,
+			isHTTP:        true,
+			expectedFlags: tcpFlagFIN | tcpFlagPSH | tcpFlagACK,
+			expectedAck:   1000 + uint32(len(httpRequest)),
+			expectedData:  httpForbiddenResponse,
+		},
+		{
+			name:          "IPv6 HTTP request with VLAN from event",
+			clientIP:      net.ParseIP("fd00::1"),
+			serverIP:      net.ParseIP("fd00::2"),
+			eventVLANID:   2,
+			data:          httpRequest,
+			isHTTP:        true,
+			expectedFlags: tcpFlagFIN | tcpFlagPSH | tcpFlagACK,
+			expectedAck:   1000 + uint32(len(httpRequest)),
+			expectedData:  httpForbiddenResponse,
+		},
+		{
+			name:          "IPv4 non-HTTP request",
+			clientIP:      net.ParseIP("10.10.0.1").To4(),
+			serverIP:      net.ParseIP("10.10.0.2").To4(),
+			frameVLANID:   1,
+			data:          []byte("foo"),
+			expectedFlags: tcpFlagRST | tcpFlagACK,
+			expectedAck:   1003,
+		},
+		{
+			name:          "IPv4 HTTP segment without data",
+			clientIP:      net.ParseIP("10.10.0.1").To4(),
+			serverIP:      net.ParseIP("10.10.0.2").To4(),
+			frameVLANID:   1,
+			isHTTP:        true,
+			expectedFlags: tcpFlagRST | tcpFlagACK,
+			expectedAck:   1000,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := buildTCPFrame(tc.clientIP, tc.serverIP, tc.frameVLANID, 1000, 2000, tc.data)
+			frame, err := buildRejectResponseFrame(request, tc.eventVLANID, tc.isHTTP)
+			require.NoError(t, err)
+
+			assert.Equal(t, []byte(clientMAC), frame[0:6])
+			assert.Equal(t, []byte(serverMAC), frame[6:12])
+			require.Equal(t, uint16(etherTypeDot1q), binary.BigEndian.Uint16(frame[12:14]))
+			assert.Equal(t, tc.frameVLANID+tc.eventVLANID, binary.BigEndian.Uint16(frame[14:16]))
+
+			var segment []byte
+			if tc.clientIP.To4() != nil {
+				require.Equal(t, uint16(etherTypeIPv4), binary.BigEndian.Uint16(frame[16:18]))
+				ipHdr := frame[18 : 18+ipv4HdrLen]
+				assert.Equal(t, []byte(tc.serverIP), ipHdr[12:16])
+				assert.Equal(t, []byte(tc.clientIP), ipHdr[16:20])
+				assert.Equal(t, uint16(0), checksum(ipHdr, 0))
+				segment = frame[18+ipv4HdrLen:]
+				assert.Equal(t, len(segment)+ipv4HdrLen, int(binary.BigEndian.Uint16(ipHdr[2:4])))
+			} else {
+				require.Equal(t, uint16(etherTypeIPv6), binary.BigEndian.Uint16(frame[16:18]))
+				ipHdr := frame[18 : 18+ipv6HdrLen]
+				assert.Equal(t, []byte(tc.serverIP), ipHdr[8:24])
+				assert.Equal(t, []byte(tc.clientIP), ipHdr[24:40])
+				segment = frame[18+ipv6HdrLen:]
+				assert.Equal(t, len(segment), int(binary.BigEndian.Uint16(ipHdr[4:6])))
+			}
+			assert.Equal(t, uint16(80), binary.BigEndian.Uint16(segment[0:2]))
+			assert.Equal(t, uint16(34567), binary.BigEndian.Uint16(segment[2:4]))
+			assert.Equal(t, uint32(2000), binary.BigEndian.Uint32(segment[4:8]))
+			assert.Equal(t, tc.expectedAck, binary.BigEndian.Uint32(segment[8:12]))
+			assert.Equal(t, tc.expectedFlags, segment[13])
+			if tc.expectedData != nil {
+				assert.Equal(t, tc.expectedData, segment[tcpHdrLen:], "Unexpected TCP data")
+			} else {
+				assert.Empty(t, segment[tcpHdrLen:], "Unexpected TCP data")
+			}
+			assert.Equal(t, uint16(0), checksum(segment, pseudoHeaderSum(tc.serverIP, tc.clientIP, len(segment))))
+		})
+	}
+}
+
+func TestRejectResponderProcessEvent(t *testing.T) {
+	request := buildTCPFrame(net.ParseIP("10.10.0.1").To4(), net.ParseIP("10.10.0.2").To4(), 0, 1000, 2000, []byte("GET / HTTP/1.1\r\n\r\n"))
+	packet := base64.StdEncoding.EncodeToString(request)
+	testCases := []struct {
+		name          string
+		event         string
+		expectedFrame bool
+	}{
+		{
+			name:          "HTTPForbidden alert",
+			event:         fmt.Sprintf(`{"event_type":"alert","vlan":[1],"app_proto":"http","alert":{"action":"blocked","metadata":{"antrea_policy":["AntreaNetworkPolicy:ns1/test"],"antrea_reject_response":["HTTPForbidden"]}},"packet":"%s"}`, packet),
+			expectedFrame: true,
+		},
+		{
+			name:  "alert without reject response",
+			event: fmt.Sprintf(`{"event_type":"alert","vlan":[1],"app_proto":"http","alert":{"action":"blocked","metadata":{"antrea_policy":["AntreaNetworkPolicy:ns1/test"]}},"packet":"%s"}`, packet),
+		},
+		{
+			name:  "http event",
+			event: `{"event_type":"http","vlan":[1],"app_proto":"http"}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var frames [][]byte
+			r := newRejectResponder()
+			r.writeFrameFn = func(frame []byte) error {
+				frames = append(frames, frame)
+				return nil
+			}
+			require.NoError(t, r.processEvent([]byte(tc.event)))
+			if tc.expectedFrame {
+				require.Len(t, frames, 1)
+				assert.Equal(t, uint16(1), binary.BigEndian.Uint16(frames[0][14:16]))
+			} else {
+				assert.Empty(t, frames)
+			}
+		})
+	}
+}
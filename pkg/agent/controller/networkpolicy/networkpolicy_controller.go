@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -37,14 +38,17 @@ import (
 	"antrea.io/antrea/pkg/agent/client"
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
 	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy"
 	proxytypes "antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/install"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/util/channel"
 	utilwait "antrea.io/antrea/pkg/util/wait"
@@ -72,7 +76,7 @@ const (
 )
 
 type L7RuleReconciler interface {
-	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol, enableLogging bool) error
+	AddRule(ruleID, policyName, ruleName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol, enableLogging bool, rejectResponse crdv1beta1.RejectResponseType) error
 	DeleteRule(ruleID string, vlanID uint32) error
 }
 
@@ -152,7 +156,9 @@ type Controller struct {
 	fullSyncGroup         sync.WaitGroup
 	ifaceStore            interfacestore.InterfaceStore
 	// denyConnStore is for storing deny connections for flow exporter.
-	denyConnStore  *connections.DenyConnectionStore
+	denyConnStore *connections.DenyConnectionStore
+	// proxier is used to resolve the Services of the traffic in audit logs.
+	proxier        proxy.Proxier
 	gwPort         uint32
 	tunPort        uint32
 	nodeConfig     *config.NodeConfig
@@ -164,9 +170,13 @@ type Controller struct {
 	appliedToGroupStore *fileStore
 	addressGroupStore   *fileStore
 
+	// packetCapturer writes the packets matching the rules with packet capture enabled to files.
+	packetCapturer *packetcapture.Capturer
+
 	logPacketAction           packetInAction
 	rejectRequestAction       packetInAction
 	storeDenyConnectionAction packetInAction
+	capturePacketAction       packetInAction
 }
 
 // NewNetworkPolicyController returns a new *Controller.
@@ -239,6 +249,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	serializer := protobuf.NewSerializer(scheme, scheme)
 	codec := codecs.CodecForVersions(serializer, serializer, v1beta2.SchemeGroupVersion, v1beta2.SchemeGroupVersion)
+	c.packetCapturer = packetcapture.NewCapturer(fs, packetcapture.Dir())
 	fs = afero.NewBasePathFs(fs, dataPath)
 	c.networkPolicyStore, err = newFileStore(fs, networkPoliciesDir, codec)
 	if err != nil {
@@ -267,7 +278,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 		c.ofClient.RegisterPacketInHandler(uint8(openflow.PacketInCategoryNP), c)
 		if loggerOptions != nil {
 			// Initialize logger for Antrea Policy audit logging
-			auditLogger, err := newAuditLogger(loggerOptions)
+			auditLogger, err := newAuditLogger(loggerOptions, nodeName)
 			if err != nil {
 				return nil, err
 			}
@@ -528,6 +539,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 	c.logPacketAction = c.logPacket
 	c.rejectRequestAction = c.rejectRequest
 	c.storeDenyConnectionAction = c.storeDenyConnection
+	c.capturePacketAction = c.capturePacket
 	return c, nil
 }
 
@@ -592,6 +604,10 @@ func (c *Controller) SetDenyConnStore(denyConnStore *connections.DenyConnectionS
 	c.denyConnStore = denyConnStore
 }
 
+func (c *Controller) SetProxier(proxier proxy.Proxier) {
+	c.proxier = proxier
+}
+
 // Run begins watching and processing Antrea AddressGroups, AppliedToGroups
 // and NetworkPolicies, and spawns workers that reconciles NetworkPolicy rules.
 // Run will not return until stopCh is closed.
@@ -625,6 +641,10 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	go wait.NonSlidingUntil(c.addressGroupWatcher.watch, 5*time.Second, stopCh)
 	go wait.NonSlidingUntil(c.networkPolicyWatcher.watch, 5*time.Second, stopCh)
 
+	if c.auditLogger != nil {
+		go c.auditLogger.Run(stopCh)
+	}
+
 	if c.antreaPolicyEnabled {
 		for i := 0; i < defaultDNSWorkers; i++ {
 			go wait.Until(c.fqdnController.worker, time.Second, stopCh)
@@ -680,6 +700,10 @@ func (c *Controller) GetIGMPNPRuleInfo(podName, podNamespace string, groupAddres
 	var matchedRule *rule
 	for _, obj := range objects {
 		rule := obj.(*rule)
+		// Rules in Audit mode are not realized for IGMP traffic.
+		if rule.isAuditRule() {
+			continue
+		}
 		groupMembers, anyExists := c.ruleCache.unionAppliedToGroups(rule.AppliedToGroups)
 		if !anyExists {
 			continue
@@ -741,6 +765,14 @@ func (c *Controller) processAllItemsInQueue() {
 	// Reconcile all rule keys at once.
 	if err := c.syncRules(batchSyncRuleKeys); err != nil {
 		klog.Errorf("Error occurred when reconciling all rules for init events: %v", err)
+		// Only the rules which cannot be realized are retried if the other rules are realized.
+		var ruleErrs ruleRealizationErrors
+		if errors.As(err, &ruleErrs) {
+			for k := range ruleErrs {
+				c.queue.AddRateLimited(k)
+			}
+			return
+		}
 		for _, k := range batchSyncRuleKeys {
 			c.queue.AddRateLimited(k)
 		}
@@ -777,6 +809,9 @@ func (c *Controller) syncRule(key string) error {
 				c.l7VlanIDAllocator.release(key)
 			}
 		}
+		if err := c.updatePacketCaptureRule(key, nil); err != nil {
+			return err
+		}
 		return nil
 	}
 	// If the rule is not realizable, we can simply skip it as it will be marked as dirty
@@ -797,7 +832,7 @@ func (c *Controller) syncRule(key string) error {
 		vlanID := c.l7VlanIDAllocator.allocate(key)
 		rule.L7RuleVlanID = &vlanID
 
-		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.EnableLogging); err != nil {
+		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), rule.Name, vlanID, rule.L7Protocols, rule.EnableLogging, rule.RejectResponse); err != nil {
 			return err
 		}
 	}
@@ -815,6 +850,12 @@ func (c *Controller) syncRule(key string) error {
 		}
 	}
 	if err != nil {
+		if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+			c.statusManager.SetRuleRealizationFailure(key, rule.PolicyUID, err)
+		}
+		return err
+	}
+	if err := c.updatePacketCaptureRule(key, rule); err != nil {
 		return err
 	}
 	if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
@@ -825,7 +866,8 @@ func (c *Controller) syncRule(key string) error {
 
 // syncRules calls the reconciler to sync all the rules after watchers complete full sync.
 // After flows for those init events are installed, subsequent rules will be handled asynchronously
-// by the syncRule() function.
+// by the syncRule() function. If some Pod rules cannot be realized, their failures are reported
+// and a ruleRealizationErrors is returned with their errors, the other rules are realized.
 func (c *Controller) syncRules(keys []string) error {
 	startTime := time.Now()
 	defer func() {
@@ -852,7 +894,7 @@ func (c *Controller) syncRules(keys []string) error {
 				vlanID := c.l7VlanIDAllocator.allocate(key)
 				rule.L7RuleVlanID = &vlanID
 
-				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.EnableLogging); err != nil {
+				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), rule.Name, vlanID, rule.L7Protocols, rule.EnableLogging, rule.RejectResponse); err != nil {
 					return err
 				}
 			}
@@ -868,12 +910,30 @@ func (c *Controller) syncRules(keys []string) error {
 			return err
 		}
 	}
-	if err := c.podReconciler.BatchReconcile(allPodRules); err != nil {
+	var ruleErrs ruleRealizationErrors
+	if err := c.podReconciler.BatchReconcile(allPodRules); err != nil && !errors.As(err, &ruleErrs) {
 		return err
 	}
+	for _, rule := range allPodRules {
+		if _, failed := ruleErrs[rule.ID]; failed {
+			continue
+		}
+		if err := c.updatePacketCaptureRule(rule.ID, rule); err != nil {
+			return err
+		}
+	}
+	// The rules which were deleted while antrea-agent was not running are not added, delete their capture files.
+	if err := c.packetCapturer.DeleteStaleFiles(); err != nil {
+		klog.ErrorS(err, "Failed to delete stale packet capture files")
+	}
 	if c.statusManagerEnabled {
 		for _, rule := range allPodRules {
-			if v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+			if !v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+				continue
+			}
+			if err, failed := ruleErrs[rule.ID]; failed {
+				c.statusManager.SetRuleRealizationFailure(rule.ID, rule.PolicyUID, err)
+			} else {
 				c.statusManager.SetRuleRealization(rule.ID, rule.PolicyUID)
 			}
 		}
@@ -885,6 +945,9 @@ func (c *Controller) syncRules(keys []string) error {
 			}
 		}
 	}
+	if len(ruleErrs) > 0 {
+		return ruleErrs
+	}
 	return nil
 }
 
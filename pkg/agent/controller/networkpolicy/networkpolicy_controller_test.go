@@ -54,13 +54,15 @@ import (
 const testNamespace = "ns1"
 
 var mockOFTables = map[*openflow.Table]uint8{
-	openflow.AntreaPolicyEgressRuleTable:  uint8(5),
-	openflow.EgressRuleTable:              uint8(6),
-	openflow.EgressDefaultTable:           uint8(7),
-	openflow.AntreaPolicyIngressRuleTable: uint8(12),
-	openflow.IngressRuleTable:             uint8(13),
-	openflow.IngressDefaultTable:          uint8(14),
-	openflow.OutputTable:                  uint8(28),
+	openflow.AntreaPolicyEgressAuditRuleTable:  uint8(4),
+	openflow.AntreaPolicyEgressRuleTable:       uint8(5),
+	openflow.EgressRuleTable:                   uint8(6),
+	openflow.EgressDefaultTable:                uint8(7),
+	openflow.AntreaPolicyIngressAuditRuleTable: uint8(11),
+	openflow.AntreaPolicyIngressRuleTable:      uint8(12),
+	openflow.IngressRuleTable:                  uint8(13),
+	openflow.IngressDefaultTable:               uint8(14),
+	openflow.OutputTable:                       uint8(28),
 }
 
 type antreaClientGetter struct {
@@ -199,6 +199,12 @@ func newNodeReconciler(routeClient route.Interface, ipv4Enabled, ipv6Enabled boo
 // Reconcile checks whether the provided rule has been enforced or not, and invoke the add or update method accordingly.
 func (r *nodeReconciler) Reconcile(rule *CompletedRule) error {
 	klog.InfoS("Reconciling Node NetworkPolicy rule", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+	if rule.isAuditRule() {
+		// Audit mode is not supported for Node NetworkPolicy, the rule is not realized so that it doesn't affect
+		// any traffic.
+		klog.InfoS("Skipping Node NetworkPolicy rule in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+		return nil
+	}
 
 	value, exists := r.lastRealizeds.Load(rule.ID)
 	var err error
@@ -217,6 +223,10 @@ func (r *nodeReconciler) RunIDAllocatorWorker(stopCh <-chan struct{}) {
 func (r *nodeReconciler) BatchReconcile(rules []*CompletedRule) error {
 	var rulesToInstall []*CompletedRule
 	for _, rule := range rules {
+		if rule.isAuditRule() {
+			klog.InfoS("Skipping Node NetworkPolicy rule in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+			continue
+		}
 		if _, exists := r.lastRealizeds.Load(rule.ID); exists {
 			klog.ErrorS(nil, "Rule should not have been realized yet: initialization phase", "rule", rule.ID)
 		} else {
@@ -0,0 +1,83 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"antrea.io/libOpenflow/util"
+	"antrea.io/ofnet/ofctrl"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
+	"antrea.io/antrea/pkg/agent/openflow"
+)
+
+// capturePacket writes the packet-in packet to the capture file of the Drop or Reject rule it
+// matches.
+func (c *Controller) capturePacket(pktIn *ofctrl.PacketIn) error {
+	buffer, ok := pktIn.Data.(*util.Buffer)
+	if !ok {
+		return errors.New("unexpected packetIn data for packet capture")
+	}
+	match := getMatchRegField(pktIn.GetMatches(), openflow.APConjIDField)
+	if match == nil {
+		return errors.New("packetIn for packet capture misses the conjunction ID")
+	}
+	ruleID, err := getInfoInReg(match, nil)
+	if err != nil {
+		return fmt.Errorf("error when obtaining rule id from reg: %v", err)
+	}
+	rule := c.GetRuleByFlowID(ruleID)
+	if rule == nil || rule.PacketCapture == nil || rule.PolicyRef == nil {
+		// The rule must have been deleted or updated.
+		klog.V(4).InfoS("Cannot find rule with packet capture enabled", "ruleID", ruleID)
+		return nil
+	}
+	complete, err := c.packetCapturer.Capture(rule.PolicyRef, rule.Name, rule.PacketCapture.MaxPackets, buffer.Bytes())
+	if err != nil {
+		return err
+	}
+	if complete {
+		// Stop sending the packets matching the rule to antrea-agent, as they would otherwise share the packet-in
+		// rate limit of NetworkPolicy with the Reject and logging operations for nothing.
+		if err := c.ofClient.StopPolicyRulePacketCapture(ruleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updatePacketCaptureRule records whether a rule with the given ID captures packets, so that its capture file
+// is deleted when it is deleted from the Node.
+func (c *Controller) updatePacketCaptureRule(ruleID string, rule *CompletedRule) error {
+	if rule == nil || rule.PacketCapture == nil || rule.SourceRef == nil {
+		return c.packetCapturer.DeleteRule(ruleID)
+	}
+	c.packetCapturer.AddRule(ruleID, rule.SourceRef, rule.Name)
+	return nil
+}
+
+// GetPolicyPacketCaptures returns the packet capture files of policy rules on the Node.
+func (c *Controller) GetPolicyPacketCaptures() ([]packetcapture.CaptureInfo, error) {
+	return c.packetCapturer.List()
+}
+
+// OpenPolicyPacketCapture opens a packet capture file of policy rules by name.
+func (c *Controller) OpenPolicyPacketCapture(name string) (io.ReadCloser, error) {
+	return c.packetCapturer.Open(name)
+}
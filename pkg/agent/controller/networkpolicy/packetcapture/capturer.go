@@ -0,0 +1,283 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/util/logdir"
+)
+
+const fileSuffix = ".pcap"
+
+// Dir returns the directory of the packet capture files of policy rules on the Node, next to the
+// NetworkPolicy audit logs.
+func Dir() string {
+	return filepath.Join(logdir.GetLogDir(), "networkpolicy", "captures")
+}
+
+// invalidFileNameChars matches the characters which are replaced in the file names of the captures.
+var invalidFileNameChars = regexp.MustCompile(`[^A-Za-z0-9.-]`)
+
+// CaptureInfo describes the capture file of a policy rule.
+type CaptureInfo struct {
+	// Name is the name of the capture file.
+	Name string
+	// Packets is the number of packets in the capture file.
+	Packets int32
+	// Size is the size of the capture file in bytes.
+	Size int64
+	// LastUpdate is the last time a packet was written to the capture file.
+	LastUpdate time.Time
+}
+
+// Capturer writes the packets matching the policy rules with packet capture enabled to a pcap file
+// per rule, until the maximum number of packets of the rule is reached. The files are kept across
+// agent restarts, and the packets they already contain count toward the maximum. A file is deleted
+// when the last rule writing to it is deleted from the Node.
+type Capturer struct {
+	fs    afero.Fs
+	dir   string
+	clock clock.Clock
+	mutex sync.Mutex
+	// packets stores the number of packets in each capture file, keyed by file name.
+	packets map[string]int32
+	// ruleFiles stores the capture file name of each rule added with AddRule, keyed by rule ID.
+	ruleFiles map[string]string
+	// fileRules stores the IDs of the rules writing to each capture file, keyed by file name.
+	fileRules map[string]sets.Set[string]
+}
+
+// NewCapturer returns a new *Capturer writing capture files in dir. The directory is created when
+// the first packet is captured.
+func NewCapturer(fs afero.Fs, dir string) *Capturer {
+	return &Capturer{
+		fs:        fs,
+		dir:       dir,
+		clock:     clock.RealClock{},
+		packets:   map[string]int32{},
+		ruleFiles: map[string]string{},
+		fileRules: map[string]sets.Set[string]{},
+	}
+}
+
+// FileName returns the name of the capture file of a policy rule.
+func FileName(policy *v1beta2.NetworkPolicyReference, ruleName string) string {
+	parts := []string{string(policy.Type)}
+	if policy.Namespace != "" {
+		parts = append(parts, policy.Namespace)
+	}
+	parts = append(parts, policy.Name, ruleName)
+	for i := range parts {
+		parts[i] = invalidFileNameChars.ReplaceAllString(parts[i], "-")
+	}
+	// Kubernetes object names cannot contain underscores, which makes the file names unambiguous.
+	return strings.Join(parts, "_") + fileSuffix
+}
+
+// AddRule records that the rule with the given ID captures packets to the capture file of the
+// policy rule.
+func (c *Capturer) AddRule(ruleID string, policy *v1beta2.NetworkPolicyReference, ruleName string) {
+	name := FileName(policy, ruleName)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if oldName, ok := c.ruleFiles[ruleID]; ok {
+		if oldName == name {
+			return
+		}
+		c.fileRules[oldName].Delete(ruleID)
+	}
+	c.ruleFiles[ruleID] = name
+	if c.fileRules[name] == nil {
+		c.fileRules[name] = sets.New[string]()
+	}
+	c.fileRules[name].Insert(ruleID)
+}
+
+// DeleteRule records that the rule with the given ID has been deleted from the Node. If no other
+// rule captures packets to its capture file, the file is deleted, so that the capture restarts if
+// the rule is added again. It does nothing if the rule was not added with AddRule.
+func (c *Capturer) DeleteRule(ruleID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	name, ok := c.ruleFiles[ruleID]
+	if !ok {
+		return nil
+	}
+	rules := c.fileRules[name]
+	rules.Delete(ruleID)
+	if rules.Len() == 0 {
+		if err := c.deleteFileLocked(name); err != nil {
+			return err
+		}
+		delete(c.fileRules, name)
+	}
+	delete(c.ruleFiles, ruleID)
+	return nil
+}
+
+// DeleteStaleFiles deletes the capture files no rule added with AddRule captures packets to. It is
+// called once all the rules have been added after the agent starts, to delete the files of the
+// rules which were deleted while the agent was not running.
+func (c *Capturer) DeleteStaleFiles() error {
+	files, err := afero.ReadDir(c.fs, c.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read packet capture directory %s: %w", c.dir, err)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, file := range files {
+		if !file.Mode().IsRegular() || !strings.HasSuffix(file.Name(), fileSuffix) {
+			continue
+		}
+		if _, ok := c.fileRules[file.Name()]; ok {
+			continue
+		}
+		if err := c.deleteFileLocked(file.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Capturer) deleteFileLocked(name string) error {
+	path := filepath.Join(c.dir, name)
+	if err := c.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete packet capture file %s: %w", path, err)
+	}
+	delete(c.packets, name)
+	klog.V(2).InfoS("Deleted packet capture file", "file", path)
+	return nil
+}
+
+// Capture writes a packet matching a policy rule to the capture file of the rule, unless the file
+// already has maxPackets packets. It returns true if the file has maxPackets packets after the call,
+// in which case the packets matching the rule no longer need to be sent to antrea-agent.
+func (c *Capturer) Capture(policy *v1beta2.NetworkPolicyReference, ruleName string, maxPackets int32, data []byte) (bool, error) {
+	name := FileName(policy, ruleName)
+	path := filepath.Join(c.dir, name)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	packets, ok := c.packets[name]
+	if !ok {
+		// The capture file may have been written before the agent restarted.
+		packets = c.loadPackets(path)
+		c.packets[name] = packets
+	}
+	if packets >= maxPackets {
+		return true, nil
+	}
+	flags := os.O_WRONLY | os.O_APPEND
+	if packets == 0 {
+		if err := c.fs.MkdirAll(c.dir, 0755); err != nil {
+			return false, fmt.Errorf("failed to create packet capture directory %s: %w", c.dir, err)
+		}
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	f, err := c.fs.OpenFile(path, flags, 0644)
+	if err != nil {
+		return false, fmt.Errorf("failed to open packet capture file %s: %w", path, err)
+	}
+	defer f.Close()
+	if packets == 0 {
+		if err := writeFileHeader(f); err != nil {
+			return false, fmt.Errorf("failed to write packet capture file %s: %w", path, err)
+		}
+	}
+	if err := writeRecord(f, c.clock.Now(), data); err != nil {
+		return false, fmt.Errorf("failed to write packet capture file %s: %w", path, err)
+	}
+	c.packets[name] = packets + 1
+	if packets+1 < maxPackets {
+		return false, nil
+	}
+	klog.InfoS("Reached the maximum number of captured packets for policy rule", "policy", policy.ToString(), "rule", ruleName, "file", path)
+	return true, nil
+}
+
+// loadPackets returns the number of packets in an existing capture file. A missing or invalid file
+// is considered as empty, and is overwritten by the next capture.
+func (c *Capturer) loadPackets(path string) int32 {
+	f, err := c.fs.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	packets, err := countRecords(f)
+	if err != nil {
+		klog.ErrorS(err, "Invalid packet capture file, it will be overwritten", "file", path)
+		return 0
+	}
+	return packets
+}
+
+// List returns the capture files, sorted by name.
+func (c *Capturer) List() ([]CaptureInfo, error) {
+	files, err := afero.ReadDir(c.fs, c.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read packet capture directory %s: %w", c.dir, err)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var captures []CaptureInfo
+	for _, file := range files {
+		if !file.Mode().IsRegular() || !strings.HasSuffix(file.Name(), fileSuffix) {
+			continue
+		}
+		packets, ok := c.packets[file.Name()]
+		if !ok {
+			packets = c.loadPackets(filepath.Join(c.dir, file.Name()))
+		}
+		captures = append(captures, CaptureInfo{
+			Name:       file.Name(),
+			Packets:    packets,
+			Size:       file.Size(),
+			LastUpdate: file.ModTime(),
+		})
+	}
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].Name < captures[j].Name
+	})
+	return captures, nil
+}
+
+// Open opens a capture file for reading. It returns an error satisfying os.IsNotExist if there is
+// no capture file with the name.
+func (c *Capturer) Open(name string) (io.ReadCloser, error) {
+	if name != filepath.Base(name) || !strings.HasSuffix(name, fileSuffix) {
+		return nil, fmt.Errorf("invalid packet capture file name %q", name)
+	}
+	return c.fs.Open(filepath.Join(c.dir, name))
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const testDir = "/var/log/antrea/networkpolicy/captures"
+
+var (
+	acnpRef = &v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaClusterNetworkPolicy, Name: "acnp1"}
+	annpRef = &v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp1"}
+)
+
+func newTestCapturer(fs afero.Fs) (*Capturer, *clocktesting.FakeClock) {
+	c := NewCapturer(fs, testDir)
+	fakeClock := clocktesting.NewFakeClock(time.Unix(1700000000, 123456000))
+	c.clock = fakeClock
+	return c, fakeClock
+}
+
+func mustCapture(t *testing.T, c *Capturer, policy *v1beta2.NetworkPolicyReference, ruleName string, maxPackets int32, data []byte) {
+	_, err := c.Capture(policy, ruleName, maxPackets, data)
+	require.NoError(t, err)
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "AntreaClusterNetworkPolicy_acnp1_drop-all.pcap", FileName(acnpRef, "drop-all"))
+	assert.Equal(t, "AntreaNetworkPolicy_ns1_annp1_rule-1-.pcap", FileName(annpRef, "rule 1/"))
+}
+
+func TestCapture(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	c, _ := newTestCapturer(fs)
+	packet1 := []byte{1, 2, 3, 4}
+	packet2 := []byte{5, 6, 7, 8, 9}
+	complete, err := c.Capture(acnpRef, "rule1", 2, packet1)
+	require.NoError(t, err)
+	assert.False(t, complete)
+	complete, err = c.Capture(acnpRef, "rule1", 2, packet2)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	// The maximum number of packets is reached.
+	complete, err = c.Capture(acnpRef, "rule1", 2, packet1)
+	require.NoError(t, err)
+	assert.True(t, complete)
+
+	data, err := afero.ReadFile(fs, testDir+"/AntreaClusterNetworkPolicy_acnp1_rule1.pcap")
+	require.NoError(t, err)
+	expected := new(bytes.Buffer)
+	expected.Write([]byte{0xd4, 0xc3, 0xb2, 0xa1, 2, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0, 0, 1, 0, 0, 0})
+	for _, packet := range [][]byte{packet1, packet2} {
+		binary.Write(expected, binary.LittleEndian, []uint32{1700000000, 123456, uint32(len(packet)), uint32(len(packet))})
+		expected.Write(packet)
+	}
+	assert.Equal(t, expected.Bytes(), data)
+
+	captures, err := c.List()
+	require.NoError(t, err)
+	require.Len(t, captures, 1)
+	assert.Equal(t, "AntreaClusterNetworkPolicy_acnp1_rule1.pcap", captures[0].Name)
+	assert.Equal(t, int32(2), captures[0].Packets)
+	assert.Equal(t, int64(len(data)), captures[0].Size)
+}
+
+func TestCaptureAfterRestart(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	c, _ := newTestCapturer(fs)
+	mustCapture(t, c, annpRef, "rule1", 3, []byte{1})
+	mustCapture(t, c, annpRef, "rule1", 3, []byte{2})
+	require.NoError(t, afero.WriteFile(fs, testDir+"/AntreaNetworkPolicy_ns1_annp1_rule2.pcap", []byte("invalid"), 0644))
+
+	// The packets captured before the restart count toward the maximum.
+	c, _ = newTestCapturer(fs)
+	captures, err := c.List()
+	require.NoError(t, err)
+	require.Len(t, captures, 2)
+	assert.Equal(t, int32(2), captures[0].Packets)
+	assert.Equal(t, int32(0), captures[1].Packets)
+	mustCapture(t, c, annpRef, "rule1", 3, []byte{3})
+	mustCapture(t, c, annpRef, "rule1", 3, []byte{4})
+	mustCapture(t, c, annpRef, "rule2", 3, []byte{5})
+
+	f, err := fs.Open(testDir + "/AntreaNetworkPolicy_ns1_annp1_rule1.pcap")
+	require.NoError(t, err)
+	defer f.Close()
+	packets, err := countRecords(f)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), packets)
+	// The invalid file is overwritten.
+	f, err = fs.Open(testDir + "/AntreaNetworkPolicy_ns1_annp1_rule2.pcap")
+	require.NoError(t, err)
+	defer f.Close()
+	packets, err = countRecords(f)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), packets)
+}
+
+func TestDeleteRule(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	c, _ := newTestCapturer(fs)
+	// Two rules with the same name, e.g. the old and new versions of an updated rule, write to the same file.
+	c.AddRule("rule-id-1", acnpRef, "rule1")
+	c.AddRule("rule-id-2", acnpRef, "rule1")
+	mustCapture(t, c, acnpRef, "rule1", 1, []byte{1})
+	path := testDir + "/AntreaClusterNetworkPolicy_acnp1_rule1.pcap"
+
+	require.NoError(t, c.DeleteRule("rule-id-1"))
+	exists, err := afero.Exists(fs, path)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	// Deleting a rule which doesn't capture packets does nothing.
+	require.NoError(t, c.DeleteRule("rule-id-3"))
+
+	require.NoError(t, c.DeleteRule("rule-id-2"))
+	exists, err = afero.Exists(fs, path)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// The capture restarts when the rule is added again.
+	c.AddRule("rule-id-1", acnpRef, "rule1")
+	complete, err := c.Capture(acnpRef, "rule1", 2, []byte{2})
+	require.NoError(t, err)
+	assert.False(t, complete)
+	captures, err := c.List()
+	require.NoError(t, err)
+	require.Len(t, captures, 1)
+	assert.Equal(t, int32(1), captures[0].Packets)
+}
+
+func TestDeleteStaleFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	c, _ := newTestCapturer(fs)
+	require.NoError(t, c.DeleteStaleFiles())
+	mustCapture(t, c, acnpRef, "rule1", 1, []byte{1})
+	mustCapture(t, c, annpRef, "rule1", 1, []byte{1})
+	require.NoError(t, afero.WriteFile(fs, testDir+"/other", []byte("other"), 0644))
+
+	c, _ = newTestCapturer(fs)
+	c.AddRule("rule-id-1", annpRef, "rule1")
+	require.NoError(t, c.DeleteStaleFiles())
+	captures, err := c.List()
+	require.NoError(t, err)
+	require.Len(t, captures, 1)
+	assert.Equal(t, "AntreaNetworkPolicy_ns1_annp1_rule1.pcap", captures[0].Name)
+	// Files which are not capture files are kept.
+	exists, err := afero.Exists(fs, testDir+"/other")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestListEmpty(t *testing.T) {
+	c, _ := newTestCapturer(afero.NewMemMapFs())
+	captures, err := c.List()
+	require.NoError(t, err)
+	assert.Empty(t, captures)
+}
+
+func TestOpen(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	c, _ := newTestCapturer(fs)
+	mustCapture(t, c, acnpRef, "rule1", 1, []byte{1})
+
+	f, err := c.Open("AntreaClusterNetworkPolicy_acnp1_rule1.pcap")
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	f.Close()
+	require.NoError(t, err)
+	assert.Len(t, data, fileHeaderLen+recordHeaderLen+1)
+
+	_, err = c.Open("AntreaClusterNetworkPolicy_acnp1_rule2.pcap")
+	assert.True(t, os.IsNotExist(err))
+	_, err = c.Open("../antrea-agent.log")
+	assert.ErrorContains(t, err, "invalid packet capture file name")
+}
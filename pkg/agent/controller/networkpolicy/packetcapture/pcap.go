@@ -0,0 +1,94 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The captures are written in the classic pcap format, with microsecond timestamps and Ethernet
+// link-layer headers, which can be read by tcpdump and Wireshark.
+const (
+	pcapMagic        uint32 = 0xa1b2c3d4
+	pcapVersionMajor uint16 = 2
+	pcapVersionMinor uint16 = 4
+	pcapSnapLen      uint32 = 65535
+	linkTypeEthernet uint32 = 1
+
+	fileHeaderLen   = 24
+	recordHeaderLen = 16
+)
+
+// writeFileHeader writes the global header of a pcap file.
+func writeFileHeader(w io.Writer) error {
+	header := make([]byte, fileHeaderLen)
+	binary.LittleEndian.PutUint32(header[0:4], pcapMagic)
+	binary.LittleEndian.PutUint16(header[4:6], pcapVersionMajor)
+	binary.LittleEndian.PutUint16(header[6:8], pcapVersionMinor)
+	// The timezone offset and the timestamp accuracy are always 0.
+	binary.LittleEndian.PutUint32(header[16:20], pcapSnapLen)
+	binary.LittleEndian.PutUint32(header[20:24], linkTypeEthernet)
+	_, err := w.Write(header)
+	return err
+}
+
+// writeRecord writes a packet to a pcap file, truncated to the snapshot length.
+func writeRecord(w io.Writer, ts time.Time, data []byte) error {
+	capturedLen := len(data)
+	if capturedLen > int(pcapSnapLen) {
+		capturedLen = int(pcapSnapLen)
+	}
+	header := make([]byte, recordHeaderLen)
+	binary.LittleEndian.PutUint32(header[0:4], uint32(ts.Unix()))
+	binary.LittleEndian.PutUint32(header[4:8], uint32(ts.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(header[8:12], uint32(capturedLen))
+	binary.LittleEndian.PutUint32(header[12:16], uint32(len(data)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data[:capturedLen])
+	return err
+}
+
+// countRecords returns the number of packets in a pcap file written by writeFileHeader and
+// writeRecord.
+func countRecords(r io.Reader) (int32, error) {
+	header := make([]byte, fileHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, fmt.Errorf("failed to read pcap file header: %w", err)
+	}
+	if magic := binary.LittleEndian.Uint32(header[0:4]); magic != pcapMagic {
+		return 0, fmt.Errorf("unexpected pcap magic number %#x", magic)
+	}
+	var count int32
+	recordHeader := make([]byte, recordHeaderLen)
+	for {
+		if _, err := io.ReadFull(r, recordHeader); err != nil {
+			if errors.Is(err, io.EOF) {
+				return count, nil
+			}
+			return 0, fmt.Errorf("failed to read pcap record header: %w", err)
+		}
+		capturedLen := int64(binary.LittleEndian.Uint32(recordHeader[8:12]))
+		if n, err := io.CopyN(io.Discard, r, capturedLen); err != nil {
+			return 0, fmt.Errorf("failed to read pcap record data, expected %d bytes but got %d: %w", capturedLen, n, err)
+		}
+		count++
+	}
+}
@@ -62,6 +62,11 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 			return err
 		}
 	}
+	if checkOperation(openflow.PacketInNPCaptureOperation) {
+		if err := c.capturePacketAction(pktIn); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -205,6 +210,15 @@ func isAntreaPolicyIngressTable(tableID uint8) bool {
 	return false
 }
 
+func isAntreaPolicyAuditTable(tableID uint8) bool {
+	for _, table := range openflow.GetAntreaPolicyAuditTables() {
+		if table.IsInitialized() && table.GetID() == tableID {
+			return true
+		}
+	}
+	return false
+}
+
 func isAntreaPolicyEgressTable(tableID uint8) bool {
 	for _, table := range openflow.GetAntreaPolicyEgressTables() {
 		if table.IsInitialized() && table.GetID() == tableID {
@@ -30,6 +30,7 @@ func TestController_HandlePacketIn(t *testing.T) {
 	logPacketErr := fmt.Errorf("log")
 	rejectRequestErr := fmt.Errorf("reject")
 	storeDenyConnectionErr := fmt.Errorf("storeDenyConnection")
+	capturePacketErr := fmt.Errorf("capturePacket")
 	controller.logPacketAction = func(in *ofctrl.PacketIn) error {
 		return logPacketErr
 	}
@@ -39,6 +40,9 @@ func TestController_HandlePacketIn(t *testing.T) {
 	controller.storeDenyConnectionAction = func(in *ofctrl.PacketIn) error {
 		return storeDenyConnectionErr
 	}
+	controller.capturePacketAction = func(in *ofctrl.PacketIn) error {
+		return capturePacketErr
+	}
 
 	logPktIn := &ofctrl.PacketIn{
 		PacketIn: &openflow15.PacketIn{},
@@ -88,6 +92,14 @@ func TestController_HandlePacketIn(t *testing.T) {
 			},
 			expectErr: storeDenyConnectionErr,
 		},
+		{
+			name: "CaptureOperation",
+			packetIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{},
+				UserData: []byte{uint8(openflow.PacketInCategoryNP), uint8(openflow.PacketInNPCaptureOperation)},
+			},
+			expectErr: capturePacketErr,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			err := controller.HandlePacketIn(tt.packetIn)
@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -36,6 +37,18 @@ import (
 	"antrea.io/antrea/pkg/util/k8s"
 )
 
+// ruleRealizationErrors holds the errors of the rules which cannot be realized, keyed by rule ID.
+type ruleRealizationErrors map[string]error
+
+func (e ruleRealizationErrors) Error() string {
+	ruleIDs := sets.List(sets.KeySet(e))
+	messages := make([]string, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		messages = append(messages, fmt.Sprintf("rule %s: %v", ruleID, e[ruleID]))
+	}
+	return fmt.Sprintf("failed to realize %d rules: %s", len(e), strings.Join(messages, "; "))
+}
+
 var (
 	baselineTierPriority int32 = 253
 	banpTierPriority     int32 = 254
@@ -60,7 +73,9 @@ type Reconciler interface {
 
 	// BatchReconcile reconciles the desired state of the provided CompletedRules
 	// with the actual state of Openflow entries in batch. It should only be invoked
-	// if all rules are newly added without last realized status.
+	// if all rules are newly added without last realized status. If some rules
+	// cannot be realized, the other rules are realized and a ruleRealizationErrors
+	// is returned with the errors of those rules.
 	BatchReconcile(rules []*CompletedRule) error
 
 	// Forget cleanups the actual state of Openflow entries of the specified ruleID.
@@ -296,6 +311,12 @@ func (r *podReconciler) RunIDAllocatorWorker(stopCh <-chan struct{}) {
 // invoke the add or update method accordingly.
 func (r *podReconciler) Reconcile(rule *CompletedRule) error {
 	klog.InfoS("Reconciling Pod NetworkPolicy rule", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+	if rule.isAuditRule() && r.getRuleType(rule) != unicast {
+		// Audit mode is only supported for unicast traffic, the rule is not realized so that it doesn't affect any
+		// traffic.
+		klog.InfoS("Skipping multicast NetworkPolicy rule in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+		return nil
+	}
 	var err error
 	var ofPriority *uint16
 
@@ -361,6 +382,14 @@ func (r *podReconciler) getOFRuleTable(rule *CompletedRule) uint8 {
 			}
 			return openflow.EgressRuleTable.GetID()
 		}
+		if rule.isAuditRule() {
+			// Rules of policies in Audit mode, including the ones in the baseline Tier, are installed in the audit
+			// tables, which are evaluated before the tables of enforced Antrea-native policies.
+			if rule.Direction == v1beta2.DirectionIn {
+				return openflow.AntreaPolicyIngressAuditRuleTable.GetID()
+			}
+			return openflow.AntreaPolicyEgressAuditRuleTable.GetID()
+		}
 		if rule.Direction == v1beta2.DirectionIn {
 			ruleTables = openflow.GetAntreaPolicyIngressTables()
 		} else {
@@ -436,6 +465,10 @@ func (r *podReconciler) BatchReconcile(rules []*CompletedRule) error {
 	var priorities []*uint16
 	prioritiesByTable := map[uint8][]*uint16{}
 	for _, rule := range rules {
+		if rule.isAuditRule() && r.getRuleType(rule) != unicast {
+			klog.InfoS("Skipping multicast NetworkPolicy rule in Audit mode", "rule", rule.ID, "policy", rule.SourceRef.ToString())
+			continue
+		}
 		if _, exists := r.lastRealizeds.Load(rule.ID); exists {
 			klog.ErrorS(nil, "Rule should not have been realized yet: initialization phase", "rule", rule.ID)
 		} else {
@@ -456,7 +489,8 @@ func (r *podReconciler) BatchReconcile(rules []*CompletedRule) error {
 		}
 	}
 	ofRuleInstallErr := r.batchAdd(rulesToInstall, priorities)
-	if ofRuleInstallErr != nil {
+	var ruleErrs ruleRealizationErrors
+	if ofRuleInstallErr != nil && !errors.As(ofRuleInstallErr, &ruleErrs) {
 		// If batch reconcile fails, all priorities should be released and the
 		// priorityAssigners should return to the initial state.
 		for tableID, ofPriorities := range prioritiesByTable {
@@ -583,6 +617,8 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				RateLimit:     rule.RateLimit,
+				PacketCapture: rule.PacketCapture,
 			}
 		}
 	} else {
@@ -614,6 +650,8 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				RateLimit:     rule.RateLimit,
+				PacketCapture: rule.PacketCapture,
 			}
 		}
 
@@ -639,6 +677,8 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 					PolicyRef:     rule.SourceRef,
 					EnableLogging: rule.EnableLogging,
 					LogLabel:      rule.LogLabel,
+					RateLimit:     rule.RateLimit,
+					PacketCapture: rule.PacketCapture,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -694,18 +734,29 @@ func (r *podReconciler) batchAdd(rules []*CompletedRule, ofPriorities []*uint16)
 			ofIDUpdateMaps[idx][svcKey] = ofRule.FlowID
 		}
 	}
-	if err := r.ofClient.BatchInstallPolicyRuleFlows(allOFRules); err != nil {
+	var ofRuleErrs openflow.PolicyRuleErrors
+	if err := r.ofClient.BatchInstallPolicyRuleFlows(allOFRules); err != nil && !errors.As(err, &ofRuleErrs) {
 		for _, rule := range allOFRules {
 			r.idAllocator.forgetRule(rule.FlowID)
 		}
 		return err
 	}
+	ruleErrs := ruleRealizationErrors{}
 	for i, lastRealized := range lastRealizeds {
 		ofIDUpdatesByRule := ofIDUpdateMaps[i]
 		for svcKey, ofID := range ofIDUpdatesByRule {
+			// Record ofID only if its Openflow is installed successfully.
+			if err, failed := ofRuleErrs[ofID]; failed {
+				r.idAllocator.forgetRule(ofID)
+				ruleErrs[rules[i].ID] = err
+				continue
+			}
 			lastRealized.ofIDs[svcKey] = ofID
 		}
 	}
+	if len(ruleErrs) > 0 {
+		return ruleErrs
+	}
 	return nil
 }
 
@@ -739,6 +790,8 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 				PolicyRef:     newRule.SourceRef,
 				EnableLogging: newRule.EnableLogging,
 				LogLabel:      newRule.LogLabel,
+				RateLimit:     newRule.RateLimit,
+				PacketCapture: newRule.PacketCapture,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -797,6 +850,8 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					RateLimit:     newRule.RateLimit,
+					PacketCapture: newRule.PacketCapture,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -869,6 +924,8 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					RateLimit:     newRule.RateLimit,
+					PacketCapture: newRule.PacketCapture,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
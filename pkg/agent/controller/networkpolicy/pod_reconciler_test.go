@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -35,6 +36,7 @@ import (
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/third_party/proxy"
 )
 
@@ -205,6 +207,49 @@ func TestReconcilerForget(t *testing.T) {
 	}
 }
 
+func TestGetOFRuleTable(t *testing.T) {
+	prepareMockTables()
+	baselinePriority := baselineTierPriority
+	tests := []struct {
+		name            string
+		rule            *rule
+		expectedTableID uint8
+	}{
+		{
+			name:            "K8s NetworkPolicy ingress rule",
+			rule:            &rule{Direction: v1beta2.DirectionIn, SourceRef: &np1},
+			expectedTableID: openflow.IngressRuleTable.GetID(),
+		},
+		{
+			name:            "Antrea-native policy egress rule",
+			rule:            &rule{Direction: v1beta2.DirectionOut, SourceRef: &cnp1, TierPriority: &tierPriority},
+			expectedTableID: openflow.AntreaPolicyEgressRuleTable.GetID(),
+		},
+		{
+			name:            "Antrea-native policy baseline ingress rule",
+			rule:            &rule{Direction: v1beta2.DirectionIn, SourceRef: &cnp1, TierPriority: &baselinePriority},
+			expectedTableID: openflow.IngressDefaultTable.GetID(),
+		},
+		{
+			name:            "Antrea-native policy ingress rule in Audit mode",
+			rule:            &rule{Direction: v1beta2.DirectionIn, SourceRef: &cnp1, TierPriority: &tierPriority, EnforcementMode: crdv1beta1.EnforcementModeAudit},
+			expectedTableID: openflow.AntreaPolicyIngressAuditRuleTable.GetID(),
+		},
+		{
+			name:            "Antrea-native policy baseline egress rule in Audit mode",
+			rule:            &rule{Direction: v1beta2.DirectionOut, SourceRef: &cnp1, TierPriority: &baselinePriority, EnforcementMode: crdv1beta1.EnforcementModeAudit},
+			expectedTableID: openflow.AntreaPolicyEgressAuditRuleTable.GetID(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			r := newTestReconciler(t, controller, interfacestore.NewInterfaceStore(), openflowtest.NewMockClient(controller), true, false)
+			assert.Equal(t, tt.expectedTableID, r.getOFRuleTable(&CompletedRule{rule: tt.rule}))
+		})
+	}
+}
+
 func TestReconcilerReconcile(t *testing.T) {
 	ifaceStore := interfacestore.NewInterfaceStore()
 	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
@@ -1096,6 +1141,50 @@ func TestReconcilerBatchReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcilerBatchReconcilePartialFailure(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("pod1", "ns1", "container1"),
+		IPs:                      []net.IP{net.ParseIP("2.2.2.2")},
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "pod1", PodNamespace: "ns1", ContainerID: "container1"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 1},
+	})
+	ingressRule := &CompletedRule{
+		rule:          &rule{ID: "ingress-rule", Direction: v1beta2.DirectionIn, SourceRef: &np1},
+		FromAddresses: addressGroup1,
+		TargetMembers: appliedToGroup1,
+	}
+	egressRule := &CompletedRule{
+		rule:          &rule{ID: "egress-rule", Direction: v1beta2.DirectionOut, SourceRef: &np1},
+		ToAddresses:   addressGroup1,
+		TargetMembers: appliedToGroup1,
+	}
+	controller := gomock.NewController(t)
+	mockOFClient := openflowtest.NewMockClient(controller)
+	r := newTestReconciler(t, controller, ifaceStore, mockOFClient, true, true)
+	ruleErr := errors.New("cannot realize rule")
+	mockOFClient.EXPECT().BatchInstallPolicyRuleFlows(gomock.Any()).
+		DoAndReturn(func(rules []*types.PolicyRule) error {
+			require.Len(t, rules, 2)
+			for _, rule := range rules {
+				if rule.Direction == v1beta2.DirectionIn {
+					return openflow.PolicyRuleErrors{rule.FlowID: ruleErr}
+				}
+			}
+			return nil
+		})
+
+	err := r.BatchReconcile([]*CompletedRule{ingressRule, egressRule})
+	assert.Equal(t, ruleRealizationErrors{ingressRule.ID: ruleErr}, err)
+	// Only the rule which is realized has its ofIDs recorded.
+	lastRealized, exists := r.lastRealizeds.Load(ingressRule.ID)
+	require.True(t, exists)
+	assert.Empty(t, lastRealized.(*podPolicyLastRealized).ofIDs)
+	lastRealized, exists = r.lastRealizeds.Load(egressRule.ID)
+	require.True(t, exists)
+	assert.Len(t, lastRealized.(*podPolicyLastRealized).ofIDs, 1)
+}
+
 func TestReconcilerUpdate(t *testing.T) {
 	ifaceStore := interfacestore.NewInterfaceStore()
 	ifaceStore.AddInterface(
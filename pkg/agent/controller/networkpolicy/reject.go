@@ -20,10 +20,12 @@ import (
 
 	"antrea.io/libOpenflow/protocol"
 	"antrea.io/ofnet/ofctrl"
+	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -184,9 +186,31 @@ func (c *Controller) rejectRequest(pktIn *ofctrl.PacketIn) error {
 		isIPv6,
 		ethernetPkt,
 		proto,
+		c.getRejectResponse(pktIn),
 		mutateFunc)
 }
 
+// getRejectResponse returns the reject response of the rule matched by the packet-in packet. An empty response, which
+// means the default response of the protocol, is returned if the rule cannot be found.
+func (c *Controller) getRejectResponse(pktIn *ofctrl.PacketIn) crdv1beta1.RejectResponseType {
+	match := getMatchRegField(pktIn.GetMatches(), openflow.APConjIDField)
+	if match == nil {
+		return ""
+	}
+	ruleID, err := getInfoInReg(match, nil)
+	if err != nil {
+		klog.ErrorS(err, "Error when obtaining rule id from reg for reject response")
+		return ""
+	}
+	rule := c.GetRuleByFlowID(ruleID)
+	if rule == nil || rule.PolicyRef == nil {
+		// The rule must have been deleted or updated.
+		klog.V(4).InfoS("Cannot find rule for reject response", "ruleID", ruleID)
+		return ""
+	}
+	return c.ruleCache.getRejectResponse(string(rule.PolicyRef.UID), rule.Direction, rule.Name)
+}
+
 // getRejectType returns rejectType of a rejection.
 func getRejectType(isServiceTraffic, antreaProxyEnabled, srcIsLocal, dstIsLocal bool) rejectType {
 	if !isServiceTraffic {
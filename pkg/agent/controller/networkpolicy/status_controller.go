@@ -17,6 +17,8 @@ package networkpolicy
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,11 +40,13 @@ const (
 // StatusManager keeps track of the realized NetworkPolicy rules. It syncs the status of a NetworkPolicy to the
 // antrea-controller once it is realized. A policy is considered realized when all of its desired rules have been
 // realized and all of its undesired rules have been removed.
-// For each new policy, SetRuleRealization is supposed to be called for each of its desired rules while
-// DeleteRuleRealization is supposed to be called for the removed rules.
+// For each new policy, SetRuleRealization or SetRuleRealizationFailure is supposed to be called for each of its
+// desired rules while DeleteRuleRealization is supposed to be called for the removed rules.
 type StatusManager interface {
 	// SetRuleRealization updates the actual status for the given NetworkPolicy rule.
 	SetRuleRealization(ruleID string, policyID types.UID)
+	// SetRuleRealizationFailure updates the actual status for the given NetworkPolicy rule which cannot be realized.
+	SetRuleRealizationFailure(ruleID string, policyID types.UID, err error)
 	// DeleteRuleRealization deletes the actual status for the given NetworkPolicy rule.
 	DeleteRuleRealization(ruleID string)
 	// Resync triggers syncing status with the antrea-controller for the given NetworkPolicy.
@@ -71,6 +75,8 @@ type StatusController struct {
 type realizedRule struct {
 	ruleID   string
 	policyID types.UID
+	// failureMessage is the error which occurred when realizing the rule, empty if the rule is realized.
+	failureMessage string
 }
 
 func realizedRuleKeyFunc(obj interface{}) (string, error) {
@@ -95,16 +101,26 @@ func newStatusController(antreaClientProvider client.AntreaClientProvider, nodeN
 }
 
 func (c *StatusController) SetRuleRealization(ruleID string, policyID types.UID) {
-	_, exists, _ := c.realizedRules.GetByKey(ruleID)
+	obj, exists, _ := c.realizedRules.GetByKey(ruleID)
 	// This rule has been realized before. The current call must be triggered by group member updates, which doesn't
 	// affect the policy's realization status.
-	if exists {
+	if exists && obj.(*realizedRule).failureMessage == "" {
 		return
 	}
 	c.realizedRules.Add(&realizedRule{ruleID: ruleID, policyID: policyID})
 	c.queue.Add(policyID)
 }
 
+func (c *StatusController) SetRuleRealizationFailure(ruleID string, policyID types.UID, err error) {
+	obj, exists, _ := c.realizedRules.GetByKey(ruleID)
+	// The same failure has been reported before.
+	if exists && obj.(*realizedRule).failureMessage == err.Error() {
+		return
+	}
+	c.realizedRules.Add(&realizedRule{ruleID: ruleID, policyID: policyID, failureMessage: err.Error()})
+	c.queue.Add(policyID)
+}
+
 func (c *StatusController) DeleteRuleRealization(ruleID string) {
 	obj, exists, _ := c.realizedRules.GetByKey(ruleID)
 	// This rule hasn't been realized before, so it doesn't affect the policy's realization status.
@@ -178,18 +194,23 @@ func (c *StatusController) syncHandler(uid types.UID) error {
 	for _, r := range desiredRules {
 		desiredRuleSet.Insert(r.ID)
 	}
+	var failureMessages []string
 	for _, r := range actualRules {
 		ruleID := r.(*realizedRule).ruleID
 		if !desiredRuleSet.Has(ruleID) {
 			return nil
 		}
 		desiredRuleSet.Delete(ruleID)
+		if failureMessage := r.(*realizedRule).failureMessage; failureMessage != "" {
+			failureMessages = append(failureMessages, failureMessage)
+		}
 	}
 	if len(desiredRuleSet) > 0 {
 		return nil
 	}
+	sort.Strings(failureMessages)
 
-	// At this point, all desired rules have been realized and all undesired rules have been removed, report it to the antrea-controller.
+	// At this point, all desired rules have been processed and all undesired rules have been removed, report it to the antrea-controller.
 	klog.V(2).Infof("Syncing NetworkPolicyStatus for %s, generation: %v", uid, policy.Generation)
 	status := &v1beta2.NetworkPolicyStatus{
 		ObjectMeta: metav1.ObjectMeta{
@@ -199,7 +220,8 @@ func (c *StatusController) syncHandler(uid types.UID) error {
 			{
 				NodeName:           c.nodeName,
 				Generation:         policy.Generation,
-				RealizationFailure: false,
+				RealizationFailure: len(failureMessages) > 0,
+				Message:            strings.Join(failureMessages, "; "),
 			},
 		},
 	}
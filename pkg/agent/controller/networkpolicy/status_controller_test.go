@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -180,6 +181,34 @@ func TestSyncStatusUpForUpdatedPolicy(t *testing.T) {
 	assert.NoError(t, matchGeneration(policy.Generation), "The generation should be updated to %v but was not updated", policy.Generation)
 }
 
+func TestSyncStatusForFailedRule(t *testing.T) {
+	statusController, ruleCache, statusControl := newTestStatusController()
+	ruleCache.AddAppliedToGroup(newAppliedToGroup("appliedToGroup1", []v1beta2.GroupMember{*newAppliedToGroupMemberPod("pod1", "ns1")}))
+	policy := newNetworkPolicyWithMultipleRules("policy1", "uid1", []string{"addressGroup1"}, []string{}, []string{"appliedToGroup1"}, nil)
+	policy.Generation = 1
+	ruleCache.AddNetworkPolicy(policy)
+	rules := ruleCache.getEffectiveRulesByNetworkPolicy(string(policy.UID))
+	require.Len(t, rules, 2)
+
+	statusController.SetRuleRealizationFailure(rules[0].ID, policy.UID, fmt.Errorf("cannot rate-limit the traffic"))
+	statusController.SetRuleRealization(rules[1].ID, policy.UID)
+	require.NoError(t, statusController.syncHandler(policy.UID))
+	expectedNodeStatus := v1beta2.NetworkPolicyNodeStatus{
+		NodeName:           testNode1,
+		Generation:         1,
+		RealizationFailure: true,
+		Message:            "cannot rate-limit the traffic",
+	}
+	assert.Equal(t, []v1beta2.NetworkPolicyNodeStatus{expectedNodeStatus}, statusControl.getNetworkPolicyStatus().Nodes)
+
+	// The failure is cleared once the rule is realized.
+	statusController.SetRuleRealization(rules[0].ID, policy.UID)
+	require.NoError(t, statusController.syncHandler(policy.UID))
+	expectedNodeStatus.RealizationFailure = false
+	expectedNodeStatus.Message = ""
+	assert.Equal(t, []v1beta2.NetworkPolicyNodeStatus{expectedNodeStatus}, statusControl.getNetworkPolicyStatus().Nodes)
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy has 100 rules. Its current result is:
 // 47754 ns/op           15320 B/op         23 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
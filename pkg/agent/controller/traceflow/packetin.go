@@ -167,9 +167,56 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 	ns = tf.Spec.Source.Namespace
 	srcPod = tf.Spec.Source.Pod
 
+	// In a session Traceflow, identify the request packet and the
+	// direction of the packet.
+	var sessionPkt *binding.Packet
+	var sessionPacket int32
+	var sessionReply bool
+	if tfState.session != nil {
+		sessionPkt, err = binding.ParsePacketIn(pktIn)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse Traceflow session packet: %w", err)
+		}
+		sessionPacket, sessionReply = getSessionPacket(tf, sessionPkt)
+		if sessionReply && !matchesSessionEchoID(tf, sessionPkt) {
+			// OVS cannot match the Echo ID of ICMP connections, so the
+			// replies of other pings from the source Pod to the
+			// destination are tagged too. They are not reported, and
+			// are delivered if they reached the source Pod.
+			if tfState.isSender {
+				if match := getMatchRegField(matchers, openflow.TargetOFPortField); match != nil {
+					if outputPort, err := getRegValue(match, nil); err == nil && outputPort == tfState.ofPort {
+						if err := c.ofClient.SendEthPacketOut(c.nodeConfig.GatewayConfig.OFPort, outputPort, etherData, nil); err != nil {
+							klog.ErrorS(err, "Failed to deliver ICMP Echo Reply not belonging to Traceflow", "Traceflow", tfState.name)
+						}
+					}
+				}
+			}
+			return nil, nil, nil, skipTraceflowUpdateErr
+		}
+	}
+
 	obs := []crdv1beta1.Observation{}
 	tableID := pktIn.TableId
-	if tfState.isSender {
+	if sessionReply {
+		// The reply packet was sent by the destination, or was
+		// received from another Node.
+		ob := new(crdv1beta1.Observation)
+		ob.Component = crdv1beta1.ComponentForwarding
+		ob.Action = crdv1beta1.ActionReceived
+		obs = append(obs, *ob)
+	} else if tfState.isSender && tf.Spec.Source.Node != "" {
+		// The packet was injected on the gateway or uplink port of the
+		// source Node.
+		ob := new(crdv1beta1.Observation)
+		ob.Component = crdv1beta1.ComponentClassifier
+		ob.ComponentInfo = c.nodeConfig.GatewayConfig.Name
+		if tf.Spec.Source.IP != "" && c.nodeConfig.HostInterfaceOFPort != 0 && c.nodeConfig.UplinkNetConfig != nil {
+			ob.ComponentInfo = c.nodeConfig.UplinkNetConfig.Name
+		}
+		ob.Action = crdv1beta1.ActionForwarded
+		obs = append(obs, *ob)
+	} else if tfState.isSender {
 		ob := new(crdv1beta1.Observation)
 		ob.Component = crdv1beta1.ComponentSpoofGuard
 		ob.Action = crdv1beta1.ActionForwarded
@@ -311,7 +358,11 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				obs = append(obs, *obEgress)
 			}
 			ob.TunnelDstIP = tunnelDstIP
-			ob.Action = crdv1beta1.ActionForwarded
+			if c.forwardsToPeerCluster(ipDst) {
+				ob.Action = crdv1beta1.ActionForwardedToRemoteCluster
+			} else {
+				ob.Action = crdv1beta1.ActionForwarded
+			}
 		} else if ipDst == gatewayIP.String() && outputPort == gwPort {
 			ob.Action = crdv1beta1.ActionDelivered
 		} else if c.networkConfig.TrafficEncapMode.SupportsEncap() && outputPort == gwPort {
@@ -338,7 +389,14 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				obEgress := getEgressObservation(true, egressIP, egressName, egressNode)
 				obs = append(obs, *obEgress)
 			}
-			ob.Action = crdv1beta1.ActionForwardedOutOfOverlay
+			// With WireGuard, the packets to the peer member clusters
+			// are forwarded to the WireGuard interface through the
+			// gateway port.
+			if c.forwardsToPeerCluster(ipDst) {
+				ob.Action = crdv1beta1.ActionForwardedToRemoteCluster
+			} else {
+				ob.Action = crdv1beta1.ActionForwardedOutOfOverlay
+			}
 		} else if outputPort == gwPort { // noEncap
 			ob.Action = crdv1beta1.ActionForwarded
 		} else {
@@ -348,12 +406,68 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		ob.ComponentInfo = openflow.OutputTable.GetName()
 		ob.Component = crdv1beta1.ComponentForwarding
 		obs = append(obs, *ob)
+
+		// The reply packet reaches the source Pod: report it as the
+		// captured packet, and complete the TCP handshake if it is
+		// the SYN-ACK.
+		if sessionReply && tfState.isSender && outputPort == tfState.ofPort {
+			capturedPacket = parseCapturedPacket(pktIn)
+			if sessionPkt.IPProto == protocol.Type_TCP && sessionPkt.TCPFlags&tcpFlagSYN != 0 {
+				if err := c.injectSessionACK(tfState, sessionPkt); err != nil {
+					klog.ErrorS(err, "Failed to inject ACK for Traceflow", "Traceflow", tfState.name)
+				}
+			}
+		}
 	}
 
-	nodeResult := crdv1beta1.NodeResult{Node: c.nodeConfig.Name, Timestamp: time.Now().Unix(), Observations: obs}
+	nodeResult := crdv1beta1.NodeResult{Node: c.nodeConfig.Name, Timestamp: time.Now().Unix(), Packet: sessionPacket, Reply: sessionReply, Observations: obs}
 	return tf, &nodeResult, capturedPacket, nil
 }
 
+// forwardsToPeerCluster returns whether the packet to ipDst is forwarded to a peer member cluster
+// by the Multi-cluster Gateway on the Node.
+func (c *Controller) forwardsToPeerCluster(ipDst string) bool {
+	if c.mcGatewayQuerier == nil {
+		return false
+	}
+	return c.mcGatewayQuerier.ForwardsToPeerCluster(net.ParseIP(ipDst))
+}
+
+// getSessionPacket returns the index of the request packet of a session Traceflow that the packet belongs to, and
+// whether the packet is the reply to the request packet.
+func getSessionPacket(tf *crdv1beta1.Traceflow, pkt *binding.Packet) (int32, bool) {
+	if pkt.IPProto == protocol.Type_TCP {
+		// The SYN, and the SYN-ACK or RST replying to it, belong to the
+		// first request packet. The ACK completing the handshake is the
+		// second request packet.
+		if pkt.TCPFlags&tcpFlagRST != 0 {
+			return 1, true
+		}
+		if pkt.TCPFlags&tcpFlagSYN != 0 {
+			return 1, pkt.TCPFlags&tcpFlagACK != 0
+		}
+		return 2, false
+	}
+	var firstSeq uint16
+	if icmp := tf.Spec.Packet.TransportHeader.ICMP; icmp != nil {
+		firstSeq = uint16(icmp.Sequence)
+	}
+	return int32(pkt.ICMPEchoSeq-firstSeq) + 1, pkt.ICMPType == icmpEchoReplyType || pkt.ICMPType == icmpv6EchoReplyType
+}
+
+// matchesSessionEchoID returns whether the ICMP Echo ID of a packet is the one of the Echo Requests of a session
+// Traceflow. TCP packets always match.
+func matchesSessionEchoID(tf *crdv1beta1.Traceflow, pkt *binding.Packet) bool {
+	if pkt.IPProto == protocol.Type_TCP {
+		return true
+	}
+	var echoID uint16
+	if icmp := tf.Spec.Packet.TransportHeader.ICMP; icmp != nil {
+		echoID = uint16(icmp.ID)
+	}
+	return pkt.ICMPEchoID == echoID
+}
+
 func getMatchPktMarkField(matchers *ofctrl.Matchers) *ofctrl.MatchField {
 	return matchers.GetMatchByName("NXM_NX_PKT_MARK")
 }
@@ -35,7 +35,10 @@ import (
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/querier"
 	queriertest "antrea.io/antrea/pkg/querier/testing"
+	"antrea.io/antrea/pkg/util/ip"
 )
 
 var (
@@ -44,6 +47,14 @@ var (
 	egressNode = "fakeEgressNode"
 )
 
+type fakeMCGatewayQuerier struct {
+	peerCIDR *net.IPNet
+}
+
+func (q *fakeMCGatewayQuerier) ForwardsToPeerCluster(dstIP net.IP) bool {
+	return q.peerCIDR.Contains(dstIP)
+}
+
 func prepareMockTables() {
 	openflow.InitMockTables(
 		map[*openflow.Table]uint8{
@@ -231,6 +242,15 @@ func TestParsePacketIn(t *testing.T) {
 			Data: xreg0,
 		},
 	}
+	xreg0ToTunnel := make([]byte, 8)
+	binary.BigEndian.PutUint32(xreg0ToTunnel[4:8], 2) // outputPort in 32bit reg1
+	matchOutPortToTunnel := &openflow15.MatchField{
+		Class: openflow15.OXM_CLASS_PACKET_REGS,
+		Field: openflow15.NXM_NX_REG0,
+		Value: &openflow15.ByteArrayField{
+			Data: xreg0ToTunnel,
+		},
+	}
 	matchPktMark := &openflow15.MatchField{
 		Class: openflow15.OXM_CLASS_NXM_1,
 		Field: openflow15.NXM_NX_PKT_MARK,
@@ -281,6 +301,7 @@ func TestParsePacketIn(t *testing.T) {
 		nodeConfig         *config.NodeConfig
 		tfState            *traceflowState
 		pktIn              *ofctrl.PacketIn
+		mcGatewayQuerier   querier.MulticlusterGatewayQuerier
 		expectedCalls      func(*queriertest.MockAgentNetworkPolicyInfoQuerier, *queriertest.MockEgressQuerier)
 		expectedTf         *crdv1beta1.Traceflow
 		expectedNodeResult *crdv1beta1.NodeResult
@@ -488,6 +509,67 @@ func TestParsePacketIn(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "packet at Multi-cluster Gateway forwarded to peer member cluster",
+			networkConfig: &config.NetworkConfig{
+				TrafficEncapMode: 0,
+			},
+			nodeConfig: &config.NodeConfig{
+				TunnelOFPort: 2,
+				GatewayConfig: &config.GatewayConfig{
+					OFPort: 1,
+				},
+			},
+			tfState: &traceflowState{
+				name: "traceflow-pod-to-ipv4",
+				tag:  1,
+			},
+			pktIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.OutputTable.GetID(),
+					Match: openflow15.Match{
+						Fields: []openflow15.MatchField{*matchTunDst, *matchOutPortToTunnel},
+					},
+					Data: util.NewBuffer(pktBytesPodToIP),
+				},
+			},
+			mcGatewayQuerier: &fakeMCGatewayQuerier{peerCIDR: ip.MustParseCIDR("192.168.99.0/24")},
+			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			},
+			expectedTf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traceflow-pod-to-ipv4",
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						IP: dstIPv4,
+					},
+					Multicluster: true,
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedNodeResult: &crdv1beta1.NodeResult{
+				Observations: []crdv1beta1.Observation{
+					{
+						Component: crdv1beta1.ComponentForwarding,
+						Action:    crdv1beta1.ActionReceived,
+					},
+					{
+						Component:     crdv1beta1.ComponentForwarding,
+						ComponentInfo: openflow.OutputTable.GetName(),
+						Action:        crdv1beta1.ActionForwardedToRemoteCluster,
+						TunnelDstIP:   egressIP,
+					},
+				},
+			},
+		},
 		{
 			name:       "packet at source Node forwarded by acnp egress rule",
 			nodeConfig: &config.NodeConfig{},
@@ -692,6 +774,7 @@ func TestParsePacketIn(t *testing.T) {
 			tfc.crdInformerFactory.Start(stopCh)
 			tfc.crdInformerFactory.WaitForCacheSync(stopCh)
 			tfc.runningTraceflows[tt.expectedTf.Status.DataplaneTag] = tt.tfState
+			tfc.mcGatewayQuerier = tt.mcGatewayQuerier
 			tt.expectedCalls(tfc.networkPolicyQuerier, tfc.egressQuerier)
 
 			tf, nodeResult, _, err := tfc.parsePacketIn(tt.pktIn)
@@ -732,3 +815,74 @@ func TestParsePacketInLiveDuplicates(t *testing.T) {
 	_, _, _, err := tfc.parsePacketIn(pktIn)
 	assert.ErrorIs(t, err, skipTraceflowUpdateErr)
 }
+
+func TestGetSessionPacket(t *testing.T) {
+	tf := &crdv1beta1.Traceflow{
+		Spec: crdv1beta1.TraceflowSpec{
+			Packet: crdv1beta1.Packet{
+				TransportHeader: crdv1beta1.TransportHeader{ICMP: &crdv1beta1.ICMPEchoRequestHeader{Sequence: 10}},
+			},
+		},
+	}
+	tcs := []struct {
+		name           string
+		packet         *binding.Packet
+		expectedPacket int32
+		expectedReply  bool
+	}{
+		{
+			name:           "SYN",
+			packet:         &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagSYN},
+			expectedPacket: 1,
+		},
+		{
+			name:           "SYN-ACK",
+			packet:         &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagSYN | tcpFlagACK},
+			expectedPacket: 1,
+			expectedReply:  true,
+		},
+		{
+			name:           "RST",
+			packet:         &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagRST | tcpFlagACK},
+			expectedPacket: 1,
+			expectedReply:  true,
+		},
+		{
+			name:           "ACK",
+			packet:         &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagACK},
+			expectedPacket: 2,
+		},
+		{
+			name:           "Echo Request",
+			packet:         &binding.Packet{IPProto: protocol.Type_ICMP, ICMPType: icmpEchoRequestType, ICMPEchoSeq: 11},
+			expectedPacket: 2,
+		},
+		{
+			name:           "ICMPv6 Echo Reply",
+			packet:         &binding.Packet{IPProto: protocol.Type_IPv6ICMP, ICMPType: icmpv6EchoReplyType, ICMPEchoSeq: 12},
+			expectedPacket: 3,
+			expectedReply:  true,
+		},
+	}
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			packet, reply := getSessionPacket(tf, tt.packet)
+			assert.Equal(t, tt.expectedPacket, packet)
+			assert.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestMatchesSessionEchoID(t *testing.T) {
+	tf := &crdv1beta1.Traceflow{
+		Spec: crdv1beta1.TraceflowSpec{
+			Packet: crdv1beta1.Packet{
+				TransportHeader: crdv1beta1.TransportHeader{ICMP: &crdv1beta1.ICMPEchoRequestHeader{ID: 100}},
+			},
+		},
+	}
+	assert.True(t, matchesSessionEchoID(tf, &binding.Packet{IPProto: protocol.Type_ICMP, ICMPType: icmpEchoReplyType, ICMPEchoID: 100}))
+	assert.False(t, matchesSessionEchoID(tf, &binding.Packet{IPProto: protocol.Type_ICMP, ICMPType: icmpEchoReplyType, ICMPEchoID: 101}))
+	assert.True(t, matchesSessionEchoID(tf, &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagSYN | tcpFlagACK}))
+	assert.True(t, matchesSessionEchoID(&crdv1beta1.Traceflow{}, &binding.Packet{IPProto: protocol.Type_IPv6ICMP, ICMPType: icmpv6EchoReplyType}))
+}
@@ -19,11 +19,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"sync"
 	"time"
 
 	"antrea.io/libOpenflow/protocol"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -61,11 +63,25 @@ const (
 	injectPacketDelay      = 2000
 	injectLocalPacketDelay = 100
 
-	// ICMP Echo Request type and code.
+	// Delay in milliseconds between the request packets of a session Traceflow.
+	injectSessionPacketInterval = 500
+
+	// ICMP Echo Request and Echo Reply types and code.
 	icmpEchoRequestType   uint8 = 8
+	icmpEchoReplyType     uint8 = 0
 	icmpv6EchoRequestType uint8 = 128
+	icmpv6EchoReplyType   uint8 = 129
 	icmpEchoRequestCode   uint8 = 0
 
+	tcpFlagSYN uint8 = 0b10
+	tcpFlagRST uint8 = 0b100
+	tcpFlagACK uint8 = 0b10000
+
+	// Range of the TCP source port of a session Traceflow, which is chosen in the ephemeral port range of Linux
+	// when it is not specified.
+	sessionMinSourcePort = 32768
+	sessionMaxSourcePort = 60999
+
 	defaultTTL uint8 = 64
 )
 
@@ -81,6 +97,11 @@ type traceflowState struct {
 	isSender     bool
 	// Agent received the first Traceflow packet from OVS.
 	receivedPacket bool
+	// session is set for a session Traceflow.
+	session *crdv1beta1.TraceflowSession
+	// The first request packet of a session Traceflow and the OVS port of the source Pod, set on the sender Node.
+	sessionPacket *binding.Packet
+	ofPort        uint32
 }
 
 // Controller is responsible for setting up Openflow entries and injecting traceflow packet into
@@ -96,6 +117,7 @@ type Controller struct {
 	ofClient               openflow.Client
 	networkPolicyQuerier   querier.AgentNetworkPolicyInfoQuerier
 	egressQuerier          querier.EgressQuerier
+	mcGatewayQuerier       querier.MulticlusterGatewayQuerier
 	interfaceStore         interfacestore.InterfaceStore
 	networkConfig          *config.NetworkConfig
 	nodeConfig             *config.NodeConfig
@@ -118,6 +140,7 @@ func NewTraceflowController(
 	client openflow.Client,
 	npQuerier querier.AgentNetworkPolicyInfoQuerier,
 	egressQuerier querier.EgressQuerier,
+	mcGatewayQuerier querier.MulticlusterGatewayQuerier,
 	interfaceStore interfacestore.InterfaceStore,
 	networkConfig *config.NetworkConfig,
 	nodeConfig *config.NodeConfig,
@@ -132,6 +155,7 @@ func NewTraceflowController(
 		ofClient:              client,
 		networkPolicyQuerier:  npQuerier,
 		egressQuerier:         egressQuerier,
+		mcGatewayQuerier:      mcGatewayQuerier,
 		interfaceStore:        interfaceStore,
 		networkConfig:         networkConfig,
 		nodeConfig:            nodeConfig,
@@ -308,38 +332,71 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	}
 
 	receiverOnly := false
-	var pod, ns string
-	if tf.Spec.Source.Pod != "" {
-		pod = tf.Spec.Source.Pod
-		ns = tf.Spec.Source.Namespace
-	} else {
-		// Live-traffic Traceflow with only the Destination Pod specified.
-		pod = tf.Spec.Destination.Pod
-		ns = tf.Spec.Destination.Namespace
-		receiverOnly = true
-	}
-
-	// TODO: let controller compute the sender/receiver Node, and the sender
-	// /receiver Node can just return an error, if fails to find the Pod.
-	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
-	isSender := len(podInterfaces) > 0 && !receiverOnly
-
+	isSender := false
 	liveTraffic := tf.Spec.LiveTraffic
+	session := tf.Spec.Session != nil
 	var packet, matchPacket *binding.Packet
 	var ofPort uint32
-	if len(podInterfaces) > 0 {
-		packet, err = c.preparePacket(tf, podInterfaces[0], receiverOnly)
-		if err != nil {
-			return err
+	if tf.Spec.RemoteSource != nil {
+		// The packet is injected in the source member cluster, and enters
+		// the local cluster through the Multi-cluster Gateway with the
+		// data plane tag of the source Traceflow. Flows are installed on
+		// every Node and no packet is injected.
+	} else if tf.Spec.Source.Node != "" {
+		// The packet is sent from the host network of the source Node, or
+		// from an external client through the source Node.
+		if tf.Spec.Source.Node == c.nodeConfig.Name {
+			isSender = true
+			packet, ofPort, err = c.prepareNodePacket(tf)
+			if err != nil {
+				return err
+			}
+			klog.V(2).Infof("Traceflow packet %v", *packet)
 		}
-		ofPort = uint32(podInterfaces[0].OFPort)
-		// On the sender or receiver (the receiverOnly case) Node, trace
-		// the first packet of the first connection that matches the
-		// Traceflow spec.
-		if liveTraffic {
-			matchPacket = packet
+	} else {
+		var pod, ns string
+		if tf.Spec.Source.Pod != "" {
+			pod = tf.Spec.Source.Pod
+			ns = tf.Spec.Source.Namespace
+		} else {
+			// Live-traffic Traceflow with only the Destination Pod specified.
+			pod = tf.Spec.Destination.Pod
+			ns = tf.Spec.Destination.Namespace
+			receiverOnly = true
+		}
+
+		// TODO: let controller compute the sender/receiver Node, and the sender
+		// /receiver Node can just return an error, if fails to find the Pod.
+		podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
+		isSender = len(podInterfaces) > 0 && !receiverOnly
+
+		if len(podInterfaces) > 0 {
+			packet, err = c.preparePacket(tf, podInterfaces[0], receiverOnly)
+			if err != nil {
+				return err
+			}
+			ofPort = uint32(podInterfaces[0].OFPort)
+			// On the sender or receiver (the receiverOnly case) Node, trace
+			// the first packet of the first connection that matches the
+			// Traceflow spec.
+			if liveTraffic {
+				matchPacket = packet
+			}
+			if session {
+				if packet.IPProto == protocol.Type_TCP && packet.SourcePort == 0 {
+					packet.SourcePort = sessionSourcePort(tf)
+				}
+				matchPacket = packet
+			}
+			klog.V(2).Infof("Traceflow packet %v", *packet)
+		} else if session {
+			// The reply packets of the session are identified by the
+			// source Pod IP and port on every Node.
+			matchPacket, err = c.prepareSessionMatchPacket(tf)
+			if err != nil {
+				return err
+			}
 		}
-		klog.V(2).Infof("Traceflow packet %v", *packet)
 	}
 
 	// Store Traceflow to cache.
@@ -347,7 +404,11 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	tfState := traceflowState{
 		uid: tf.UID, name: tf.Name, tag: tf.Status.DataplaneTag,
 		liveTraffic: liveTraffic, droppedOnly: tf.Spec.DroppedOnly && liveTraffic,
-		receiverOnly: receiverOnly, isSender: isSender}
+		receiverOnly: receiverOnly, isSender: isSender, session: tf.Spec.Session}
+	if session && isSender {
+		tfState.sessionPacket = packet
+		tfState.ofPort = ofPort
+	}
 	c.runningTraceflows[tfState.tag] = &tfState
 	c.runningTraceflowsMutex.Unlock()
 
@@ -357,17 +418,17 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	if timeout == 0 {
 		timeout = crdv1beta1.DefaultTraceflowTimeout
 	}
-	err = c.ofClient.InstallTraceflowFlows(uint8(tfState.tag), liveTraffic, tfState.droppedOnly, receiverOnly, matchPacket, ofPort, uint16(timeout))
+	err = c.ofClient.InstallTraceflowFlows(uint8(tfState.tag), liveTraffic, tfState.droppedOnly, receiverOnly, session, matchPacket, ofPort, uint16(timeout))
 	if err != nil {
 		return err
 	}
 
 	// Skip packet injection if the source Pod is not found on the local Node.
 	if !liveTraffic && isSender {
-		if packet.DestinationMAC == nil {
-			// If the destination is Service/IP or the packet will
-			// be sent to remote Node, wait a small period for other
-			// Nodes.
+		if packet.DestinationMAC == nil || tf.Spec.Source.Node != "" {
+			// If the destination is Service/IP, the packet will be
+			// sent to remote Node, or the source is a Node, wait a
+			// small period for other Nodes.
 			time.Sleep(time.Duration(injectPacketDelay) * time.Millisecond)
 		} else {
 			// Issue #2116
@@ -375,11 +436,104 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 			time.Sleep(time.Duration(injectLocalPacketDelay) * time.Millisecond)
 		}
 		klog.V(2).Infof("Injecting packet for Traceflow %s", tf.Name)
+		if session {
+			return c.injectSessionPackets(tf, uint8(tfState.tag), *packet, ofPort)
+		}
 		err = c.ofClient.SendTraceflowPacket(uint8(tfState.tag), packet, ofPort, -1)
 	}
 	return err
 }
 
+// injectSessionPackets injects the request packets of a session Traceflow. In TCPHandshake mode, only the SYN is
+// injected here, and the ACK is injected when the SYN-ACK is received. In ICMPEcho mode, the Echo Requests are injected
+// with increasing sequence numbers.
+func (c *Controller) injectSessionPackets(tf *crdv1beta1.Traceflow, tag uint8, packet binding.Packet, ofPort uint32) error {
+	if tf.Spec.Session.Mode == crdv1beta1.TraceflowSessionTCPHandshake {
+		return c.ofClient.SendTraceflowPacket(tag, &packet, ofPort, -1)
+	}
+	packets := tf.Spec.Session.Packets
+	if packets == 0 {
+		packets = crdv1beta1.DefaultTraceflowSessionPackets
+	}
+	for i := int32(0); i < packets; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(injectSessionPacketInterval) * time.Millisecond)
+		}
+		if err := c.ofClient.SendTraceflowPacket(tag, &packet, ofPort, -1); err != nil {
+			return err
+		}
+		packet.ICMPEchoSeq++
+	}
+	return nil
+}
+
+// injectSessionACK injects the ACK completing the TCP handshake of a session Traceflow, replying to the SYN-ACK
+// delivered to the source Pod. A RST is injected right after the ACK to close the connection established on the
+// destination, as no socket in the source Pod owns it. The RST follows the same path as the ACK, and is not tagged
+// with the data plane tag so that it is not traced.
+func (c *Controller) injectSessionACK(tfState *traceflowState, synACK *binding.Packet) error {
+	ack := *tfState.sessionPacket
+	ack.TCPFlags = tcpFlagACK
+	ack.TCPSeqNum = synACK.TCPAckNum
+	ack.TCPAckNum = synACK.TCPSeqNum + 1
+	klog.V(2).InfoS("Injecting ACK for Traceflow", "Traceflow", tfState.name)
+	if err := c.ofClient.SendTraceflowPacket(uint8(tfState.tag), &ack, tfState.ofPort, -1); err != nil {
+		return err
+	}
+	rst := ack
+	rst.TCPFlags = tcpFlagRST
+	rst.TCPAckNum = 0
+	klog.V(2).InfoS("Injecting RST for Traceflow", "Traceflow", tfState.name)
+	return c.ofClient.SendTraceflowPacket(0, &rst, tfState.ofPort, -1)
+}
+
+// sessionSourcePort returns the TCP source port of a session Traceflow whose source port is not specified. The port is
+// derived from the UID of the Traceflow, so that all Nodes can identify the connection without coordination.
+func sessionSourcePort(tf *crdv1beta1.Traceflow) uint16 {
+	h := fnv.New32a()
+	h.Write([]byte(tf.UID))
+	return uint16(sessionMinSourcePort + h.Sum32()%(sessionMaxSourcePort-sessionMinSourcePort+1))
+}
+
+// prepareSessionMatchPacket builds the packet identifying the connection of a session Traceflow on a Node which is not
+// the sender Node. Only the fields of the original direction of the connection are set.
+func (c *Controller) prepareSessionMatchPacket(tf *crdv1beta1.Traceflow) (*binding.Packet, error) {
+	requestPacket, err := c.preparePacket(tf, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	srcPod, err := c.kubeClient.CoreV1().Pods(tf.Spec.Source.Namespace).Get(context.TODO(), tf.Spec.Source.Pod, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the source Pod: %v", err)
+	}
+	podIPs := make([]net.IP, len(srcPod.Status.PodIPs))
+	for i, ip := range srcPod.Status.PodIPs {
+		podIPs[i] = net.ParseIP(ip.IP)
+	}
+	packet := &binding.Packet{IsIPv6: requestPacket.IsIPv6, IPProto: requestPacket.IPProto}
+	if packet.IsIPv6 {
+		packet.SourceIP, _ = util.GetIPWithFamily(podIPs, util.FamilyIPv6)
+	} else {
+		packet.SourceIP = util.GetIPv4Addr(podIPs).To4()
+	}
+	if packet.SourceIP == nil {
+		return nil, errors.New("source Pod does not have an IP address of the IP header family")
+	}
+	// A connection to a Service is DNATed on the sender Node, and its original destination on the other Nodes is the
+	// selected Endpoint, which is unknown here.
+	if tf.Spec.Destination.Service == "" {
+		packet.DestinationIP = requestPacket.DestinationIP
+		packet.DestinationPort = requestPacket.DestinationPort
+	}
+	if packet.IPProto == protocol.Type_TCP {
+		packet.SourcePort = requestPacket.SourcePort
+		if packet.SourcePort == 0 {
+			packet.SourcePort = sessionSourcePort(tf)
+		}
+	}
+	return packet, nil
+}
+
 func (c *Controller) validateTraceflow(tf *crdv1beta1.Traceflow) error {
 	if tf.Spec.Destination.Service != "" && !c.enableAntreaProxy {
 		return errors.New("using Service destination requires AntreaProxy enabled")
@@ -395,12 +549,14 @@ func (c *Controller) validateTraceflow(tf *crdv1beta1.Traceflow) error {
 	return nil
 }
 
+// preparePacket builds the Traceflow packet. intf is the interface of the source Pod, or of the
+// destination Pod in a receiver-only Traceflow, and is nil when the source is a Node.
 func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestore.InterfaceConfig, receiverOnly bool) (*binding.Packet, error) {
 	liveTraffic := tf.Spec.LiveTraffic
 	isICMP := false
 	packet := new(binding.Packet)
 	packet.IsIPv6 = tf.Spec.Packet.IPv6Header != nil
-	if !liveTraffic {
+	if !liveTraffic && intf != nil {
 		if packet.IsIPv6 {
 			packet.SourceIP = intf.GetIPv6Addr()
 			if packet.SourceIP == nil {
@@ -568,6 +724,114 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 	return packet, nil
 }
 
+// prepareNodePacket builds the packet of a Traceflow whose source is the local Node, and returns
+// it with the OVS port on which it should be injected. Without a source IP, the packet is sent from
+// the host network and enters OVS through the gateway port. With a source IP, the packet comes from
+// an external client: it enters OVS through the uplink port when the uplink is connected to the OVS
+// bridge, and through the gateway port otherwise, after being routed by the host.
+func (c *Controller) prepareNodePacket(tf *crdv1beta1.Traceflow) (*binding.Packet, uint32, error) {
+	packet, err := c.preparePacket(tf, nil, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	gatewayConfig := c.nodeConfig.GatewayConfig
+	if tf.Spec.Source.IP == "" {
+		if packet.IsIPv6 {
+			packet.SourceIP = gatewayConfig.IPv6
+		} else {
+			packet.SourceIP = gatewayConfig.IPv4
+		}
+		if packet.SourceIP == nil {
+			return nil, 0, errors.New("source Node does not have a gateway IP address of the IP header family")
+		}
+		packet.SourceMAC = gatewayConfig.MAC
+		return packet, gatewayConfig.OFPort, nil
+	}
+
+	packet.SourceIP = net.ParseIP(tf.Spec.Source.IP)
+	if packet.SourceIP == nil {
+		return nil, 0, errors.New("invalid source IP address")
+	}
+	if (packet.SourceIP.To4() == nil) != packet.IsIPv6 {
+		return nil, 0, errors.New("source IP does not match the IP header family")
+	}
+	if !packet.IsIPv6 {
+		packet.SourceIP = packet.SourceIP.To4()
+	}
+	fromUplink := c.nodeConfig.HostInterfaceOFPort != 0 && c.nodeConfig.UplinkNetConfig != nil
+	if tf.Spec.Destination.Service != "" {
+		if err := c.setExternalServiceDestination(tf, packet, fromUplink); err != nil {
+			return nil, 0, err
+		}
+	}
+	if fromUplink {
+		// The packet is sent by a router of the Node network to the Node.
+		packet.SourceMAC = openflow.GlobalVirtualMAC
+		packet.DestinationMAC = c.nodeConfig.UplinkNetConfig.MAC
+		return packet, c.nodeConfig.UplinkNetConfig.OFPort, nil
+	}
+	packet.SourceMAC = gatewayConfig.MAC
+	return packet, gatewayConfig.OFPort, nil
+}
+
+// setExternalServiceDestination sets the destination of a packet sent to the destination Service
+// by an external client. The packet is sent to the LoadBalancer ingress IP of the Service if there
+// is one, or else to the NodePort of the Service matching the destination port.
+func (c *Controller) setExternalServiceDestination(tf *crdv1beta1.Traceflow, packet *binding.Packet, fromUplink bool) error {
+	dstSvc, err := c.serviceLister.Services(tf.Spec.Destination.Namespace).Get(tf.Spec.Destination.Service)
+	if err != nil {
+		return fmt.Errorf("failed to get the destination Service: %v", err)
+	}
+	for _, ingress := range dstSvc.Status.LoadBalancer.Ingress {
+		ingressIP := net.ParseIP(ingress.IP)
+		if ingressIP == nil || (ingressIP.To4() == nil) != packet.IsIPv6 {
+			continue
+		}
+		if !packet.IsIPv6 {
+			ingressIP = ingressIP.To4()
+		}
+		packet.DestinationIP = ingressIP
+		return nil
+	}
+	for _, port := range dstSvc.Spec.Ports {
+		if port.NodePort == 0 || port.Port != int32(packet.DestinationPort) || !protocolMatches(port.Protocol, packet.IPProto) {
+			continue
+		}
+		if fromUplink {
+			var nodeIP *net.IPNet
+			if packet.IsIPv6 {
+				nodeIP = c.nodeConfig.NodeIPv6Addr
+			} else {
+				nodeIP = c.nodeConfig.NodeIPv4Addr
+			}
+			if nodeIP == nil {
+				return errors.New("source Node does not have an IP address of the IP header family")
+			}
+			packet.DestinationIP = nodeIP.IP
+		} else if packet.IsIPv6 {
+			// NodePort traffic is redirected by the host to OVS through the gateway port,
+			// with the virtual NodePort DNAT IP as the destination.
+			packet.DestinationIP = config.VirtualNodePortDNATIPv6
+		} else {
+			packet.DestinationIP = config.VirtualNodePortDNATIPv4.To4()
+		}
+		packet.DestinationPort = uint16(port.NodePort)
+		return nil
+	}
+	return errors.New("destination Service is not exposed outside the cluster by a LoadBalancer ingress IP or a NodePort matching the destination port")
+}
+
+func protocolMatches(svcProtocol corev1.Protocol, ipProto uint8) bool {
+	switch svcProtocol {
+	case corev1.ProtocolUDP:
+		return ipProto == protocol.Type_UDP
+	case corev1.ProtocolSCTP:
+		return ipProto == protocol.IP_SCTP
+	default:
+		return ipProto == protocol.Type_TCP
+	}
+}
+
 func (c *Controller) errorTraceflowCRD(tf *crdv1beta1.Traceflow, reason string) (*crdv1beta1.Traceflow, error) {
 	tf.Status.Phase = crdv1beta1.Failed
 
@@ -16,6 +16,7 @@ package traceflow
 
 import (
 	"bytes"
+	"context"
 	"net"
 	"os"
 	"testing"
@@ -29,12 +30,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	"antrea.io/antrea/pkg/agent/util"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -495,6 +499,189 @@ func TestPreparePacket(t *testing.T) {
 	}
 }
 
+func TestPrepareNodePacket(t *testing.T) {
+	gatewayMAC, _ := net.ParseMAC("aa:bb:cc:dd:ee:01")
+	uplinkMAC, _ := net.ParseMAC("aa:bb:cc:dd:ee:02")
+	gatewayConfig := &config.GatewayConfig{Name: "antrea-gw0", IPv4: net.ParseIP("192.168.10.1").To4(), MAC: gatewayMAC, OFPort: 2}
+	nodeConfig := &config.NodeConfig{
+		Name:          "node1",
+		NodeIPv4Addr:  &net.IPNet{IP: net.ParseIP("172.18.0.2").To4(), Mask: net.CIDRMask(24, 32)},
+		GatewayConfig: gatewayConfig,
+	}
+	bridgedUplinkNodeConfig := &config.NodeConfig{
+		Name:                "node1",
+		NodeIPv4Addr:        nodeConfig.NodeIPv4Addr,
+		GatewayConfig:       gatewayConfig,
+		UplinkNetConfig:     &config.AdapterNetConfig{Name: "eth0", MAC: uplinkMAC, OFPort: 3},
+		HostInterfaceOFPort: 4,
+	}
+	lbService := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-lb"},
+		Spec: v1.ServiceSpec{
+			Type:      v1.ServiceTypeLoadBalancer,
+			ClusterIP: "10.96.0.10",
+			Ports:     []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80, NodePort: 30080}},
+		},
+		Status: v1.ServiceStatus{LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "172.18.1.10"}}}},
+	}
+	nodePortService := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-nodeport"},
+		Spec: v1.ServiceSpec{
+			Type:      v1.ServiceTypeNodePort,
+			ClusterIP: "10.96.0.11",
+			Ports:     []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80, NodePort: 30081}},
+		},
+	}
+	clusterIPService := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-clusterip"},
+		Spec: v1.ServiceSpec{
+			ClusterIP: "10.96.0.12",
+			Ports:     []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80}},
+		},
+	}
+	tcpPacket := crdv1beta1.Packet{TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{SrcPort: 12345, DstPort: 80}}}
+
+	tcs := []struct {
+		name           string
+		source         crdv1beta1.Source
+		destination    crdv1beta1.Destination
+		nodeConfig     *config.NodeConfig
+		expectedPacket *binding.Packet
+		expectedOFPort uint32
+		expectedErr    string
+	}{
+		{
+			name:        "host network to Pod",
+			source:      crdv1beta1.Source{Node: "node1"},
+			destination: crdv1beta1.Destination{Namespace: pod2.Namespace, Pod: pod2.Name},
+			nodeConfig:  nodeConfig,
+			expectedPacket: &binding.Packet{
+				SourceIP:        gatewayConfig.IPv4,
+				SourceMAC:       gatewayMAC,
+				DestinationIP:   net.ParseIP(pod2IPv4),
+				DestinationMAC:  pod2MAC,
+				IPProto:         protocol.Type_TCP,
+				SourcePort:      12345,
+				DestinationPort: 80,
+				TCPFlags:        2,
+				TTL:             64,
+			},
+			expectedOFPort: gatewayConfig.OFPort,
+		},
+		{
+			name:        "host network to ClusterIP",
+			source:      crdv1beta1.Source{Node: "node1"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: lbService.Name},
+			nodeConfig:  nodeConfig,
+			expectedPacket: &binding.Packet{
+				SourceIP:        gatewayConfig.IPv4,
+				SourceMAC:       gatewayMAC,
+				DestinationIP:   net.ParseIP("10.96.0.10").To4(),
+				IPProto:         protocol.Type_TCP,
+				SourcePort:      12345,
+				DestinationPort: 80,
+				TCPFlags:        2,
+				TTL:             64,
+			},
+			expectedOFPort: gatewayConfig.OFPort,
+		},
+		{
+			name:        "external client to LoadBalancer IP",
+			source:      crdv1beta1.Source{Node: "node1", IP: "8.8.8.8"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: lbService.Name},
+			nodeConfig:  nodeConfig,
+			expectedPacket: &binding.Packet{
+				SourceIP:        net.ParseIP("8.8.8.8").To4(),
+				SourceMAC:       gatewayMAC,
+				DestinationIP:   net.ParseIP("172.18.1.10").To4(),
+				IPProto:         protocol.Type_TCP,
+				SourcePort:      12345,
+				DestinationPort: 80,
+				TCPFlags:        2,
+				TTL:             64,
+			},
+			expectedOFPort: gatewayConfig.OFPort,
+		},
+		{
+			name:        "external client to NodePort",
+			source:      crdv1beta1.Source{Node: "node1", IP: "8.8.8.8"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: nodePortService.Name},
+			nodeConfig:  nodeConfig,
+			expectedPacket: &binding.Packet{
+				SourceIP:        net.ParseIP("8.8.8.8").To4(),
+				SourceMAC:       gatewayMAC,
+				DestinationIP:   config.VirtualNodePortDNATIPv4.To4(),
+				IPProto:         protocol.Type_TCP,
+				SourcePort:      12345,
+				DestinationPort: 30081,
+				TCPFlags:        2,
+				TTL:             64,
+			},
+			expectedOFPort: gatewayConfig.OFPort,
+		},
+		{
+			name:        "external client to NodePort through bridged uplink",
+			source:      crdv1beta1.Source{Node: "node1", IP: "8.8.8.8"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: nodePortService.Name},
+			nodeConfig:  bridgedUplinkNodeConfig,
+			expectedPacket: &binding.Packet{
+				SourceIP:        net.ParseIP("8.8.8.8").To4(),
+				SourceMAC:       openflow.GlobalVirtualMAC,
+				DestinationIP:   nodeConfig.NodeIPv4Addr.IP,
+				DestinationMAC:  uplinkMAC,
+				IPProto:         protocol.Type_TCP,
+				SourcePort:      12345,
+				DestinationPort: 30081,
+				TCPFlags:        2,
+				TTL:             64,
+			},
+			expectedOFPort: 3,
+		},
+		{
+			name:        "external client to ClusterIP Service",
+			source:      crdv1beta1.Source{Node: "node1", IP: "8.8.8.8"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: clusterIPService.Name},
+			nodeConfig:  nodeConfig,
+			expectedErr: "destination Service is not exposed outside the cluster",
+		},
+		{
+			name:        "external client IP family mismatch",
+			source:      crdv1beta1.Source{Node: "node1", IP: "2001:db8::1"},
+			destination: crdv1beta1.Destination{Namespace: "default", Service: lbService.Name},
+			nodeConfig:  nodeConfig,
+			expectedErr: "source IP does not match the IP header family",
+		},
+	}
+
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf", UID: "uid"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      tt.source,
+					Destination: tt.destination,
+					Packet:      tcpPacket,
+				},
+			}
+			tfc := newFakeTraceflowController(t, []runtime.Object{tf}, nil, tt.nodeConfig)
+			serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, svc := range []*v1.Service{lbService, nodePortService, clusterIPService} {
+				require.NoError(t, serviceIndexer.Add(svc))
+			}
+			tfc.serviceLister = corelisters.NewServiceLister(serviceIndexer)
+
+			pkt, ofPort, err := tfc.prepareNodePacket(tf)
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPacket, pkt)
+				assert.Equal(t, tt.expectedOFPort, ofPort)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestErrTraceflowCRD(t *testing.T) {
 	tf := &crdv1beta1.Traceflow{
 		ObjectMeta: metav1.ObjectMeta{
@@ -570,7 +757,7 @@ func TestStartTraceflow(t *testing.T) {
 				ICMPType:       8,
 			},
 			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
-				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
 				mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), &binding.Packet{
 					SourceIP:       net.ParseIP(pod1IPv4),
 					SourceMAC:      pod1MAC,
@@ -610,7 +797,7 @@ func TestStartTraceflow(t *testing.T) {
 				ICMPType:      8,
 			},
 			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
-				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
 				mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), &binding.Packet{
 					SourceIP:      net.ParseIP(pod1IPv4),
 					SourceMAC:     pod1MAC,
@@ -621,6 +808,46 @@ func TestStartTraceflow(t *testing.T) {
 				}, ofPortPod1, int32(-1))
 			},
 		},
+		{
+			name: "Pod-to-Pod ICMPEcho session traceflow",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf7", UID: "uid7"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: pod2.Namespace,
+						Pod:       pod2.Name,
+					},
+					Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho, Packets: 2},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			ofPort: ofPortPod1,
+			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
+				packet := binding.Packet{
+					SourceIP:       net.ParseIP(pod1IPv4),
+					SourceMAC:      pod1MAC,
+					DestinationIP:  net.ParseIP(pod2IPv4),
+					DestinationMAC: pod2MAC,
+					IPProto:        1,
+					TTL:            64,
+					ICMPType:       8,
+				}
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, true, &packet, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				secondPacket := packet
+				secondPacket.ICMPEchoSeq = 1
+				gomock.InOrder(
+					mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), &packet, ofPortPod1, int32(-1)),
+					mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), &secondPacket, ofPortPod1, int32(-1)),
+				)
+			},
+		},
 		{
 			name: "live traceflow receive only",
 			tf: &crdv1beta1.Traceflow{
@@ -639,7 +866,7 @@ func TestStartTraceflow(t *testing.T) {
 			},
 			ofPort: ofPortPod2,
 			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
-				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), true, false, true, &binding.Packet{DestinationMAC: pod2MAC}, ofPortPod2, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), true, false, true, false, &binding.Packet{DestinationMAC: pod2MAC}, ofPortPod2, uint16(crdv1beta1.DefaultTraceflowTimeout))
 			},
 		},
 	}
@@ -672,6 +899,136 @@ func TestStartTraceflow(t *testing.T) {
 	}
 }
 
+func TestPrepareSessionMatchPacket(t *testing.T) {
+	pod4 := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-4", Namespace: "default"},
+		Status: v1.PodStatus{
+			PodIPs: []v1.PodIP{{IP: "192.168.12.10"}, {IP: "fd12::10"}},
+		},
+	}
+	tcs := []struct {
+		name           string
+		tf             *crdv1beta1.Traceflow
+		expectedPacket *binding.Packet
+		expectedErr    string
+	}{
+		{
+			name: "ICMP",
+			tf: &crdv1beta1.Traceflow{
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: pod4.Namespace, Pod: pod4.Name},
+					Destination: crdv1beta1.Destination{IP: "192.168.13.10"},
+					Session:     &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+				},
+			},
+			expectedPacket: &binding.Packet{SourceIP: net.ParseIP("192.168.12.10").To4(), DestinationIP: net.ParseIP("192.168.13.10"), IPProto: protocol.Type_ICMP},
+		},
+		{
+			name: "IPv6 TCP",
+			tf: &crdv1beta1.Traceflow{
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: pod4.Namespace, Pod: pod4.Name},
+					Destination: crdv1beta1.Destination{IP: "fd13::10"},
+					Packet: crdv1beta1.Packet{
+						IPv6Header:      &crdv1beta1.IPv6Header{},
+						TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{SrcPort: 10000, DstPort: 80}},
+					},
+					Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+				},
+			},
+			expectedPacket: &binding.Packet{IsIPv6: true, SourceIP: net.ParseIP("fd12::10"), DestinationIP: net.ParseIP("fd13::10"), IPProto: protocol.Type_TCP, SourcePort: 10000, DestinationPort: 80},
+		},
+		{
+			name: "TCP to Service",
+			tf: &crdv1beta1.Traceflow{
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: pod4.Namespace, Pod: pod4.Name},
+					Destination: crdv1beta1.Destination{Namespace: "default", Service: "svc"},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{SrcPort: 10000, DstPort: 80}},
+					},
+					Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+				},
+			},
+			// The original destination of the connection is only known on the sender Node.
+			expectedPacket: &binding.Packet{SourceIP: net.ParseIP("192.168.12.10").To4(), IPProto: protocol.Type_TCP, SourcePort: 10000},
+		},
+		{
+			name: "source Pod without IP",
+			tf: &crdv1beta1.Traceflow{
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: pod3.Namespace, Pod: pod3.Name},
+					Destination: crdv1beta1.Destination{IP: "192.168.13.10"},
+					Session:     &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+				},
+			},
+			expectedErr: "source Pod does not have an IP address of the IP header family",
+		},
+	}
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			tfc := newFakeTraceflowController(t, nil, nil, nil)
+			_, err := tfc.kubeClient.CoreV1().Pods(pod4.Namespace).Create(context.TODO(), pod4, metav1.CreateOptions{})
+			require.NoError(t, err)
+			serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			require.NoError(t, serviceIndexer.Add(&v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+				Spec:       v1.ServiceSpec{ClusterIP: "10.96.0.10"},
+			}))
+			tfc.serviceLister = corelisters.NewServiceLister(serviceIndexer)
+			packet, err := tfc.prepareSessionMatchPacket(tt.tf)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPacket, packet)
+			}
+		})
+	}
+}
+
+func TestSessionSourcePort(t *testing.T) {
+	tf1 := &crdv1beta1.Traceflow{ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"}}
+	tf2 := &crdv1beta1.Traceflow{ObjectMeta: metav1.ObjectMeta{Name: "tf2", UID: "uid2"}}
+	port := sessionSourcePort(tf1)
+	assert.Equal(t, port, sessionSourcePort(tf1))
+	assert.NotEqual(t, port, sessionSourcePort(tf2))
+	assert.GreaterOrEqual(t, port, uint16(sessionMinSourcePort))
+	assert.LessOrEqual(t, port, uint16(sessionMaxSourcePort))
+}
+
+func TestInjectSessionACK(t *testing.T) {
+	tfc := newFakeTraceflowController(t, nil, nil, nil)
+	syn := &binding.Packet{
+		SourceIP:        net.ParseIP(pod1IPv4),
+		SourceMAC:       pod1MAC,
+		DestinationIP:   net.ParseIP(pod2IPv4),
+		DestinationMAC:  pod2MAC,
+		IPProto:         protocol.Type_TCP,
+		TTL:             64,
+		SourcePort:      40000,
+		DestinationPort: 80,
+		TCPFlags:        tcpFlagSYN,
+	}
+	tfState := &traceflowState{name: "tf1", tag: 1, sessionPacket: syn, ofPort: ofPortPod1}
+	synACK := &binding.Packet{IPProto: protocol.Type_TCP, TCPFlags: tcpFlagSYN | tcpFlagACK, TCPSeqNum: 1000, TCPAckNum: 1}
+	expectedACK := *syn
+	expectedACK.TCPFlags = tcpFlagACK
+	expectedACK.TCPSeqNum = 1
+	expectedACK.TCPAckNum = 1001
+	// The RST closing the connection is not traced.
+	expectedRST := expectedACK
+	expectedRST.TCPFlags = tcpFlagRST
+	expectedRST.TCPAckNum = 0
+	gomock.InOrder(
+		tfc.mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), &expectedACK, ofPortPod1, int32(-1)),
+		tfc.mockOFClient.EXPECT().SendTraceflowPacket(uint8(0), &expectedRST, ofPortPod1, int32(-1)),
+	)
+	require.NoError(t, tfc.injectSessionACK(tfState, synACK))
+	// The first request packet is not modified.
+	assert.Equal(t, tcpFlagSYN, syn.TCPFlags)
+}
+
 func TestSyncTraceflow(t *testing.T) {
 	tcs := []struct {
 		name          string
@@ -736,7 +1093,7 @@ func TestSyncTraceflow(t *testing.T) {
 				isSender: true,
 			},
 			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
-				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, uint32(1), uint16(20))
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, false, nil, uint32(1), uint16(20))
 				mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), gomock.Any(), ofPortPod1, int32(-1))
 			},
 		},
@@ -772,7 +1129,7 @@ func TestSyncTraceflow(t *testing.T) {
 			},
 			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
 				mockOFClient.EXPECT().UninstallTraceflowFlows(uint8(1))
-				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, uint32(1), uint16(20))
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, false, nil, uint32(1), uint16(20))
 				mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), gomock.Any(), ofPortPod1, int32(-1))
 			},
 		},
@@ -872,7 +1229,7 @@ func TestProcessTraceflowItem(t *testing.T) {
 	tfc.crdInformerFactory.Start(stopCh)
 	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
 
-	tfc.mockOFClient.EXPECT().InstallTraceflowFlows(uint8(tc.tf.Status.DataplaneTag), tc.tf.Spec.LiveTraffic, tc.tf.Spec.DroppedOnly, tc.receiverOnly, nil, tc.ofPort, uint16(crdv1beta1.DefaultTraceflowTimeout))
+	tfc.mockOFClient.EXPECT().InstallTraceflowFlows(uint8(tc.tf.Status.DataplaneTag), tc.tf.Spec.LiveTraffic, tc.tf.Spec.DroppedOnly, tc.receiverOnly, false, nil, tc.ofPort, uint16(crdv1beta1.DefaultTraceflowTimeout))
 	tfc.mockOFClient.EXPECT().SendTraceflowPacket(uint8(tc.tf.Status.DataplaneTag), tc.packet, tc.ofPort, int32(-1))
 	tfc.enqueueTraceflow(tc.tf)
 	got := tfc.processTraceflowItem()
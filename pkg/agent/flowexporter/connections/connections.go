@@ -157,6 +157,12 @@ func (cs *connectionStore) UpdateConnAndQueue(pqItem *flowexporter.ItemToExpire,
 	conn.LastExportTime = currTime
 	conn.AppProtocolName = ""
 	conn.HttpVals = ""
+	conn.L7NetworkPolicyName = ""
+	conn.L7NetworkPolicyNamespace = ""
+	conn.L7NetworkPolicyType = 0
+	conn.L7NetworkPolicyRuleName = ""
+	conn.L7NetworkPolicyRuleAction = 0
+	conn.L7ViolationVals = ""
 	if conn.ReadyToDelete || !conn.IsActive {
 		cs.expirePriorityQueue.RemoveItemFromMap(conn)
 	} else {
@@ -357,6 +357,17 @@ func (cs *ConntrackConnectionStore) fillL7EventInfo(l7EventMap map[flowexporter.
 				conn.HttpVals += string(jsonBytes)
 				conn.AppProtocolName = "http"
 			}
+			if violation := l7event.violation; violation != nil {
+				conn.L7NetworkPolicyName = violation.PolicyName
+				conn.L7NetworkPolicyNamespace = violation.PolicyNamespace
+				conn.L7NetworkPolicyType = violation.PolicyType
+				conn.L7NetworkPolicyRuleName = violation.RuleName
+				conn.L7NetworkPolicyRuleAction = violation.RuleAction
+				conn.L7ViolationVals = violation.Vals
+				if conn.AppProtocolName == "" {
+					conn.AppProtocolName = violation.AppProtocolName
+				}
+			}
 			// In case L7 event is received after the last planned export of the TCP connection, add
 			// the event back to the queue to be exported in next export cycle
 			_, exists := cs.expirePriorityQueue.KeyToItem[connKey]
@@ -23,6 +23,7 @@ import (
 	"net/netip"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,17 +32,47 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	k8sutil "antrea.io/antrea/pkg/util/k8s"
 	"antrea.io/antrea/pkg/util/podstore"
 )
 
+const (
+	// metadataKeyPolicy and metadataKeyRule are the metadata keys of the Suricata reject rules
+	// generated by the L7 engine for the policy and the rule.
+	metadataKeyPolicy = "antrea_policy"
+	metadataKeyRule   = "antrea_rule"
+)
+
 type PodL7FlowExporterAttrGetter interface {
 	IsL7FlowExporterRequested(podNN string, ingress bool) bool
 }
 
 // L7ProtocolFields holds layer 7 protocols supported
 type L7ProtocolFields struct {
-	http map[int32]*Http
+	http      map[int32]*Http
+	violation *L7Violation
+}
+
+// L7Violation holds the layer 7 NetworkPolicy rule which rejected a request of a connection.
+type L7Violation struct {
+	PolicyName      string
+	PolicyNamespace string
+	PolicyType      uint8
+	RuleName        string
+	RuleAction      uint8
+	AppProtocolName string
+	// Vals is the serialized JSON dictionary of the rejected request.
+	Vals string
+}
+
+// l7ViolationVals holds the fields of a rejected request: the HTTP method, hostname and URL of an
+// HTTP request, or the SNI of a TLS handshake.
+type l7ViolationVals struct {
+	Method   string `json:"http_method,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	URL      string `json:"url,omitempty"`
+	SNI      string `json:"sni,omitempty"`
 }
 
 // Http holds the L7 HTTP flow JSON values.
@@ -56,6 +87,19 @@ type Http struct {
 	ContentLength int32  `json:"length"`
 }
 
+// Tls holds the L7 TLS flow JSON values.
+type Tls struct {
+	SNI string `json:"sni"`
+}
+
+// Alert holds the Suricata alert JSON values. The metadata of the alerts generated by the reject
+// rules of Antrea-native L7 NetworkPolicies includes the policy and the rule.
+type Alert struct {
+	Action    string              `json:"action"`
+	Signature string              `json:"signature"`
+	Metadata  map[string][]string `json:"metadata"`
+}
+
 // JsonToEvent holds Suricata event JSON values.
 // See https://docs.suricata.io/en/latest/output/eve/eve-json-format.html?highlight=HTTP%20event#event-types
 type JsonToEvent struct {
@@ -70,7 +114,10 @@ type JsonToEvent struct {
 	DestPort    int32      `json:"dest_port"`
 	Proto       string     `json:"proto"`
 	TxID        int32      `json:"tx_id"`
+	AppProto    string     `json:"app_proto"`
 	HTTP        *Http      `json:"http"`
+	TLS         *Tls       `json:"tls"`
+	Alert       *Alert     `json:"alert"`
 }
 
 type L7Listener struct {
@@ -147,7 +194,7 @@ func (l *L7Listener) processLog(data []byte) error {
 	if err != nil {
 		return fmt.Errorf("error parsing JSON data %v", data)
 	}
-	if event.EventType != "http" {
+	if event.EventType != "http" && event.EventType != "alert" {
 		return nil
 	}
 	if err = l.addOrUpdateL7EventMap(&event); err != nil {
@@ -200,10 +247,61 @@ func (l *L7Listener) addOrUpdateL7EventMap(event *JsonToEvent) error {
 			}
 			l.l7Events[connKey].http[event.TxID] = event.HTTP
 		}
+	case "alert":
+		// Violations of L7 NetworkPolicies are always recorded, as they are not subject to the
+		// L7 flow export annotation of the Pods.
+		violation := newL7Violation(event)
+		if violation == nil {
+			return nil
+		}
+		fields := l.l7Events[connKey]
+		fields.violation = violation
+		l.l7Events[connKey] = fields
 	}
 	return nil
 }
 
+// newL7Violation returns the L7Violation of a Suricata alert, or nil if the alert is not generated
+// by an Antrea-native L7 NetworkPolicy. The policy is identified by the metadata of the alert, in the
+// format of <type>:<namespace>/<name>, or <type>:<name> for a cluster-scoped policy.
+func newL7Violation(event *JsonToEvent) *L7Violation {
+	if event.Alert == nil || len(event.Alert.Metadata[metadataKeyPolicy]) == 0 {
+		return nil
+	}
+	policyType, policyRef, found := strings.Cut(event.Alert.Metadata[metadataKeyPolicy][0], ":")
+	if !found {
+		klog.ErrorS(nil, "Invalid policy in L7 alert metadata", "signature", event.Alert.Signature)
+		return nil
+	}
+	violation := &L7Violation{
+		PolicyName:      policyRef,
+		PolicyType:      flowexporter.PolicyTypeToUint8(v1beta2.NetworkPolicyType(policyType)),
+		RuleAction:      flowexporter.RuleActionToUint8("Reject"),
+		AppProtocolName: event.AppProto,
+	}
+	if namespace, name, found := strings.Cut(policyRef, "/"); found {
+		violation.PolicyNamespace, violation.PolicyName = namespace, name
+	}
+	if ruleNames := event.Alert.Metadata[metadataKeyRule]; len(ruleNames) > 0 {
+		violation.RuleName = ruleNames[0]
+	}
+	var vals l7ViolationVals
+	if event.HTTP != nil {
+		vals.Method, vals.Hostname, vals.URL = event.HTTP.Method, event.HTTP.Hostname, event.HTTP.URL
+	}
+	if event.TLS != nil {
+		vals.SNI = event.TLS.SNI
+	}
+	if vals != (l7ViolationVals{}) {
+		jsonBytes, err := json.Marshal(vals)
+		if err != nil {
+			klog.ErrorS(err, "Converting L7 violation failed")
+		}
+		violation.Vals = string(jsonBytes)
+	}
+	return violation
+}
+
 func (l *L7Listener) ConsumeL7EventMap() map[flowexporter.ConnectionKey]L7ProtocolFields {
 	l.l7mut.Lock()
 	defer l.l7mut.Unlock()
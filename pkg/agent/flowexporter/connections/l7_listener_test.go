@@ -299,3 +299,86 @@ func TestFlowExporterL7ListenerHttp(t *testing.T) {
 		})
 	}
 }
+
+func TestNewL7Violation(t *testing.T) {
+	testCases := []struct {
+		name              string
+		event             *JsonToEvent
+		expectedViolation *L7Violation
+	}{
+		{
+			name: "HTTP request rejected by ANNP",
+			event: &JsonToEvent{
+				EventType: "alert",
+				AppProto:  "http",
+				Alert: &Alert{
+					Action:    "blocked",
+					Signature: "Reject by AntreaNetworkPolicy:test-ns/test-annp",
+					Metadata: map[string][]string{
+						"antrea_policy": {"AntreaNetworkPolicy:test-ns/test-annp"},
+						"antrea_rule":   {"allow-http"},
+					},
+				},
+				HTTP: &Http{
+					Hostname:  "10.10.0.2",
+					URL:       "/admin",
+					UserAgent: "curl/7.74.0",
+					Method:    "GET",
+				},
+			},
+			expectedViolation: &L7Violation{
+				PolicyName:      "test-annp",
+				PolicyNamespace: "test-ns",
+				PolicyType:      2,
+				RuleName:        "allow-http",
+				RuleAction:      3,
+				AppProtocolName: "http",
+				Vals:            `{"http_method":"GET","hostname":"10.10.0.2","url":"/admin"}`,
+			},
+		},
+		{
+			name: "TLS handshake rejected by ACNP",
+			event: &JsonToEvent{
+				EventType: "alert",
+				AppProto:  "tls",
+				Alert: &Alert{
+					Action:    "blocked",
+					Signature: "Reject by AntreaClusterNetworkPolicy:test-acnp",
+					Metadata: map[string][]string{
+						"antrea_policy": {"AntreaClusterNetworkPolicy:test-acnp"},
+					},
+				},
+				TLS: &Tls{SNI: "www.google.com"},
+			},
+			expectedViolation: &L7Violation{
+				PolicyName:      "test-acnp",
+				PolicyType:      3,
+				RuleAction:      3,
+				AppProtocolName: "tls",
+				Vals:            `{"sni":"www.google.com"}`,
+			},
+		},
+		{
+			name: "Alert not generated by Antrea",
+			event: &JsonToEvent{
+				EventType: "alert",
+				Alert:     &Alert{Action: "allowed", Signature: "Other signature"},
+			},
+		},
+		{
+			name: "Invalid policy metadata",
+			event: &JsonToEvent{
+				EventType: "alert",
+				Alert: &Alert{
+					Action:   "blocked",
+					Metadata: map[string][]string{"antrea_policy": {"test-annp"}},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedViolation, newL7Violation(tc.event))
+		})
+	}
+}
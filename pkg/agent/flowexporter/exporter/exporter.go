@@ -105,6 +105,12 @@ var (
 		"appProtocolName",
 		"httpVals",
 		"egressNodeName",
+		"l7NetworkPolicyName",
+		"l7NetworkPolicyNamespace",
+		"l7NetworkPolicyType",
+		"l7NetworkPolicyRuleName",
+		"l7NetworkPolicyRuleAction",
+		"l7ViolationVals",
 	}
 	AntreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	AntreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -598,6 +604,18 @@ func (exp *FlowExporter) addConnToSet(conn *flowexporter.Connection) error {
 			ie.SetStringValue(conn.HttpVals)
 		case "egressNodeName":
 			ie.SetStringValue(conn.EgressNodeName)
+		case "l7NetworkPolicyName":
+			ie.SetStringValue(conn.L7NetworkPolicyName)
+		case "l7NetworkPolicyNamespace":
+			ie.SetStringValue(conn.L7NetworkPolicyNamespace)
+		case "l7NetworkPolicyType":
+			ie.SetUnsigned8Value(conn.L7NetworkPolicyType)
+		case "l7NetworkPolicyRuleName":
+			ie.SetStringValue(conn.L7NetworkPolicyRuleName)
+		case "l7NetworkPolicyRuleAction":
+			ie.SetUnsigned8Value(conn.L7NetworkPolicyRuleAction)
+		case "l7ViolationVals":
+			ie.SetStringValue(conn.L7ViolationVals)
 		}
 	}
 	err := exp.ipfixSet.AddRecord(eL, templateID)
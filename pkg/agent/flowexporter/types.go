@@ -87,6 +87,13 @@ type Connection struct {
 	AppProtocolName                      string
 	HttpVals                             string
 	EgressNodeName                       string
+	// Fields of the layer 7 NetworkPolicy rule which rejected the layer 7 traffic of the connection
+	L7NetworkPolicyName       string
+	L7NetworkPolicyNamespace  string
+	L7NetworkPolicyType       uint8
+	L7NetworkPolicyRuleName   string
+	L7NetworkPolicyRuleAction uint8
+	L7ViolationVals           string
 }
 
 type ItemToExpire struct {
@@ -612,6 +612,32 @@ func (c *MCDefaultRouteController) getActiveGateway() (*mcv1alpha1.Gateway, erro
 	return activeGW, nil
 }
 
+// ForwardsToPeerCluster returns whether packets to dstIP are forwarded from the Node to a peer
+// member cluster, i.e. the Node is the active Gateway and dstIP belongs to the Service CIDR or the
+// Pod CIDRs of a ClusterInfoImport.
+func (c *MCDefaultRouteController) ForwardsToPeerCluster(dstIP net.IP) bool {
+	activeGW, err := c.getActiveGateway()
+	if err != nil || activeGW == nil || activeGW.Name != c.nodeConfig.Name {
+		return false
+	}
+	ciImports, err := c.ciImportLister.List(labels.Everything())
+	if err != nil {
+		return false
+	}
+	for _, ciImport := range ciImports {
+		cidrs := []string{ciImport.Spec.ServiceCIDR}
+		if c.enablePodToPodConnectivity {
+			cidrs = append(cidrs, ciImport.Spec.PodCIDRs...)
+		}
+		for _, cidr := range cidrs {
+			if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(dstIP) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func getActiveGateway(gwLister mclisters.GatewayLister) (*mcv1alpha1.Gateway, error) {
 	gws, err := gwLister.List(labels.Everything())
 	if err != nil {
@@ -625,3 +625,63 @@ func TestEnqueueClusterInfoImport(t *testing.T) {
 		})
 	}
 }
+
+func TestForwardsToPeerCluster(t *testing.T) {
+	ciImport := mcv1alpha1.ClusterInfoImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-b-default-clusterinfo",
+			Namespace: "default",
+		},
+		Spec: mcv1alpha1.ClusterInfo{
+			ClusterID:   "cluster-b",
+			ServiceCIDR: "10.96.0.0/16",
+			PodCIDRs:    []string{"10.10.0.0/16"},
+		},
+	}
+	for _, tc := range []struct {
+		name     string
+		nodeName string
+		dstIP    string
+		expected bool
+	}{
+		{
+			name:     "Service IP of peer cluster on Gateway",
+			nodeName: "node-1",
+			dstIP:    "10.96.1.10",
+			expected: true,
+		},
+		{
+			name:     "Pod IP of peer cluster on Gateway",
+			nodeName: "node-1",
+			dstIP:    "10.10.1.10",
+			expected: true,
+		},
+		{
+			name:     "IP outside peer clusters on Gateway",
+			nodeName: "node-1",
+			dstIP:    "192.168.1.10",
+			expected: false,
+		},
+		{
+			name:     "Service IP of peer cluster on regular Node",
+			nodeName: "node-3",
+			dstIP:    "10.96.1.10",
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newMCDefaultRouteController(t,
+				&config.NodeConfig{Name: tc.nodeName},
+				&config.NetworkConfig{},
+				agent.WireGuardConfig{},
+				nil,
+				"none",
+				nil,
+			)
+			defer c.queue.ShutDown()
+			c.gwInformer.Informer().GetIndexer().Add(&gateway1)
+			c.ciImportInformer.Informer().GetIndexer().Add(&ciImport)
+			assert.Equal(t, tc.expected, c.ForwardsToPeerCluster(net.ParseIP(tc.dstIP)))
+		})
+	}
+}
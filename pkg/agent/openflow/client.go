@@ -131,7 +131,8 @@ type Client interface {
 	// dropTable.
 	InstallPolicyRuleFlows(ofPolicyRule *types.PolicyRule) error
 
-	// BatchInstallPolicyRuleFlows installs multiple flows for NetworkPolicy rules in batch.
+	// BatchInstallPolicyRuleFlows installs multiple flows for NetworkPolicy rules in batch. If some rules cannot be
+	// realized, the other rules are installed and a PolicyRuleErrors is returned with the errors of those rules.
 	BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule) error
 
 	// UninstallPolicyRuleFlows removes the Openflow entry relevant to the specified NetworkPolicy rule.
@@ -139,6 +140,11 @@ type Client interface {
 	// UninstallPolicyRuleFlows will do nothing if no Openflow entry for the rule is installed.
 	UninstallPolicyRuleFlows(ruleID uint32) ([]string, error)
 
+	// StopPolicyRulePacketCapture updates the action flow of the specified Drop or Reject NetworkPolicy rule with
+	// packet capture enabled so that the packets matching it are no longer sent to the controller for capture. It
+	// does nothing if the rule is not installed or its packet capture is already stopped.
+	StopPolicyRulePacketCapture(ruleID uint32) error
+
 	// AddPolicyRuleAddress adds one or multiple addresses to the specified NetworkPolicy rule. If addrType is true, the
 	// addresses are added to PolicyRule.From, else to PolicyRule.To.
 	AddPolicyRuleAddress(ruleID uint32, addrType types.AddressType, addresses []types.Address, priority *uint16, enableLogging, isMCNPRule bool) error
@@ -231,8 +237,9 @@ type Client interface {
 	// SendTraceflowPacket injects packet to specified OVS port for Openflow.
 	SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet, inPort uint32, outPort int32) error
 
-	// InstallTraceflowFlows installs flows for a Traceflow request.
-	InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error
+	// InstallTraceflowFlows installs flows for a Traceflow request. For a session Traceflow, packet is the first
+	// request packet of the session, and ofPort is the OVS port of the source Pod if it is on the local Node.
+	InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly, session bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error
 
 	// UninstallTraceflowFlows uninstalls flows for a Traceflow request.
 	UninstallTraceflowFlows(dataplaneTag uint8) error
@@ -1207,7 +1214,9 @@ func (c *client) SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet,
 		}
 		packetOutBuilder = packetOutBuilder.SetTCPDstPort(packet.DestinationPort).
 			SetTCPSrcPort(tcpSrcPort).
-			SetTCPFlags(packet.TCPFlags)
+			SetTCPFlags(packet.TCPFlags).
+			SetTCPSeqNum(packet.TCPSeqNum).
+			SetTCPAckNum(packet.TCPAckNum)
 	case protocol.Type_UDP:
 		if packet.IsIPv6 {
 			packetOutBuilder = packetOutBuilder.SetIPProtocol(binding.ProtocolUDPv6)
@@ -1234,7 +1243,7 @@ func (c *client) SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet,
 	return c.bridge.SendPacketOut(packetOutObj)
 }
 
-func (c *client) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error {
+func (c *client) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly, session bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error {
 	cacheKey := fmt.Sprintf("%x", dataplaneTag)
 	var flows []binding.Flow
 	for _, f := range c.traceableFeatures {
@@ -1243,6 +1252,7 @@ func (c *client) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedO
 			liveTraffic,
 			droppedOnly,
 			receiverOnly,
+			session,
 			packet,
 			ofPort,
 			timeoutSeconds)...)
@@ -222,7 +222,9 @@ func (f *featureNetworkPolicy) getRequiredTables() []*Table {
 	}
 	if f.enableAntreaPolicy {
 		tables = append(tables,
+			AntreaPolicyEgressAuditRuleTable,
 			AntreaPolicyEgressRuleTable,
+			AntreaPolicyIngressAuditRuleTable,
 			AntreaPolicyIngressRuleTable,
 		)
 		if f.enableL7NetworkPolicy {
@@ -331,7 +333,8 @@ type traceableFeature interface {
 		ovsMetersAreSupported,
 		liveTraffic,
 		droppedOnly,
-		receiverOnly bool,
+		receiverOnly,
+		session bool,
 		packet *binding.Packet,
 		ofPort uint32,
 		timeoutSeconds uint16) []binding.Flow
@@ -132,6 +132,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -142,6 +143,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -179,6 +181,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -189,6 +192,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -221,6 +225,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -231,6 +236,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -263,6 +269,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -274,6 +281,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATTable,
 					L2ForwardingCalcTable,
 					TrafficControlTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -307,6 +315,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -318,6 +327,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATTable,
 					L2ForwardingCalcTable,
 					TrafficControlTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -386,6 +396,7 @@ func TestBuildPipeline(t *testing.T) {
 					ConntrackTable,
 					ConntrackStateTable,
 					DNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -393,6 +404,7 @@ func TestBuildPipeline(t *testing.T) {
 					L3ForwardingTable,
 					L3DecTTLTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -430,6 +442,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -440,6 +453,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -477,6 +491,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -486,6 +501,7 @@ func TestBuildPipeline(t *testing.T) {
 					SNATMarkTable,
 					SNATTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -526,12 +542,14 @@ func TestBuildPipeline(t *testing.T) {
 					ConntrackTable,
 					ConntrackStateTable,
 					EgressSecurityClassifierTable,
+					AntreaPolicyEgressAuditRuleTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
 					EgressMetricTable,
 					L2ForwardingCalcTable,
 					IngressSecurityClassifierTable,
+					AntreaPolicyIngressAuditRuleTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -17,11 +17,14 @@ package openflow
 import (
 	"fmt"
 	"net"
+	"net/netip"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -32,6 +35,7 @@ import (
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
+	utilip "antrea.io/antrea/pkg/util/ip"
 	thirdpartynp "antrea.io/antrea/third_party/networkpolicy"
 )
 
@@ -75,6 +79,7 @@ var (
 	MatchLabelID        = types.NewMatchKey(binding.ProtocolIP, types.LabelIDAddr, "tun_id")
 	MatchTCPFlags       = types.NewMatchKey(binding.ProtocolTCP, types.TCPFlagsAddr, "tcp_flags")
 	MatchTCPv6Flags     = types.NewMatchKey(binding.ProtocolTCPv6, types.TCPFlagsAddr, "tcp_flags")
+	MatchIPv6NextHeader = types.NewMatchKey(binding.ProtocolIPv6, types.IPProtocolAddr, "nw_proto")
 	// MatchCTState should be used with ct_state condition as matchValue.
 	// MatchValue example: `+rpl+trk`.
 	MatchCTState = types.NewMatchKey(binding.ProtocolIP, types.CTStateAddr, "ct_state")
@@ -84,6 +89,8 @@ var (
 	// There could be other flows like default flow and Traceflow flows in the table. Only metric flows are supposed to
 	// have normal priority.
 	metricFlowIdentifier = fmt.Sprintf("priority=%d,", priorityNormal)
+	// auditFlowIdentifier is used to identify the action flows of the rules in the audit tables.
+	auditFlowIdentifier = "conj_id="
 
 	protocolTCP = v1beta2.ProtocolTCP
 	dnsPort     = int32(53)
@@ -378,6 +385,9 @@ func (m *matchPair) KeyString() string {
 		} else {
 			valueStr = fmt.Sprintf("%v", m.matchValue)
 		}
+	case uint8:
+		// This case includes the matchValue is the Next Header of an IPv6 extension header.
+		valueStr = fmt.Sprintf("%d", v)
 	default:
 		// The default cases include the matchValue is an ofport Number.
 		valueStr = fmt.Sprintf("%s", m.matchValue)
@@ -674,6 +684,11 @@ type policyRuleConjunction struct {
 	ruleName     string
 	ruleTableID  uint8
 	ruleLogLabel string
+	// meter rate-limits the traffic matching the rule. It is nil unless the action of the rule is RateLimit.
+	meter binding.Meter
+	// actionFlowWithoutCapture replaces the action flow of a Drop or Reject rule with packet capture enabled when its
+	// packet capture is stopped. It is nil for other rules, or once the packet capture is stopped.
+	actionFlowWithoutCapture binding.Flow
 }
 
 // clause groups conjunctive match flows. Matches in a clause represent source addresses(for fromClause), or destination
@@ -936,30 +951,46 @@ func getServiceMatchPairs(service v1beta2.Service, ipProtocols []binding.Protoco
 		}
 	case v1beta2.ProtocolICMP:
 		for _, ipProtocol := range ipProtocols {
-			if ipProtocol == binding.ProtocolIP {
-				var matchPairs []matchPair
-				if service.ICMPType != nil {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPType, matchValue: service.ICMPType})
-				}
-				if service.ICMPCode != nil {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPCode, matchValue: service.ICMPCode})
-				}
-				if len(matchPairs) == 0 {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPType, matchValue: nil})
-				}
-				conjMatchesMatchPairs = append(conjMatchesMatchPairs, matchPairs)
-			} else {
-				var matchPairs []matchPair
-				if service.ICMPType != nil {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPv6Type, matchValue: service.ICMPType})
-				}
-				if service.ICMPCode != nil {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPv6Code, matchValue: service.ICMPCode})
+			isIPv6 := ipProtocol != binding.ProtocolIP
+			typeMatchKey, codeMatchKey := MatchICMPType, MatchICMPCode
+			if isIPv6 {
+				typeMatchKey, codeMatchKey = MatchICMPv6Type, MatchICMPv6Code
+			}
+			icmpType, endICMPType := service.ICMPType, service.EndICMPType
+			if service.ICMPTypeName != "" {
+				typeValue, ok := service.ICMPTypeName.Value(isIPv6)
+				if !ok {
+					// The named type doesn't exist in this IP family, so no traffic of this IP family is matched.
+					continue
 				}
-				if len(matchPairs) == 0 {
-					matchPairs = append(matchPairs, matchPair{matchKey: MatchICMPv6Type, matchValue: nil})
+				icmpType, endICMPType = &typeValue, nil
+			}
+			// OVS can only match ICMP type and code exactly, so a range is realized with one match per value.
+			for _, typeValue := range icmpRangeValues(icmpType, endICMPType) {
+				for _, codeValue := range icmpRangeValues(service.ICMPCode, service.EndICMPCode) {
+					var matchPairs []matchPair
+					if typeValue != nil {
+						matchPairs = append(matchPairs, matchPair{matchKey: typeMatchKey, matchValue: typeValue})
+					}
+					if codeValue != nil {
+						matchPairs = append(matchPairs, matchPair{matchKey: codeMatchKey, matchValue: codeValue})
+					}
+					if len(matchPairs) == 0 {
+						matchPairs = append(matchPairs, matchPair{matchKey: typeMatchKey, matchValue: nil})
+					}
+					conjMatchesMatchPairs = append(conjMatchesMatchPairs, matchPairs)
 				}
-				conjMatchesMatchPairs = append(conjMatchesMatchPairs, matchPairs)
+			}
+		}
+	case v1beta2.ProtocolIPv6ExtensionHeader:
+		for _, ipProtocol := range ipProtocols {
+			if ipProtocol == binding.ProtocolIP {
+				continue
+			}
+			// OVS skips the extension headers it knows when parsing a packet, so the supported extension headers are
+			// matched by the Next Header which OVS stops parsing at.
+			if nextHeader, ok := service.IPv6ExtensionHeader.NextHeader(); ok {
+				conjMatchesMatchPairs = append(conjMatchesMatchPairs, []matchPair{{matchKey: MatchIPv6NextHeader, matchValue: uint8(nextHeader)}})
 			}
 		}
 	case v1beta2.ProtocolIGMP:
@@ -982,6 +1013,20 @@ func getServiceMatchPairs(service v1beta2.Service, ipProtocols []binding.Protoco
 	return conjMatchesMatchPairs
 }
 
+// icmpRangeValues returns all the values in the ICMP type or code range [start, end]. If start is nil, a single nil
+// value is returned, which means the field is not matched.
+func icmpRangeValues(start, end *int32) []*int32 {
+	if start == nil || end == nil || *end <= *start {
+		return []*int32{start}
+	}
+	values := make([]*int32, 0, *end-*start+1)
+	for v := *start; v <= *end; v++ {
+		value := v
+		values = append(values, &value)
+	}
+	return values
+}
+
 // portsToBitRanges converts ports in Service to a list of BitRange.
 func portsToBitRanges(port *intstr.IntOrString, endPort *int32) []types.BitRange {
 	var ovsBitRanges []types.BitRange
@@ -1161,12 +1206,22 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
 
-	conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	conj, err := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	if err != nil {
+		return err
+	}
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	ctxChanges := c.featureNetworkPolicy.calculateMatchFlowChangesForRule(conj, rule)
 
+	// The meter must be installed before the flows referring to it. Openflow bundle message doesn't support
+	// meter, hence it is added individually.
+	if conj.meter != nil {
+		if err := conj.meter.Add(); err != nil {
+			return fmt.Errorf("error when installing OF Meter for rule %d: %w", conj.id, err)
+		}
+	}
 	var flowMessages []*openflow15.FlowMod
 	for _, fm := range append(conj.metricFlows, conj.actionFlows...) {
 		flowMessages = append(flowMessages, fm)
@@ -1183,13 +1238,14 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 }
 
 // calculateActionFlowChangesForRule calculates and updates the actionFlows for the conjunction corresponded to the ofPolicyRule.
-func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.PolicyRule) *policyRuleConjunction {
+// It returns an error if the rule cannot be realized.
+func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.PolicyRule) (*policyRuleConjunction, error) {
 	ruleOfID := rule.FlowID
 	// Check if the policyRuleConjunction is added into cache or not. If yes, return nil.
 	conj := f.getPolicyRuleConjunction(ruleOfID)
 	if conj != nil {
 		klog.V(2).Infof("PolicyRuleConjunction %d is already added in cache", ruleOfID)
-		return nil
+		return nil, nil
 	}
 	conj = &policyRuleConjunction{
 		id:           ruleOfID,
@@ -1209,22 +1265,65 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		// Install action flows.
 		var actionFlows []binding.Flow
 		var metricFlows []binding.Flow
-		if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
+		if rule.IsAntreaNetworkPolicyRule() && isAntreaPolicyAuditTable(rule.TableID) {
+			// No metric flow is installed for the rules in the audit tables as the packets matching them continue
+			// to be processed by other rules. The metrics are collected from their action flows instead.
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority, *rule.Action))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
-			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
+			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging, rule.PacketCapture != nil))
+			if rule.PacketCapture != nil {
+				conj.actionFlowWithoutCapture = f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging, false)
+			}
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionReject {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
-			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging))
+			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging, rule.PacketCapture != nil))
+			if rule.PacketCapture != nil {
+				conj.actionFlowWithoutCapture = f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging, false)
+			}
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
+			// Traffic matching a RateLimit rule is allowed, and rate-limited by the meter of the rule in its
+			// metric flows.
+			var meterID uint32
+			if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionRateLimit {
+				var err error
+				if conj.meter, meterID, err = f.rateLimitMeter(ruleOfID, rule.RateLimit); err != nil {
+					return nil, err
+				}
+			}
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, meterID)...)
 			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
 		conj.metricFlows = GetFlowModMessages(metricFlows, binding.AddMessage)
 	}
-	return conj
+	return conj, nil
+}
+
+// rateLimitMeter generates the meter rate-limiting the traffic matching the rule with the given conjunction ID, and
+// returns it with its ID. It returns an error if the rule has no rate limit or if OVS meters are not supported, as
+// the traffic matching the rule would be allowed without being rate-limited.
+func (f *featureNetworkPolicy) rateLimitMeter(conjunctionID uint32, rateLimit *v1beta2.RateLimit) (binding.Meter, uint32, error) {
+	if rateLimit == nil {
+		return nil, 0, fmt.Errorf("RateLimit rule %d has no rate limit", conjunctionID)
+	}
+	if !f.ovsMetersAreSupported {
+		return nil, 0, fmt.Errorf("cannot rate-limit the traffic matching rule %d as OVS meters are not supported", conjunctionID)
+	}
+	meterID := NetworkPolicyRateLimitMeterIDBase + conjunctionID
+	meterFlags := ofctrl.MeterBurst | ofctrl.MeterPktps
+	if rateLimit.Unit == v1beta2.RateLimitUnitKbitsPerSecond {
+		meterFlags = ofctrl.MeterBurst | ofctrl.MeterKbps
+	}
+	meter := f.bridge.NewMeter(binding.MeterIDType(meterID), meterFlags).
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(rateLimit.Rate).
+		Burst(rateLimit.Burst).
+		Done()
+	return meter, meterID, nil
 }
 
 // calculateMatchFlowChangesForRule calculates the contextChanges for the policyRule, and updates the context status in case of batch install.
@@ -1240,13 +1339,13 @@ func (f *featureNetworkPolicy) calculateMatchFlowChangesForRule(conj *policyRule
 func (f *featureNetworkPolicy) addRuleToConjunctiveMatch(conj *policyRuleConjunction, rule *types.PolicyRule) {
 	isMCNPRule := containsLabelIdentityAddress(rule.From)
 	if conj.fromClause != nil {
-		for _, addr := range rule.From {
+		for _, addr := range aggregateIPNetAddresses(rule.From) {
 			match := generateAddressConjMatch(conj.fromClause.ruleTable.GetID(), addr, types.SrcAddress, rule.Priority)
 			f.addActionToConjunctiveMatch(conj.fromClause, match, rule.EnableLogging, isMCNPRule)
 		}
 	}
 	if conj.toClause != nil {
-		for _, addr := range rule.To {
+		for _, addr := range aggregateIPNetAddresses(rule.To) {
 			match := generateAddressConjMatch(conj.toClause.ruleTable.GetID(), addr, types.DstAddress, rule.Priority)
 			f.addActionToConjunctiveMatch(conj.toClause, match, rule.EnableLogging, isMCNPRule)
 		}
@@ -1303,8 +1402,27 @@ func (f *featureNetworkPolicy) addActionToConjunctiveMatch(clause *clause, match
 	}
 }
 
+// PolicyRuleErrors is returned by BatchInstallPolicyRuleFlows with the errors of the rules which cannot be realized,
+// keyed by their FlowIDs. The flows of the other rules are installed.
+type PolicyRuleErrors map[uint32]error
+
+func (e PolicyRuleErrors) Error() string {
+	ids := make([]uint32, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	messages := make([]string, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, e[id].Error())
+	}
+	return fmt.Sprintf("failed to realize %d rules: %s", len(e), strings.Join(messages, "; "))
+}
+
 // BatchInstallPolicyRuleFlows installs flows for NetworkPolicy rules in case of agent restart. It calculates and
 // accumulates all Openflow entry updates required and installs all of them on OVS bridge in one bundle.
+// The rules which cannot be realized, e.g. RateLimit rules on a Node without OVS meters, are skipped and returned
+// in a PolicyRuleErrors, while the other rules are installed.
 // It resets the global conjunctive match flow cache upon failure, and should NOT be used after any rule is installed
 // via the InstallPolicyRuleFlows method. Otherwise the cache would be out of sync.
 func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule) error {
@@ -1313,9 +1431,16 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 
 	var allFlowMessages []*openflow15.FlowMod
 	var conjunctions []*policyRuleConjunction
+	ruleErrs := PolicyRuleErrors{}
 
 	for _, rule := range ofPolicyRules {
-		conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+		conj, err := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+		if err != nil {
+			// The rule is not added to the conjunctive match flows, so it doesn't prevent the other rules from
+			// being installed.
+			ruleErrs[rule.FlowID] = err
+			continue
+		}
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
 		for _, msg := range append(conj.actionFlows, conj.metricFlows...) {
 			allFlowMessages = append(allFlowMessages, msg)
@@ -1340,6 +1465,15 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		}
 	}
 
+	// The meters must be installed before the flows referring to them.
+	for _, conj := range conjunctions {
+		if conj.meter != nil {
+			if err := conj.meter.Add(); err != nil {
+				c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+				return fmt.Errorf("error when installing OF Meter for rule %d: %w", conj.id, err)
+			}
+		}
+	}
 	// Send the changed Openflow entries to the OVS bridge.
 	if err := c.ofEntryOperations.AddAll(allFlowMessages); err != nil {
 		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
@@ -1352,6 +1486,9 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		// Add the policyRuleConjunction into policyCache
 		c.featureNetworkPolicy.policyCache.Add(conj)
 	}
+	if len(ruleErrs) > 0 {
+		return ruleErrs
+	}
 	return nil
 }
 
@@ -1458,7 +1595,8 @@ func (c *policyRuleConjunction) calculateClauses(rule *types.PolicyRule) (uint8,
 		c.fromClause = c.newClause(fromID, nClause, ruleTable, defaultTable)
 	}
 	if rule.To != nil {
-		if isEgressRule || (rule.IsAntreaNetworkPolicyRule() && !containsLabelIdentityAddress(rule.From)) {
+		// The rules in the audit tables must not drop any packet.
+		if isEgressRule || (rule.IsAntreaNetworkPolicyRule() && (!containsLabelIdentityAddress(rule.From) || isAntreaPolicyAuditTable(rule.TableID))) {
 			defaultTable = nil
 		} else {
 			defaultTable = dropTable
@@ -1477,10 +1615,10 @@ func (c *policyRuleConjunction) calculateChangesForRuleCreation(featureNetworkPo
 	isMCNPRule := containsLabelIdentityAddress(rule.From)
 	var ctxChanges []*conjMatchFlowContextChange
 	if c.fromClause != nil {
-		ctxChanges = append(ctxChanges, c.fromClause.addAddrFlows(featureNetworkPolicy, types.SrcAddress, rule.From, rule.Priority, rule.EnableLogging, isMCNPRule)...)
+		ctxChanges = append(ctxChanges, c.fromClause.addAddrFlows(featureNetworkPolicy, types.SrcAddress, aggregateIPNetAddresses(rule.From), rule.Priority, rule.EnableLogging, isMCNPRule)...)
 	}
 	if c.toClause != nil {
-		ctxChanges = append(ctxChanges, c.toClause.addAddrFlows(featureNetworkPolicy, types.DstAddress, rule.To, rule.Priority, rule.EnableLogging, isMCNPRule)...)
+		ctxChanges = append(ctxChanges, c.toClause.addAddrFlows(featureNetworkPolicy, types.DstAddress, aggregateIPNetAddresses(rule.To), rule.Priority, rule.EnableLogging, isMCNPRule)...)
 	}
 	if c.serviceClause != nil {
 		ctxChanges = append(ctxChanges, c.serviceClause.addServiceFlows(featureNetworkPolicy, rule.Service, rule.Priority, rule.EnableLogging)...)
@@ -1488,6 +1626,46 @@ func (c *policyRuleConjunction) calculateChangesForRuleCreation(featureNetworkPo
 	return ctxChanges
 }
 
+// aggregateIPNetAddresses returns the provided addresses with the IPNetAddresses, and separately the CTIPNetAddresses,
+// replaced by the smallest list of CIDRs covering the same addresses. The CIDRs of the IPBlocks of a rule, which can hold
+// large lists of CIDRs loaded from IPSets, may overlap or be adjacent once several IPBlocks are combined or their except
+// CIDRs are removed, and each CIDR requires a conjunctive match flow. As the IPBlocks of a rule never change, only group
+// member IPs being added or deleted incrementally, the CIDRs are only aggregated when the rule is installed.
+func aggregateIPNetAddresses(addresses []types.Address) []types.Address {
+	var ipNets, ctIPNets []netip.Prefix
+	result := make([]types.Address, 0, len(addresses))
+	for _, addr := range addresses {
+		switch a := addr.(type) {
+		case *IPNetAddress:
+			ipNets = append(ipNets, ipNetToPrefix(net.IPNet(*a)))
+		case *CTIPNetAddress:
+			ctIPNets = append(ctIPNets, ipNetToPrefix(net.IPNet(*a)))
+		default:
+			result = append(result, addr)
+		}
+	}
+	if len(ipNets) < 2 && len(ctIPNets) < 2 {
+		return addresses
+	}
+	for _, prefix := range utilip.AggregateCIDRs(ipNets) {
+		result = append(result, NewIPNetAddress(prefixToIPNet(prefix)))
+	}
+	for _, prefix := range utilip.AggregateCIDRs(ctIPNets) {
+		result = append(result, NewCTIPNetAddress(prefixToIPNet(prefix)))
+	}
+	return result
+}
+
+func ipNetToPrefix(ipNet net.IPNet) netip.Prefix {
+	addr, _ := netip.AddrFromSlice(ipNet.IP)
+	ones, _ := ipNet.Mask.Size()
+	return netip.PrefixFrom(addr.Unmap(), ones)
+}
+
+func prefixToIPNet(prefix netip.Prefix) net.IPNet {
+	return net.IPNet{IP: prefix.Addr().AsSlice(), Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen())}
+}
+
 func containsLabelIdentityAddress(addresses []types.Address) bool {
 	contains := false
 	for _, addr := range addresses {
@@ -1582,6 +1760,12 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	if err := c.ofEntryOperations.DeleteAll(append(conj.actionFlows, conj.metricFlows...)); err != nil {
 		return nil, err
 	}
+	// The meter can only be deleted after the flows referring to it.
+	if conj.meter != nil {
+		if err := conj.meter.Delete(); err != nil {
+			return nil, fmt.Errorf("error when deleting OF Meter for rule %d: %w", conj.id, err)
+		}
+	}
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	// Get the conjMatchFlowContext changes.
@@ -1595,6 +1779,28 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	return staleOFPriorities, nil
 }
 
+// StopPolicyRulePacketCapture replaces the action flow of a Drop or Reject rule with packet capture enabled with one
+// which doesn't send the packets to antrea-agent, so that the packets matching the rule no longer consume the
+// packet-in rate limit of NetworkPolicy once its capture is complete.
+func (c *client) StopPolicyRulePacketCapture(ruleID uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
+	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
+	conj := c.featureNetworkPolicy.getPolicyRuleConjunction(ruleID)
+	if conj == nil || conj.actionFlowWithoutCapture == nil {
+		return nil
+	}
+	if err := c.ofEntryOperations.ModifyAll([]*openflow15.FlowMod{getFlowModMessage(conj.actionFlowWithoutCapture, binding.ModifyMessage)}); err != nil {
+		return fmt.Errorf("error when stopping packet capture for rule %d: %w", ruleID, err)
+	}
+	// The updated action flow is installed instead of the original one when the flows are replayed.
+	conj.actionFlows = []*openflow15.FlowMod{getFlowModMessage(conj.actionFlowWithoutCapture, binding.AddMessage)}
+	conj.actionFlowWithoutCapture = nil
+	return nil
+}
+
 // getStalePriorities returns the ofPriorities that will be stale on the rule table where the
 // policyRuleConjunction is installed, after the deletion of that policyRuleConjunction.
 func (f *featureNetworkPolicy) getStalePriorities(conj *policyRuleConjunction) (staleOFPriorities []string) {
@@ -1946,6 +2152,13 @@ func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	return uint32(id), m
 }
 
+func parseAuditFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
+	m := parseFlowMetric(flowMap)
+	m.Sessions = m.Packets
+	id, _ := strconv.ParseUint(flowMap["conj_id"], 10, 32)
+	return uint32(id), m
+}
+
 func parseFlowToMap(flow string) map[string]string {
 	split := strings.Split(flow, ",")
 	flowMap := make(map[string]string)
@@ -2034,10 +2247,10 @@ func (c *client) MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric {
 
 func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	result := map[uint32]*types.RuleMetric{}
-	collectMetricsFromFlows := func(table *Table, getMetricAndID func(flowMap map[string]string) (uint32, types.RuleMetric)) {
+	collectMetricsFromFlows := func(table *Table, getMetricAndID func(flowMap map[string]string) (uint32, types.RuleMetric), identifier string) {
 		dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
 		for _, flow := range dumpedFlows {
-			if !strings.Contains(flow, metricFlowIdentifier) {
+			if !strings.Contains(flow, identifier) {
 				continue
 			}
 			flowMap := parseFlowToMap(flow)
@@ -2051,8 +2264,8 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	}
 	if c.enableMulticast {
 		// We need to collect NP statistics matching IGMP query messages and egress multicast traffic.
-		collectMetricsFromFlows(MulticastIngressMetricTable, parseMulticastMetricFlow)
-		collectMetricsFromFlows(MulticastEgressMetricTable, parseMulticastMetricFlow)
+		collectMetricsFromFlows(MulticastIngressMetricTable, parseMulticastMetricFlow, metricFlowIdentifier)
+		collectMetricsFromFlows(MulticastEgressMetricTable, parseMulticastMetricFlow, metricFlowIdentifier)
 	}
 	// We have two flows for each allow rule. One matches 'ct_state=+new'
 	// and counts the number of first packets, which is also the number
@@ -2060,8 +2273,14 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	// matches 'ct_state=-new' and is used to count all subsequent
 	// packets in the session. We need to merge metrics from these 2
 	// flows to get the correct number of total packets.
-	collectMetricsFromFlows(EgressMetricTable, parseMetricFlow)
-	collectMetricsFromFlows(IngressMetricTable, parseMetricFlow)
+	collectMetricsFromFlows(EgressMetricTable, parseMetricFlow, metricFlowIdentifier)
+	collectMetricsFromFlows(IngressMetricTable, parseMetricFlow, metricFlowIdentifier)
+	if c.enableAntreaPolicy {
+		// The rules of Antrea-native policies in Audit mode are only matched by the first packets of connections,
+		// the metrics of a Drop or Reject rule count the packets which would have been dropped or rejected.
+		collectMetricsFromFlows(AntreaPolicyEgressAuditRuleTable, parseAuditFlow, auditFlowIdentifier)
+		collectMetricsFromFlows(AntreaPolicyIngressAuditRuleTable, parseAuditFlow, auditFlowIdentifier)
+	}
 	return result
 }
 
@@ -2146,6 +2365,7 @@ func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 	f.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}}
 	if f.enableAntreaPolicy {
 		f.egressTables[AntreaPolicyEgressRuleTable.GetID()] = struct{}{}
+		f.egressTables[AntreaPolicyEgressAuditRuleTable.GetID()] = struct{}{}
 		if f.enableMulticast {
 			f.egressTables[MulticastEgressRuleTable.GetID()] = struct{}{}
 		}
@@ -2168,45 +2388,53 @@ func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 func (f *featureNetworkPolicy) skipPolicyRuleCheckFlows() []binding.Flow {
 	var flows []binding.Flow
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
-	egressCTStateFlowTable := EgressRuleTable
-	ingressCTStateFlowTable := IngressRuleTable
+	egressCTStateFlowTables := []*Table{EgressRuleTable}
+	ingressCTStateFlowTables := []*Table{IngressRuleTable}
 	priority := priorityHigh
 	if f.enableAntreaPolicy {
-		egressCTStateFlowTable = AntreaPolicyEgressRuleTable
-		ingressCTStateFlowTable = AntreaPolicyIngressRuleTable
+		// The audit tables are the first tables of Antrea-native policies, packets in established or related
+		// connections have been evaluated by them with the first packet of the connections.
+		egressCTStateFlowTables = []*Table{AntreaPolicyEgressAuditRuleTable, AntreaPolicyEgressRuleTable}
+		ingressCTStateFlowTables = []*Table{AntreaPolicyIngressAuditRuleTable, AntreaPolicyIngressRuleTable}
 		priority = priorityTopAntreaPolicy
 	}
 	for _, ipProtocol := range f.ipProtocols {
-		flows = append(flows,
-			egressCTStateFlowTable.ofTable.BuildFlow(priority).
-				Cookie(cookieID).
-				MatchProtocol(ipProtocol).
-				MatchCTStateNew(false).
-				MatchCTStateEst(true).
-				Action().GotoTable(EgressMetricTable.GetID()).
-				Done(),
-			egressCTStateFlowTable.ofTable.BuildFlow(priority).
-				Cookie(cookieID).
-				MatchProtocol(ipProtocol).
-				MatchCTStateNew(false).
-				MatchCTStateRel(true).
-				Action().GotoTable(EgressMetricTable.GetID()).
-				Done(),
-			ingressCTStateFlowTable.ofTable.BuildFlow(priority).
-				Cookie(cookieID).
-				MatchProtocol(ipProtocol).
-				MatchCTStateNew(false).
-				MatchCTStateEst(true).
-				Action().GotoTable(IngressMetricTable.GetID()).
-				Done(),
-			ingressCTStateFlowTable.ofTable.BuildFlow(priority).
-				Cookie(cookieID).
-				MatchProtocol(ipProtocol).
-				MatchCTStateNew(false).
-				MatchCTStateRel(true).
-				Action().GotoTable(IngressMetricTable.GetID()).
-				Done(),
-		)
+		for _, egressCTStateFlowTable := range egressCTStateFlowTables {
+			flows = append(flows,
+				egressCTStateFlowTable.ofTable.BuildFlow(priority).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateEst(true).
+					Action().GotoTable(EgressMetricTable.GetID()).
+					Done(),
+				egressCTStateFlowTable.ofTable.BuildFlow(priority).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateRel(true).
+					Action().GotoTable(EgressMetricTable.GetID()).
+					Done(),
+			)
+		}
+		for _, ingressCTStateFlowTable := range ingressCTStateFlowTables {
+			flows = append(flows,
+				ingressCTStateFlowTable.ofTable.BuildFlow(priority).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateEst(true).
+					Action().GotoTable(IngressMetricTable.GetID()).
+					Done(),
+				ingressCTStateFlowTable.ofTable.BuildFlow(priority).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateRel(true).
+					Action().GotoTable(IngressMetricTable.GetID()).
+					Done(),
+			)
+		}
 	}
 	return flows
 }
@@ -2260,7 +2488,7 @@ func (f *featureNetworkPolicy) loggingNPPacketFlowWithOperations(cookieID uint64
 }
 
 func (f *featureNetworkPolicy) initLoggingFlows() []binding.Flow {
-	maxOperationValue := PacketInNPLoggingOperation + PacketInNPStoreDenyOperation + PacketInNPRejectOperation
+	maxOperationValue := PacketInNPLoggingOperation + PacketInNPStoreDenyOperation + PacketInNPRejectOperation + PacketInNPCaptureOperation
 	flows := make([]binding.Flow, 0, maxOperationValue)
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	for operation := 1; operation <= maxOperationValue; operation++ {
@@ -2272,6 +2500,11 @@ func (f *featureNetworkPolicy) initLoggingFlows() []binding.Flow {
 func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 	var groups []binding.OFEntry
 	candidateTables := []*Table{EgressRuleTable, EgressMetricTable, IngressRuleTable, IngressMetricTable}
+	if f.enableAntreaPolicy {
+		// The logging groups used by the rules in the audit tables resubmit the packets to the tables of enforced
+		// Antrea-native policies.
+		candidateTables = append(candidateTables, AntreaPolicyEgressRuleTable, AntreaPolicyIngressRuleTable)
+	}
 	if f.enableMulticast {
 		candidateTables = append(candidateTables, MulticastEgressMetricTable, MulticastIngressMetricTable)
 	}
@@ -2302,7 +2535,15 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.meter != nil {
+			conj.meter.Reset()
+			meters = append(meters, conj.meter)
+		}
+	}
+	return meters
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
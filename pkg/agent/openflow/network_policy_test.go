@@ -520,6 +520,187 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 	}
 }
 
+func TestInstallRateLimitPolicyRuleFlows(t *testing.T) {
+	actionRateLimit := crdv1beta1.RuleActionRateLimit
+	for _, tt := range []struct {
+		name                string
+		enableOVSMeters     bool
+		expectedMetricFlows []string
+	}{
+		{
+			name:            "OVS meters supported",
+			enableOVSMeters: true,
+			expectedMetricFlows: []string{
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=+new,ct_label=0xa/0xffffffff,ip actions=meter:1034,goto_table:ConntrackCommit",
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=-new,ct_label=0xa/0xffffffff,ip actions=meter:1034,goto_table:ConntrackCommit",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+			bridge := mocks.NewMockBridge(ctrl)
+			c := newFakeClientWithBridge(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap, bridge, setEnableOVSMeters(tt.enableOVSMeters))
+			defer resetPipelines()
+
+			rule := &types.PolicyRule{
+				Direction: v1beta2.DirectionIn,
+				From:      parseAddresses([]string{"192.168.1.40"}),
+				Action:    &actionRateLimit,
+				Priority:  &priority100,
+				To:        []types.Address{NewOFPortAddress(1)},
+				FlowID:    uint32(10),
+				TableID:   AntreaPolicyIngressRuleTable.GetID(),
+				PolicyRef: &v1beta2.NetworkPolicyReference{
+					Type:      v1beta2.AntreaNetworkPolicy,
+					Namespace: "ns1",
+					Name:      "np1",
+					UID:       "id1",
+				},
+				RateLimit: &v1beta2.RateLimit{Unit: v1beta2.RateLimitUnitPacketsPerSecond, Rate: 100, Burst: 200},
+			}
+			expectedFlows := append([]string{
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,conj_id=10,ip actions=set_field:0xa->reg6,ct(commit,table=IngressMetric,zone=65520,exec(set_field:0xa/0xffffffff->ct_label))",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,ip,nw_src=192.168.1.40 actions=conjunction(10,1/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,reg1=0x1 actions=conjunction(10,2/2)",
+			}, tt.expectedMetricFlows...)
+
+			meter := mocks.NewMockMeter(ctrl)
+			if tt.enableOVSMeters {
+				meterBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+				bridge.EXPECT().NewMeter(binding.MeterIDType(1034), ofctrl.MeterBurst|ofctrl.MeterPktps).Return(meter).Times(1)
+				meter.EXPECT().MeterBand().Return(meterBuilder).Times(1)
+				meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(1)
+				meterBuilder.EXPECT().Rate(uint32(100)).Return(meterBuilder).Times(1)
+				meterBuilder.EXPECT().Burst(uint32(200)).Return(meterBuilder).Times(1)
+				meterBuilder.EXPECT().Done().Return(meter).Times(1)
+				meter.EXPECT().Add().Return(nil).Times(1)
+			}
+			mockOperations.EXPECT().AddAll(newFlowModIgnoreTxIDMatcher(expectedFlows)).Return(nil).Times(1)
+			require.NoError(t, c.BatchInstallPolicyRuleFlows([]*types.PolicyRule{rule}))
+
+			if tt.enableOVSMeters {
+				meter.EXPECT().Reset().Times(1)
+				assert.Equal(t, []binding.OFEntry{meter}, c.featureNetworkPolicy.replayMeters())
+				meter.EXPECT().Delete().Return(nil).Times(1)
+			} else {
+				assert.Empty(t, c.featureNetworkPolicy.replayMeters())
+			}
+			mockOperations.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			bridge.EXPECT().AddFlowsInBundle(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			_, err := c.UninstallPolicyRuleFlows(rule.FlowID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInstallRateLimitPolicyRuleFlowsFailure(t *testing.T) {
+	actionRateLimit := crdv1beta1.RuleActionRateLimit
+	for _, tt := range []struct {
+		name            string
+		enableOVSMeters bool
+		rateLimit       *v1beta2.RateLimit
+		expectedErr     string
+	}{
+		{
+			name:            "OVS meters not supported",
+			enableOVSMeters: false,
+			rateLimit:       &v1beta2.RateLimit{Unit: v1beta2.RateLimitUnitPacketsPerSecond, Rate: 100, Burst: 200},
+			expectedErr:     "cannot rate-limit the traffic matching rule 10 as OVS meters are not supported",
+		},
+		{
+			name:            "no rate limit",
+			enableOVSMeters: true,
+			expectedErr:     "RateLimit rule 10 has no rate limit",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+			bridge := mocks.NewMockBridge(ctrl)
+			c := newFakeClientWithBridge(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap, bridge, setEnableOVSMeters(tt.enableOVSMeters))
+			defer resetPipelines()
+
+			rule := &types.PolicyRule{
+				Direction: v1beta2.DirectionIn,
+				From:      parseAddresses([]string{"192.168.1.40"}),
+				Action:    &actionRateLimit,
+				Priority:  &priority100,
+				To:        []types.Address{NewOFPortAddress(1)},
+				FlowID:    uint32(10),
+				TableID:   AntreaPolicyIngressRuleTable.GetID(),
+				PolicyRef: &v1beta2.NetworkPolicyReference{
+					Type:      v1beta2.AntreaNetworkPolicy,
+					Namespace: "ns1",
+					Name:      "np1",
+					UID:       "id1",
+				},
+				RateLimit: tt.rateLimit,
+			}
+			allowRule := &types.PolicyRule{
+				Direction: v1beta2.DirectionIn,
+				From:      parseAddresses([]string{"192.168.1.41"}),
+				Action:    &actionAllow,
+				Priority:  &priority200,
+				To:        []types.Address{NewOFPortAddress(1)},
+				FlowID:    uint32(11),
+				TableID:   AntreaPolicyIngressRuleTable.GetID(),
+				PolicyRef: rule.PolicyRef,
+			}
+			// The rule which cannot be realized doesn't prevent the other rules from being installed.
+			mockOperations.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			err := c.BatchInstallPolicyRuleFlows([]*types.PolicyRule{rule, allowRule})
+			var ruleErrs PolicyRuleErrors
+			require.ErrorAs(t, err, &ruleErrs)
+			require.Len(t, ruleErrs, 1)
+			assert.EqualError(t, ruleErrs[rule.FlowID], tt.expectedErr)
+			assert.Nil(t, c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID))
+			assert.NotNil(t, c.featureNetworkPolicy.getPolicyRuleConjunction(allowRule.FlowID))
+
+			assert.EqualError(t, c.InstallPolicyRuleFlows(rule), tt.expectedErr)
+			assert.Nil(t, c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID))
+		})
+	}
+}
+
+func TestStopPolicyRulePacketCapture(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+	c := newFakeClient(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	actionDrop := crdv1beta1.RuleActionDrop
+	rule := &types.PolicyRule{
+		Direction: v1beta2.DirectionIn,
+		From:      parseAddresses([]string{"192.168.1.40"}),
+		Action:    &actionDrop,
+		Priority:  &priority100,
+		To:        []types.Address{NewOFPortAddress(1)},
+		FlowID:    uint32(10),
+		TableID:   AntreaPolicyIngressRuleTable.GetID(),
+		PolicyRef: &v1beta2.NetworkPolicyReference{
+			Type:      v1beta2.AntreaNetworkPolicy,
+			Namespace: "ns1",
+			Name:      "np1",
+			UID:       "id1",
+		},
+		PacketCapture: &v1beta2.RulePacketCapture{MaxPackets: 10},
+	}
+	mockOperations.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, c.BatchInstallPolicyRuleFlows([]*types.PolicyRule{rule}))
+
+	expectedActionFlow := "cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,conj_id=10 actions=set_field:0xa->reg3,set_field:0x400/0x400->reg0,goto_table:IngressMetric"
+	mockOperations.EXPECT().ModifyAll(newFlowModIgnoreTxIDMatcher([]string{expectedActionFlow})).Return(nil).Times(1)
+	require.NoError(t, c.StopPolicyRulePacketCapture(rule.FlowID))
+	// The packet capture of the rule is already stopped.
+	require.NoError(t, c.StopPolicyRulePacketCapture(rule.FlowID))
+	// Rules which are not installed are ignored.
+	require.NoError(t, c.StopPolicyRulePacketCapture(uint32(11)))
+
+	conj := c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID)
+	assert.Equal(t, []string{expectedActionFlow}, getFlowStrings(conj.actionFlows))
+}
+
 type flowModIgnoreTxIDMatcher struct {
 	flowMods []string
 }
@@ -668,6 +849,89 @@ func TestConjMatchFlowContextKeyConflict(t *testing.T) {
 	assert.Equal(t, clause2.action, act2)
 }
 
+func TestGetServiceMatchPairs(t *testing.T) {
+	icmpType3 := int32(3)
+	icmpType4 := int32(4)
+	icmpType5 := int32(5)
+	icmpType135 := int32(135)
+	icmpType137 := int32(137)
+	icmpCode1 := int32(1)
+	protocolIPv6ExtensionHeader := v1beta2.ProtocolIPv6ExtensionHeader
+	dualStack := []binding.Protocol{binding.ProtocolIP, binding.ProtocolIPv6}
+	tests := []struct {
+		name        string
+		service     v1beta2.Service
+		ipProtocols []binding.Protocol
+		expected    [][]matchPair
+	}{
+		{
+			name:        "ICMP type and code",
+			service:     v1beta2.Service{Protocol: &protocolICMP, ICMPType: &icmpType8, ICMPCode: &icmpCode0},
+			ipProtocols: []binding.Protocol{binding.ProtocolIP},
+			expected:    [][]matchPair{{{MatchICMPType, &icmpType8}, {MatchICMPCode, &icmpCode0}}},
+		},
+		{
+			name:        "ICMP type range",
+			service:     v1beta2.Service{Protocol: &protocolICMP, ICMPType: &icmpType3, EndICMPType: &icmpType5},
+			ipProtocols: []binding.Protocol{binding.ProtocolIP},
+			expected:    [][]matchPair{{{MatchICMPType, &icmpType3}}, {{MatchICMPType, &icmpType4}}, {{MatchICMPType, &icmpType5}}},
+		},
+		{
+			name:        "ICMP type with code range",
+			service:     v1beta2.Service{Protocol: &protocolICMP, ICMPType: &icmpType3, ICMPCode: &icmpCode0, EndICMPCode: &icmpCode1},
+			ipProtocols: []binding.Protocol{binding.ProtocolIPv6},
+			expected: [][]matchPair{
+				{{MatchICMPv6Type, &icmpType3}, {MatchICMPv6Code, &icmpCode0}},
+				{{MatchICMPv6Type, &icmpType3}, {MatchICMPv6Code, &icmpCode1}},
+			},
+		},
+		{
+			name:        "ICMP type name in dual-stack",
+			service:     v1beta2.Service{Protocol: &protocolICMP, ICMPTypeName: crdv1beta1.ICMPTypeRedirect},
+			ipProtocols: dualStack,
+			expected:    [][]matchPair{{{MatchICMPType, &icmpType5}}, {{MatchICMPv6Type, &icmpType137}}},
+		},
+		{
+			name:        "ICMPv6 only type name in dual-stack",
+			service:     v1beta2.Service{Protocol: &protocolICMP, ICMPTypeName: crdv1beta1.ICMPTypeNeighborSolicitation},
+			ipProtocols: dualStack,
+			expected:    [][]matchPair{{{MatchICMPv6Type, &icmpType135}}},
+		},
+		{
+			name:        "IPv6 extension header in IPv4 cluster",
+			service:     v1beta2.Service{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderNoNextHeader},
+			ipProtocols: []binding.Protocol{binding.ProtocolIP},
+			expected:    nil,
+		},
+		{
+			name:        "IPv6 ESP",
+			service:     v1beta2.Service{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderESP},
+			ipProtocols: dualStack,
+			expected:    [][]matchPair{{{MatchIPv6NextHeader, uint8(50)}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getServiceMatchPairs(tt.service, tt.ipProtocols))
+		})
+	}
+}
+
+func TestAggregateIPNetAddresses(t *testing.T) {
+	ctIPNet := func(cidr string) types.Address {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		return NewCTIPNetAddress(*ipNet)
+	}
+	addresses := append(parseAddresses([]string{"10.0.0.1", "10.0.0.0/25", "10.0.0.128/25", "10.0.0.5/32", "fd00::/65", "fd00::8000:0:0:0/65", "103"}),
+		ctIPNet("10.1.0.0/24"), ctIPNet("10.1.1.0/24"))
+	expected := append(parseAddresses([]string{"10.0.0.1", "103", "10.0.0.0/24", "fd00::/64"}), ctIPNet("10.1.0.0/23"))
+	assert.Equal(t, expected, aggregateIPNetAddresses(addresses))
+
+	// Addresses without CIDRs to aggregate are returned as is.
+	addresses = parseAddresses([]string{"10.0.0.1", "10.0.0.0/25", "103"})
+	assert.Equal(t, addresses, aggregateIPNetAddresses(addresses))
+}
+
 func TestInstallPolicyRuleFlowsInDualStackCluster(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	preparePipelines()
@@ -1013,6 +1277,7 @@ func preparePipelines() {
 	mockFeatureNetworkPolicy.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}}
 	if mockFeatureNetworkPolicy.enableAntreaPolicy {
 		mockFeatureNetworkPolicy.egressTables[AntreaPolicyEgressRuleTable.GetID()] = struct{}{}
+		mockFeatureNetworkPolicy.egressTables[AntreaPolicyEgressAuditRuleTable.GetID()] = struct{}{}
 	}
 	mockFeatureNetworkPolicy.category = cookie.NetworkPolicy
 	mockFeaturePodConnectivity.category = cookie.PodConnectivity
@@ -1102,6 +1367,15 @@ func TestParseMetricFlow(t *testing.T) {
 	}
 }
 
+func TestParseAuditFlow(t *testing.T) {
+	flow := "table=AntreaPolicyIngressAuditRule, n_packets=7, n_bytes=518, priority=14900,conj_id=12 actions=set_field:0xc->reg3,set_field:0x800/0x1800->reg0,group:1"
+	rule, metric := parseAuditFlow(parseFlowToMap(flow))
+	require.Equal(t, uint32(12), rule)
+	require.Equal(t, uint64(518), metric.Bytes)
+	require.Equal(t, uint64(7), metric.Packets)
+	require.Equal(t, uint64(7), metric.Sessions)
+}
+
 func TestNetworkPolicyMetrics(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1369,6 +1643,14 @@ func networkPolicyInitFlows(ovsMeterSupported, externalNodeEnabled, l7NetworkPol
 		"cookie=0x1020000000000, table=Output, priority=200,reg0=0xa400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.05,max_len=65535)",
 		"cookie=0x1020000000000, table=Output, priority=200,reg0=0xc400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.06,max_len=65535)",
 		"cookie=0x1020000000000, table=Output, priority=200,reg0=0xe400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.07,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x10400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.08,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x12400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.09,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x14400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0a,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x16400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0b,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x18400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0c,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1a400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0d,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1c400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0e,max_len=65535)",
+		"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1e400000/0xfe600000 actions=controller(id=32776,reason=no_match,userdata=01.0f,max_len=65535)",
 	}
 	if ovsMeterSupported {
 		loggingFlows = []string{
@@ -1379,13 +1661,25 @@ func networkPolicyInitFlows(ovsMeterSupported, externalNodeEnabled, l7NetworkPol
 			"cookie=0x1020000000000, table=Output, priority=200,reg0=0xa400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.05,max_len=65535)",
 			"cookie=0x1020000000000, table=Output, priority=200,reg0=0xc400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.06,max_len=65535)",
 			"cookie=0x1020000000000, table=Output, priority=200,reg0=0xe400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.07,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x10400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.08,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x12400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.09,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x14400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0a,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x16400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0b,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x18400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0c,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1a400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0d,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1c400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0e,max_len=65535)",
+			"cookie=0x1020000000000, table=Output, priority=200,reg0=0x1e400000/0xfe600000 actions=meter:256,controller(id=32776,reason=no_match,userdata=01.0f,max_len=65535)",
 		}
 	}
 	if externalNodeEnabled {
 		return append(loggingFlows,
+			"cookie=0x1020000000000, table=AntreaPolicyEgressAuditRule, priority=64990,ct_state=-new+est,ip actions=goto_table:EgressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:EgressMetric",
+			"cookie=0x1020000000000, table=AntreaPolicyEgressAuditRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
+			"cookie=0x1020000000000, table=AntreaPolicyIngressAuditRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
+			"cookie=0x1020000000000, table=AntreaPolicyIngressAuditRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
 			"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
 		)
 	}
@@ -1394,9 +1688,13 @@ func networkPolicyInitFlows(ovsMeterSupported, externalNodeEnabled, l7NetworkPol
 		"cookie=0x1020000000000, table=IngressSecurityClassifier, priority=200,reg0=0x10/0xf0 actions=goto_table:IngressMetric",
 		"cookie=0x1020000000000, table=IngressSecurityClassifier, priority=200,reg0=0x40/0xf0 actions=goto_table:IngressMetric",
 		"cookie=0x1020000000000, table=IngressSecurityClassifier, priority=200,ct_mark=0x40/0x40 actions=goto_table:ConntrackCommit",
+		"cookie=0x1020000000000, table=AntreaPolicyEgressAuditRule, priority=64990,ct_state=-new+est,ip actions=goto_table:EgressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:EgressMetric",
+		"cookie=0x1020000000000, table=AntreaPolicyEgressAuditRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyEgressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:EgressMetric",
+		"cookie=0x1020000000000, table=AntreaPolicyIngressAuditRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+est,ip actions=goto_table:IngressMetric",
+		"cookie=0x1020000000000, table=AntreaPolicyIngressAuditRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
 		"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=64990,ct_state=-new+rel,ip actions=goto_table:IngressMetric",
 	)
 	if l7NetworkPolicyEnabled {
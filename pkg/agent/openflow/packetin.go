@@ -75,6 +75,10 @@ const (
 	// can be consumed by the Flow Exporter to export flow records for connections
 	// denied by network policy rules.
 	PacketInNPStoreDenyOperation = 0b100
+	// PacketInNPCaptureOperation is used when sending packetIn message to controller
+	// indicating that the packet matches a policy rule with packet capture enabled,
+	// and should be written to the capture file of the rule.
+	PacketInNPCaptureOperation = 0b1000
 
 	// We use OpenFlow Meter for packetIn rate limiting on OVS side.
 	// Meter Entry ID.
@@ -84,6 +88,10 @@ const (
 	PacketInMeterIDNP  = 256
 	PacketInMeterIDTF  = 257
 	PacketInMeterIDDNS = 258
+	// Meter IDs from 1024 are used to rate-limit the traffic matching the
+	// Antrea-native policy rules with the RateLimit action. The meter ID of a
+	// rule is the sum of this base and the conjunction ID of the rule.
+	NetworkPolicyRateLimitMeterIDBase = 1024
 )
 
 // RegisterPacketInHandler stores controller handler in a map with category as keys.
@@ -19,6 +19,7 @@ import (
 
 	"antrea.io/libOpenflow/protocol"
 
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -31,11 +32,15 @@ const (
 	tcpAck uint8 = 0b010000
 	tcpRst uint8 = 0b000100
 
+	icmpEchoRequestType            uint8 = 8
 	icmpDstUnreachableType         uint8 = 3
+	icmpDstHostUnreachableCode     uint8 = 1
 	icmpDstHostAdminProhibitedCode uint8 = 10
 
+	icmpv6EchoRequestType        uint8 = 128
 	icmpv6DstUnreachableType     uint8 = 1
 	icmpv6DstAdminProhibitedCode uint8 = 1
+	icmpv6DstAddrUnreachableCode uint8 = 3
 )
 
 func SendRejectPacketOut(ofClient Client,
@@ -48,8 +53,10 @@ func SendRejectPacketOut(ofClient Client,
 	isIPv6 bool,
 	ethernetPkt *protocol.Ethernet,
 	proto uint8,
+	rejectResponse crdv1beta1.RejectResponseType,
 	mutateFunc func(binding.PacketOutBuilder) binding.PacketOutBuilder) error {
-	if proto == protocol.Type_TCP {
+	// TCP traffic is reset unless an ICMP response is requested.
+	if proto == protocol.Type_TCP && rejectResponse != crdv1beta1.RejectResponseICMPAdminProhibited && rejectResponse != crdv1beta1.RejectResponseICMPHostUnreachable {
 		// Get TCP data.
 		oriTCPSrcPort, oriTCPDstPort, oriTCPSeqNum, _, _, _, _, err := binding.GetTCPHeaderData(ethernetPkt.Data)
 		if err != nil {
@@ -75,13 +82,19 @@ func SendRejectPacketOut(ofClient Client,
 			nil,
 			mutateFunc)
 	}
-	// Use ICMP host administratively prohibited for ICMP, UDP, SCTP reject.
+	// Use ICMP host administratively prohibited for ICMP, UDP, SCTP reject by default.
 	icmpType := icmpDstUnreachableType
 	icmpCode := icmpDstHostAdminProhibitedCode
+	if rejectResponse == crdv1beta1.RejectResponseICMPHostUnreachable {
+		icmpCode = icmpDstHostUnreachableCode
+	}
 	ipHdrLen := ipv4HdrLen
 	if isIPv6 {
 		icmpType = icmpv6DstUnreachableType
 		icmpCode = icmpv6DstAdminProhibitedCode
+		if rejectResponse == crdv1beta1.RejectResponseICMPHostUnreachable {
+			icmpCode = icmpv6DstAddrUnreachableCode
+		}
 		ipHdrLen = ipv6HdrLen
 	}
 	ipHdr, _ := ethernetPkt.Data.MarshalBinary()
@@ -36,6 +36,7 @@ import (
 	"antrea.io/antrea/pkg/agent/openflow/operations"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	"antrea.io/antrea/pkg/util/runtime"
@@ -148,11 +149,12 @@ var (
 	DNATTable = newTable("DNAT", stagePreRouting, pipelineIP)
 
 	// Tables in stageEgressSecurity:
-	EgressSecurityClassifierTable = newTable("EgressSecurityClassifier", stageEgressSecurity, pipelineIP)
-	AntreaPolicyEgressRuleTable   = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
-	EgressRuleTable               = newTable("EgressRule", stageEgressSecurity, pipelineIP)
-	EgressDefaultTable            = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
-	EgressMetricTable             = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
+	EgressSecurityClassifierTable    = newTable("EgressSecurityClassifier", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressAuditRuleTable = newTable("AntreaPolicyEgressAuditRule", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressRuleTable      = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
+	EgressRuleTable                  = newTable("EgressRule", stageEgressSecurity, pipelineIP)
+	EgressDefaultTable               = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
+	EgressMetricTable                = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
 
 	// Tables in stageRouting:
 	L3ForwardingTable = newTable("L3Forwarding", stageRouting, pipelineIP)
@@ -169,11 +171,12 @@ var (
 	TrafficControlTable   = newTable("TrafficControl", stageSwitching, pipelineIP)
 
 	// Tables in stageIngressSecurity:
-	IngressSecurityClassifierTable = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
-	AntreaPolicyIngressRuleTable   = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
-	IngressRuleTable               = newTable("IngressRule", stageIngressSecurity, pipelineIP)
-	IngressDefaultTable            = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
-	IngressMetricTable             = newTable("IngressMetric", stageIngressSecurity, pipelineIP)
+	IngressSecurityClassifierTable    = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressAuditRuleTable = newTable("AntreaPolicyIngressAuditRule", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressRuleTable      = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
+	IngressRuleTable                  = newTable("IngressRule", stageIngressSecurity, pipelineIP)
+	IngressDefaultTable               = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
+	IngressMetricTable                = newTable("IngressMetric", stageIngressSecurity, pipelineIP)
 
 	// Tables in stageConntrack:
 	ConntrackCommitTable = newTable("ConntrackCommit", stageConntrack, pipelineIP)
@@ -282,6 +285,7 @@ func GetAntreaPolicyEgressTables() []*Table {
 	return []*Table{
 		AntreaPolicyEgressRuleTable,
 		EgressDefaultTable,
+		AntreaPolicyEgressAuditRuleTable,
 	}
 }
 
@@ -301,6 +305,7 @@ func GetAntreaPolicyIngressTables() []*Table {
 	return []*Table{
 		AntreaPolicyIngressRuleTable,
 		IngressDefaultTable,
+		AntreaPolicyIngressAuditRuleTable,
 	}
 }
 
@@ -315,9 +320,29 @@ func GetAntreaPolicyMultiTierTables() []*Table {
 	return []*Table{
 		AntreaPolicyEgressRuleTable,
 		AntreaPolicyIngressRuleTable,
+		AntreaPolicyEgressAuditRuleTable,
+		AntreaPolicyIngressAuditRuleTable,
 	}
 }
 
+// GetAntreaPolicyAuditTables returns the tables in which the rules of Antrea-native policies in Audit mode are
+// installed. Packets matching these rules are never dropped or rejected in these tables.
+func GetAntreaPolicyAuditTables() []*Table {
+	return []*Table{
+		AntreaPolicyEgressAuditRuleTable,
+		AntreaPolicyIngressAuditRuleTable,
+	}
+}
+
+func isAntreaPolicyAuditTable(tableID uint8) bool {
+	for _, table := range GetAntreaPolicyAuditTables() {
+		if table.IsInitialized() && table.GetID() == tableID {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	CtZone       = 0xfff0
 	CtZoneV6     = 0xffe6
@@ -859,13 +884,16 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
 	liveTraffic,
 	droppedOnly,
-	receiverOnly bool,
+	receiverOnly,
+	session bool,
 	packet *binding.Packet,
 	ofPort uint32,
 	timeout uint16) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(cookie.Traceflow).Raw()
 	var flows []binding.Flow
-	if packet == nil {
+	if session {
+		flows = append(flows, f.sessionFlowsToTrace(dataplaneTag, ovsMetersAreSupported, packet, ofPort, timeout)...)
+	} else if packet == nil {
 		for _, ipProtocol := range f.ipProtocols {
 			flows = append(flows,
 				ConntrackStateTable.ofTable.BuildFlow(priorityLow+1).
@@ -957,9 +985,10 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 		}
 		return fb
 	}
-	// Clear the loaded DSCP bits before output.
+	// Clear the loaded DSCP bits before output. The packets of a session Traceflow are delivered too, so that the
+	// destination can reply to them.
 	ifLiveTraffic := func(fb binding.FlowBuilder) binding.FlowBuilder {
-		if liveTraffic {
+		if liveTraffic || session {
 			return fb.Action().LoadIPDSCP(0).
 				Action().OutputToRegField(TargetOFPortField)
 		}
@@ -1040,12 +1069,117 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 	return flows
 }
 
+// sessionFlowsToTrace generates the flows for a session Traceflow, which traces the packets of a connection in both
+// directions. packet is the first request packet sent by the source Pod, and ofPort is the OVS port of the source Pod
+// if it is on the local Node, or 0 otherwise. The injected request packets carry the dataplane tag and go to
+// stagePreRouting as in a normal Traceflow. The reply packets, which are sent by the destination without the tag, are
+// identified by the original direction of the connection in conntrack, tagged with the dataplane tag, and then follow
+// the same path as the other reply packets of the connection. The connection is matched with all the fields of its
+// original direction known by packet, so that the replies of other connections of the source Pod are not traced. The
+// reply packets reaching the source Pod are only sent to the Antrea Agent and are not delivered, because no socket in
+// the source Pod expects them.
+func (f *featurePodConnectivity) sessionFlowsToTrace(dataplaneTag uint8,
+	ovsMetersAreSupported bool,
+	packet *binding.Packet,
+	ofPort uint32,
+	timeout uint16) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(cookie.Traceflow).Raw()
+	var flows []binding.Flow
+	var ipProtocol, ctProtocol binding.Protocol
+	switch packet.IPProto {
+	case protocol.Type_TCP:
+		ctProtocol = binding.ProtocolTCP
+		if packet.IsIPv6 {
+			ctProtocol = binding.ProtocolTCPv6
+		}
+	case protocol.Type_IPv6ICMP:
+		ctProtocol = binding.ProtocolICMPv6
+	default:
+		ctProtocol = binding.ProtocolICMP
+	}
+	if packet.IsIPv6 {
+		ipProtocol = binding.ProtocolIPv6
+	} else {
+		ipProtocol = binding.ProtocolIP
+	}
+	replyFlowBuilder := func(priority uint16) binding.FlowBuilder {
+		fb := ConntrackStateTable.ofTable.BuildFlow(priority).
+			Cookie(cookieID).
+			MatchProtocol(ctProtocol).
+			MatchCTStateEst(true).
+			MatchCTStateTrk(true).
+			MatchCTStateRpl(true).
+			MatchCTSrcIP(packet.SourceIP).
+			MatchCTProtocol(ctProtocol).
+			SetHardTimeout(timeout)
+		// The original destination is unknown on the Nodes other than the sender Node when the connection is DNATed.
+		if packet.DestinationIP != nil {
+			fb = fb.MatchCTDstIP(packet.DestinationIP)
+		}
+		switch packet.IPProto {
+		case protocol.Type_TCP:
+			fb = fb.MatchCTSrcPort(packet.SourcePort)
+			if packet.DestinationPort != 0 {
+				fb = fb.MatchCTDstPort(packet.DestinationPort)
+			}
+		case protocol.Type_ICMP:
+			// For ICMP connections, ct_tp_src is the ICMP type of the original direction. The Echo ID cannot be
+			// matched by OVS, and is checked by the Antrea Agent when the reply reaches the source Pod.
+			fb = fb.MatchCTSrcPort(uint16(icmpEchoRequestType))
+		case protocol.Type_IPv6ICMP:
+			fb = fb.MatchCTSrcPort(uint16(icmpv6EchoRequestType))
+		}
+		return fb
+	}
+	flows = append(flows,
+		ConntrackStateTable.ofTable.BuildFlow(priorityLow+1).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchIPDSCP(dataplaneTag).
+			SetHardTimeout(timeout).
+			Action().GotoStage(stagePreRouting).
+			Done(),
+		// Tag the reply packets of non-Service connections, and forward them to stageEgressSecurity like the flow
+		// installed by featurePodConnectivity.conntrackFlows.
+		replyFlowBuilder(priorityLow+3).
+			MatchCTMark(NotServiceCTMark).
+			Action().LoadIPDSCP(dataplaneTag).
+			Action().GotoStage(stageEgressSecurity).
+			Done(),
+		// Tag the reply packets of Service connections, and forward them to stageEgressSecurity like the flow
+		// installed by featureService.conntrackFlows.
+		replyFlowBuilder(priorityLow+3).
+			MatchCTMark(ServiceCTMark).
+			Action().LoadIPDSCP(dataplaneTag).
+			Action().LoadRegMark(RewriteMACRegMark).
+			Action().GotoStage(stageEgressSecurity).
+			Done(),
+	)
+	if ofPort != 0 {
+		// Only SendToController if the reply packet is output to the source Pod. This flow must have higher priority
+		// than the Traceflow flows which output the packets to OVS ports.
+		fb := OutputTable.ofTable.BuildFlow(priorityNormal+4).
+			Cookie(cookieID).
+			MatchRegFieldWithValue(TargetOFPortField, ofPort).
+			MatchProtocol(ipProtocol).
+			MatchRegMark(OutputToOFPortRegMark).
+			MatchIPDSCP(dataplaneTag).
+			SetHardTimeout(timeout)
+		if ovsMetersAreSupported {
+			fb = fb.Action().Meter(PacketInMeterIDTF)
+		}
+		flows = append(flows, fb.Action().SendToController([]byte{uint8(PacketInCategoryTF)}, false).Done())
+	}
+	return flows
+}
+
 // flowsToTrace is used to generate flows for Traceflow in featureService.
 func (f *featureService) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
 	liveTraffic,
 	droppedOnly,
-	receiverOnly bool,
+	receiverOnly,
+	session bool,
 	packet *binding.Packet,
 	ofPort uint32,
 	timeout uint16) []binding.Flow {
@@ -1061,9 +1195,10 @@ func (f *featureService) flowsToTrace(dataplaneTag uint8,
 		}
 		return fb
 	}
-	// Clear the loaded DSCP bits before output.
+	// Clear the loaded DSCP bits before output. The packets of a session Traceflow are delivered too, so that the
+	// destination can reply to them.
 	ifLiveTraffic := func(fb binding.FlowBuilder) binding.FlowBuilder {
-		if liveTraffic {
+		if liveTraffic || session {
 			return fb.Action().LoadIPDSCP(0).
 				Action().OutputToRegField(TargetOFPortField)
 		}
@@ -1095,7 +1230,8 @@ func (f *featureNetworkPolicy) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
 	liveTraffic,
 	droppedOnly,
-	receiverOnly bool,
+	receiverOnly,
+	session bool,
 	packet *binding.Packet,
 	ofPort uint32,
 	timeout uint16) []binding.Flow {
@@ -1597,7 +1733,9 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the metric flows of a rule allowing traffic. If meterID is not 0, all the packets
+// of the connections matching the rule, in both directions, are rate-limited by the meter.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, meterID uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1616,12 +1754,15 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 		metricTable = MulticastIngressMetricTable
 	}
 	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
-		return metricTable.ofTable.BuildFlow(priorityNormal).
+		fb := metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		if meterID != 0 {
+			fb = fb.Action().Meter(meterID)
+		}
+		return fb.Action().NextTable().
 			Done()
 	}
 	var flows []binding.Flow
@@ -1804,9 +1945,10 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 }
 
 // conjunctionActionDenyFlow generates the flow to mark the packet to be denied (dropped or rejected) if policyRuleConjunction
-// ID is matched. Any matched flow will be dropped in corresponding metric tables.
+// ID is matched. Any matched flow will be dropped in corresponding metric tables. If enablePacketCapture is true, the
+// packet is also sent to antrea-agent to be captured.
 func (f *featureNetworkPolicy) conjunctionActionDenyFlow(conjunctionID uint32, table binding.Table, priority *uint16,
-	disposition uint32, enableLogging bool) binding.Flow {
+	disposition uint32, enableLogging, enablePacketCapture bool) binding.Flow {
 	ofPriority := *priority
 	metricTable := IngressMetricTable
 	tableID := table.GetID()
@@ -1840,8 +1982,11 @@ func (f *featureNetworkPolicy) conjunctionActionDenyFlow(conjunctionID uint32, t
 	if disposition == DispositionRej {
 		packetInOperations += PacketInNPRejectOperation
 	}
+	if enablePacketCapture {
+		packetInOperations += PacketInNPCaptureOperation
+	}
 
-	if enableLogging || f.enableDenyTracking || disposition == DispositionRej {
+	if packetInOperations != 0 {
 		groupID := f.getLoggingAndResubmitGroupID(metricTable.GetID())
 		return flowBuilder.Action().LoadToRegField(PacketInOperationField, uint32(packetInOperations)).
 			Action().LoadToRegField(PacketInTableField, uint32(tableID)).
@@ -1881,6 +2026,42 @@ func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow for a rule of an Antrea-native policy in Audit mode if
+// policyRuleConjunction ID is matched. Packets matching a Drop or Reject rule are sent to antrea-agent for logging,
+// and all matching packets are forwarded to the table of enforced Antrea-native policies in the same direction. Like
+// the other rules in the audit tables, the first matching rule determines the audit result of the packet.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16, action crdv1beta1.RuleAction) binding.Flow {
+	ofPriority := *priority
+	nextTable := AntreaPolicyIngressRuleTable
+	tableID := table.GetID()
+	if _, ok := f.egressTables[tableID]; ok {
+		nextTable = AntreaPolicyEgressRuleTable
+	}
+	flowBuilder := table.BuildFlow(ofPriority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchConjID(conjunctionID)
+
+	var disposition uint32
+	switch action {
+	case crdv1beta1.RuleActionDrop:
+		disposition = DispositionDrop
+	case crdv1beta1.RuleActionReject:
+		disposition = DispositionRej
+	default:
+		return flowBuilder.Action().GotoTable(nextTable.GetID()).
+			Done()
+	}
+	// APDenyRegMark is not loaded, so that the packet is not dropped in the metric table.
+	groupID := f.getLoggingAndResubmitGroupID(nextTable.GetID())
+	return flowBuilder.
+		Action().LoadToRegField(APConjIDField, conjunctionID).
+		Action().LoadToRegField(APDispositionField, disposition).
+		Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
+		Action().LoadToRegField(PacketInTableField, uint32(tableID)).
+		Action().Group(groupID).
+		Done()
+}
+
 func (c *client) Disconnect() error {
 	return c.bridge.Disconnect()
 }
@@ -1976,6 +2157,8 @@ func (f *featureNetworkPolicy) addFlowMatch(fb binding.FlowBuilder, matchKey *ty
 		if matchValue != nil {
 			fb = fb.MatchICMPv6Code(uint8(*matchValue.(*int32)))
 		}
+	case MatchIPv6NextHeader:
+		fb = fb.MatchIPProtocolValue(true, matchValue.(uint8))
 	case MatchServiceGroupID:
 		fb = fb.MatchRegFieldWithValue(ServiceGroupIDField, matchValue.(uint32))
 	case MatchIGMPProtocol:
@@ -2166,12 +2349,13 @@ func (f *featureNetworkPolicy) ingressClassifierFlows() []binding.Flow {
 			Done(),
 	}
 	if f.enableAntreaPolicy && f.proxyAll {
-		// This generates the flow to match the NodePort Service packets and forward them to AntreaPolicyIngressRuleTable.
-		// Policies applied on NodePort Service will be enforced in AntreaPolicyIngressRuleTable.
+		// This generates the flow to match the NodePort Service packets and forward them to AntreaPolicyIngressAuditRuleTable.
+		// Policies applied on NodePort Service will be audited in AntreaPolicyIngressAuditRuleTable and enforced in
+		// AntreaPolicyIngressRuleTable.
 		flows = append(flows, IngressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal+1).
 			Cookie(cookieID).
 			MatchRegMark(ToNodePortAddressRegMark).
-			Action().GotoTable(AntreaPolicyIngressRuleTable.GetID()).
+			Action().GotoTable(AntreaPolicyIngressAuditRuleTable.GetID()).
 			Done())
 	}
 	return flows
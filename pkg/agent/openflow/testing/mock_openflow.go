@@ -532,17 +532,17 @@ func (mr *MockClientMockRecorder) InstallServiceGroup(arg0, arg1, arg2 any) *gom
 }
 
 // InstallTraceflowFlows mocks base method.
-func (m *MockClient) InstallTraceflowFlows(arg0 byte, arg1, arg2, arg3 bool, arg4 *openflow0.Packet, arg5 uint32, arg6 uint16) error {
+func (m *MockClient) InstallTraceflowFlows(arg0 byte, arg1, arg2, arg3, arg4 bool, arg5 *openflow0.Packet, arg6 uint32, arg7 uint16) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallTraceflowFlows", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "InstallTraceflowFlows", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InstallTraceflowFlows indicates an expected call of InstallTraceflowFlows.
-func (mr *MockClientMockRecorder) InstallTraceflowFlows(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+func (mr *MockClientMockRecorder) InstallTraceflowFlows(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowFlows), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowFlows), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
 // InstallTrafficControlMarkFlows mocks base method.
@@ -847,6 +847,20 @@ func (mr *MockClientMockRecorder) StartPacketInHandler(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPacketInHandler", reflect.TypeOf((*MockClient)(nil).StartPacketInHandler), arg0)
 }
 
+// StopPolicyRulePacketCapture mocks base method.
+func (m *MockClient) StopPolicyRulePacketCapture(arg0 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPolicyRulePacketCapture", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopPolicyRulePacketCapture indicates an expected call of StopPolicyRulePacketCapture.
+func (mr *MockClientMockRecorder) StopPolicyRulePacketCapture(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPolicyRulePacketCapture", reflect.TypeOf((*MockClient)(nil).StopPolicyRulePacketCapture), arg0)
+}
+
 // SubscribePacketIn mocks base method.
 func (m *MockClient) SubscribePacketIn(arg0 byte, arg1 *openflow0.PacketInQueue) error {
 	m.ctrl.T.Helper()
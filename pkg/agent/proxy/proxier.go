@@ -1342,6 +1342,7 @@ func (p *proxier) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 		isIPv6,
 		ethernetPkt,
 		proto,
+		"",
 		nil)
 }
 
@@ -49,6 +49,9 @@ type statsCollection struct {
 	antreaNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
 	// multicastGroups is a map that encodes the list of Pods that has joined the multicast group.
 	multicastGroups map[string][]cpv1beta.PodReference
+	// peerStats is a mapping from the peers of NetworkPolicy rules to their traffic stats since they
+	// were last reported. It's only populated when the collection of peer stats is enabled.
+	peerStats map[rulePeer]*statsv1alpha1.TrafficStats
 }
 
 // Collector is responsible for collecting stats from the Openflow client, calculating the delta compared with the last
@@ -66,9 +69,12 @@ type Collector struct {
 	// It is used to calculate the delta of the statistics that will be reported.
 	lastStatsCollection *statsCollection
 	multicastEnabled    bool
+	// peerStatsCollector collects the stats of the NetworkPolicy rules by peer. It's nil if the collection of
+	// peer stats is disabled.
+	peerStatsCollector *PeerStatsCollector
 }
 
-func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller) *Collector {
+func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller, peerStatsCollector *PeerStatsCollector) *Collector {
 	nodeName, _ := env.GetNodeName()
 	manager := &Collector{
 		nodeName:             nodeName,
@@ -77,6 +83,7 @@ func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient ope
 		networkPolicyQuerier: npQuerier,
 		multicastQuerier:     mcQuerier,
 		multicastEnabled:     mcQuerier != nil,
+		peerStatsCollector:   peerStatsCollector,
 	}
 	return manager
 }
@@ -91,6 +98,7 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 	// If the counters increase during antrea-agent's downtime, the delta will not be reported to the antrea-controller,
 	// it's however better than reporting the full statistics twice which could introduce greater deviations.
 	m.lastStatsCollection = m.collect()
+	m.resetPeerStats()
 
 	for {
 		select {
@@ -102,6 +110,7 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 				klog.Errorf("Failed to report stats: %v", err)
 			} else {
 				m.lastStatsCollection = curStatsCollection
+				m.resetPeerStats()
 			}
 		case <-stopCh:
 			return
@@ -109,6 +118,14 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 	}
 }
 
+// resetPeerStats discards the stats of the peers of NetworkPolicy rules collected so far. Unlike the
+// stats of OVS flows, they are reported as they are instead of as the delta with the last collection.
+func (m *Collector) resetPeerStats() {
+	if m.peerStatsCollector != nil {
+		m.peerStatsCollector.reset()
+	}
+}
+
 // collect collects the stats of Openflow rules, maps them to the stats of NetworkPolicies.
 // It returns a map from NetworkPolicyReferences to their stats.
 func (m *Collector) collect() *statsCollection {
@@ -140,11 +157,16 @@ func (m *Collector) collect() *statsCollection {
 	if m.multicastEnabled {
 		multicastGroupMap = m.multicastQuerier.GetGroupPods()
 	}
+	var peerStatsMap map[rulePeer]*statsv1alpha1.TrafficStats
+	if m.peerStatsCollector != nil {
+		peerStatsMap = m.peerStatsCollector.collect()
+	}
 	return &statsCollection{
 		networkPolicyStats:              npStatsMap,
 		antreaClusterNetworkPolicyStats: acnpStatsMap,
 		antreaNetworkPolicyStats:        annpStatsMap,
 		multicastGroups:                 multicastGroupMap,
+		peerStats:                       peerStatsMap,
 	}
 }
 
@@ -208,6 +230,12 @@ func (m *Collector) calculateNPStats(curStatsCollection *statsCollection) (npSta
 	npStats = calculateDiff(curStatsCollection.networkPolicyStats, m.lastStatsCollection.networkPolicyStats)
 	acnpStats = calculateRuleDiff(curStatsCollection.antreaClusterNetworkPolicyStats, m.lastStatsCollection.antreaClusterNetworkPolicyStats)
 	annpStats = calculateRuleDiff(curStatsCollection.antreaNetworkPolicyStats, m.lastStatsCollection.antreaNetworkPolicyStats)
+	if m.peerStatsCollector != nil {
+		peerStats := groupPeerStats(curStatsCollection.peerStats)
+		npStats = mergePeerStats(npStats, peerStats[cpv1beta.K8sNetworkPolicy], false)
+		acnpStats = mergePeerStats(acnpStats, peerStats[cpv1beta.AntreaClusterNetworkPolicy], true)
+		annpStats = mergePeerStats(annpStats, peerStats[cpv1beta.AntreaNetworkPolicy], true)
+	}
 	return npStats, acnpStats, annpStats
 }
 
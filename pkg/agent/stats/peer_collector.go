@@ -0,0 +1,325 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/flowexporter/connections"
+	"antrea.io/antrea/pkg/agent/openflow"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// maxPeersPerRule is the maximum number of peers whose stats are kept for a rule until they are
+// reported. When it's exceeded, the stats of the peers with the least traffic are discarded.
+const maxPeersPerRule = 1000
+
+// peer identifies the peer of a NetworkPolicy rule. Either pod or ip is set.
+type peer struct {
+	pod statsv1alpha1.PodReference
+	ip  string
+}
+
+// rulePeer identifies a peer of a NetworkPolicy rule. The rule name is empty for K8s
+// NetworkPolicies, as their stats are not reported by rule.
+type rulePeer struct {
+	policyType cpv1beta.NetworkPolicyType
+	policyUID  types.UID
+	rule       string
+	peer       peer
+}
+
+// connCounters stores the counters of a conntrack connection.
+type connCounters struct {
+	id      uint32
+	packets uint64
+	bytes   uint64
+}
+
+// PeerStatsCollector collects the traffic stats of NetworkPolicy rules by peer. OVS flows only
+// provide the stats of rules, so the stats of the peers are calculated from the conntrack
+// connections, whose labels store the flow IDs of the ingress and egress rules which allowed them.
+// As a result, only the traffic of the allow rules is broken down by peer, and the traffic of
+// connections which are closed between 2 collections is partially missing.
+type PeerStatsCollector struct {
+	connDumper           connections.ConnTrackDumper
+	zones                []uint16
+	networkPolicyQuerier querier.AgentNetworkPolicyInfoQuerier
+	// connections stores the counters of the connections dumped in the last collection, to
+	// calculate the traffic of the connections since then.
+	connections map[flowexporter.ConnectionKey]connCounters
+	// ruleStats stores the traffic stats of the rules by peer since they were last reported, keyed
+	// by the flow IDs of the rules.
+	ruleStats map[uint32]map[peer]*statsv1alpha1.TrafficStats
+}
+
+func NewPeerStatsCollector(connDumper connections.ConnTrackDumper, v4Enabled, v6Enabled, connectUplinkToBridge bool, npQuerier querier.AgentNetworkPolicyInfoQuerier) *PeerStatsCollector {
+	var zones []uint16
+	if v4Enabled {
+		if connectUplinkToBridge {
+			zones = append(zones, uint16(openflow.IPCtZoneTypeRegMark.GetValue()<<12))
+		} else {
+			zones = append(zones, openflow.CtZone)
+		}
+	}
+	if v6Enabled {
+		if connectUplinkToBridge {
+			zones = append(zones, uint16(openflow.IPv6CtZoneTypeRegMark.GetValue()<<12))
+		} else {
+			zones = append(zones, openflow.CtZoneV6)
+		}
+	}
+	return &PeerStatsCollector{
+		connDumper:           connDumper,
+		zones:                zones,
+		networkPolicyQuerier: npQuerier,
+		connections:          map[flowexporter.ConnectionKey]connCounters{},
+		ruleStats:            map[uint32]map[peer]*statsv1alpha1.TrafficStats{},
+	}
+}
+
+// collect dumps the conntrack connections, adds up their traffic since the last collection to the
+// stats of the peers of their rules, and returns the stats of the peers of the existing rules since
+// they were last reported.
+func (c *PeerStatsCollector) collect() map[rulePeer]*statsv1alpha1.TrafficStats {
+	var conns []*flowexporter.Connection
+	for _, zone := range c.zones {
+		zoneConns, _, err := c.connDumper.DumpFlows(zone)
+		if err != nil {
+			// Keep the stats of the last collection, the traffic will be counted in the next one.
+			klog.ErrorS(err, "Failed to dump conntrack connections for NetworkPolicy peer stats", "zone", zone)
+			return c.getRulePeerStats()
+		}
+		conns = append(conns, zoneConns...)
+	}
+	curConnections := make(map[flowexporter.ConnectionKey]connCounters, len(conns))
+	// Many connections share the same peers, which are only looked up once.
+	peers := map[netip.Addr]peer{}
+	getPeer := func(ip netip.Addr) peer {
+		p, exists := peers[ip]
+		if !exists {
+			if namespace, name := c.networkPolicyQuerier.GetPodByIP(net.IP(ip.AsSlice())); name != "" {
+				p.pod = statsv1alpha1.PodReference{Namespace: namespace, Name: name}
+			} else {
+				p.ip = ip.String()
+			}
+			peers[ip] = p
+		}
+		return p
+	}
+	for _, conn := range conns {
+		if len(conn.Labels) < 8 {
+			continue
+		}
+		ingressOfID := binary.LittleEndian.Uint32(conn.Labels[:4])
+		egressOfID := binary.LittleEndian.Uint32(conn.Labels[4:8])
+		if ingressOfID == 0 && egressOfID == 0 {
+			continue
+		}
+		key := flowexporter.NewConnectionKey(conn)
+		counters := connCounters{
+			id:      conn.ID,
+			packets: conn.OriginalPackets + conn.ReversePackets,
+			bytes:   conn.OriginalBytes + conn.ReverseBytes,
+		}
+		curConnections[key] = counters
+		inc := statsv1alpha1.TrafficStats{
+			Packets: int64(counters.packets),
+			Bytes:   int64(counters.bytes),
+		}
+		lastCounters, exists := c.connections[key]
+		// The 5-tuple of a connection can be reused by a new connection.
+		if !exists || lastCounters.id != counters.id || counters.bytes < lastCounters.bytes {
+			inc.Sessions = 1
+		} else {
+			inc.Packets -= int64(lastCounters.packets)
+			inc.Bytes -= int64(lastCounters.bytes)
+		}
+		if inc.Bytes == 0 && inc.Sessions == 0 {
+			continue
+		}
+		// The source of the connection is the peer of the ingress rule, and its destination,
+		// after DNAT, is the peer of the egress rule.
+		if ingressOfID != 0 {
+			c.addUp(ingressOfID, getPeer(conn.FlowKey.SourceAddress), &inc)
+		}
+		if egressOfID != 0 {
+			c.addUp(egressOfID, getPeer(conn.FlowKey.DestinationAddress), &inc)
+		}
+	}
+	c.connections = curConnections
+	c.discardPeers()
+	return c.getRulePeerStats()
+}
+
+// reset discards the stats of the peers once they have been reported.
+func (c *PeerStatsCollector) reset() {
+	c.ruleStats = map[uint32]map[peer]*statsv1alpha1.TrafficStats{}
+}
+
+func (c *PeerStatsCollector) addUp(ofID uint32, p peer, inc *statsv1alpha1.TrafficStats) {
+	peerStats, exists := c.ruleStats[ofID]
+	if !exists {
+		peerStats = map[peer]*statsv1alpha1.TrafficStats{}
+		c.ruleStats[ofID] = peerStats
+	}
+	stats, exists := peerStats[p]
+	if !exists {
+		stats = new(statsv1alpha1.TrafficStats)
+		peerStats[p] = stats
+	}
+	stats.Sessions += inc.Sessions
+	stats.Packets += inc.Packets
+	stats.Bytes += inc.Bytes
+}
+
+// discardPeers discards the stats of the peers with the least traffic of the rules which have more
+// than maxPeersPerRule peers.
+func (c *PeerStatsCollector) discardPeers() {
+	for _, peerStats := range c.ruleStats {
+		if len(peerStats) <= maxPeersPerRule {
+			continue
+		}
+		peers := make([]peer, 0, len(peerStats))
+		for p := range peerStats {
+			peers = append(peers, p)
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			return peerStats[peers[i]].Bytes > peerStats[peers[j]].Bytes
+		})
+		for _, p := range peers[maxPeersPerRule:] {
+			delete(peerStats, p)
+		}
+	}
+}
+
+// getRulePeerStats returns the stats of the peers of the existing rules. The stats of the rules which
+// no longer exist are deleted.
+func (c *PeerStatsCollector) getRulePeerStats() map[rulePeer]*statsv1alpha1.TrafficStats {
+	result := map[rulePeer]*statsv1alpha1.TrafficStats{}
+	for ofID, peerStats := range c.ruleStats {
+		rule := c.networkPolicyQuerier.GetRuleByFlowID(ofID)
+		if rule == nil || rule.PolicyRef == nil {
+			delete(c.ruleStats, ofID)
+			continue
+		}
+		key := rulePeer{
+			policyType: rule.PolicyRef.Type,
+			policyUID:  rule.PolicyRef.UID,
+		}
+		if rule.PolicyRef.Type != cpv1beta.K8sNetworkPolicy {
+			key.rule = rule.Name
+		}
+		for p, stats := range peerStats {
+			key.peer = p
+			// Rules with the same name share the stats of their peers.
+			if curStats, exists := result[key]; exists {
+				curStats.Sessions += stats.Sessions
+				curStats.Packets += stats.Packets
+				curStats.Bytes += stats.Bytes
+			} else {
+				statsCopy := *stats
+				result[key] = &statsCopy
+			}
+		}
+	}
+	return result
+}
+
+// groupPeerStats groups the stats of the peers of NetworkPolicy rules by NetworkPolicy type,
+// NetworkPolicy UID and rule name.
+func groupPeerStats(statsMap map[rulePeer]*statsv1alpha1.TrafficStats) map[cpv1beta.NetworkPolicyType]map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats {
+	result := map[cpv1beta.NetworkPolicyType]map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats{}
+	for key, stats := range statsMap {
+		if stats.Bytes == 0 {
+			continue
+		}
+		peerStats := statsv1alpha1.PeerTrafficStats{
+			TrafficStats: *stats,
+		}
+		if key.peer.ip != "" {
+			peerStats.IP = key.peer.ip
+		} else {
+			pod := key.peer.pod
+			peerStats.Pod = &pod
+		}
+		policies, exists := result[key.policyType]
+		if !exists {
+			policies = map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats{}
+			result[key.policyType] = policies
+		}
+		if _, exists := policies[key.policyUID]; !exists {
+			policies[key.policyUID] = map[string][]statsv1alpha1.PeerTrafficStats{}
+		}
+		policies[key.policyUID][key.rule] = append(policies[key.policyUID][key.rule], peerStats)
+	}
+	return result
+}
+
+// mergePeerStats sets the stats of the peers in the stats of the NetworkPolicies and their rules.
+// The stats of the peers of K8s NetworkPolicies are set at the policy level. A NetworkPolicy or rule
+// is added if it has no stats, which could happen as OVS flows and conntrack connections are not
+// dumped at the same time.
+func mergePeerStats(policyStats []cpv1beta.NetworkPolicyStats, peerStats map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats, byRule bool) []cpv1beta.NetworkPolicyStats {
+	merged := map[types.UID]bool{}
+	for i := range policyStats {
+		rulePeerStats, exists := peerStats[policyStats[i].NetworkPolicy.UID]
+		if !exists {
+			continue
+		}
+		merged[policyStats[i].NetworkPolicy.UID] = true
+		if !byRule {
+			policyStats[i].PeerTrafficStats = rulePeerStats[""]
+			continue
+		}
+		mergedRules := map[string]bool{}
+		for j := range policyStats[i].RuleTrafficStats {
+			ruleName := policyStats[i].RuleTrafficStats[j].Name
+			if stats, exists := rulePeerStats[ruleName]; exists {
+				policyStats[i].RuleTrafficStats[j].PeerTrafficStats = stats
+				mergedRules[ruleName] = true
+			}
+		}
+		for ruleName, stats := range rulePeerStats {
+			if !mergedRules[ruleName] {
+				policyStats[i].RuleTrafficStats = append(policyStats[i].RuleTrafficStats, statsv1alpha1.RuleTrafficStats{Name: ruleName, PeerTrafficStats: stats})
+			}
+		}
+	}
+	for uid, rulePeerStats := range peerStats {
+		if merged[uid] {
+			continue
+		}
+		stats := cpv1beta.NetworkPolicyStats{NetworkPolicy: cpv1beta.NetworkPolicyReference{UID: uid}}
+		if !byRule {
+			stats.PeerTrafficStats = rulePeerStats[""]
+		} else {
+			for ruleName, ruleStats := range rulePeerStats {
+				stats.RuleTrafficStats = append(stats.RuleTrafficStats, statsv1alpha1.RuleTrafficStats{Name: ruleName, PeerTrafficStats: ruleStats})
+			}
+		}
+		policyStats = append(policyStats, stats)
+	}
+	return policyStats
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	connectionstest "antrea.io/antrea/pkg/agent/flowexporter/connections/testing"
+	"antrea.io/antrea/pkg/agent/openflow"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+var (
+	pod1IP     = netip.MustParseAddr("10.10.0.1")
+	pod2IP     = netip.MustParseAddr("10.10.1.2")
+	externalIP = netip.MustParseAddr("192.168.1.1")
+)
+
+func newConnection(id uint32, srcIP, dstIP netip.Addr, srcPort uint16, ingressOfID, egressOfID uint32, packets, bytes uint64) *flowexporter.Connection {
+	labels := make([]byte, 16)
+	binary.LittleEndian.PutUint32(labels[:4], ingressOfID)
+	binary.LittleEndian.PutUint32(labels[4:8], egressOfID)
+	return &flowexporter.Connection{
+		ID:     id,
+		Labels: labels,
+		FlowKey: flowexporter.Tuple{
+			SourceAddress:      srcIP,
+			DestinationAddress: dstIP,
+			Protocol:           6,
+			SourcePort:         srcPort,
+			DestinationPort:    80,
+		},
+		OriginalPackets: packets,
+		OriginalBytes:   bytes,
+		ReversePackets:  packets,
+		ReverseBytes:    bytes,
+	}
+}
+
+func TestPeerStatsCollectorCollect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	connDumper := connectionstest.NewMockConnTrackDumper(ctrl)
+	npQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	npQuerier.EXPECT().GetPodByIP(net.IP(pod1IP.AsSlice())).Return("ns1", "pod1").AnyTimes()
+	npQuerier.EXPECT().GetPodByIP(net.IP(pod2IP.AsSlice())).Return("ns2", "pod2").AnyTimes()
+	npQuerier.EXPECT().GetPodByIP(net.IP(externalIP.AsSlice())).Return("", "").AnyTimes()
+	npQuerier.EXPECT().GetRuleByFlowID(uint32(1)).Return(&agenttypes.PolicyRule{Name: "rule1", PolicyRef: &np1}).AnyTimes()
+	npQuerier.EXPECT().GetRuleByFlowID(uint32(2)).Return(&agenttypes.PolicyRule{Name: "rule2", PolicyRef: &acnp1}).AnyTimes()
+	npQuerier.EXPECT().GetRuleByFlowID(uint32(3)).Return(nil).AnyTimes()
+	c := NewPeerStatsCollector(connDumper, true, false, false, npQuerier)
+
+	// Connection from Pod1 to Pod2, allowed by the egress rule 2 and the ingress rule 1, and
+	// connection from an external IP to Pod2 allowed by the ingress rule 1.
+	connDumper.EXPECT().DumpFlows(uint16(openflow.CtZone)).Return([]*flowexporter.Connection{
+		newConnection(1, pod1IP, pod2IP, 1000, 1, 2, 5, 100),
+		newConnection(2, externalIP, pod2IP, 1001, 1, 0, 1, 10),
+		newConnection(3, pod1IP, externalIP, 1002, 0, 0, 1, 10),
+	}, 3, nil)
+	pod1Peer := peer{pod: statsv1alpha1.PodReference{Namespace: "ns1", Name: "pod1"}}
+	pod2Peer := peer{pod: statsv1alpha1.PodReference{Namespace: "ns2", Name: "pod2"}}
+	externalPeer := peer{ip: externalIP.String()}
+	assert.Equal(t, map[rulePeer]*statsv1alpha1.TrafficStats{
+		{policyType: np1.Type, policyUID: np1.UID, peer: pod1Peer}:                    {Sessions: 1, Packets: 10, Bytes: 200},
+		{policyType: np1.Type, policyUID: np1.UID, peer: externalPeer}:                {Sessions: 1, Packets: 2, Bytes: 20},
+		{policyType: acnp1.Type, policyUID: acnp1.UID, rule: "rule2", peer: pod2Peer}: {Sessions: 1, Packets: 10, Bytes: 200},
+	}, c.collect())
+
+	// The first connection is updated, the second one is closed and its 5-tuple is reused by a
+	// new connection, and a connection allowed by a deleted rule is added.
+	connDumper.EXPECT().DumpFlows(uint16(openflow.CtZone)).Return([]*flowexporter.Connection{
+		newConnection(1, pod1IP, pod2IP, 1000, 1, 2, 6, 150),
+		newConnection(4, externalIP, pod2IP, 1001, 1, 0, 1, 5),
+		newConnection(5, externalIP, pod2IP, 1003, 3, 0, 1, 5),
+	}, 3, nil)
+	assert.Equal(t, map[rulePeer]*statsv1alpha1.TrafficStats{
+		{policyType: np1.Type, policyUID: np1.UID, peer: pod1Peer}:                    {Sessions: 1, Packets: 12, Bytes: 300},
+		{policyType: np1.Type, policyUID: np1.UID, peer: externalPeer}:                {Sessions: 2, Packets: 4, Bytes: 30},
+		{policyType: acnp1.Type, policyUID: acnp1.UID, rule: "rule2", peer: pod2Peer}: {Sessions: 1, Packets: 12, Bytes: 300},
+	}, c.collect())
+
+	// Once reported, only the traffic since the last collection is returned.
+	c.reset()
+	connDumper.EXPECT().DumpFlows(uint16(openflow.CtZone)).Return([]*flowexporter.Connection{
+		newConnection(1, pod1IP, pod2IP, 1000, 1, 2, 7, 160),
+	}, 1, nil)
+	assert.Equal(t, map[rulePeer]*statsv1alpha1.TrafficStats{
+		{policyType: np1.Type, policyUID: np1.UID, peer: pod1Peer}:                    {Packets: 2, Bytes: 20},
+		{policyType: acnp1.Type, policyUID: acnp1.UID, rule: "rule2", peer: pod2Peer}: {Packets: 2, Bytes: 20},
+	}, c.collect())
+}
+
+func TestPeerStatsCollectorMaxPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	connDumper := connectionstest.NewMockConnTrackDumper(ctrl)
+	npQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	npQuerier.EXPECT().GetPodByIP(gomock.Any()).Return("", "").Times(maxPeersPerRule + 1)
+	npQuerier.EXPECT().GetRuleByFlowID(uint32(1)).Return(&agenttypes.PolicyRule{Name: "rule1", PolicyRef: &np1}).AnyTimes()
+	c := NewPeerStatsCollector(connDumper, true, false, false, npQuerier)
+
+	// Each peer has 2 connections, and the first peer has the least traffic.
+	var conns []*flowexporter.Connection
+	for i := 0; i <= maxPeersPerRule; i++ {
+		srcIP := netip.AddrFrom4([4]byte{10, 20, byte(i >> 8), byte(i)})
+		conns = append(conns,
+			newConnection(uint32(2*i), srcIP, pod2IP, 1000, 1, 0, 1, 10+uint64(i)),
+			newConnection(uint32(2*i+1), srcIP, pod2IP, 1001, 1, 0, 1, 10+uint64(i)),
+		)
+	}
+	connDumper.EXPECT().DumpFlows(uint16(openflow.CtZone)).Return(conns, len(conns), nil)
+	stats := c.collect()
+	assert.Len(t, stats, maxPeersPerRule)
+	assert.NotContains(t, stats, rulePeer{policyType: np1.Type, policyUID: np1.UID, peer: peer{ip: "10.20.0.0"}})
+}
+
+func TestGroupPeerStats(t *testing.T) {
+	pod1Peer := peer{pod: statsv1alpha1.PodReference{Namespace: "ns1", Name: "pod1"}}
+	externalPeer := peer{ip: externalIP.String()}
+	stats := map[rulePeer]*statsv1alpha1.TrafficStats{
+		{policyType: np1.Type, policyUID: np1.UID, peer: pod1Peer}:                        {Sessions: 1, Packets: 5, Bytes: 100},
+		{policyType: acnp1.Type, policyUID: acnp1.UID, rule: "rule1", peer: pod1Peer}:     {},
+		{policyType: annp1.Type, policyUID: annp1.UID, rule: "rule1", peer: pod1Peer}:     {Packets: 2, Bytes: 50},
+		{policyType: acnp1.Type, policyUID: acnp1.UID, rule: "rule2", peer: externalPeer}: {Sessions: 1, Packets: 2, Bytes: 20},
+	}
+	assert.Equal(t, map[cpv1beta.NetworkPolicyType]map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats{
+		cpv1beta.K8sNetworkPolicy: {
+			np1.UID: {"": {{Pod: &pod1Peer.pod, TrafficStats: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100}}}},
+		},
+		cpv1beta.AntreaClusterNetworkPolicy: {
+			acnp1.UID: {"rule2": {{IP: externalIP.String(), TrafficStats: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 20}}}},
+		},
+		cpv1beta.AntreaNetworkPolicy: {
+			annp1.UID: {"rule1": {{Pod: &pod1Peer.pod, TrafficStats: statsv1alpha1.TrafficStats{Packets: 2, Bytes: 50}}}},
+		},
+	}, groupPeerStats(stats))
+}
+
+func TestMergePeerStats(t *testing.T) {
+	pod1Stats := statsv1alpha1.PeerTrafficStats{
+		Pod:          &statsv1alpha1.PodReference{Namespace: "ns1", Name: "pod1"},
+		TrafficStats: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100},
+	}
+	externalStats := statsv1alpha1.PeerTrafficStats{
+		IP:           externalIP.String(),
+		TrafficStats: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 20},
+	}
+	npStats := mergePeerStats([]cpv1beta.NetworkPolicyStats{
+		{
+			NetworkPolicy: cpv1beta.NetworkPolicyReference{UID: np1.UID},
+			TrafficStats:  statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100},
+		},
+	}, map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats{
+		np1.UID: {"": {pod1Stats}},
+		np2.UID: {"": {externalStats}},
+	}, false)
+	assert.ElementsMatch(t, []cpv1beta.NetworkPolicyStats{
+		{
+			NetworkPolicy:    cpv1beta.NetworkPolicyReference{UID: np1.UID},
+			TrafficStats:     statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100},
+			PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{pod1Stats},
+		},
+		{
+			NetworkPolicy:    cpv1beta.NetworkPolicyReference{UID: np2.UID},
+			PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{externalStats},
+		},
+	}, npStats)
+
+	acnpStats := mergePeerStats([]cpv1beta.NetworkPolicyStats{
+		{
+			NetworkPolicy: cpv1beta.NetworkPolicyReference{UID: acnp1.UID},
+			RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+				{Name: "rule1", TrafficStats: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100}},
+			},
+		},
+	}, map[types.UID]map[string][]statsv1alpha1.PeerTrafficStats{
+		acnp1.UID: {"rule1": {pod1Stats}, "rule2": {externalStats}},
+	}, true)
+	assert.Len(t, acnpStats, 1)
+	assert.ElementsMatch(t, []statsv1alpha1.RuleTrafficStats{
+		{
+			Name:             "rule1",
+			TrafficStats:     statsv1alpha1.TrafficStats{Sessions: 1, Packets: 5, Bytes: 100},
+			PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{pod1Stats},
+		},
+		{
+			Name:             "rule2",
+			PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{externalStats},
+		},
+	}, acnpStats[0].RuleTrafficStats)
+}
@@ -61,6 +61,7 @@ const (
 	LabelIDAddr
 	TCPFlagsAddr
 	CTStateAddr
+	IPProtocolAddr
 	UnSupported
 )
 
@@ -104,6 +105,8 @@ type PolicyRule struct {
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
 	LogLabel      string
+	RateLimit     *v1beta2.RateLimit
+	PacketCapture *v1beta2.RulePacketCapture
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
@@ -21,8 +21,10 @@ import (
 	fallbackversion "antrea.io/antrea/pkg/antctl/fallback/version"
 	checkcluster "antrea.io/antrea/pkg/antctl/raw/check/cluster"
 	checkinstallation "antrea.io/antrea/pkg/antctl/raw/check/installation"
+	"antrea.io/antrea/pkg/antctl/raw/connectivitymatrix"
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
+	"antrea.io/antrea/pkg/antctl/raw/policyrecommendation"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
@@ -508,6 +510,44 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(controllerapis.EndpointQueryResponse{}),
 		},
+		{
+			use:     "ruleanalysis",
+			aliases: []string{"rulesanalysis"},
+			short:   "Find shadowed, redundant and conflicting rules of Antrea-native policies.",
+			long:    "Analyze the rules of Antrea-native policies with the current members of the groups they select, and report the rules which are shadowed by or redundant with a rule enforced before them, as they never match any traffic, and the rules which conflict with the rule of a higher-priority Tier with an opposite action.",
+			example: `  Find the rules of all Antrea-native policies which are shadowed, redundant or conflicting
+  $ antctl query ruleanalysis
+  Find the rules of Antrea NetworkPolicies in Namespace ns1 which are shadowed, redundant or conflicting
+  $ antctl query ruleanalysis -T ANNP -n ns1
+  Find the rules of ClusterNetworkPolicy acnp1 which are shadowed, redundant or conflicting
+  $ antctl query ruleanalysis acnp1 -T ACNP
+`,
+			commandGroup: query,
+			controllerEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/ruleanalysis",
+					params: []flagInfo{
+						{
+							name:  "name",
+							usage: "Name of the policy",
+							arg:   true,
+						},
+						{
+							name:      "namespace",
+							usage:     "Namespace of the Antrea NetworkPolicies",
+							shorthand: "n",
+						},
+						{
+							name:      "type",
+							usage:     "Type of the policies: ACNP, ANNP",
+							shorthand: "T",
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			transformedResponse: reflect.TypeOf(controllerapis.RuleAnalysisResponse{}),
+		},
 		{
 			use:     "networkpolicyevaluation",
 			aliases: []string{"networkpoliciesevaluation", "networkpolicyeval", "networkpolicieseval", "netpoleval"},
@@ -515,6 +555,8 @@ $ antctl get podmulticaststats pod -n namespace`,
 			long:    "Analyze network policies in the cluster and return the rule expected to be effective on the source and destination endpoints provided.",
 			example: `  Query effective NetworkPolicy rule between two Pods
   $ antctl query networkpolicyevaluation -S ns1/pod1 -D ns2/pod2
+  Query effective NetworkPolicy rule between two Pods as if the policies and Tiers in the provided files were applied
+  $ antctl query networkpolicyevaluation -S ns1/pod1 -D ns2/pod2 -f policies.yaml,tiers.yaml
 `,
 			commandGroup: query,
 			controllerEndpoint: &endpoint{
@@ -531,6 +573,11 @@ $ antctl get podmulticaststats pod -n namespace`,
 							usage:     "Destination endpoint, specified by <Namespace>/<name>.",
 							shorthand: "D",
 						},
+						{
+							name:      "policies",
+							usage:     "Comma-separated files containing K8s NetworkPolicies, Antrea-native policies and Tiers to evaluate as if they were applied. They replace existing objects with the same kind, Namespace and name.",
+							shorthand: "f",
+						},
 					},
 					parameterTransform: networkpolicy.NewNetworkPolicyEvaluation,
 					restMethod:         restPost,
@@ -633,6 +680,20 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(agentapis.MemberlistResponse{}),
 		},
+		{
+			use:          "policypacketcapture",
+			aliases:      []string{"ppc", "policypacketcaptures"},
+			short:        "Print packet capture files of policy rules",
+			long:         "Print the packet capture files of the Drop and Reject policy rules with packetCapture set on the local Node. The files can be downloaded from the /policypacketcaptures endpoint of the agent API, using the name query parameter.",
+			commandGroup: get,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path:       "/policypacketcaptures",
+					outputType: multiple,
+				},
+			},
+			transformedResponse: reflect.TypeOf(agentapis.PolicyPacketCaptureResponse{}),
+		},
 	},
 	rawCommands: []rawCommand{
 		{
@@ -657,6 +718,11 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      true,
 			supportController: true,
 		},
+		{
+			cobraCommand:      policyrecommendation.Command,
+			supportAgent:      false,
+			supportController: true,
+		},
 		{
 			cobraCommand:      proxy.Command,
 			supportAgent:      false,
@@ -668,6 +734,12 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportController: true,
 			commandGroup:      get,
 		},
+		{
+			cobraCommand:      connectivitymatrix.Command,
+			supportAgent:      false,
+			supportController: true,
+			commandGroup:      get,
+		},
 		{
 			cobraCommand:      multicluster.GetCmd,
 			supportAgent:      false,
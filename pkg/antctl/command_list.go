@@ -143,6 +143,10 @@ func (cl *commandList) GetDebugCommands(mode string) [][]string {
 			// cannot be used as is in e2e tests.
 			continue
 		}
+		if cmd.cobraCommand.Use == "policyrecommendation" {
+			// policyrecommendation requires flows as input.
+			continue
+		}
 		if mode == runtime.ModeController && cmd.supportController ||
 			mode == runtime.ModeAgent && cmd.supportAgent {
 			var currentCommand []string
@@ -0,0 +1,209 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/antctl/runtime"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+var (
+	Command *cobra.Command
+	option  = &struct {
+		namespace     string
+		clusterGroup  string
+		labelSelector string
+		ports         []string
+		outputType    string
+	}{}
+	getClients = getAntreaClient
+)
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "connectivitymatrix",
+		Short:   "Print the connectivity matrix of a set of Pods",
+		Long:    "Print the verdict (Allow, Drop or Reject) of the NetworkPolicies expected to be effective on the traffic between each pair of Pods of a Namespace, a ClusterGroup or a label selector, and the policy rule which decides it. The verdict is computed for each destination port if ports are provided.",
+		Aliases: []string{"connectivitymatrices", "cm"},
+		Example: `  Print the connectivity matrix of the Pods in Namespace ns1
+  $ antctl get connectivitymatrix -n ns1
+  Print the connectivity matrix of the Pods with label app=web in all Namespaces, for TCP port 80 and UDP port 53
+  $ antctl get connectivitymatrix -l app=web --ports tcp/80,udp/53
+  Print the connectivity matrix of the Pods in ClusterGroup cg1 in CSV format
+  $ antctl get connectivitymatrix --clustergroup cg1 -o csv
+`,
+		RunE: runE,
+		Args: cobra.NoArgs,
+	}
+
+	Command.Flags().StringVarP(&option.namespace, "namespace", "n", "", "Namespace of the Pods, all Namespaces are selected if only a label selector is provided")
+	Command.Flags().StringVar(&option.clusterGroup, "clustergroup", "", "ClusterGroup selecting the Pods, cannot be used with --namespace and --selector")
+	Command.Flags().StringVarP(&option.labelSelector, "selector", "l", "", "label selector of the Pods, e.g. app=web")
+	Command.Flags().StringSliceVar(&option.ports, "ports", nil, "destination ports to compute the verdicts for, specified by [<protocol>/]<port>, e.g. tcp/80,udp/53; the protocol defaults to TCP")
+	Command.Flags().StringVarP(&option.outputType, "output", "o", "table", "output type: table (default), json, csv")
+}
+
+func getAntreaClient(cmd *cobra.Command) (antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if runtime.InPod {
+		raw.SetupLocalKubeconfig(kubeconfig)
+	}
+	_, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return antreaClientset, nil
+}
+
+// parsePort parses a port specified by [<protocol>/]<port>.
+func parsePort(str string) (cpv1beta.ConnectivityMatrixPort, error) {
+	protocol, portStr := "tcp", str
+	if parts := strings.Split(str, "/"); len(parts) == 2 {
+		protocol, portStr = parts[0], parts[1]
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return cpv1beta.ConnectivityMatrixPort{}, fmt.Errorf("invalid port %q, must be [<protocol>/]<port>", str)
+	}
+	return cpv1beta.ConnectivityMatrixPort{Protocol: cpv1beta.Protocol(strings.ToUpper(protocol)), Port: int32(port)}, nil
+}
+
+func newRequest() (*cpv1beta.ConnectivityMatrixRequest, error) {
+	if option.clusterGroup != "" && (option.namespace != "" || option.labelSelector != "") {
+		return nil, errors.New("--clustergroup cannot be used with --namespace and --selector")
+	}
+	if option.clusterGroup == "" && option.namespace == "" && option.labelSelector == "" {
+		return nil, errors.New("one of --clustergroup, --namespace and --selector must be provided")
+	}
+	switch option.outputType {
+	case "table", "json", "csv":
+	default:
+		return nil, fmt.Errorf("unsupported output type %q, must be table, json or csv", option.outputType)
+	}
+	request := &cpv1beta.ConnectivityMatrixRequest{
+		Namespace:     option.namespace,
+		ClusterGroup:  option.clusterGroup,
+		LabelSelector: option.labelSelector,
+	}
+	for _, p := range option.ports {
+		port, err := parsePort(p)
+		if err != nil {
+			return nil, err
+		}
+		request.Ports = append(request.Ports, port)
+	}
+	return request, nil
+}
+
+func podName(pod cpv1beta.PodReference) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+func header() []string {
+	return []string{"SOURCE", "DESTINATION", "PORT", "VERDICT", "POLICY", "RULE-INDEX", "DIRECTION"}
+}
+
+func row(cell *cpv1beta.ConnectivityMatrixCell) []string {
+	port := ""
+	if cell.Port != nil {
+		port = fmt.Sprintf("%s/%d", strings.ToLower(string(cell.Port.Protocol)), cell.Port.Port)
+	}
+	policy, ruleIndex, direction := "", "", ""
+	if cell.Rule != nil {
+		policy = string(cell.Rule.NetworkPolicy.Type) + ":"
+		if cell.Rule.NetworkPolicy.Namespace != "" {
+			policy += cell.Rule.NetworkPolicy.Namespace + "/"
+		}
+		policy += cell.Rule.NetworkPolicy.Name
+		ruleIndex = strconv.Itoa(int(cell.Rule.RuleIndex))
+		if cell.Rule.RuleIndex == math.MaxInt32 {
+			// The synthetic isolation rules of K8s NetworkPolicies have a MaxInt32 index.
+			ruleIndex = "Isolation"
+		}
+		direction = string(cell.Rule.Rule.Direction)
+	}
+	return []string{podName(cell.Source), podName(cell.Destination), port, string(cell.Verdict), policy, ruleIndex, direction}
+}
+
+func outputMatrix(response *cpv1beta.ConnectivityMatrixResponse, w io.Writer) error {
+	switch option.outputType {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(response)
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write(header()); err != nil {
+			return err
+		}
+		for i := range response.Cells {
+			if err := writer.Write(row(&response.Cells[i])); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+	if len(response.Cells) == 0 {
+		_, err := fmt.Fprintf(w, "%d Pods selected, no connectivity to report\n", len(response.Pods))
+		return err
+	}
+	rows := [][]string{header()}
+	for i := range response.Cells {
+		rows = append(rows, row(&response.Cells[i]))
+	}
+	return output.ConstructFormattedTable(rows, false, w)
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	request, err := newRequest()
+	if err != nil {
+		return err
+	}
+	client, err := getClients(cmd)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	matrix, err := client.ControlplaneV1beta2().ConnectivityMatrices().Create(ctx, &cpv1beta.ConnectivityMatrix{Request: request}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error when computing connectivity matrix: %w", err)
+	}
+	if matrix.Response == nil {
+		return errors.New("empty connectivity matrix response")
+	}
+	return outputMatrix(matrix.Response, cmd.OutOrStdout())
+}
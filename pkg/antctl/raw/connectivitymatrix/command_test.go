@@ -0,0 +1,187 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	antreafake "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+var (
+	pod1     = cpv1beta.PodReference{Namespace: "ns1", Name: "pod1"}
+	pod2     = cpv1beta.PodReference{Namespace: "ns1", Name: "pod2"}
+	port80   = cpv1beta.ConnectivityMatrixPort{Protocol: cpv1beta.ProtocolTCP, Port: 80}
+	response = &cpv1beta.ConnectivityMatrixResponse{
+		Pods: []cpv1beta.PodReference{pod1, pod2},
+		Cells: []cpv1beta.ConnectivityMatrixCell{
+			{
+				Source:      pod1,
+				Destination: pod2,
+				Port:        &port80,
+				Verdict:     crdv1beta1.RuleActionAllow,
+			},
+			{
+				Source:      pod2,
+				Destination: pod1,
+				Port:        &port80,
+				Verdict:     crdv1beta1.RuleActionDrop,
+				Rule: &cpv1beta.NetworkPolicyEvaluationResponse{
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns1", Name: "default-deny"},
+					RuleIndex:     math.MaxInt32,
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn},
+				},
+			},
+		},
+	}
+)
+
+func resetOptions() {
+	option.namespace = ""
+	option.clusterGroup = ""
+	option.labelSelector = ""
+	option.ports = nil
+	option.outputType = "table"
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		setOptions      func()
+		expectedRequest *cpv1beta.ConnectivityMatrixRequest
+		expectedErr     string
+	}{
+		{
+			name: "namespace and ports",
+			setOptions: func() {
+				option.namespace = "ns1"
+				option.ports = []string{"80", "udp/53"}
+			},
+			expectedRequest: &cpv1beta.ConnectivityMatrixRequest{
+				Namespace: "ns1",
+				Ports: []cpv1beta.ConnectivityMatrixPort{
+					{Protocol: cpv1beta.ProtocolTCP, Port: 80},
+					{Protocol: cpv1beta.ProtocolUDP, Port: 53},
+				},
+			},
+		},
+		{
+			name:            "clustergroup",
+			setOptions:      func() { option.clusterGroup = "cg1" },
+			expectedRequest: &cpv1beta.ConnectivityMatrixRequest{ClusterGroup: "cg1"},
+		},
+		{
+			name:        "no selection",
+			setOptions:  func() {},
+			expectedErr: "one of --clustergroup, --namespace and --selector must be provided",
+		},
+		{
+			name: "clustergroup and selector",
+			setOptions: func() {
+				option.clusterGroup = "cg1"
+				option.labelSelector = "app=web"
+			},
+			expectedErr: "--clustergroup cannot be used with --namespace and --selector",
+		},
+		{
+			name: "invalid port",
+			setOptions: func() {
+				option.namespace = "ns1"
+				option.ports = []string{"tcp/http"}
+			},
+			expectedErr: `invalid port "tcp/http"`,
+		},
+		{
+			name: "invalid output type",
+			setOptions: func() {
+				option.namespace = "ns1"
+				option.outputType = "yaml"
+			},
+			expectedErr: `unsupported output type "yaml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetOptions()
+			tt.setOptions()
+			request, err := newRequest()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRequest, request)
+			}
+		})
+	}
+}
+
+func TestRunE(t *testing.T) {
+	client := antreafake.NewSimpleClientset()
+	client.PrependReactor("create", "connectivitymatrices", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		matrix := action.(k8stesting.CreateAction).GetObject().(*cpv1beta.ConnectivityMatrix).DeepCopy()
+		assert.Equal(t, &cpv1beta.ConnectivityMatrixRequest{Namespace: "ns1", Ports: []cpv1beta.ConnectivityMatrixPort{port80}}, matrix.Request)
+		matrix.Response = response
+		return true, matrix, nil
+	})
+	getClients = func(cmd *cobra.Command) (antrea.Interface, error) {
+		return client, nil
+	}
+	defer func() {
+		getClients = getAntreaClient
+	}()
+
+	tests := []struct {
+		outputType     string
+		expectedOutput string
+	}{
+		{
+			outputType: "table",
+			expectedOutput: "SOURCE   DESTINATION PORT   VERDICT POLICY                            RULE-INDEX DIRECTION\n" +
+				"ns1/pod1 ns1/pod2    tcp/80 Allow   <NONE>                            <NONE>     <NONE>   \n" +
+				"ns1/pod2 ns1/pod1    tcp/80 Drop    K8sNetworkPolicy:ns1/default-deny Isolation  In       \n",
+		},
+		{
+			outputType: "csv",
+			expectedOutput: `SOURCE,DESTINATION,PORT,VERDICT,POLICY,RULE-INDEX,DIRECTION
+ns1/pod1,ns1/pod2,tcp/80,Allow,,,
+ns1/pod2,ns1/pod1,tcp/80,Drop,K8sNetworkPolicy:ns1/default-deny,Isolation,In
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.outputType, func(t *testing.T) {
+			resetOptions()
+			option.namespace = "ns1"
+			option.ports = []string{"tcp/80"}
+			option.outputType = tt.outputType
+			cmd := &cobra.Command{}
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			require.NoError(t, runE(cmd, nil))
+			assert.Equal(t, tt.expectedOutput, out.String())
+		})
+	}
+}
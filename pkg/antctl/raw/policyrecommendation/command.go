@@ -0,0 +1,279 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	antctlruntime "antrea.io/antrea/pkg/antctl/runtime"
+	"antrea.io/antrea/pkg/controller/policyrecommendation"
+	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+)
+
+const (
+	clickHouseUsernameEnv = "CLICKHOUSE_USERNAME"
+	clickHousePasswordEnv = "CLICKHOUSE_PASSWORD"
+)
+
+var (
+	Command *cobra.Command
+	option  = &struct {
+		flowRecords        string
+		clickHouseURL      string
+		clickHouseDatabase string
+		since              time.Duration
+		policyType         string
+		isolation          string
+		tier               string
+		priority           float64
+		namespaces         []string
+		outputType         string
+		insecure           bool
+	}{}
+	getRestClient   = getControllerRestClient
+	queryClickHouse = queryClickHouseFlows
+)
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "policyrecommendation",
+		Short:   "Recommend Antrea-native policies from observed flows",
+		Long:    "Recommend Antrea NetworkPolicies or ClusterNetworkPolicies which allow exactly the Pod-to-Pod, Pod-to-Service and Pod-to-external traffic observed by the Flow Aggregator. One policy is recommended for each workload, i.e. each group of Pods sharing a Namespace and the same labels. The flows are sent to the Antrea Controller, which generates the recommended policies and retrieves the labels of the Pods which are not included in the flows. Flow records can be read from the output of \"antctl get flowrecords -o json\" or queried from the ClickHouse database of the Flow Aggregator. The ClickHouse credentials are read from the " + clickHouseUsernameEnv + " and " + clickHousePasswordEnv + " environment variables.",
+		Aliases: []string{"pr"},
+		Example: `  Recommend Antrea NetworkPolicies from flow records saved with "antctl get flowrecords -o json" in the Flow Aggregator Pod
+  $ antctl policyrecommendation --flow-records flows.json
+  Recommend Antrea ClusterNetworkPolicies for Namespace ns1 from the flows exported to ClickHouse in the last 7 days
+  $ antctl policyrecommendation --clickhouse-url tcp://clickhouse.flow-visibility.svc:9000 --since 168h --type acnp -n ns1
+  Recommend policies which only allow the observed traffic, without dropping other traffic
+  $ antctl policyrecommendation --flow-records flows.json --isolation none
+`,
+		RunE: runE,
+		Args: cobra.NoArgs,
+	}
+
+	Command.Flags().StringVarP(&option.flowRecords, "flow-records", "f", "", "file containing flow records in the JSON format of \"antctl get flowrecords -o json\", or - to read from stdin")
+	Command.Flags().StringVar(&option.clickHouseURL, "clickhouse-url", "", "URL of the ClickHouse database to query flows from, e.g. tcp://clickhouse.flow-visibility.svc:9000")
+	Command.Flags().StringVar(&option.clickHouseDatabase, "clickhouse-database", "default", "name of the ClickHouse database where the flows table is created")
+	Command.Flags().DurationVar(&option.since, "since", 24*time.Hour, "only consider flows queried from ClickHouse which were active during this duration")
+	Command.Flags().StringVar(&option.policyType, "type", string(policyrecommendation.PolicyTypeANNP), "type of the recommended policies: annp or acnp")
+	Command.Flags().StringVar(&option.isolation, "isolation", string(policyrecommendation.IsolationApplied), "isolation of the selected workloads: applied (drop traffic which was not observed) or none")
+	Command.Flags().StringVar(&option.tier, "tier", policyrecommendation.DefaultTier, "Tier of the recommended policies")
+	Command.Flags().Float64Var(&option.priority, "priority", policyrecommendation.DefaultPriority, "priority of the recommended policies")
+	Command.Flags().StringSliceVarP(&option.namespaces, "namespace", "n", nil, "only recommend policies for workloads in these Namespaces")
+	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json")
+	if !antctlruntime.InPod {
+		Command.Flags().BoolVar(&option.insecure, "insecure", false, "Skip TLS verification when connecting to Antrea API.")
+	}
+}
+
+func getControllerRestClient(ctx context.Context, cmd *cobra.Command) (*rest.RESTClient, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg := rest.CopyConfig(kubeconfig)
+	cfg.GroupVersion = &schema.GroupVersion{Group: "", Version: ""}
+	if antctlruntime.InPod {
+		raw.SetupLocalKubeconfig(cfg)
+	} else {
+		k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create clientset: %w", err)
+		}
+		if cfg, err = raw.CreateControllerClientCfg(ctx, k8sClientset, antreaClientset, cfg, option.insecure); err != nil {
+			return nil, fmt.Errorf("error when creating controller client config: %w", err)
+		}
+	}
+	client, err := rest.RESTClientFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest client: %w", err)
+	}
+	return client, nil
+}
+
+func queryClickHouseFlows(ctx context.Context, start, end time.Time) ([]*policyrecommendation.Flow, error) {
+	compress := false
+	db, err := clickhouseclient.ConnectClickHouse(&clickhouseclient.ClickHouseConfig{
+		Username:    os.Getenv(clickHouseUsernameEnv),
+		Password:    os.Getenv(clickHousePasswordEnv),
+		Database:    option.clickHouseDatabase,
+		DatabaseURL: option.clickHouseURL,
+		Compress:    &compress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return policyrecommendation.QueryClickHouse(ctx, db, start, end)
+}
+
+func validateOptions() error {
+	if (option.flowRecords == "") == (option.clickHouseURL == "") {
+		return errors.New("exactly one of --flow-records and --clickhouse-url must be provided")
+	}
+	switch policyrecommendation.PolicyType(option.policyType) {
+	case policyrecommendation.PolicyTypeANNP, policyrecommendation.PolicyTypeACNP:
+	default:
+		return fmt.Errorf("unsupported policy type %q, must be annp or acnp", option.policyType)
+	}
+	switch policyrecommendation.IsolationMethod(option.isolation) {
+	case policyrecommendation.IsolationApplied, policyrecommendation.IsolationNone:
+	default:
+		return fmt.Errorf("unsupported isolation %q, must be applied or none", option.isolation)
+	}
+	if option.outputType != "yaml" && option.outputType != "json" {
+		return fmt.Errorf("unsupported output type %q, must be yaml or json", option.outputType)
+	}
+	return nil
+}
+
+func readFlows(ctx context.Context, cmd *cobra.Command) ([]*policyrecommendation.Flow, error) {
+	if option.clickHouseURL != "" {
+		end := time.Now()
+		return queryClickHouse(ctx, end.Add(-option.since), end)
+	}
+	var r io.Reader
+	if option.flowRecords == "-" {
+		r = cmd.InOrStdin()
+	} else {
+		f, err := os.Open(option.flowRecords)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	return policyrecommendation.ReadFlowRecords(r)
+}
+
+// requestRecommendation sends the flows to the Antrea Controller, which
+// recommends policies from them according to the provided options.
+func requestRecommendation(ctx context.Context, client *rest.RESTClient, flows []*policyrecommendation.Flow) (*policyrecommendation.Recommendation, error) {
+	body, err := json.Marshal(flows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal flows: %w", err)
+	}
+	query := url.Values{}
+	query.Set("type", option.policyType)
+	query.Set("isolation", option.isolation)
+	query.Set("tier", option.tier)
+	query.Set("priority", strconv.FormatFloat(option.priority, 'f', -1, 64))
+	for _, ns := range option.namespaces {
+		query.Add("namespace", ns)
+	}
+	u := url.URL{Path: "/policyrecommendation", RawQuery: query.Encode()}
+	rawResp, err := client.Post().RequestURI(u.RequestURI()).SetHeader("Content-Type", "application/json").Body(body).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error when requesting policy recommendation: %w", err)
+	}
+	var recommendation policyrecommendation.Recommendation
+	if err := json.Unmarshal(rawResp, &recommendation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy recommendation: %w", err)
+	}
+	return &recommendation, nil
+}
+
+// toManifest converts a recommended policy to a manifest which can be applied
+// as is, i.e. without status and server-populated metadata.
+func toManifest(obj runtime.Object) (map[string]interface{}, error) {
+	manifest, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(manifest, "status")
+	unstructured.RemoveNestedField(manifest, "metadata", "creationTimestamp")
+	return manifest, nil
+}
+
+func output(recommendation *policyrecommendation.Recommendation, w io.Writer) error {
+	var objects []runtime.Object
+	for _, np := range recommendation.NetworkPolicies {
+		objects = append(objects, np)
+	}
+	for _, cnp := range recommendation.ClusterNetworkPolicies {
+		objects = append(objects, cnp)
+	}
+	manifests := make([]map[string]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		manifest, err := toManifest(obj)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, manifest)
+	}
+	if option.outputType == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(manifests)
+	}
+	for i, manifest := range manifests {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w, "---"); err != nil {
+				return err
+			}
+		}
+		data, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	if err := validateOptions(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	flows, err := readFlows(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("error when reading flows: %w", err)
+	}
+	client, err := getRestClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	recommendation, err := requestRecommendation(ctx, client, flows)
+	if err != nil {
+		return err
+	}
+	for _, warning := range recommendation.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+	}
+	if err := output(recommendation, cmd.OutOrStdout()); err != nil {
+		return fmt.Errorf("error when outputting recommended policies: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/rest/fake"
+	"k8s.io/client-go/tools/cache"
+
+	recommendationhandler "antrea.io/antrea/pkg/apiserver/handlers/policyrecommendation"
+	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	"antrea.io/antrea/pkg/controller/policyrecommendation"
+)
+
+const flowRecords = `[
+  {
+    "sourceIPv4Address": "10.10.0.1",
+    "destinationIPv4Address": "10.10.1.1",
+    "sourceTransportPort": 34567,
+    "destinationTransportPort": 80,
+    "protocolIdentifier": 6,
+    "sourcePodName": "client",
+    "sourcePodNamespace": "ns1",
+    "destinationPodName": "server",
+    "destinationPodNamespace": "ns1",
+    "destinationPodLabels": "{\"app\":\"server\"}"
+  }
+]`
+
+var (
+	clientPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "client",
+			Labels:    map[string]string{"app": "client", "pod-template-hash": "5d8f7c"},
+		},
+	}
+	clientPolicyName = recommendedPolicyName("ns1", map[string]string{"app": "client"})
+	serverPolicyName = recommendedPolicyName("ns1", map[string]string{"app": "server"})
+)
+
+// recommendedPolicyName returns the name of the policy recommended for a
+// workload, by recommending a policy for a flow from that workload.
+func recommendedPolicyName(namespace string, labels map[string]string) string {
+	recommendation := policyrecommendation.Recommend([]*policyrecommendation.Flow{{
+		SourcePodNamespace: namespace,
+		SourcePodName:      "pod",
+		SourcePodLabels:    labels,
+		DestinationIP:      "8.8.8.8",
+		Protocol:           17,
+		DestinationPort:    53,
+	}}, policyrecommendation.Options{})
+	return recommendation.NetworkPolicies[0].Name
+}
+
+func resetOptions() {
+	option.flowRecords = ""
+	option.clickHouseURL = ""
+	option.clickHouseDatabase = "default"
+	option.since = 24 * time.Hour
+	option.policyType = string(policyrecommendation.PolicyTypeANNP)
+	option.isolation = string(policyrecommendation.IsolationNone)
+	option.tier = policyrecommendation.DefaultTier
+	option.priority = policyrecommendation.DefaultPriority
+	option.namespaces = nil
+	option.outputType = "yaml"
+}
+
+func TestValidateOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		setOptions  func()
+		expectedErr string
+	}{
+		{
+			name:       "flow records",
+			setOptions: func() { option.flowRecords = "flows.json" },
+		},
+		{
+			name:        "no flow source",
+			setOptions:  func() {},
+			expectedErr: "exactly one of --flow-records and --clickhouse-url must be provided",
+		},
+		{
+			name: "both flow sources",
+			setOptions: func() {
+				option.flowRecords = "flows.json"
+				option.clickHouseURL = "tcp://clickhouse:9000"
+			},
+			expectedErr: "exactly one of --flow-records and --clickhouse-url must be provided",
+		},
+		{
+			name: "invalid policy type",
+			setOptions: func() {
+				option.flowRecords = "flows.json"
+				option.policyType = "k8s"
+			},
+			expectedErr: `unsupported policy type "k8s"`,
+		},
+		{
+			name: "invalid isolation",
+			setOptions: func() {
+				option.flowRecords = "flows.json"
+				option.isolation = "all"
+			},
+			expectedErr: `unsupported isolation "all"`,
+		},
+		{
+			name: "invalid output type",
+			setOptions: func() {
+				option.flowRecords = "flows.json"
+				option.outputType = "table"
+			},
+			expectedErr: `unsupported output type "table"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetOptions()
+			tt.setOptions()
+			err := validateOptions()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// getFakeRestClient returns a RESTClient which serves requests with the
+// policy recommendation handler of the Antrea Controller.
+func getFakeRestClient(t *testing.T, pods ...*corev1.Pod) func(ctx context.Context, cmd *cobra.Command) (*rest.RESTClient, error) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range pods {
+		require.NoError(t, indexer.Add(pod))
+	}
+	handler := recommendationhandler.HandleFunc(corelisters.NewPodLister(indexer))
+	return func(ctx context.Context, cmd *cobra.Command) (*rest.RESTClient, error) {
+		client, err := rest.RESTClientFor(&rest.Config{
+			ContentConfig: rest.ContentConfig{
+				NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+				GroupVersion:         &schema.GroupVersion{},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.Client = fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/policyrecommendation", req.URL.Path)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			return recorder.Result(), nil
+		})
+		return client, nil
+	}
+}
+
+func TestRunE(t *testing.T) {
+	getRestClient = getFakeRestClient(t, clientPod)
+	defer func() {
+		getRestClient = getControllerRestClient
+	}()
+
+	t.Run("yaml output", func(t *testing.T) {
+		resetOptions()
+		option.flowRecords = "-"
+		cmd := &cobra.Command{}
+		cmd.SetIn(strings.NewReader(flowRecords))
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		require.NoError(t, runE(cmd, nil))
+		assert.Equal(t, fmt.Sprintf(`apiVersion: crd.antrea.io/v1beta1
+kind: NetworkPolicy
+metadata:
+  name: %s
+  namespace: ns1
+spec:
+  appliedTo:
+  - podSelector:
+      matchLabels:
+        app: client
+  egress:
+  - action: Allow
+    enableLogging: false
+    ports:
+    - port: 80
+      protocol: TCP
+    to:
+    - podSelector:
+        matchLabels:
+          app: server
+  priority: 5
+  tier: application
+---
+apiVersion: crd.antrea.io/v1beta1
+kind: NetworkPolicy
+metadata:
+  name: %s
+  namespace: ns1
+spec:
+  appliedTo:
+  - podSelector:
+      matchLabels:
+        app: server
+  ingress:
+  - action: Allow
+    enableLogging: false
+    from:
+    - podSelector:
+        matchLabels:
+          app: client
+    ports:
+    - port: 80
+      protocol: TCP
+  priority: 5
+  tier: application
+`, clientPolicyName, serverPolicyName), out.String())
+	})
+
+	t.Run("json output from ClickHouse", func(t *testing.T) {
+		resetOptions()
+		option.clickHouseURL = "tcp://clickhouse:9000"
+		option.policyType = string(policyrecommendation.PolicyTypeACNP)
+		option.namespaces = []string{"ns2"}
+		option.outputType = "json"
+		queryClickHouse = func(ctx context.Context, start, end time.Time) ([]*policyrecommendation.Flow, error) {
+			assert.Equal(t, 24*time.Hour, end.Sub(start))
+			return nil, nil
+		}
+		defer func() {
+			queryClickHouse = queryClickHouseFlows
+		}()
+		cmd := &cobra.Command{}
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		require.NoError(t, runE(cmd, nil))
+		assert.Equal(t, "[]\n", out.String())
+	})
+}
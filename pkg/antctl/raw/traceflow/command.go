@@ -45,14 +45,17 @@ const defaultTimeout time.Duration = time.Second * 10
 var (
 	Command *cobra.Command
 	option  = &struct {
-		source      string
-		destination string
-		outputType  string
-		flow        string
-		liveTraffic bool
-		droppedOnly bool
-		timeout     time.Duration
-		nowait      bool
+		source       string
+		sourceNode   string
+		destination  string
+		outputType   string
+		flow         string
+		liveTraffic  bool
+		droppedOnly  bool
+		multicluster bool
+		timeout      time.Duration
+		nowait       bool
+		compare      []string
 	}{}
 	getClients = getK8sClient
 )
@@ -97,22 +100,39 @@ func init() {
   $antctl traceflow -S pod1 -D ns1/svc1 -f tcp,tcp_dst=80
   Start a Traceflow from pod1 to pod2, with a UDP packet to destination port 1234
   $antctl traceflow -S pod1 -D pod2 -f udp,udp_dst=1234
+  Start a Traceflow from the host network of node1 to pod2
+  $antctl traceflow --source-node node1 -D pod2
+  Start a Traceflow from an external client 1.1.1.1 to the LoadBalancer IP or NodePort of svc1, through node1
+  $antctl traceflow -S 1.1.1.1 --source-node node1 -D svc1 -f tcp,tcp_dst=80
   Start a Traceflow for live TCP traffic from pod1 to svc1, with 1 minute timeout
   $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic -t 1m
   Start a Traceflow to capture the first dropped TCP packet to pod1 on port 80, within 10 minutes
   $antctl traceflow -D pod1 -f tcp,tcp_dst=80 --live-traffic --dropped-only -t 10m
+  Start a Traceflow from pod1 to the multi-cluster Service antrea-mc-svc1, following the packet into the member cluster of the Endpoint
+  $antctl traceflow -S pod1 -D antrea-mc-svc1 -f tcp,tcp_dst=80 --multicluster
+  Start a Traceflow from pod1 to pod2 and output the results as a timeline
+  $antctl traceflow -S pod1 -D pod2 -o timeline
+  Start a Traceflow from pod1 to pod2 and render the results as a graph with Graphviz
+  $antctl traceflow -S pod1 -D pod2 -o dot | dot -Tsvg > tf.svg
+  Start a Traceflow from pod3 to pod2 and compare it with the existing Traceflow tf1
+  $antctl traceflow -S pod3 -D pod2 --compare tf1
+  Compare the existing Traceflows tf1 and tf2
+  $antctl traceflow --compare tf1,tf2
 `,
 		RunE: runE,
 		Args: cobra.NoArgs,
 	}
 
 	Command.Flags().StringVarP(&option.source, "source", "S", "", "source of the Traceflow: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.sourceNode, "source-node", "", "", "Node from which the Traceflow packet is sent, from its host network, or from an external client when the source is an IP")
 	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination of the Traceflow: Namespace/Pod, Pod, Namespace/Service, Service or IP")
-	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json")
+	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json, dot (Graphviz graph of the results) or timeline")
 	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, ipv6")
 	Command.Flags().BoolVarP(&option.liveTraffic, "live-traffic", "L", false, "if set, the Traceflow will trace the first packet of the matched live traffic flow")
 	Command.Flags().BoolVarP(&option.droppedOnly, "dropped-only", "", false, "if set, capture only the dropped packet in a live-traffic Traceflow")
+	Command.Flags().BoolVarP(&option.multicluster, "multicluster", "", false, "if set, the Traceflow will follow the packet through the Multi-cluster Gateway into the peer member clusters")
 	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
+	Command.Flags().StringSliceVarP(&option.compare, "compare", "", nil, "compare the results side by side and highlight the first diverging Observation: with one existing Traceflow name, compare it with the started Traceflow; with two existing Traceflow names, compare them without starting a Traceflow")
 }
 
 func getK8sClient(cmd *cobra.Command) (kubernetes.Interface, antrea.Interface, error) {
@@ -137,7 +157,19 @@ func runE(cmd *cobra.Command, _ []string) error {
 		option.timeout = defaultTimeout
 	}
 
-	if !option.liveTraffic && option.source == "" {
+	if len(option.compare) > 2 {
+		fmt.Fprintf(cmd.OutOrStdout(), "At most two Traceflows can be compared")
+		return nil
+	}
+	if len(option.compare) == 2 {
+		_, client, err := getClients(cmd)
+		if err != nil {
+			return err
+		}
+		return compareExisting(client, cmd.OutOrStdout())
+	}
+
+	if !option.liveTraffic && option.source == "" && option.sourceNode == "" {
 		fmt.Fprintf(cmd.OutOrStdout(), "Please provide source")
 		return nil
 	}
@@ -157,10 +189,21 @@ func runE(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if option.liveTraffic && option.multicluster {
+		fmt.Fprintf(cmd.OutOrStdout(), "--multicluster does not work with live-traffic Traceflow")
+		return nil
+	}
+
 	k8sclient, client, err := getClients(cmd)
 	if err != nil {
 		return err
 	}
+	var reference *v1beta1.Traceflow
+	if len(option.compare) == 1 {
+		if reference, err = client.CrdV1beta1().Traceflows().Get(context.TODO(), option.compare[0], metav1.GetOptions{}); err != nil {
+			return fmt.Errorf("error when retrieving Traceflow %s: %w", option.compare[0], err)
+		}
+	}
 	tf, err := newTraceflow(k8sclient)
 	if err != nil {
 		return fmt.Errorf("error when filling up Traceflow config: %w", err)
@@ -204,17 +247,53 @@ func runE(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("error when retrieving Traceflow: %w", err)
 	}
 
+	if reference != nil {
+		if err := compareOutput(reference, res, cmd.OutOrStdout()); err != nil {
+			return fmt.Errorf("error when outputting result: %w", err)
+		}
+		return err
+	}
 	if err := output(res, cmd.OutOrStdout()); err != nil {
 		return fmt.Errorf("error when outputting result: %w", err)
 	}
 	return err
 }
 
+// compareExisting compares the two existing Traceflows given with --compare.
+func compareExisting(client antrea.Interface, writer io.Writer) error {
+	var tfs [2]*v1beta1.Traceflow
+	for i, name := range option.compare {
+		tf, err := client.CrdV1beta1().Traceflows().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error when retrieving Traceflow %s: %w", name, err)
+		}
+		tfs[i] = tf
+	}
+	if err := compareOutput(tfs[0], tfs[1], writer); err != nil {
+		return fmt.Errorf("error when outputting result: %w", err)
+	}
+	return nil
+}
+
 func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 	var srcName, dstName string
 	var src v1beta1.Source
 
-	if option.source != "" {
+	if option.sourceNode != "" {
+		if option.liveTraffic {
+			return nil, errors.New("source Node is not supported in live-traffic Traceflow")
+		}
+		src.Node = option.sourceNode
+		srcName = src.Node
+		if option.source != "" {
+			srcIP := net.ParseIP(option.source)
+			if srcIP == nil {
+				return nil, errors.New("source must be an IP address when the source Node is set")
+			}
+			src.IP = srcIP.String()
+			srcName = src.IP
+		}
+	} else if option.source != "" {
 		srcIP := net.ParseIP(option.source)
 		if srcIP != nil {
 			if !option.liveTraffic {
@@ -275,7 +354,7 @@ func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 		dstName = "any"
 	}
 
-	if src.Pod == "" && dst.Pod == "" {
+	if src.Pod == "" && src.Node == "" && dst.Pod == "" {
 		return nil, errors.New("one of source and destination must be a Pod")
 	}
 
@@ -290,12 +369,13 @@ func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 			Name: name,
 		},
 		Spec: v1beta1.TraceflowSpec{
-			Source:      src,
-			Destination: dst,
-			Packet:      *pkt,
-			LiveTraffic: option.liveTraffic,
-			DroppedOnly: option.droppedOnly,
-			Timeout:     int32(option.timeout.Seconds()),
+			Source:       src,
+			Destination:  dst,
+			Packet:       *pkt,
+			LiveTraffic:  option.liveTraffic,
+			DroppedOnly:  option.droppedOnly,
+			Multicluster: option.multicluster,
+			Timeout:      int32(option.timeout.Seconds()),
 		},
 	}
 	return tf, nil
@@ -393,14 +473,21 @@ func getPortFields(cleanFlow string) (map[string]int, error) {
 	return fields, nil
 }
 
-func output(tf *v1beta1.Traceflow, writer io.Writer) error {
-	r := Response{
+func newResponse(tf *v1beta1.Traceflow) *Response {
+	r := &Response{
 		Name:        tf.Name,
 		Phase:       tf.Status.Phase,
 		Reason:      tf.Status.Reason,
 		Source:      fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
 		NodeResults: tf.Status.Results,
 	}
+	if len(tf.Spec.Source.Node) > 0 {
+		if len(tf.Spec.Source.IP) > 0 {
+			r.Source = fmt.Sprintf("%s via Node %s", tf.Spec.Source.IP, tf.Spec.Source.Node)
+		} else {
+			r.Source = fmt.Sprintf("Node %s", tf.Spec.Source.Node)
+		}
+	}
 	if len(tf.Spec.Destination.IP) > 0 {
 		r.Destination = tf.Spec.Destination.IP
 	} else if len(tf.Spec.Destination.Pod) != 0 {
@@ -419,17 +506,25 @@ func output(tf *v1beta1.Traceflow, writer io.Writer) error {
 			r.CapturedPacket.TransportHeader = &pkt.TransportHeader
 		}
 	}
+	return r
+}
 
-	if option.outputType == "json" {
-		if err := jsonOutput(&r, writer); err != nil {
+func output(tf *v1beta1.Traceflow, writer io.Writer) error {
+	switch option.outputType {
+	case "json":
+		if err := jsonOutput(newResponse(tf), writer); err != nil {
 			return fmt.Errorf("error when converting output to json: %w", err)
 		}
-	} else if option.outputType == "yaml" {
-		if err := yamlOutput(&r, writer); err != nil {
+	case "yaml":
+		if err := yamlOutput(newResponse(tf), writer); err != nil {
 			return fmt.Errorf("error when converting output to yaml: %w", err)
 		}
-	} else {
-		return fmt.Errorf("output types should be yaml or json")
+	case "dot":
+		return dotOutput(tf, writer)
+	case "timeline":
+		return timelineOutput(tf, writer)
+	default:
+		return fmt.Errorf("output types should be yaml, json, dot or timeline")
 	}
 	return nil
 }
@@ -206,7 +206,9 @@ func TestRunE(t *testing.T) {
 		outputType  string
 		liveTraffic string
 		droppedOnly string
+		compare     []string
 		expected    string
+		expectedErr string
 	}{
 		{
 			name:     "no source",
@@ -269,14 +271,87 @@ destination: default/service
 source: default/pod-1
 `,
 		},
+		{
+			name:       "dummy-traceflow-timeline",
+			src:        srcPod,
+			dst:        dstPod,
+			outputType: "timeline",
+			expected: `: Succeeded
+default/pod-1 -> default/pod-2
+STEP  NODE  COMPONENT  ACTION  DETAILS
+`,
+		},
+		{
+			name:       "dummy-traceflow-dot",
+			src:        srcPod,
+			dst:        dstPod,
+			outputType: "dot",
+			expected: `  label="default/pod-1 -> default/pod-2: Succeeded";
+`,
+		},
+		{
+			name:        "invalid output type",
+			src:         srcPod,
+			dst:         dstPod,
+			outputType:  "table",
+			expectedErr: "output types should be yaml, json, dot or timeline",
+		},
+		{
+			name:     "compare too many Traceflows",
+			compare:  []string{"tf1", "tf2", "tf3"},
+			expected: "At most two Traceflows can be compared",
+		},
+		{
+			name:     "compare existing Traceflows",
+			compare:  []string{"tf1", "tf2"},
+			expected: "First diverging Observation at step 2",
+		},
+		{
+			name:       "compare with existing Traceflow",
+			src:        srcPod,
+			dst:        dstPod,
+			outputType: "yaml",
+			compare:    []string{"tf1"},
+			expected:   "First diverging Observation at step 1",
+		},
+	}
+	existingTraceflows := []runtime.Object{
+		&v1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+			Status: v1beta1.TraceflowStatus{
+				Phase: v1beta1.Succeeded,
+				Results: []v1beta1.NodeResult{{
+					Node: "node1",
+					Observations: []v1beta1.Observation{
+						{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+						{Component: v1beta1.ComponentNetworkPolicy, ComponentInfo: "IngressRule", Action: v1beta1.ActionDropped},
+					},
+				}},
+			},
+		},
+		&v1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf2"},
+			Status: v1beta1.TraceflowStatus{
+				Phase: v1beta1.Succeeded,
+				Results: []v1beta1.NodeResult{{
+					Node: "node1",
+					Observations: []v1beta1.Observation{
+						{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+						{Component: v1beta1.ComponentForwarding, Action: v1beta1.ActionReceived},
+					},
+				}},
+			},
+		},
 	}
 
 	for _, tt := range tcs {
 		t.Run(tt.name, func(t *testing.T) {
 			modifyCommandAndOption(tt.src, tt.dst, tt.outputType, tt.liveTraffic, tt.droppedOnly, "")
 			defer modifyCommandAndOption("", "", "yaml", "", "", "")
+			option.compare = tt.compare
+			defer func() { option.compare = nil }()
 
-			client := antreafakeclient.NewSimpleClientset()
+			client := antreafakeclient.NewSimpleClientset(existingTraceflows...)
 			client.PrependReactor("create", "traceflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
 				createAction := action.(k8stesting.CreateAction)
 				obj := createAction.GetObject().(*v1beta1.Traceflow)
@@ -294,6 +369,10 @@ source: default/pod-1
 			Command.SetOut(buf)
 			Command.SetErr(buf)
 			err := runE(Command, nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
 			require.NoError(t, err)
 			assert.Contains(t, buf.String(), tt.expected)
 		})
@@ -372,12 +451,14 @@ kind: Config`),
 
 func TestNewTraceflow(t *testing.T) {
 	tcs := []struct {
-		name        string
-		src         string
-		dst         string
-		liveTraffic string
-		droppedOnly string
-		expectedTf  *v1beta1.Traceflow
+		name         string
+		src          string
+		srcNode      string
+		dst          string
+		liveTraffic  string
+		droppedOnly  string
+		multicluster string
+		expectedTf   *v1beta1.Traceflow
 	}{
 		{
 			name: "dummy-traceflow-dst-pod",
@@ -481,6 +562,62 @@ func TestNewTraceflow(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "dummy-traceflow-node-to-pod",
+			srcNode: "node-1",
+			dst:     "pod-2",
+			expectedTf: &v1beta1.Traceflow{
+				Spec: v1beta1.TraceflowSpec{
+					Source: v1beta1.Source{
+						Node: "node-1",
+					},
+					Destination: v1beta1.Destination{
+						Namespace: "default",
+						Pod:       "pod-2",
+					},
+					Packet: v1beta1.Packet{
+						IPv6Header: &v1beta1.IPv6Header{
+							NextHeader: &protocolTCP,
+						},
+						TransportHeader: v1beta1.TransportHeader{
+							TCP: &v1beta1.TCPHeader{
+								DstPort: 4321,
+							},
+						},
+					},
+					Timeout: 10,
+				},
+			},
+		},
+		{
+			name:    "dummy-traceflow-external-to-service",
+			src:     ipv4,
+			srcNode: "node-1",
+			dst:     "service",
+			expectedTf: &v1beta1.Traceflow{
+				Spec: v1beta1.TraceflowSpec{
+					Source: v1beta1.Source{
+						IP:   ipv4,
+						Node: "node-1",
+					},
+					Destination: v1beta1.Destination{
+						Namespace: "default",
+						Service:   "service",
+					},
+					Packet: v1beta1.Packet{
+						IPv6Header: &v1beta1.IPv6Header{
+							NextHeader: &protocolTCP,
+						},
+						TransportHeader: v1beta1.TransportHeader{
+							TCP: &v1beta1.TCPHeader{
+								DstPort: 4321,
+							},
+						},
+					},
+					Timeout: 10,
+				},
+			},
+		},
 		{
 			name: "dummy-traceflow-pod-to-service",
 			src:  srcPod,
@@ -509,12 +646,46 @@ func TestNewTraceflow(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "dummy-traceflow-multicluster",
+			src:          srcPod,
+			dst:          "antrea-mc-service",
+			multicluster: "true",
+			expectedTf: &v1beta1.Traceflow{
+				Spec: v1beta1.TraceflowSpec{
+					Source: v1beta1.Source{
+						Namespace: "default",
+						Pod:       "pod-1",
+					},
+					Destination: v1beta1.Destination{
+						Namespace: "default",
+						Service:   "antrea-mc-service",
+					},
+					Packet: v1beta1.Packet{
+						IPv6Header: &v1beta1.IPv6Header{
+							NextHeader: &protocolTCP,
+						},
+						TransportHeader: v1beta1.TransportHeader{
+							TCP: &v1beta1.TCPHeader{
+								DstPort: 4321,
+							},
+						},
+					},
+					Multicluster: true,
+					Timeout:      10,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
 			modifyCommandAndOption(tc.src, tc.dst, "yaml", tc.liveTraffic, tc.droppedOnly, "")
 			defer modifyCommandAndOption("", "", "yaml", "", "", "")
+			Command.Flags().Set("source-node", tc.srcNode)
+			defer Command.Flags().Set("source-node", "")
+			Command.Flags().Set("multicluster", tc.multicluster)
+			defer Command.Flags().Set("multicluster", "false")
 
 			tf, err := newTraceflow(k8sClient)
 			require.NoError(t, err)
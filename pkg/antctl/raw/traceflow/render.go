@@ -0,0 +1,311 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// step is an Observation of a Traceflow, together with the Node which reported it. The Node of a peer member cluster
+// in a multi-cluster Traceflow is prefixed with the ID of its cluster.
+type step struct {
+	node   string
+	packet int32
+	reply  bool
+	ob     v1beta1.Observation
+}
+
+// getSteps flattens the results of a Traceflow into a list of steps. The steps of a session Traceflow are grouped by
+// request packet, with the steps of the request packet before those of its reply. The results of a packet are ordered
+// along its path, as their timestamps only have a precision of one second and the clocks of the Nodes may differ: the
+// Node which sent the packet comes first and the Node where the packet left its path last, the Nodes which forwarded
+// the packet to another Node being ordered by timestamp in between.
+func getSteps(results []v1beta1.NodeResult) []step {
+	sorted := make([]v1beta1.NodeResult, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Packet != sorted[j].Packet {
+			return sorted[i].Packet < sorted[j].Packet
+		}
+		if sorted[i].Reply != sorted[j].Reply {
+			return !sorted[i].Reply
+		}
+		if pi, pj := pathPosition(&sorted[i]), pathPosition(&sorted[j]); pi != pj {
+			return pi < pj
+		}
+		if sorted[i].Timestamp != sorted[j].Timestamp {
+			return sorted[i].Timestamp < sorted[j].Timestamp
+		}
+		if sorted[i].Cluster != sorted[j].Cluster {
+			return sorted[i].Cluster < sorted[j].Cluster
+		}
+		return sorted[i].Node < sorted[j].Node
+	})
+	var steps []step
+	for _, result := range sorted {
+		node := result.Node
+		if result.Cluster != "" {
+			node = result.Cluster + "/" + node
+		}
+		for _, ob := range result.Observations {
+			steps = append(steps, step{node: node, packet: result.Packet, reply: result.Reply, ob: ob})
+		}
+	}
+	return steps
+}
+
+// pathPosition returns the position of a Node on the path of the packet, based on the Observations it reported: 0 for
+// the Node which sent the packet, 1 for a Node which forwarded the packet to another Node, and 2 for the Node where the
+// packet left its path.
+func pathPosition(result *v1beta1.NodeResult) int {
+	if len(result.Observations) == 0 {
+		return 2
+	}
+	switch result.Observations[0].Component {
+	case v1beta1.ComponentSpoofGuard, v1beta1.ComponentClassifier:
+		return 0
+	}
+	switch result.Observations[len(result.Observations)-1].Action {
+	case v1beta1.ActionForwarded, v1beta1.ActionForwardedToRemoteCluster:
+		return 1
+	}
+	return 2
+}
+
+// packetName returns the name of the packet of a step in a session Traceflow, or an empty string otherwise.
+func (s *step) packetName() string {
+	if s.packet == 0 {
+		return ""
+	}
+	if s.reply {
+		return fmt.Sprintf("#%d reply", s.packet)
+	}
+	return fmt.Sprintf("#%d", s.packet)
+}
+
+// details returns the optional fields of the Observation of a step.
+func (s *step) details() string {
+	ob := s.ob
+	var details []string
+	add := func(key, value string) {
+		if value != "" {
+			details = append(details, fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+	add("info", ob.ComponentInfo)
+	add("networkPolicy", ob.NetworkPolicy)
+	add("rule", ob.NetworkPolicyRule)
+	add("pod", ob.Pod)
+	add("srcPodIP", ob.SrcPodIP)
+	add("translatedSrcIP", ob.TranslatedSrcIP)
+	add("translatedDstIP", ob.TranslatedDstIP)
+	add("tunnelDstIP", ob.TunnelDstIP)
+	add("egress", ob.Egress)
+	add("egressIP", ob.EgressIP)
+	add("egressNode", ob.EgressNode)
+	return strings.Join(details, " ")
+}
+
+// sameAs returns whether two steps are equivalent when comparing two Traceflows. Node names and IP addresses are
+// ignored, as they are expected to differ between Traceflows with different sources or destinations.
+func (s *step) sameAs(o *step) bool {
+	return s.packet == o.packet && s.reply == o.reply &&
+		s.ob.Component == o.ob.Component &&
+		s.ob.Action == o.ob.Action &&
+		s.ob.ComponentInfo == o.ob.ComponentInfo &&
+		s.ob.NetworkPolicy == o.ob.NetworkPolicy &&
+		s.ob.NetworkPolicyRule == o.ob.NetworkPolicyRule
+}
+
+func (s *step) String() string {
+	str := fmt.Sprintf("%s %s %s", s.node, s.ob.Component, s.ob.Action)
+	if packet := s.packetName(); packet != "" {
+		str = fmt.Sprintf("%s %s", packet, str)
+	}
+	if details := s.details(); details != "" {
+		str = fmt.Sprintf("%s %s", str, details)
+	}
+	return str
+}
+
+// tableWriter aligns the columns of a table like tabwriter.Writer, without padding the last column of each line.
+type tableWriter struct {
+	*tabwriter.Writer
+	table bytes.Buffer
+	out   *bytes.Buffer
+}
+
+func newTableWriter(out *bytes.Buffer) *tableWriter {
+	w := &tableWriter{out: out}
+	w.Writer = tabwriter.NewWriter(&w.table, 0, 0, 2, ' ', 0)
+	return w
+}
+
+func (w *tableWriter) Flush() {
+	w.Writer.Flush()
+	for _, line := range strings.Split(strings.TrimSuffix(w.table.String(), "\n"), "\n") {
+		w.out.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	w.table.Reset()
+}
+
+func isSession(steps []step) bool {
+	for i := range steps {
+		if steps[i].packet != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// timelineOutput writes the results of a Traceflow as a compact timeline, with one line per Observation.
+func timelineOutput(tf *v1beta1.Traceflow, writer io.Writer) error {
+	r := newResponse(tf)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Traceflow %s: %s", r.Name, r.Phase)
+	if r.Reason != "" {
+		fmt.Fprintf(&b, " (%s)", r.Reason)
+	}
+	fmt.Fprintf(&b, "\n%s -> %s\n", r.Source, r.Destination)
+	steps := getSteps(tf.Status.Results)
+	session := isSession(steps)
+	w := newTableWriter(&b)
+	if session {
+		fmt.Fprintln(w, "STEP\tPACKET\tNODE\tCOMPONENT\tACTION\tDETAILS")
+	} else {
+		fmt.Fprintln(w, "STEP\tNODE\tCOMPONENT\tACTION\tDETAILS")
+	}
+	for i := range steps {
+		s := &steps[i]
+		if session {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", i+1, s.packetName(), s.node, s.ob.Component, s.ob.Action, s.details())
+		} else {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", i+1, s.node, s.ob.Component, s.ob.Action, s.details())
+		}
+	}
+	w.Flush()
+	if _, err := io.Copy(writer, &b); err != nil {
+		return fmt.Errorf("error when outputing timeline: %w", err)
+	}
+	return nil
+}
+
+// dotOutput writes the results of a Traceflow as a Graphviz graph in the DOT language. The Observations of each Node
+// are grouped in a cluster, and the Observations of each packet are linked in order. Observations ending the path of a
+// packet are filled in green if the packet is delivered, and in red if it is dropped or rejected.
+func dotOutput(tf *v1beta1.Traceflow, writer io.Writer) error {
+	r := newResponse(tf)
+	steps := getSteps(tf.Status.Results)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(r.Name))
+	fmt.Fprintf(&b, "  label=%s;\n", dotQuote(fmt.Sprintf("%s -> %s: %s", r.Source, r.Destination, r.Phase)))
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+
+	var nodes []string
+	stepsByNode := map[string][]int{}
+	for i := range steps {
+		node := steps[i].node
+		if _, ok := stepsByNode[node]; !ok {
+			nodes = append(nodes, node)
+		}
+		stepsByNode[node] = append(stepsByNode[node], i)
+	}
+	for n, node := range nodes {
+		fmt.Fprintf(&b, "  subgraph cluster_%d {\n", n)
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(node))
+		for _, i := range stepsByNode[node] {
+			s := &steps[i]
+			label := fmt.Sprintf("%s\n%s", s.ob.Component, s.ob.Action)
+			if packet := s.packetName(); packet != "" {
+				label = fmt.Sprintf("%s\n%s", packet, label)
+			}
+			if details := s.details(); details != "" {
+				label = fmt.Sprintf("%s\n%s", label, strings.ReplaceAll(details, " ", "\n"))
+			}
+			fmt.Fprintf(&b, "    step%d [label=%s", i+1, dotQuote(label))
+			switch s.ob.Action {
+			case v1beta1.ActionDelivered:
+				b.WriteString(", fillcolor=palegreen")
+			case v1beta1.ActionDropped, v1beta1.ActionRejected:
+				b.WriteString(", fillcolor=lightcoral")
+			}
+			b.WriteString("];\n")
+		}
+		b.WriteString("  }\n")
+	}
+	for i := 1; i < len(steps); i++ {
+		if steps[i].packet == steps[i-1].packet && steps[i].reply == steps[i-1].reply {
+			fmt.Fprintf(&b, "  step%d -> step%d;\n", i, i+1)
+		}
+	}
+	b.WriteString("}\n")
+	if _, err := io.Copy(writer, &b); err != nil {
+		return fmt.Errorf("error when outputing DOT graph: %w", err)
+	}
+	return nil
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+// compareOutput writes the timelines of two Traceflows side by side, and highlights the first diverging Observation.
+func compareOutput(tf1, tf2 *v1beta1.Traceflow, writer io.Writer) error {
+	steps1 := getSteps(tf1.Status.Results)
+	steps2 := getSteps(tf2.Status.Results)
+	divergence := -1
+	for i := 0; i < len(steps1) || i < len(steps2); i++ {
+		if i >= len(steps1) || i >= len(steps2) || !steps1[i].sameAs(&steps2[i]) {
+			divergence = i
+			break
+		}
+	}
+
+	var b bytes.Buffer
+	w := newTableWriter(&b)
+	fmt.Fprintf(w, "\tSTEP\t%s (%s)\t%s (%s)\n", tf1.Name, tf1.Status.Phase, tf2.Name, tf2.Status.Phase)
+	cell := func(steps []step, i int) string {
+		if i >= len(steps) {
+			return "-"
+		}
+		return steps[i].String()
+	}
+	for i := 0; i < len(steps1) || i < len(steps2); i++ {
+		marker := ""
+		if i == divergence {
+			marker = ">>"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", marker, i+1, cell(steps1, i), cell(steps2, i))
+	}
+	w.Flush()
+	if divergence == -1 {
+		b.WriteString("No diverging Observation\n")
+	} else {
+		fmt.Fprintf(&b, "First diverging Observation at step %d\n", divergence+1)
+	}
+	if _, err := io.Copy(writer, &b); err != nil {
+		return fmt.Errorf("error when outputing comparison: %w", err)
+	}
+	return nil
+}
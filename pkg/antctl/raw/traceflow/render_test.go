@@ -0,0 +1,246 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func newRenderTraceflow(name, dstPod string, results ...v1beta1.NodeResult) *v1beta1.Traceflow {
+	return &v1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.TraceflowSpec{
+			Source:      v1beta1.Source{Namespace: "default", Pod: "pod-1"},
+			Destination: v1beta1.Destination{Namespace: "default", Pod: dstPod},
+		},
+		Status: v1beta1.TraceflowStatus{
+			Phase:   v1beta1.Succeeded,
+			Results: results,
+		},
+	}
+}
+
+var (
+	senderResult = v1beta1.NodeResult{
+		Node:      "node-1",
+		Role:      "Sender",
+		Timestamp: 1,
+		Observations: []v1beta1.Observation{
+			{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+			{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionForwarded, TunnelDstIP: "192.168.1.2"},
+		},
+	}
+	deliveredResult = v1beta1.NodeResult{
+		Node:      "node-2",
+		Role:      "Receiver",
+		Timestamp: 2,
+		Observations: []v1beta1.Observation{
+			{Component: v1beta1.ComponentForwarding, ComponentInfo: "Classification", Action: v1beta1.ActionReceived},
+			{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionDelivered},
+		},
+	}
+	droppedResult = v1beta1.NodeResult{
+		Node:      "node-2",
+		Role:      "Receiver",
+		Timestamp: 2,
+		Observations: []v1beta1.Observation{
+			{Component: v1beta1.ComponentForwarding, ComponentInfo: "Classification", Action: v1beta1.ActionReceived},
+			{Component: v1beta1.ComponentNetworkPolicy, ComponentInfo: "IngressRule", Action: v1beta1.ActionDropped, NetworkPolicy: "AntreaNetworkPolicy:default/deny"},
+		},
+	}
+)
+
+func TestTimelineOutput(t *testing.T) {
+	tcs := []struct {
+		name     string
+		tf       *v1beta1.Traceflow
+		expected string
+	}{
+		{
+			name: "regular Traceflow",
+			// Results are not ordered by the Traceflow Controller.
+			tf: newRenderTraceflow("tf", "pod-2", deliveredResult, senderResult),
+			expected: `Traceflow tf: Succeeded
+default/pod-1 -> default/pod-2
+STEP  NODE    COMPONENT   ACTION     DETAILS
+1     node-1  SpoofGuard  Forwarded
+2     node-1  Forwarding  Forwarded  info=Output tunnelDstIP=192.168.1.2
+3     node-2  Forwarding  Received   info=Classification
+4     node-2  Forwarding  Delivered  info=Output
+`,
+		},
+		{
+			name: "multi-cluster Traceflow",
+			tf: newRenderTraceflow("tf", "pod-2",
+				v1beta1.NodeResult{Node: "node-1", Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionForwardedToRemoteCluster, TunnelDstIP: "172.18.0.3"},
+				}},
+				v1beta1.NodeResult{Node: "node-2", Cluster: "cluster-b", Timestamp: 2, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionDelivered},
+				}},
+			),
+			expected: `Traceflow tf: Succeeded
+default/pod-1 -> default/pod-2
+STEP  NODE              COMPONENT   ACTION                    DETAILS
+1     node-1            SpoofGuard  Forwarded
+2     node-1            Forwarding  ForwardedToRemoteCluster  info=Output tunnelDstIP=172.18.0.3
+3     cluster-b/node-2  Forwarding  Delivered                 info=Output
+`,
+		},
+		{
+			name: "results with the same timestamp",
+			tf: newRenderTraceflow("tf", "pod-2",
+				v1beta1.NodeResult{Node: "node-0", Cluster: "cluster-b", Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionDelivered},
+				}},
+				v1beta1.NodeResult{Node: "node-3", Cluster: "cluster-b", Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionForwarded, TunnelDstIP: "192.168.2.1"},
+				}},
+				v1beta1.NodeResult{Node: "node-2", Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionForwardedToRemoteCluster, TunnelDstIP: "172.18.0.3"},
+				}},
+				v1beta1.NodeResult{Node: "node-1", Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+					{Component: v1beta1.ComponentForwarding, ComponentInfo: "Output", Action: v1beta1.ActionForwarded, TunnelDstIP: "192.168.1.2"},
+				}},
+			),
+			expected: `Traceflow tf: Succeeded
+default/pod-1 -> default/pod-2
+STEP  NODE              COMPONENT   ACTION                    DETAILS
+1     node-1            SpoofGuard  Forwarded
+2     node-1            Forwarding  Forwarded                 info=Output tunnelDstIP=192.168.1.2
+3     node-2            Forwarding  ForwardedToRemoteCluster  info=Output tunnelDstIP=172.18.0.3
+4     cluster-b/node-3  Forwarding  Forwarded                 info=Output tunnelDstIP=192.168.2.1
+5     cluster-b/node-0  Forwarding  Delivered                 info=Output
+`,
+		},
+		{
+			name: "session Traceflow",
+			tf: newRenderTraceflow("tf", "pod-2",
+				v1beta1.NodeResult{Node: "node-1", Packet: 1, Reply: true, Timestamp: 2, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentForwarding, Action: v1beta1.ActionReceived},
+					{Component: v1beta1.ComponentForwarding, Action: v1beta1.ActionDelivered},
+				}},
+				v1beta1.NodeResult{Node: "node-1", Packet: 1, Timestamp: 1, Observations: []v1beta1.Observation{
+					{Component: v1beta1.ComponentSpoofGuard, Action: v1beta1.ActionForwarded},
+					{Component: v1beta1.ComponentForwarding, Action: v1beta1.ActionDelivered},
+				}},
+			),
+			expected: `Traceflow tf: Succeeded
+default/pod-1 -> default/pod-2
+STEP  PACKET    NODE    COMPONENT   ACTION     DETAILS
+1     #1        node-1  SpoofGuard  Forwarded
+2     #1        node-1  Forwarding  Delivered
+3     #1 reply  node-1  Forwarding  Received
+4     #1 reply  node-1  Forwarding  Delivered
+`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, timelineOutput(tc.tf, &b))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestDotOutput(t *testing.T) {
+	tf := newRenderTraceflow("tf", "pod-2", senderResult, droppedResult)
+	var b bytes.Buffer
+	require.NoError(t, dotOutput(tf, &b))
+	expected := `digraph "tf" {
+  label="default/pod-1 -> default/pod-2: Succeeded";
+  node [shape=box, style="rounded,filled", fillcolor=white];
+  subgraph cluster_0 {
+    label="node-1";
+    step1 [label="SpoofGuard\nForwarded"];
+    step2 [label="Forwarding\nForwarded\ninfo=Output\ntunnelDstIP=192.168.1.2"];
+  }
+  subgraph cluster_1 {
+    label="node-2";
+    step3 [label="Forwarding\nReceived\ninfo=Classification"];
+    step4 [label="NetworkPolicy\nDropped\ninfo=IngressRule\nnetworkPolicy=AntreaNetworkPolicy:default/deny", fillcolor=lightcoral];
+  }
+  step1 -> step2;
+  step2 -> step3;
+  step3 -> step4;
+}
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestDotQuote(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c\nd"`, dotQuote("a\"b\\c\nd"))
+}
+
+func TestCompareOutput(t *testing.T) {
+	tcs := []struct {
+		name     string
+		tf1      *v1beta1.Traceflow
+		tf2      *v1beta1.Traceflow
+		expected string
+	}{
+		{
+			name: "diverging Traceflows",
+			tf1:  newRenderTraceflow("tf1", "pod-2", senderResult, deliveredResult),
+			tf2:  newRenderTraceflow("tf2", "pod-3", senderResult, droppedResult),
+			expected: `    STEP  tf1 (Succeeded)                                                  tf2 (Succeeded)
+    1     node-1 SpoofGuard Forwarded                                      node-1 SpoofGuard Forwarded
+    2     node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2  node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2
+    3     node-2 Forwarding Received info=Classification                   node-2 Forwarding Received info=Classification
+>>  4     node-2 Forwarding Delivered info=Output                          node-2 NetworkPolicy Dropped info=IngressRule networkPolicy=AntreaNetworkPolicy:default/deny
+First diverging Observation at step 4
+`,
+		},
+		{
+			name: "Traceflows with different lengths",
+			tf1:  newRenderTraceflow("tf1", "pod-2", senderResult),
+			tf2:  newRenderTraceflow("tf2", "pod-3", senderResult, deliveredResult),
+			expected: `    STEP  tf1 (Succeeded)                                                  tf2 (Succeeded)
+    1     node-1 SpoofGuard Forwarded                                      node-1 SpoofGuard Forwarded
+    2     node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2  node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2
+>>  3     -                                                                node-2 Forwarding Received info=Classification
+    4     -                                                                node-2 Forwarding Delivered info=Output
+First diverging Observation at step 3
+`,
+		},
+		{
+			name: "identical paths",
+			tf1:  newRenderTraceflow("tf1", "pod-2", senderResult),
+			tf2:  newRenderTraceflow("tf2", "pod-3", senderResult),
+			expected: `  STEP  tf1 (Succeeded)                                                  tf2 (Succeeded)
+  1     node-1 SpoofGuard Forwarded                                      node-1 SpoofGuard Forwarded
+  2     node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2  node-1 Forwarding Forwarded info=Output tunnelDstIP=192.168.1.2
+No diverging Observation
+`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, compareOutput(tc.tf1, tc.tf2, &b))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
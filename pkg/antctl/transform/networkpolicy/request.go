@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -50,10 +51,21 @@ func NewNetworkPolicyEvaluation(args map[string]string) (runtime.Object, error)
 	if pod1 == "" || pod2 == "" {
 		return nil, fmt.Errorf("missing entities for NetworkPolicyEvaluation request: %v", args)
 	}
+	var proposedPolicies []string
+	if val, ok := args["policies"]; ok && val != "" {
+		for _, file := range strings.Split(val, ",") {
+			manifest, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("error when reading proposed policies: %w", err)
+			}
+			proposedPolicies = append(proposedPolicies, string(manifest))
+		}
+	}
 	return &cpv1beta.NetworkPolicyEvaluation{
 		Request: &cpv1beta.NetworkPolicyEvaluationRequest{
-			Source:      cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: ns1, Name: pod1}},
-			Destination: cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: ns2, Name: pod2}},
+			Source:           cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: ns1, Name: pod1}},
+			Destination:      cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: ns2, Name: pod2}},
+			ProposedPolicies: proposedPolicies,
 		},
 	}, nil
 }
@@ -15,6 +15,8 @@
 package networkpolicy
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,15 @@ import (
 )
 
 func TestNewNetworkPolicyEvaluation(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	policyManifest := `apiVersion: crd.antrea.io/v1beta1
+kind: Tier
+metadata:
+  name: tier1
+spec:
+  priority: 10
+`
+	require.NoError(t, os.WriteFile(policyFile, []byte(policyManifest), 0644))
 	tests := []struct {
 		name           string
 		args           map[string]string
@@ -64,6 +75,30 @@ func TestNewNetworkPolicyEvaluation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Proposed policies",
+			args: map[string]string{
+				"source":      "ns/pod1",
+				"destination": "ns/pod2",
+				"policies":    policyFile,
+			},
+			expectedObject: &cpv1beta.NetworkPolicyEvaluation{
+				Request: &cpv1beta.NetworkPolicyEvaluationRequest{
+					Source:           cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: "ns", Name: "pod1"}},
+					Destination:      cpv1beta.Entity{Pod: &cpv1beta.PodReference{Namespace: "ns", Name: "pod2"}},
+					ProposedPolicies: []string{policyManifest},
+				},
+			},
+		},
+		{
+			name: "Missing proposed policies file",
+			args: map[string]string{
+				"source":      "ns/pod1",
+				"destination": "ns/pod2",
+				"policies":    filepath.Join(t.TempDir(), "missing.yaml"),
+			},
+			expectedError: "error when reading proposed policies",
+		},
 	}
 
 	for _, tt := range tests {
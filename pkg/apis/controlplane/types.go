@@ -234,6 +234,12 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// EnforcementMode represents how the rules of this NetworkPolicy are enforced.
+	// Empty means the rules are enforced, which is always the case for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.EnforcementMode
+	// Tier is the name of the Tier associated with this NetworkPolicy. It is only set for
+	// Antrea-native policies, and empty means the default Application Tier.
+	Tier string
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +283,43 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// RateLimit is the maximum rate of the traffic matching the rule. It is set
+	// if and only if Action is RateLimit.
+	RateLimit *RateLimit
+	// PacketCapture enables capturing the packets matching the rule. It can only
+	// be set when Action is Drop or Reject.
+	PacketCapture *RulePacketCapture
+	// RejectResponse is the response sent to the client when its traffic is
+	// rejected by the rule. Empty means the default response of the protocol.
+	RejectResponse crdv1beta1.RejectResponseType
+}
+
+// RulePacketCapture describes the packet capture of a rule.
+type RulePacketCapture struct {
+	// MaxPackets is the maximum number of packets captured for the rule on each Node.
+	MaxPackets int32
+}
+
+// RateLimitUnit is the unit of the rate and burst of a RateLimit.
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitPacketsPerSecond means that the rate is in packets per
+	// second, and the burst is in packets.
+	RateLimitUnitPacketsPerSecond RateLimitUnit = "pps"
+	// RateLimitUnitKbitsPerSecond means that the rate is in kilobits per
+	// second, and the burst is in kilobits.
+	RateLimitUnitKbitsPerSecond RateLimitUnit = "kbps"
+)
+
+// RateLimit describes the maximum rate of the traffic matching a rule.
+type RateLimit struct {
+	// Unit is the unit of Rate and Burst.
+	Unit RateLimitUnit
+	// Rate is the maximum rate of the traffic.
+	Rate uint32
+	// Burst is the maximum size of the traffic which can exceed the rate at once.
+	Burst uint32
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -293,6 +336,8 @@ const (
 	ProtocolICMP Protocol = "ICMP"
 
 	ProtocolIGMP Protocol = "IGMP"
+	// ProtocolIPv6ExtensionHeader matches IPv6 packets carrying a specific extension header.
+	ProtocolIPv6ExtensionHeader Protocol = "IPv6ExtensionHeader"
 )
 
 // Service describes a port to allow traffic on.
@@ -320,21 +365,33 @@ type Service struct {
 	// both are not specified and the Protocol is ICMP, this matches all ICMP traffic.
 	ICMPType *int32
 	ICMPCode *int32
+	// EndICMPType and EndICMPCode define the end of the ICMP type and code ranges,
+	// inclusive. They can only be specified when ICMPType and ICMPCode are specified.
+	EndICMPType *int32
+	EndICMPCode *int32
+	// ICMPTypeName matches the ICMP or ICMPv6 type with the given name. It cannot be
+	// specified together with ICMPType.
+	ICMPTypeName crdv1beta1.ICMPTypeName
 
 	// IGMPType and GroupAddress can only be specified when the Protocol is IGMP.
 	IGMPType     *int32
 	GroupAddress string
+
+	// IPv6ExtensionHeader can only be specified when the Protocol is IPv6ExtensionHeader.
+	IPv6ExtensionHeader crdv1beta1.IPv6ExtensionHeaderType
 }
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
 	HTTP *HTTPProtocol
 	TLS  *TLSProtocol
+	GRPC *GRPCProtocol
+	DNS  *DNSProtocol
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All
-// fields could be used alone or together. If all fields are not provided, this
-// matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers
+// and query parameters. All fields could be used alone or together. If all
+// fields are not provided, this matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -344,6 +401,57 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// Headers represents the HTTP request headers to match. A request matches
+	// only if all the headers match.
+	Headers []HTTPHeaderMatch
+	// QueryParams represents the query parameters of the URI to match. A
+	// request matches only if all the query parameters match.
+	QueryParams []HTTPQueryParamMatch
+}
+
+// HTTPHeaderMatch describes how to match an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match. The match is case-insensitive.
+	Name string
+	// Type specifies how to match the value of the header.
+	Type crdv1beta1.StringMatchType
+	// Value is the value of the header to match.
+	Value string
+}
+
+// HTTPQueryParamMatch describes how to match a query parameter of the URI of
+// an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. The match is case-sensitive.
+	Name string
+	// Type specifies how to match the value of the query parameter.
+	Type crdv1beta1.StringMatchType
+	// Value is the value of the query parameter to match.
+	Value string
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string
+	// Headers represents the request metadata to match, which is sent as HTTP/2 headers. A request matches only
+	// if all the headers match.
+	Headers []HTTPHeaderMatch
+}
+
+// DNSProtocol matches DNS queries with specific queried name and record types.
+// All fields could be used alone or together. If all fields are not provided,
+// this matches all DNS queries.
+type DNSProtocol struct {
+	// Name represents the queried domain name to match, without the trailing dot.
+	// Both exact matches and wildcards are supported (Ex. "*.foo.com", "foo.bar.com").
+	Name string
+	// RecordTypes represents the record types of the query to match (Ex. "A", "AAAA").
+	RecordTypes []string
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -424,6 +532,9 @@ type NetworkPolicyStats struct {
 	TrafficStats statsv1alpha1.TrafficStats
 	// The stats of the NetworkPolicy rules. It's empty for K8s NetworkPolicies as they don't have rule name to identify a rule.
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats
+	// The stats of the NetworkPolicy from peer perspective. It's only set for K8s NetworkPolicies, the stats of the
+	// rules of Antrea-native policies include their peer stats.
+	PeerTrafficStats []statsv1alpha1.PeerTrafficStats
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -466,6 +577,10 @@ type Entity struct {
 type NetworkPolicyEvaluationRequest struct {
 	Source      Entity
 	Destination Entity
+	// ProposedPolicies are YAML or JSON manifests of K8s NetworkPolicies, Antrea NetworkPolicies,
+	// Antrea ClusterNetworkPolicies and Tiers, which are evaluated as if they were applied. A
+	// proposed policy or Tier replaces the existing one with the same kind, Namespace and name.
+	ProposedPolicies []string
 }
 
 // RuleRef contains basic information for the rule.
@@ -484,6 +599,56 @@ type NetworkPolicyEvaluationResponse struct {
 	Rule RuleRef
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConnectivityMatrix contains the request and response for computing the effective NetworkPolicy
+// rules between all pairs of Pods of a set.
+type ConnectivityMatrix struct {
+	metav1.TypeMeta
+	Request  *ConnectivityMatrixRequest
+	Response *ConnectivityMatrixResponse
+}
+
+// ConnectivityMatrixRequest selects the Pods of a connectivity matrix, either by ClusterGroup, or
+// by Namespace and/or label selector. Without Namespace, the label selector selects Pods in all
+// Namespaces.
+type ConnectivityMatrixRequest struct {
+	Namespace    string
+	ClusterGroup string
+	// LabelSelector selects Pods by labels, in the same format as label selectors of list options.
+	LabelSelector string
+	// Ports are the destination ports to compute verdicts for. If empty, a single verdict is
+	// computed for each pair of Pods, regardless of the ports selected by rules.
+	Ports []ConnectivityMatrixPort
+}
+
+// ConnectivityMatrixPort is a destination port of a connectivity matrix.
+type ConnectivityMatrixPort struct {
+	Protocol Protocol
+	Port     int32
+}
+
+// ConnectivityMatrixResponse is the response of a connectivity matrix computation.
+type ConnectivityMatrixResponse struct {
+	// Pods are the selected Pods, sorted by Namespace and name.
+	Pods []PodReference
+	// Cells contain the verdicts for each pair of different Pods and each requested port.
+	Cells []ConnectivityMatrixCell
+}
+
+// ConnectivityMatrixCell is the verdict for the traffic from a source Pod to a destination Pod.
+type ConnectivityMatrixCell struct {
+	Source      PodReference
+	Destination PodReference
+	// Port is the destination port, nil when no port is requested.
+	Port *ConnectivityMatrixPort
+	// Verdict is the action applied to the traffic: Allow, Drop or Reject.
+	Verdict crdv1beta1.RuleAction
+	// Rule is the effective rule which decides the verdict, nil when no rule applies and the
+	// traffic is allowed by default.
+	Rule *NetworkPolicyEvaluationResponse
+}
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string
@@ -327,10 +327,178 @@ func (m *ClusterGroupMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterGroupMembers proto.InternalMessageInfo
 
+func (m *ConnectivityMatrix) Reset()      { *m = ConnectivityMatrix{} }
+func (*ConnectivityMatrix) ProtoMessage() {}
+func (*ConnectivityMatrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *ConnectivityMatrix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivityMatrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectivityMatrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityMatrix.Merge(m, src)
+}
+func (m *ConnectivityMatrix) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivityMatrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityMatrix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityMatrix proto.InternalMessageInfo
+
+func (m *ConnectivityMatrixCell) Reset()      { *m = ConnectivityMatrixCell{} }
+func (*ConnectivityMatrixCell) ProtoMessage() {}
+func (*ConnectivityMatrixCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+}
+func (m *ConnectivityMatrixCell) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivityMatrixCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectivityMatrixCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityMatrixCell.Merge(m, src)
+}
+func (m *ConnectivityMatrixCell) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivityMatrixCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityMatrixCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityMatrixCell proto.InternalMessageInfo
+
+func (m *ConnectivityMatrixPort) Reset()      { *m = ConnectivityMatrixPort{} }
+func (*ConnectivityMatrixPort) ProtoMessage() {}
+func (*ConnectivityMatrixPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+}
+func (m *ConnectivityMatrixPort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivityMatrixPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectivityMatrixPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityMatrixPort.Merge(m, src)
+}
+func (m *ConnectivityMatrixPort) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivityMatrixPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityMatrixPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityMatrixPort proto.InternalMessageInfo
+
+func (m *ConnectivityMatrixRequest) Reset()      { *m = ConnectivityMatrixRequest{} }
+func (*ConnectivityMatrixRequest) ProtoMessage() {}
+func (*ConnectivityMatrixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+}
+func (m *ConnectivityMatrixRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivityMatrixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectivityMatrixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityMatrixRequest.Merge(m, src)
+}
+func (m *ConnectivityMatrixRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivityMatrixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityMatrixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityMatrixRequest proto.InternalMessageInfo
+
+func (m *ConnectivityMatrixResponse) Reset()      { *m = ConnectivityMatrixResponse{} }
+func (*ConnectivityMatrixResponse) ProtoMessage() {}
+func (*ConnectivityMatrixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+}
+func (m *ConnectivityMatrixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectivityMatrixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectivityMatrixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityMatrixResponse.Merge(m, src)
+}
+func (m *ConnectivityMatrixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectivityMatrixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityMatrixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityMatrixResponse proto.InternalMessageInfo
+
+func (m *DNSProtocol) Reset()      { *m = DNSProtocol{} }
+func (*DNSProtocol) ProtoMessage() {}
+func (*DNSProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+}
+func (m *DNSProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSProtocol.Merge(m, src)
+}
+func (m *DNSProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSProtocol proto.InternalMessageInfo
+
 func (m *EgressGroup) Reset()      { *m = EgressGroup{} }
 func (*EgressGroup) ProtoMessage() {}
 func (*EgressGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
 }
 func (m *EgressGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +526,7 @@ var xxx_messageInfo_EgressGroup proto.InternalMessageInfo
 func (m *EgressGroupList) Reset()      { *m = EgressGroupList{} }
 func (*EgressGroupList) ProtoMessage() {}
 func (*EgressGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
 }
 func (m *EgressGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +554,7 @@ var xxx_messageInfo_EgressGroupList proto.InternalMessageInfo
 func (m *EgressGroupPatch) Reset()      { *m = EgressGroupPatch{} }
 func (*EgressGroupPatch) ProtoMessage() {}
 func (*EgressGroupPatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
 }
 func (m *EgressGroupPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +582,7 @@ var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 func (m *Entity) Reset()      { *m = Entity{} }
 func (*Entity) ProtoMessage() {}
 func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *Entity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +610,7 @@ var xxx_messageInfo_Entity proto.InternalMessageInfo
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,10 +635,38 @@ func (m *ExternalEntityReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 
+func (m *GRPCProtocol) Reset()      { *m = GRPCProtocol{} }
+func (*GRPCProtocol) ProtoMessage() {}
+func (*GRPCProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+}
+func (m *GRPCProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProtocol.Merge(m, src)
+}
+func (m *GRPCProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProtocol proto.InternalMessageInfo
+
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +694,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +722,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupMembers) Reset()      { *m = GroupMembers{} }
 func (*GroupMembers) ProtoMessage() {}
 func (*GroupMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +750,7 @@ var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,10 +775,38 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPHeaderMatch) Reset()      { *m = HTTPHeaderMatch{} }
+func (*HTTPHeaderMatch) ProtoMessage() {}
+func (*HTTPHeaderMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+}
+func (m *HTTPHeaderMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderMatch.Merge(m, src)
+}
+func (m *HTTPHeaderMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderMatch proto.InternalMessageInfo
+
 func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
 func (*HTTPProtocol) ProtoMessage() {}
 func (*HTTPProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
 }
 func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,10 +831,38 @@ func (m *HTTPProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
 
+func (m *HTTPQueryParamMatch) Reset()      { *m = HTTPQueryParamMatch{} }
+func (*HTTPQueryParamMatch) ProtoMessage() {}
+func (*HTTPQueryParamMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+}
+func (m *HTTPQueryParamMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPQueryParamMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPQueryParamMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPQueryParamMatch.Merge(m, src)
+}
+func (m *HTTPQueryParamMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPQueryParamMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPQueryParamMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPQueryParamMatch proto.InternalMessageInfo
+
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +890,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPGroupAssociation) Reset()      { *m = IPGroupAssociation{} }
 func (*IPGroupAssociation) ProtoMessage() {}
 func (*IPGroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *IPGroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +918,7 @@ var xxx_messageInfo_IPGroupAssociation proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +946,7 @@ var xxx_messageInfo_IPNet proto.InternalMessageInfo
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +974,7 @@ var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +1002,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +1030,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +1058,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluation) Reset()      { *m = NetworkPolicyEvaluation{} }
 func (*NetworkPolicyEvaluation) ProtoMessage() {}
 func (*NetworkPolicyEvaluation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyEvaluation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1086,7 @@ var xxx_messageInfo_NetworkPolicyEvaluation proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationRequest) Reset()      { *m = NetworkPolicyEvaluationRequest{} }
 func (*NetworkPolicyEvaluationRequest) ProtoMessage() {}
 func (*NetworkPolicyEvaluationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyEvaluationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1114,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationRequest proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationResponse) Reset()      { *m = NetworkPolicyEvaluationResponse{} }
 func (*NetworkPolicyEvaluationResponse) ProtoMessage() {}
 func (*NetworkPolicyEvaluationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyEvaluationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +1142,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationResponse proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1170,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1198,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1226,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1254,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1282,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1310,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1338,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1366,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1394,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1422,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,10 +1447,66 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RulePacketCapture) Reset()      { *m = RulePacketCapture{} }
+func (*RulePacketCapture) ProtoMessage() {}
+func (*RulePacketCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{51}
+}
+func (m *RulePacketCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RulePacketCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RulePacketCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RulePacketCapture.Merge(m, src)
+}
+func (m *RulePacketCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *RulePacketCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_RulePacketCapture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RulePacketCapture proto.InternalMessageInfo
+
 func (m *RuleRef) Reset()      { *m = RuleRef{} }
 func (*RuleRef) ProtoMessage() {}
 func (*RuleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{52}
 }
 func (m *RuleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1534,7 @@ var xxx_messageInfo_RuleRef proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{53}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1562,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{54}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1590,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+	return fileDescriptor_fbaa7d016762fa1d, []int{55}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1618,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{45}
+	return fileDescriptor_fbaa7d016762fa1d, []int{56}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1646,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+	return fileDescriptor_fbaa7d016762fa1d, []int{57}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1674,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+	return fileDescriptor_fbaa7d016762fa1d, []int{58}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1394,7 +1702,7 @@ var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+	return fileDescriptor_fbaa7d016762fa1d, []int{59}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,16 +1738,25 @@ func init() {
 	proto.RegisterType((*BundleFileServer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleFileServer")
 	proto.RegisterType((*BundleServerAuthConfiguration)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleServerAuthConfiguration")
 	proto.RegisterType((*ClusterGroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ClusterGroupMembers")
+	proto.RegisterType((*ConnectivityMatrix)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ConnectivityMatrix")
+	proto.RegisterType((*ConnectivityMatrixCell)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ConnectivityMatrixCell")
+	proto.RegisterType((*ConnectivityMatrixPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ConnectivityMatrixPort")
+	proto.RegisterType((*ConnectivityMatrixRequest)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ConnectivityMatrixRequest")
+	proto.RegisterType((*ConnectivityMatrixResponse)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ConnectivityMatrixResponse")
+	proto.RegisterType((*DNSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.DNSProtocol")
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
 	proto.RegisterType((*Entity)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Entity")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
+	proto.RegisterType((*GRPCProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GRPCProtocol")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMembers")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPHeaderMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPHeaderMatch")
 	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
+	proto.RegisterType((*HTTPQueryParamMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPQueryParamMatch")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
@@ -1461,6 +1778,8 @@ func init() {
 	proto.RegisterType((*NodeStatsSummary)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NodeStatsSummary")
 	proto.RegisterType((*PaginationGetOptions)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PaginationGetOptions")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
+	proto.RegisterType((*RateLimit)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RateLimit")
+	proto.RegisterType((*RulePacketCapture)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RulePacketCapture")
 	proto.RegisterType((*RuleRef)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleRef")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
@@ -1476,198 +1795,248 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 3050 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x6c, 0x24, 0x47,
-	0xd5, 0xdb, 0xf3, 0x63, 0x7b, 0xde, 0xd8, 0x5e, 0x6f, 0x39, 0xc9, 0xfa, 0x4b, 0xb2, 0xf6, 0xa6,
-	0xf3, 0x7d, 0xd1, 0x7e, 0x28, 0x8c, 0xb3, 0x26, 0xc9, 0x2e, 0xf9, 0x13, 0x1e, 0xaf, 0xd7, 0x19,
-	0x62, 0x7b, 0x27, 0x65, 0x27, 0x11, 0x09, 0x09, 0x69, 0x77, 0xd7, 0x8c, 0x9b, 0xed, 0xe9, 0xee,
-	0xad, 0xaa, 0x76, 0xd6, 0x39, 0xa0, 0x20, 0xe0, 0x10, 0x02, 0x04, 0x71, 0x41, 0xb9, 0x71, 0xe3,
-	0xc2, 0x8d, 0x5b, 0x4e, 0xe4, 0x80, 0x94, 0x63, 0x10, 0x42, 0xe4, 0x64, 0x11, 0x23, 0x82, 0x72,
-	0x40, 0x48, 0xdc, 0x58, 0x84, 0x84, 0xea, 0xa7, 0xff, 0x66, 0x3c, 0xeb, 0x1d, 0xdb, 0x6b, 0x10,
-	0xd9, 0x93, 0xa7, 0xdf, 0x7b, 0xf5, 0xde, 0xab, 0x7a, 0xef, 0xd5, 0xfb, 0xe9, 0x36, 0x3c, 0x63,
-	0xf9, 0x9c, 0x12, 0xab, 0xe6, 0x06, 0xb3, 0xea, 0xd7, 0x6c, 0x78, 0xb5, 0x3d, 0x6b, 0x85, 0x2e,
-	0x9b, 0xb5, 0x03, 0x9f, 0xd3, 0xc0, 0x0b, 0x3d, 0xcb, 0x27, 0xb3, 0x5b, 0xe7, 0x37, 0x08, 0xb7,
-	0xe6, 0x66, 0xdb, 0xc4, 0x27, 0xd4, 0xe2, 0xc4, 0xa9, 0x85, 0x34, 0xe0, 0x01, 0xaa, 0xa9, 0x55,
-	0xdf, 0x70, 0x03, 0xfd, 0xab, 0x16, 0x5e, 0x6d, 0xd7, 0xc4, 0xfa, 0x5a, 0x76, 0x7d, 0x4d, 0xaf,
-	0xbf, 0xf7, 0x62, 0x7f, 0x79, 0x8c, 0x5b, 0x9c, 0xcd, 0x6e, 0x9d, 0xb7, 0xbc, 0x70, 0xd3, 0x3a,
-	0xdf, 0x2d, 0xe9, 0xde, 0x2f, 0xb6, 0x5d, 0xbe, 0x19, 0x6d, 0xd4, 0xec, 0xa0, 0x33, 0xdb, 0x0e,
-	0xda, 0xc1, 0xac, 0x04, 0x6f, 0x44, 0x2d, 0xf9, 0x24, 0x1f, 0xe4, 0x2f, 0x4d, 0xfe, 0xe8, 0xd5,
-	0x8b, 0x4c, 0x4a, 0x09, 0xdd, 0x8e, 0x65, 0x6f, 0xba, 0x3e, 0xa1, 0xdb, 0xa9, 0xac, 0x0e, 0xe1,
-	0xd6, 0xec, 0x56, 0xaf, 0x90, 0xd9, 0x7e, 0xab, 0x68, 0xe4, 0x73, 0xb7, 0x43, 0x7a, 0x16, 0x3c,
-	0xbe, 0xdf, 0x02, 0x66, 0x6f, 0x92, 0x8e, 0xd5, 0xb3, 0xee, 0x4b, 0xfd, 0xd6, 0x45, 0xdc, 0xf5,
-	0x66, 0x5d, 0x9f, 0x33, 0x4e, 0xbb, 0x17, 0x99, 0x7f, 0x36, 0x60, 0x74, 0xde, 0x71, 0x28, 0x61,
-	0x6c, 0x89, 0x06, 0x51, 0x88, 0x5e, 0x87, 0x11, 0xb1, 0x13, 0xc7, 0xe2, 0xd6, 0x94, 0x71, 0xd6,
-	0x38, 0x57, 0x9d, 0x7b, 0xa4, 0xa6, 0x18, 0xd7, 0xb2, 0x8c, 0x53, 0x9b, 0x08, 0xea, 0xda, 0xd6,
-	0xf9, 0xda, 0x95, 0x8d, 0x6f, 0x12, 0x9b, 0xaf, 0x10, 0x6e, 0xd5, 0xd1, 0x87, 0x3b, 0x33, 0x27,
-	0x76, 0x77, 0x66, 0x20, 0x85, 0xe1, 0x84, 0x2b, 0x8a, 0x60, 0xb4, 0x2d, 0x44, 0xad, 0x90, 0xce,
-	0x06, 0xa1, 0x6c, 0xaa, 0x70, 0xb6, 0x78, 0xae, 0x3a, 0xf7, 0xe4, 0x80, 0x66, 0xaf, 0x2d, 0xa5,
-	0x3c, 0xea, 0x77, 0x69, 0x81, 0xa3, 0x19, 0x20, 0xc3, 0x39, 0x31, 0xe6, 0x6f, 0x0d, 0x98, 0xc8,
-	0xee, 0x74, 0xd9, 0x65, 0x1c, 0x7d, 0xbd, 0x67, 0xb7, 0xb5, 0x5b, 0xdb, 0xad, 0x58, 0x2d, 0xf7,
-	0x3a, 0xa1, 0x45, 0x8f, 0xc4, 0x90, 0xcc, 0x4e, 0x2d, 0x28, 0xbb, 0x9c, 0x74, 0xe2, 0x2d, 0x3e,
-	0x35, 0xe8, 0x16, 0xb3, 0xea, 0xd6, 0xc7, 0xb4, 0xa0, 0x72, 0x43, 0xb0, 0xc4, 0x8a, 0xb3, 0xf9,
-	0x76, 0x11, 0x4e, 0x65, 0xc9, 0x9a, 0x16, 0xb7, 0x37, 0x8f, 0xc1, 0x88, 0xdf, 0x35, 0xe0, 0x94,
-	0xe5, 0x38, 0xc4, 0x59, 0x3a, 0x62, 0x53, 0xfe, 0x8f, 0x16, 0x2b, 0x76, 0x95, 0xe7, 0x8e, 0x7b,
-	0x05, 0xa2, 0xef, 0x1b, 0x30, 0x49, 0x49, 0x27, 0xd8, 0xea, 0x52, 0xa4, 0x78, 0x78, 0x45, 0xee,
-	0xd3, 0x8a, 0x4c, 0xe2, 0x5e, 0xfe, 0x78, 0x2f, 0xa1, 0xe6, 0x67, 0x06, 0x8c, 0xcf, 0x87, 0xa1,
-	0xe7, 0x12, 0x67, 0x3d, 0xf8, 0x2f, 0x8f, 0xa6, 0xdf, 0x1b, 0x80, 0xf2, 0x7b, 0x3d, 0x86, 0x78,
-	0xb2, 0xf3, 0xf1, 0xf4, 0xcc, 0xc0, 0xf1, 0x94, 0x53, 0xb8, 0x4f, 0x44, 0xbd, 0x53, 0x84, 0xc9,
-	0x3c, 0xe1, 0x9d, 0x98, 0xfa, 0xf7, 0xc5, 0xd4, 0x35, 0x98, 0xac, 0x5b, 0xcc, 0xb5, 0xe7, 0x23,
-	0xbe, 0x49, 0x7c, 0xee, 0xda, 0x16, 0x77, 0x03, 0x1f, 0x3d, 0x0c, 0x23, 0x11, 0x23, 0xd4, 0xb7,
-	0x3a, 0x44, 0x1a, 0xa3, 0x92, 0xfa, 0xcd, 0x0b, 0x1a, 0x8e, 0x13, 0x0a, 0x41, 0x1d, 0x5a, 0x8c,
-	0xbd, 0x11, 0x50, 0x67, 0xaa, 0x90, 0xa7, 0x6e, 0x6a, 0x38, 0x4e, 0x28, 0xcc, 0xf3, 0x30, 0x51,
-	0x8f, 0x7c, 0xc7, 0x23, 0x97, 0x5d, 0x8f, 0xac, 0x11, 0xba, 0x45, 0x28, 0x3a, 0x03, 0xc5, 0x88,
-	0x7a, 0x5a, 0x54, 0x55, 0x2f, 0x2e, 0xbe, 0x80, 0x97, 0xb1, 0x80, 0x9b, 0xef, 0x16, 0xe0, 0x8c,
-	0x5a, 0xa3, 0xe8, 0x85, 0xb6, 0x0b, 0x81, 0xdf, 0x72, 0xdb, 0x11, 0x55, 0x0a, 0x3f, 0x06, 0xd5,
-	0x0d, 0x62, 0x51, 0x42, 0xd7, 0x83, 0xab, 0xc4, 0xd7, 0x8c, 0x26, 0x35, 0xa3, 0x6a, 0x3d, 0x45,
-	0xe1, 0x2c, 0x1d, 0x7a, 0x08, 0x86, 0xac, 0xd0, 0x7d, 0x8e, 0x6c, 0x6b, 0xbd, 0xc7, 0xf5, 0x8a,
-	0xa1, 0xf9, 0x66, 0xe3, 0x39, 0xb2, 0x8d, 0x35, 0x16, 0xfd, 0xc8, 0x80, 0xc9, 0x8d, 0xde, 0x73,
-	0x9a, 0x2a, 0x4a, 0x47, 0x5d, 0x18, 0xd4, 0x66, 0x7b, 0x1c, 0x79, 0xfd, 0xb4, 0xb0, 0xdb, 0x1e,
-	0x08, 0xbc, 0x97, 0x60, 0xf3, 0x67, 0x25, 0x98, 0x5c, 0xf0, 0x22, 0xc6, 0x09, 0xcd, 0x39, 0xd7,
-	0xed, 0x8f, 0xa2, 0x6f, 0x1b, 0x30, 0x41, 0x5a, 0x2d, 0x62, 0x73, 0x77, 0x8b, 0x1c, 0x61, 0x10,
-	0x4d, 0x69, 0xa9, 0x13, 0x8b, 0x5d, 0xcc, 0x71, 0x8f, 0x38, 0xf4, 0x2d, 0x38, 0x95, 0xc0, 0x1a,
-	0xcd, 0xba, 0x17, 0xd8, 0x57, 0xe3, 0xf8, 0x79, 0x6c, 0x50, 0x1d, 0x1a, 0xcd, 0x55, 0xc2, 0xd3,
-	0x10, 0x5e, 0xec, 0xe6, 0x8b, 0x7b, 0x45, 0xa1, 0x8b, 0x30, 0xca, 0x03, 0x6e, 0x79, 0xf1, 0xf6,
-	0x4b, 0x67, 0x8d, 0x73, 0xc5, 0xf4, 0x5e, 0x5f, 0xcf, 0xe0, 0x70, 0x8e, 0x12, 0xcd, 0x01, 0xc8,
-	0xe7, 0xa6, 0xd5, 0x26, 0x6c, 0xaa, 0x2c, 0xd7, 0x25, 0xe7, 0xbd, 0x9e, 0x60, 0x70, 0x86, 0x4a,
-	0xf8, 0xb6, 0x1d, 0x51, 0x4a, 0x7c, 0x2e, 0x9e, 0xa7, 0x86, 0xe4, 0xa2, 0xc4, 0xb7, 0x17, 0x52,
-	0x14, 0xce, 0xd2, 0x99, 0x9f, 0x1a, 0x50, 0x5d, 0x6c, 0x7f, 0x0e, 0x2a, 0xcf, 0xdf, 0x18, 0x70,
-	0x32, 0xb3, 0xd1, 0x63, 0x48, 0x94, 0xaf, 0xe7, 0x13, 0xe5, 0xc0, 0x3b, 0xcc, 0x68, 0xdb, 0x27,
-	0x4b, 0xfe, 0xa0, 0x08, 0x13, 0x19, 0x2a, 0x95, 0x22, 0x1d, 0x80, 0x20, 0x39, 0xf7, 0x23, 0xb5,
-	0x61, 0x86, 0xef, 0x9d, 0x34, 0xb9, 0x47, 0x9a, 0xb4, 0x60, 0x68, 0xd1, 0xe7, 0x2e, 0xdf, 0x46,
-	0x2f, 0x41, 0x31, 0x0c, 0x1c, 0x7d, 0xf8, 0x03, 0x77, 0x1c, 0xcd, 0xc0, 0xc1, 0xa4, 0x45, 0x28,
-	0xf1, 0x6d, 0x52, 0x1f, 0x16, 0x39, 0x4e, 0x40, 0x04, 0x47, 0xd3, 0x83, 0xd3, 0x8b, 0xd7, 0xb9,
-	0xc8, 0xa8, 0x9e, 0x12, 0x95, 0x10, 0xa2, 0xb3, 0x50, 0xca, 0x64, 0xe2, 0x51, 0xad, 0x7d, 0x69,
-	0x55, 0x64, 0x61, 0x89, 0x41, 0xb3, 0x50, 0x11, 0x7f, 0x59, 0x68, 0xd9, 0x44, 0xa7, 0xb2, 0x53,
-	0x9a, 0xac, 0xb2, 0x1a, 0x23, 0x70, 0x4a, 0x63, 0xfe, 0xc3, 0x80, 0x09, 0xb9, 0xc3, 0x79, 0xc6,
-	0x02, 0xdb, 0x55, 0x49, 0xf4, 0x58, 0x4a, 0xb0, 0x09, 0x4b, 0x4b, 0xd4, 0x47, 0x7c, 0xe0, 0x6a,
-	0x53, 0xae, 0x4e, 0x4f, 0x33, 0xc9, 0x1f, 0xf3, 0x5d, 0xfc, 0x71, 0x8f, 0x44, 0xf3, 0xfd, 0x12,
-	0x54, 0x33, 0xf6, 0xbd, 0x6d, 0x46, 0x45, 0xdf, 0x31, 0x60, 0x9c, 0xe4, 0xac, 0x2a, 0xad, 0x53,
-	0x9d, 0x5b, 0x1a, 0xf8, 0xca, 0xd8, 0xdb, 0x37, 0xea, 0x68, 0x77, 0x67, 0x66, 0xbc, 0x0b, 0xd9,
-	0x25, 0x12, 0x3d, 0x04, 0x45, 0x37, 0x54, 0x91, 0x33, 0x5a, 0xbf, 0x4b, 0x28, 0xd8, 0x68, 0xb2,
-	0x1b, 0x3b, 0x33, 0x95, 0x46, 0x53, 0xf7, 0xb6, 0x58, 0x10, 0xa0, 0xd7, 0xa0, 0x1c, 0x06, 0x94,
-	0x8b, 0x7c, 0x26, 0x2c, 0xf2, 0xe5, 0x41, 0x75, 0x14, 0x9e, 0xe6, 0x34, 0x03, 0xca, 0xd3, 0x4b,
-	0x4d, 0x3c, 0x31, 0xac, 0xd8, 0xa2, 0x57, 0xa0, 0xe4, 0x07, 0x0e, 0x91, 0x69, 0xaf, 0x3a, 0xf7,
-	0xf4, 0xc0, 0xec, 0x03, 0x87, 0xa4, 0x1b, 0x1f, 0x91, 0x21, 0x20, 0x40, 0x92, 0x29, 0x6a, 0xc3,
-	0x30, 0x23, 0x74, 0xcb, 0xb5, 0x55, 0x86, 0xac, 0xce, 0x7d, 0x65, 0x50, 0xfe, 0x6b, 0x6a, 0x79,
-	0x2a, 0xa2, 0xba, 0xbb, 0x33, 0x33, 0x1c, 0x43, 0x63, 0xee, 0xe6, 0x7b, 0x25, 0x18, 0xbd, 0x53,
-	0x73, 0xdd, 0xa9, 0xb9, 0xf6, 0xaa, 0xb9, 0x7e, 0x6e, 0xc0, 0x78, 0xfe, 0x5e, 0xca, 0x5f, 0xcd,
-	0xc6, 0xfe, 0x57, 0x73, 0x72, 0xdb, 0x17, 0xfa, 0xde, 0xf6, 0x75, 0x28, 0x46, 0xae, 0x23, 0x9b,
-	0x8f, 0x4a, 0xfd, 0x91, 0xa4, 0x5b, 0x6a, 0x5c, 0xba, 0xb1, 0x33, 0xf3, 0x40, 0xbf, 0x29, 0x25,
-	0xdf, 0x0e, 0x09, 0xab, 0xbd, 0xd0, 0xb8, 0x84, 0xc5, 0x62, 0xf3, 0x4d, 0x18, 0x7d, 0x76, 0x7d,
-	0xbd, 0xd9, 0xa4, 0x01, 0x0f, 0xec, 0xc0, 0x13, 0x52, 0x37, 0x03, 0xc6, 0xbb, 0x73, 0xcc, 0xb3,
-	0x01, 0xe3, 0x58, 0x62, 0x44, 0xaf, 0xd4, 0x21, 0x7c, 0x33, 0x70, 0xba, 0x7b, 0xa5, 0x15, 0x09,
-	0xc5, 0x1a, 0x2b, 0x38, 0x85, 0x16, 0xdf, 0xd4, 0xea, 0x25, 0x9c, 0x9a, 0x16, 0xdf, 0xc4, 0x12,
-	0x63, 0x7e, 0x60, 0xc0, 0xb0, 0xb6, 0x2b, 0x7a, 0x09, 0x4a, 0xb6, 0xeb, 0x50, 0x1d, 0x38, 0x07,
-	0xf4, 0xa4, 0x44, 0xc8, 0x42, 0xe3, 0x12, 0xc6, 0x92, 0x21, 0x7a, 0x15, 0x86, 0xc8, 0x75, 0x9b,
-	0x84, 0x5c, 0x07, 0xca, 0x01, 0x59, 0x27, 0xbb, 0x5c, 0x94, 0xcc, 0xb0, 0x66, 0x6a, 0xfe, 0xd3,
-	0x00, 0xd4, 0x68, 0x7e, 0x7e, 0x53, 0x68, 0x0b, 0xca, 0xf2, 0x80, 0xd0, 0x83, 0x50, 0x70, 0x43,
-	0xb9, 0xd7, 0xd1, 0xfa, 0xe4, 0xee, 0xce, 0x4c, 0xa1, 0xd1, 0xcc, 0xa7, 0x96, 0x82, 0x1b, 0x8a,
-	0xe0, 0x0d, 0x29, 0x69, 0xb9, 0xd7, 0x97, 0x89, 0xdf, 0xe6, 0x9b, 0xd2, 0x83, 0xca, 0x69, 0xf0,
-	0x36, 0x33, 0x38, 0x9c, 0xa3, 0x34, 0x7f, 0x65, 0x00, 0x2c, 0x5f, 0x48, 0xdc, 0xf4, 0x65, 0x28,
-	0x6d, 0x72, 0x1e, 0x1e, 0x34, 0x55, 0x67, 0x5d, 0x5e, 0x65, 0x10, 0x01, 0xc1, 0x92, 0x27, 0x7a,
-	0x11, 0x8a, 0xdc, 0x63, 0x3a, 0x41, 0x0f, 0x7c, 0xaf, 0xae, 0x2f, 0xaf, 0x25, 0x9c, 0x65, 0x11,
-	0xb0, 0xbe, 0xbc, 0x86, 0x05, 0x43, 0xf3, 0x3d, 0x03, 0xd0, 0x4a, 0xe4, 0x89, 0xde, 0x9d, 0x71,
-	0x79, 0x7c, 0x0d, 0xbf, 0x15, 0xa0, 0x07, 0xa1, 0x2c, 0xdb, 0x18, 0x1d, 0x72, 0x49, 0xca, 0x54,
-	0x46, 0x51, 0x38, 0xf4, 0x1a, 0x94, 0xc2, 0xc0, 0x39, 0xf0, 0x84, 0x3b, 0x57, 0x9a, 0xa4, 0xa1,
-	0x18, 0x38, 0x0c, 0x4b, 0xbe, 0xe6, 0xdb, 0x06, 0x54, 0x92, 0xb4, 0x2d, 0x43, 0x37, 0xa0, 0xea,
-	0x12, 0x28, 0x67, 0xe9, 0x29, 0xc7, 0x12, 0x73, 0x0b, 0x97, 0xd3, 0x45, 0x18, 0x09, 0xf5, 0x39,
-	0xe8, 0x2b, 0xe0, 0xfe, 0x64, 0x18, 0xa4, 0xe1, 0x37, 0x32, 0xbf, 0x71, 0x42, 0x6d, 0xfe, 0xa5,
-	0x08, 0x63, 0xab, 0x84, 0xbf, 0x11, 0xd0, 0xab, 0xcd, 0xc0, 0x73, 0xed, 0xed, 0x63, 0x88, 0xa6,
-	0x16, 0x94, 0x69, 0xe4, 0x91, 0xf8, 0x80, 0xe7, 0x07, 0xae, 0x49, 0xb2, 0xfa, 0xe2, 0xc8, 0x23,
-	0xa9, 0x1d, 0xc5, 0x13, 0xc3, 0x8a, 0x3d, 0x7a, 0x1a, 0x4e, 0x5a, 0xb9, 0xa1, 0xa7, 0xca, 0x9d,
-	0x15, 0x19, 0x32, 0x27, 0xf3, 0xf3, 0x50, 0x86, 0xbb, 0x69, 0xd1, 0x39, 0x71, 0xa8, 0x6e, 0x40,
-	0x45, 0x01, 0x29, 0x12, 0x9f, 0x51, 0x1f, 0x55, 0x07, 0xaa, 0x60, 0x38, 0xc1, 0xa2, 0x47, 0x61,
-	0x94, 0xbb, 0x84, 0xc6, 0x18, 0x99, 0xee, 0xca, 0xf5, 0x09, 0x99, 0x22, 0x33, 0x70, 0x9c, 0xa3,
-	0x42, 0x0c, 0x2a, 0x2c, 0x88, 0xa8, 0x2c, 0x7e, 0x74, 0xf9, 0x74, 0xf9, 0x70, 0x47, 0x91, 0x78,
-	0xdd, 0x98, 0x48, 0x74, 0x6b, 0x31, 0x73, 0x9c, 0xca, 0x31, 0xdf, 0x29, 0xc0, 0xe9, 0xdc, 0xa2,
-	0xc5, 0x2d, 0xcb, 0x8b, 0xd4, 0x3d, 0x1a, 0xc1, 0x30, 0x25, 0xd7, 0x22, 0xa2, 0x33, 0x52, 0x75,
-	0x6e, 0xf5, 0x50, 0xea, 0xa4, 0x9c, 0xb1, 0xe2, 0xaa, 0x6a, 0x3b, 0xfd, 0x80, 0x63, 0x59, 0x68,
-	0x1b, 0x46, 0x28, 0x61, 0x61, 0xe0, 0x33, 0xa2, 0xef, 0x81, 0x2b, 0x47, 0x26, 0x57, 0xb1, 0x55,
-	0x86, 0x8b, 0x9f, 0x70, 0x22, 0xce, 0xfc, 0xab, 0x01, 0xd3, 0x37, 0xd7, 0x19, 0xbd, 0x06, 0x43,
-	0xea, 0xf4, 0xf4, 0x99, 0x3c, 0x3e, 0x70, 0x13, 0x21, 0xfb, 0x81, 0x34, 0xa7, 0x69, 0xb3, 0x68,
-	0xae, 0xa8, 0x03, 0x55, 0x87, 0x30, 0xee, 0xfa, 0x6a, 0xb8, 0x59, 0x38, 0x94, 0x90, 0xa4, 0x58,
-	0xba, 0x94, 0xb2, 0xc4, 0x59, 0xfe, 0xe6, 0x2f, 0x0b, 0x30, 0xb3, 0xcf, 0x69, 0x89, 0x06, 0x6a,
-	0xcc, 0xcf, 0xd2, 0xe8, 0xad, 0x1f, 0x95, 0x77, 0xde, 0xad, 0xb5, 0xcc, 0x5f, 0x3c, 0x38, 0x2f,
-	0x53, 0xd4, 0x70, 0x22, 0x8c, 0x1b, 0xbe, 0x43, 0xae, 0xeb, 0xdc, 0x95, 0xd4, 0x70, 0x38, 0x46,
-	0xe0, 0x94, 0x06, 0x7d, 0x0d, 0x4a, 0xe2, 0x41, 0xcf, 0x87, 0x2f, 0x0c, 0xaa, 0xac, 0xe0, 0x89,
-	0x49, 0x2b, 0xbd, 0x5f, 0x25, 0x40, 0xb2, 0x34, 0x7f, 0x67, 0xc0, 0xa9, 0x9c, 0xb2, 0xc7, 0x30,
-	0xef, 0xda, 0xc8, 0xcf, 0xbb, 0x9e, 0x3e, 0xd4, 0xe1, 0xf7, 0x99, 0x78, 0xfd, 0xcd, 0xe8, 0xba,
-	0x0d, 0x44, 0x6f, 0xb7, 0xc6, 0x2d, 0x1e, 0x31, 0xf4, 0x30, 0x8c, 0x88, 0x1e, 0x6f, 0x75, 0x8f,
-	0xd7, 0x11, 0xab, 0x1a, 0x8e, 0x13, 0x0a, 0x51, 0xef, 0xeb, 0xd7, 0xf0, 0xb1, 0x17, 0x67, 0xea,
-	0xfd, 0xa5, 0x04, 0x83, 0x33, 0x54, 0xe8, 0xab, 0x80, 0x28, 0xb1, 0x3c, 0xf7, 0x4d, 0xf9, 0x78,
-	0xd9, 0x72, 0xbd, 0x88, 0x2a, 0xf3, 0x8d, 0xd4, 0xef, 0xd5, 0x6b, 0x11, 0xee, 0xa1, 0xc0, 0x7b,
-	0xac, 0x42, 0xff, 0x0f, 0xc3, 0x1d, 0xc2, 0x98, 0xe8, 0x1b, 0x4a, 0x52, 0xd9, 0x93, 0x9a, 0xc1,
-	0xf0, 0x8a, 0x02, 0xe3, 0x18, 0x2f, 0x5f, 0x2f, 0xe7, 0x36, 0xdd, 0x24, 0x84, 0xa2, 0x0b, 0x30,
-	0x66, 0x65, 0xde, 0x39, 0xb3, 0x29, 0x43, 0xa6, 0x8a, 0x53, 0xc2, 0x4f, 0xb3, 0x2f, 0xa3, 0x19,
-	0xce, 0xd3, 0x21, 0x02, 0x23, 0x6e, 0xa8, 0x5b, 0x33, 0x65, 0xaa, 0x0b, 0x83, 0x57, 0xbd, 0x72,
-	0x7d, 0x7a, 0xc0, 0x49, 0x4f, 0x96, 0xb0, 0x46, 0x33, 0x50, 0x6e, 0x5d, 0x73, 0xfc, 0x38, 0x85,
-	0x55, 0x84, 0x2d, 0x2f, 0x3f, 0x7f, 0x69, 0x95, 0x61, 0x05, 0x47, 0x5c, 0x74, 0x5c, 0xba, 0x71,
-	0x8e, 0xa7, 0x09, 0x87, 0x6f, 0xc7, 0x33, 0x3d, 0x5b, 0xcc, 0x1b, 0x67, 0xe4, 0x88, 0x1c, 0xeb,
-	0x59, 0x1b, 0xc4, 0x6b, 0x38, 0x44, 0x5c, 0x41, 0xae, 0x6c, 0xf6, 0x8a, 0xe7, 0xc6, 0x54, 0x8e,
-	0x5d, 0xce, 0xa3, 0x70, 0x37, 0xad, 0xf9, 0xa9, 0x01, 0xf7, 0xec, 0x7d, 0x4b, 0xa0, 0xc7, 0xa0,
-	0x24, 0xda, 0x27, 0xed, 0x7b, 0x0f, 0xc4, 0x51, 0xb9, 0xbe, 0x1d, 0x92, 0x1b, 0x3b, 0x33, 0x79,
-	0x0b, 0x0a, 0x20, 0x96, 0xe4, 0x03, 0x4f, 0xe5, 0x92, 0xea, 0xaa, 0xb8, 0x5f, 0xeb, 0x57, 0x3a,
-	0x4c, 0xeb, 0xf7, 0xc1, 0x50, 0x97, 0xd3, 0x89, 0xdb, 0x05, 0x3d, 0x05, 0x15, 0xc7, 0xa5, 0xa2,
-	0xe9, 0x0e, 0xe2, 0xf7, 0x67, 0xd3, 0xb1, 0xb2, 0x97, 0x62, 0xc4, 0x8d, 0xec, 0x03, 0x4e, 0x17,
-	0x20, 0x1b, 0x4a, 0x2d, 0x1a, 0x74, 0x74, 0xce, 0x38, 0x5c, 0x19, 0x25, 0x62, 0x20, 0xdd, 0xfc,
-	0x65, 0x1a, 0x74, 0xb0, 0x64, 0x8e, 0x5e, 0x85, 0x02, 0x0f, 0xf4, 0x9d, 0x7a, 0x04, 0x22, 0x40,
-	0x8b, 0x28, 0xac, 0x07, 0xb8, 0xc0, 0x03, 0x11, 0x3d, 0x2c, 0xef, 0xb3, 0x17, 0x0e, 0xe8, 0xb3,
-	0x69, 0xf4, 0x24, 0x8e, 0x9a, 0xb0, 0x96, 0x6f, 0x4b, 0xbb, 0xaa, 0xb3, 0xb4, 0x40, 0xee, 0xa9,
-	0xe7, 0x5e, 0x84, 0x21, 0x4b, 0xd9, 0x64, 0x48, 0xda, 0xe4, 0x19, 0xf9, 0x76, 0x32, 0x36, 0xc6,
-	0x23, 0x37, 0xf9, 0x16, 0x8c, 0x3a, 0xfa, 0x13, 0xb0, 0xf3, 0x32, 0x9f, 0xa8, 0x35, 0x58, 0x73,
-	0x43, 0x4f, 0xc2, 0x18, 0xf1, 0xad, 0x0d, 0x8f, 0x2c, 0x07, 0xed, 0xb6, 0xeb, 0xb7, 0xa7, 0x86,
-	0xe5, 0x5d, 0x97, 0xe4, 0xc3, 0xc5, 0x2c, 0x12, 0xe7, 0x69, 0xf7, 0xaa, 0x66, 0x47, 0x06, 0xa8,
-	0x66, 0x63, 0x37, 0xaf, 0xf4, 0x75, 0xf3, 0x6b, 0x50, 0xf5, 0x92, 0xa6, 0x8f, 0x4d, 0x81, 0xb4,
-	0xc6, 0x13, 0x83, 0x5a, 0x23, 0xed, 0x1b, 0xd3, 0x6a, 0x24, 0x85, 0x31, 0x9c, 0x95, 0x21, 0xcc,
-	0xe2, 0x05, 0x6d, 0x79, 0x4b, 0x4c, 0x55, 0xf3, 0x39, 0x66, 0x59, 0xc3, 0x71, 0x42, 0x61, 0xbe,
-	0x5b, 0x04, 0x94, 0xf3, 0x28, 0x91, 0xa9, 0xd8, 0x7f, 0x48, 0xb9, 0x12, 0xc2, 0x28, 0xa7, 0x56,
-	0xab, 0xe5, 0xda, 0x52, 0xab, 0x5b, 0x28, 0xe4, 0xe4, 0x87, 0x7c, 0xb5, 0xf8, 0x43, 0xbe, 0xda,
-	0x7a, 0x66, 0x75, 0x66, 0xc4, 0x96, 0x81, 0xe2, 0x9c, 0x04, 0xf4, 0x96, 0x01, 0x13, 0xa2, 0x3a,
-	0xc9, 0x92, 0xe8, 0xe1, 0xe0, 0x13, 0xb7, 0x2e, 0x16, 0x77, 0x71, 0x48, 0x07, 0x12, 0xdd, 0x18,
-	0xdc, 0x23, 0xcd, 0xfc, 0x93, 0x01, 0x93, 0x3d, 0x16, 0x89, 0x8e, 0x63, 0x3a, 0xeb, 0x41, 0x59,
-	0xd4, 0x1e, 0x71, 0xca, 0x5d, 0x3a, 0x94, 0xad, 0xd3, 0xaa, 0x27, 0xad, 0x93, 0x04, 0x8c, 0x61,
-	0x25, 0xc4, 0x3c, 0x0f, 0x63, 0xb9, 0x41, 0xf8, 0xfe, 0x6f, 0x87, 0xcc, 0xf7, 0xcb, 0x30, 0x11,
-	0xf3, 0x65, 0x6b, 0x51, 0xa7, 0x63, 0xd1, 0xe3, 0xe8, 0xad, 0xbf, 0x67, 0xc0, 0xc9, 0xac, 0x63,
-	0xba, 0xc9, 0x11, 0xd5, 0x0f, 0x75, 0x44, 0xca, 0x37, 0x4e, 0x6b, 0xd9, 0x27, 0x57, 0xf3, 0x22,
-	0x70, 0xb7, 0x4c, 0xf4, 0x0b, 0x03, 0xee, 0x57, 0x52, 0xf4, 0x17, 0x13, 0x5d, 0x2b, 0xb4, 0xa3,
-	0x1e, 0x85, 0x52, 0xff, 0xab, 0x95, 0xba, 0x7f, 0xfe, 0x26, 0xf2, 0xf0, 0x4d, 0xb5, 0x41, 0x3f,
-	0x35, 0xe0, 0x6e, 0x45, 0xd0, 0xad, 0x67, 0xe9, 0xc8, 0xf4, 0x3c, 0xa3, 0xf5, 0xbc, 0x7b, 0x7e,
-	0x2f, 0x41, 0x78, 0x6f, 0xf9, 0x88, 0x41, 0xa5, 0x13, 0xcf, 0xb1, 0x64, 0x69, 0x75, 0x00, 0x65,
-	0x7a, 0x07, 0x61, 0x69, 0x4d, 0x94, 0xe0, 0x70, 0x2a, 0xc7, 0x7c, 0x15, 0xee, 0x6a, 0x5a, 0x6d,
-	0xdd, 0x33, 0x2e, 0x11, 0x7e, 0x25, 0x14, 0x3f, 0x98, 0x1a, 0x33, 0xb7, 0x95, 0xdb, 0x17, 0xb3,
-	0x63, 0xe6, 0x36, 0xc1, 0x12, 0x83, 0x1e, 0x84, 0xb2, 0xe7, 0x76, 0x5c, 0xae, 0x5b, 0x80, 0x24,
-	0x9c, 0x96, 0x05, 0x10, 0x2b, 0x9c, 0x69, 0xc1, 0x68, 0x76, 0x48, 0x76, 0x3b, 0xde, 0xb5, 0x7e,
-	0x60, 0xc0, 0xb0, 0xee, 0xe8, 0x0e, 0x59, 0x65, 0xed, 0x3f, 0x7d, 0x4b, 0xcb, 0x85, 0xe2, 0x51,
-	0x96, 0x0b, 0xe6, 0xaf, 0x8b, 0x10, 0xbf, 0x09, 0x43, 0x8f, 0x66, 0x26, 0x7c, 0x6a, 0x0b, 0x53,
-	0xfb, 0x4f, 0xf7, 0xd0, 0xaa, 0x9e, 0x2d, 0x16, 0xf6, 0xb9, 0x6b, 0x22, 0xee, 0x7a, 0x35, 0xf5,
-	0x35, 0x75, 0xad, 0xe1, 0xf3, 0x2b, 0x74, 0x8d, 0x53, 0xd7, 0x6f, 0xab, 0x69, 0x6d, 0x66, 0x12,
-	0xf9, 0x7f, 0x30, 0x4c, 0x7c, 0x39, 0xb6, 0x94, 0x5b, 0x2d, 0xab, 0x89, 0xce, 0xa2, 0x02, 0xe1,
-	0x18, 0x87, 0xce, 0xc1, 0x88, 0x6b, 0x77, 0x42, 0x51, 0x95, 0xcb, 0xaa, 0xb9, 0xac, 0x06, 0x30,
-	0x8d, 0x85, 0x95, 0xa6, 0xac, 0xd4, 0x13, 0x6c, 0x4c, 0xb9, 0x10, 0xbf, 0xa1, 0xcc, 0x50, 0x0a,
-	0x18, 0x4e, 0xb0, 0x92, 0xb2, 0xad, 0x79, 0x0e, 0x65, 0x28, 0x97, 0x12, 0x9e, 0x1a, 0x8b, 0x2e,
-	0xea, 0x2f, 0x62, 0x74, 0xd7, 0x26, 0x8b, 0xac, 0x4a, 0xd7, 0x47, 0x2d, 0xf1, 0x9c, 0x3c, 0x47,
-	0x29, 0xb6, 0xc7, 0xa8, 0x2d, 0xb7, 0x37, 0x92, 0x6e, 0x6f, 0x4d, 0x81, 0x70, 0x8c, 0x43, 0x35,
-	0x00, 0x46, 0x6d, 0xbd, 0x6b, 0x59, 0x50, 0x95, 0xeb, 0xe3, 0xe2, 0x46, 0x5e, 0x4b, 0xa0, 0x38,
-	0x43, 0x61, 0x12, 0x98, 0xe8, 0xee, 0xab, 0x6e, 0x87, 0xcb, 0xbf, 0x5b, 0x82, 0xd3, 0x6b, 0x51,
-	0x28, 0x0c, 0xa5, 0xbe, 0xdb, 0x5b, 0x08, 0x3c, 0x4f, 0x3b, 0xf1, 0xed, 0x4f, 0x3c, 0xaf, 0x40,
-	0x85, 0x5c, 0x0f, 0x5d, 0x4a, 0x9c, 0xf9, 0xd8, 0xdf, 0xbe, 0x70, 0x6b, 0x22, 0xd6, 0xdd, 0x0e,
-	0x49, 0xb7, 0xb6, 0x18, 0x33, 0xc1, 0x29, 0x3f, 0x71, 0x16, 0xcc, 0xf5, 0x6d, 0x22, 0x48, 0x75,
-	0x90, 0x25, 0x0b, 0xd6, 0x62, 0x04, 0x4e, 0x69, 0x44, 0x33, 0xdc, 0x4a, 0xbe, 0x74, 0x94, 0x3e,
-	0x78, 0x80, 0x66, 0xb8, 0xfb, 0x8b, 0xc9, 0xf4, 0x04, 0x52, 0x18, 0xce, 0xc8, 0x41, 0x3f, 0x34,
-	0x60, 0xdc, 0xca, 0x7f, 0xac, 0xa8, 0x5e, 0xbb, 0xaf, 0x1c, 0x4c, 0x74, 0x9f, 0x0f, 0x2f, 0xeb,
-	0xf7, 0x68, 0x3d, 0xc6, 0xbb, 0xbe, 0x5a, 0xec, 0x12, 0x6e, 0x7e, 0x66, 0xc0, 0x7d, 0x7d, 0x3c,
-	0xe2, 0x18, 0x06, 0x58, 0x5e, 0x7e, 0x80, 0x35, 0x70, 0x89, 0xd6, 0x47, 0xf3, 0x3e, 0xa3, 0xac,
-	0x9f, 0x14, 0xe0, 0x81, 0x3e, 0x2b, 0x0e, 0x3c, 0xd4, 0x7a, 0x12, 0xc6, 0xe2, 0xdf, 0xd9, 0x30,
-	0x4c, 0x1b, 0x82, 0x2c, 0x12, 0xe7, 0x69, 0x63, 0x51, 0xf2, 0xc2, 0x2a, 0xf6, 0x8a, 0x52, 0x97,
-	0x56, 0x4c, 0x21, 0x3c, 0xdc, 0x0e, 0x3a, 0xa1, 0x47, 0x38, 0x51, 0x93, 0x86, 0x91, 0xd4, 0xc3,
-	0x17, 0x62, 0x04, 0x4e, 0x69, 0x44, 0xa2, 0x25, 0x94, 0x06, 0x54, 0x7a, 0x58, 0xe6, 0x4d, 0xd6,
-	0xa2, 0x00, 0x62, 0x85, 0x33, 0xff, 0x6e, 0xc0, 0x99, 0x3e, 0x87, 0x72, 0x6c, 0x95, 0xfa, 0x56,
-	0xbe, 0x52, 0x7f, 0xfe, 0x88, 0xdc, 0x60, 0xdf, 0x9a, 0xfd, 0x61, 0xa8, 0x66, 0x5e, 0x0f, 0xa2,
-	0x33, 0x50, 0x64, 0xbe, 0xdb, 0xfd, 0xb5, 0xf3, 0xda, 0x6a, 0x03, 0x0b, 0x78, 0x7d, 0xfd, 0xc3,
-	0x4f, 0xa6, 0x4f, 0x7c, 0xf4, 0xc9, 0xf4, 0x89, 0x8f, 0x3f, 0x99, 0x3e, 0xf1, 0xd6, 0xee, 0xb4,
-	0xf1, 0xe1, 0xee, 0xb4, 0xf1, 0xd1, 0xee, 0xb4, 0xf1, 0xf1, 0xee, 0xb4, 0xf1, 0x87, 0xdd, 0x69,
-	0xe3, 0xc7, 0x7f, 0x9c, 0x3e, 0xf1, 0x72, 0x6d, 0xb0, 0x7f, 0x03, 0xfb, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x93, 0xce, 0x0d, 0x5e, 0x37, 0x36, 0x00, 0x00,
+	// 3854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x24, 0xc7,
+	0x75, 0xdb, 0xf3, 0x21, 0x39, 0x6f, 0x86, 0x9f, 0x2d, 0x4a, 0xda, 0xb1, 0x2c, 0x91, 0xab, 0x76,
+	0x62, 0x28, 0x81, 0x33, 0x14, 0x37, 0x92, 0x76, 0x13, 0x59, 0x8a, 0x39, 0x24, 0x97, 0x1a, 0x99,
+	0xa4, 0x46, 0x45, 0x4a, 0x46, 0x6c, 0x4b, 0x71, 0xb3, 0xbb, 0x66, 0xd8, 0x66, 0x4f, 0x77, 0x6f,
+	0x75, 0x0d, 0xbd, 0xdc, 0x43, 0xe0, 0x20, 0xf1, 0xc1, 0x51, 0x12, 0x07, 0xbe, 0x04, 0xbe, 0x25,
+	0x27, 0x5f, 0x7c, 0x48, 0xe0, 0x43, 0x00, 0xdf, 0x72, 0xd3, 0x25, 0x80, 0x83, 0x20, 0x88, 0x4f,
+	0x44, 0xc4, 0x20, 0x09, 0x72, 0x0d, 0x72, 0x09, 0x93, 0x00, 0x41, 0x7d, 0xba, 0xbb, 0xba, 0x67,
+	0x66, 0xc9, 0x21, 0xb9, 0x4c, 0x62, 0xe9, 0xc6, 0x79, 0xef, 0xd5, 0x7b, 0xaf, 0xaa, 0xde, 0xab,
+	0x7a, 0x9f, 0x6a, 0xc2, 0x1b, 0x96, 0xcf, 0x28, 0xb1, 0x1a, 0x6e, 0xb0, 0x24, 0xff, 0x5a, 0x0a,
+	0x0f, 0xba, 0x4b, 0x56, 0xe8, 0x46, 0x4b, 0x76, 0xe0, 0x33, 0x1a, 0x78, 0xa1, 0x67, 0xf9, 0x64,
+	0xe9, 0x70, 0x79, 0x8f, 0x30, 0xeb, 0xce, 0x52, 0x97, 0xf8, 0x84, 0x5a, 0x8c, 0x38, 0x8d, 0x90,
+	0x06, 0x2c, 0x40, 0x0d, 0x39, 0xea, 0xb7, 0xdc, 0x40, 0xfd, 0xd5, 0x08, 0x0f, 0xba, 0x0d, 0x3e,
+	0xbe, 0xa1, 0x8f, 0x6f, 0xa8, 0xf1, 0xcf, 0xde, 0x1b, 0x2d, 0x2f, 0x62, 0x16, 0x8b, 0x96, 0x0e,
+	0x97, 0x2d, 0x2f, 0xdc, 0xb7, 0x96, 0xf3, 0x92, 0x9e, 0xfd, 0x95, 0xae, 0xcb, 0xf6, 0xfb, 0x7b,
+	0x0d, 0x3b, 0xe8, 0x2d, 0x75, 0x83, 0x6e, 0xb0, 0x24, 0xc0, 0x7b, 0xfd, 0x8e, 0xf8, 0x25, 0x7e,
+	0x88, 0xbf, 0x14, 0xf9, 0xcb, 0x07, 0xf7, 0x22, 0x21, 0x25, 0x74, 0x7b, 0x96, 0xbd, 0xef, 0xfa,
+	0x84, 0x1e, 0xa5, 0xb2, 0x7a, 0x84, 0x59, 0x4b, 0x87, 0x83, 0x42, 0x96, 0x46, 0x8d, 0xa2, 0x7d,
+	0x9f, 0xb9, 0x3d, 0x32, 0x30, 0xe0, 0xd5, 0xb3, 0x06, 0x44, 0xf6, 0x3e, 0xe9, 0x59, 0x03, 0xe3,
+	0x7e, 0x75, 0xd4, 0xb8, 0x3e, 0x73, 0xbd, 0x25, 0xd7, 0x67, 0x11, 0xa3, 0xf9, 0x41, 0xe6, 0xbf,
+	0x18, 0x50, 0x5b, 0x71, 0x1c, 0x4a, 0xa2, 0x68, 0x83, 0x06, 0xfd, 0x10, 0x7d, 0x03, 0xa6, 0xf8,
+	0x4c, 0x1c, 0x8b, 0x59, 0x75, 0xe3, 0xb6, 0xf1, 0x62, 0xf5, 0xce, 0x4b, 0x0d, 0xc9, 0xb8, 0xa1,
+	0x33, 0x4e, 0xf7, 0x84, 0x53, 0x37, 0x0e, 0x97, 0x1b, 0x6f, 0xef, 0x7d, 0x93, 0xd8, 0x6c, 0x8b,
+	0x30, 0xab, 0x89, 0x3e, 0x3a, 0x5e, 0xbc, 0x71, 0x72, 0xbc, 0x08, 0x29, 0x0c, 0x27, 0x5c, 0x51,
+	0x1f, 0x6a, 0x5d, 0x2e, 0x6a, 0x8b, 0xf4, 0xf6, 0x08, 0x8d, 0xea, 0x85, 0xdb, 0xc5, 0x17, 0xab,
+	0x77, 0x5e, 0x1b, 0x73, 0xdb, 0x1b, 0x1b, 0x29, 0x8f, 0xe6, 0x53, 0x4a, 0x60, 0x4d, 0x03, 0x46,
+	0x38, 0x23, 0xc6, 0xfc, 0x5b, 0x03, 0xe6, 0xf4, 0x99, 0x6e, 0xba, 0x11, 0x43, 0x5f, 0x1f, 0x98,
+	0x6d, 0xe3, 0x7c, 0xb3, 0xe5, 0xa3, 0xc5, 0x5c, 0xe7, 0x94, 0xe8, 0xa9, 0x18, 0xa2, 0xcd, 0xd4,
+	0x82, 0xb2, 0xcb, 0x48, 0x2f, 0x9e, 0xe2, 0x17, 0xc7, 0x9d, 0xa2, 0xae, 0x6e, 0x73, 0x5a, 0x09,
+	0x2a, 0xb7, 0x38, 0x4b, 0x2c, 0x39, 0x9b, 0xdf, 0x2d, 0xc2, 0x4d, 0x9d, 0xac, 0x6d, 0x31, 0x7b,
+	0xff, 0x1a, 0x36, 0xf1, 0xf7, 0x0c, 0xb8, 0x69, 0x39, 0x0e, 0x71, 0x36, 0xae, 0x78, 0x2b, 0x3f,
+	0xa3, 0xc4, 0xde, 0x5c, 0xc9, 0x73, 0xc7, 0x83, 0x02, 0xd1, 0xef, 0x1b, 0x30, 0x4f, 0x49, 0x2f,
+	0x38, 0xcc, 0x29, 0x52, 0xbc, 0xbc, 0x22, 0x9f, 0x55, 0x8a, 0xcc, 0xe3, 0x41, 0xfe, 0x78, 0x98,
+	0x50, 0xf3, 0x5f, 0x0d, 0x98, 0x59, 0x09, 0x43, 0xcf, 0x25, 0xce, 0x6e, 0xf0, 0x73, 0xee, 0x4d,
+	0x7f, 0x6f, 0x00, 0xca, 0xce, 0xf5, 0x1a, 0xfc, 0xc9, 0xce, 0xfa, 0xd3, 0x1b, 0x63, 0xfb, 0x53,
+	0x46, 0xe1, 0x11, 0x1e, 0xf5, 0x61, 0x11, 0xe6, 0xb3, 0x84, 0x9f, 0xfa, 0xd4, 0xff, 0x9e, 0x4f,
+	0x3d, 0x80, 0xf9, 0xa6, 0x15, 0xb9, 0xf6, 0x4a, 0x9f, 0xed, 0x13, 0x9f, 0xb9, 0xb6, 0xc5, 0xdc,
+	0xc0, 0x47, 0x5f, 0x80, 0xa9, 0x7e, 0x44, 0xa8, 0x6f, 0xf5, 0x88, 0xd8, 0x8c, 0x4a, 0x6a, 0x37,
+	0xef, 0x2a, 0x38, 0x4e, 0x28, 0x38, 0x75, 0x68, 0x45, 0xd1, 0xb7, 0x02, 0xea, 0xd4, 0x0b, 0x59,
+	0xea, 0xb6, 0x82, 0xe3, 0x84, 0xc2, 0x5c, 0x86, 0xb9, 0x66, 0xdf, 0x77, 0x3c, 0x72, 0xdf, 0xf5,
+	0xc8, 0x0e, 0xa1, 0x87, 0x84, 0xa2, 0xe7, 0xa1, 0xd8, 0xa7, 0x9e, 0x12, 0x55, 0x55, 0x83, 0x8b,
+	0xef, 0xe2, 0x4d, 0xcc, 0xe1, 0xe6, 0xf7, 0x0a, 0xf0, 0xbc, 0x1c, 0x23, 0xe9, 0xb9, 0xb6, 0xab,
+	0x81, 0xdf, 0x71, 0xbb, 0x7d, 0x2a, 0x15, 0x7e, 0x05, 0xaa, 0x7b, 0xc4, 0xa2, 0x84, 0xee, 0x06,
+	0x07, 0xc4, 0x57, 0x8c, 0xe6, 0x15, 0xa3, 0x6a, 0x33, 0x45, 0x61, 0x9d, 0x0e, 0x7d, 0x1e, 0x26,
+	0xac, 0xd0, 0xfd, 0x32, 0x39, 0x52, 0x7a, 0xcf, 0xa8, 0x11, 0x13, 0x2b, 0xed, 0xd6, 0x97, 0xc9,
+	0x11, 0x56, 0x58, 0xf4, 0x47, 0x06, 0xcc, 0xef, 0x0d, 0xae, 0x53, 0xbd, 0x28, 0x0c, 0x75, 0x75,
+	0xdc, 0x3d, 0x1b, 0xb2, 0xe4, 0xcd, 0x5b, 0x7c, 0xdf, 0x86, 0x20, 0xf0, 0x30, 0xc1, 0xe6, 0x9f,
+	0x96, 0x60, 0x7e, 0xd5, 0xeb, 0x47, 0x8c, 0xd0, 0x8c, 0x71, 0x3d, 0x79, 0x2f, 0xfa, 0x1d, 0x03,
+	0xe6, 0x48, 0xa7, 0x43, 0x6c, 0xe6, 0x1e, 0x92, 0x2b, 0x74, 0xa2, 0xba, 0x92, 0x3a, 0xb7, 0x9e,
+	0x63, 0x8e, 0x07, 0xc4, 0xa1, 0xdf, 0x86, 0x9b, 0x09, 0xac, 0xd5, 0x6e, 0x7a, 0x81, 0x7d, 0x10,
+	0xfb, 0xcf, 0x2b, 0xe3, 0xea, 0xd0, 0x6a, 0x6f, 0x13, 0x96, 0xba, 0xf0, 0x7a, 0x9e, 0x2f, 0x1e,
+	0x14, 0x85, 0xee, 0x41, 0x8d, 0x05, 0xcc, 0xf2, 0xe2, 0xe9, 0x97, 0x6e, 0x1b, 0x2f, 0x16, 0xd3,
+	0x73, 0x7d, 0x57, 0xc3, 0xe1, 0x0c, 0x25, 0xba, 0x03, 0x20, 0x7e, 0xb7, 0xad, 0x2e, 0x89, 0xea,
+	0x65, 0x31, 0x2e, 0x59, 0xef, 0xdd, 0x04, 0x83, 0x35, 0x2a, 0x6e, 0xdb, 0x76, 0x9f, 0x52, 0xe2,
+	0x33, 0xfe, 0xbb, 0x3e, 0x21, 0x06, 0x25, 0xb6, 0xbd, 0x9a, 0xa2, 0xb0, 0x4e, 0x67, 0xfe, 0x97,
+	0x01, 0x68, 0x35, 0xf0, 0x7d, 0xa1, 0xbb, 0xcb, 0x8e, 0xb6, 0x2c, 0x46, 0xdd, 0x87, 0x28, 0x84,
+	0x49, 0x4a, 0x1e, 0xf4, 0x49, 0xc4, 0x94, 0x81, 0xb4, 0xc6, 0x5d, 0xb1, 0x41, 0xa6, 0x58, 0x32,
+	0x6c, 0x56, 0x4f, 0x8e, 0x17, 0x27, 0xd5, 0x0f, 0x1c, 0x8b, 0x41, 0x0c, 0xa6, 0x28, 0x89, 0xc2,
+	0xc0, 0x8f, 0x88, 0x70, 0xb3, 0xea, 0x9d, 0xb7, 0xae, 0x42, 0xa4, 0xe4, 0xd8, 0xac, 0xf1, 0x63,
+	0x26, 0xfe, 0x85, 0x13, 0x49, 0xe6, 0x0f, 0x4b, 0xf0, 0xcc, 0xe0, 0xb0, 0x55, 0xe2, 0x79, 0xc8,
+	0x81, 0x89, 0x28, 0xe8, 0x53, 0x9b, 0xa8, 0x15, 0x18, 0x3b, 0x70, 0x6c, 0x07, 0x0e, 0x26, 0x1d,
+	0x42, 0x89, 0x6f, 0x93, 0xf4, 0xcc, 0xd8, 0x11, 0x3c, 0xb1, 0xe2, 0x8d, 0x22, 0xa8, 0x3a, 0x24,
+	0x62, 0xae, 0x2f, 0x8f, 0x8a, 0xc2, 0x15, 0x88, 0x4a, 0x36, 0x7d, 0x2d, 0x65, 0x8c, 0x75, 0x29,
+	0xc8, 0x81, 0x52, 0x18, 0x50, 0xa6, 0x0e, 0xa6, 0xfb, 0x97, 0x5f, 0xe7, 0x76, 0x40, 0x59, 0x73,
+	0xea, 0xe4, 0x78, 0xb1, 0xc4, 0xff, 0xc2, 0x82, 0x3b, 0x7a, 0x1f, 0x26, 0x0f, 0x09, 0x75, 0x5c,
+	0x9b, 0x09, 0xd3, 0xaf, 0x34, 0x57, 0x95, 0x62, 0x93, 0xef, 0x49, 0xf0, 0xe9, 0xf1, 0xe2, 0x4b,
+	0x8f, 0x49, 0x53, 0xa9, 0xa3, 0xb2, 0xd3, 0xe5, 0x06, 0xee, 0x7b, 0x64, 0xc5, 0x16, 0x13, 0x89,
+	0x79, 0xa2, 0x1e, 0x94, 0x68, 0xdf, 0x23, 0xc2, 0x3d, 0xaa, 0x77, 0xde, 0x1e, 0x77, 0x12, 0xdb,
+	0x84, 0x7d, 0x2b, 0xa0, 0x07, 0xed, 0xc0, 0x73, 0xed, 0xa3, 0xf5, 0x43, 0xcb, 0xeb, 0xcb, 0x85,
+	0x8a, 0x2d, 0x46, 0xcc, 0x86, 0xcb, 0xc5, 0x42, 0x8c, 0xc9, 0x86, 0x19, 0x0a, 0x9f, 0x2d, 0xba,
+	0x07, 0x53, 0x22, 0x8d, 0xb3, 0x83, 0xf8, 0x6e, 0x7a, 0x2e, 0xb9, 0xd8, 0x14, 0xfc, 0x54, 0xfb,
+	0x1b, 0x27, 0xd4, 0xe8, 0xb6, 0xda, 0x07, 0xbe, 0xeb, 0xe5, 0x66, 0x4d, 0x8d, 0xd2, 0xd6, 0xd0,
+	0xfc, 0xf3, 0x02, 0x7c, 0x66, 0xa4, 0x27, 0xa1, 0x25, 0xa8, 0xf0, 0xab, 0x35, 0x0a, 0x2d, 0x3b,
+	0xbe, 0x81, 0x6f, 0x2a, 0x26, 0x95, 0xed, 0x18, 0x81, 0x53, 0x1a, 0x7e, 0x24, 0xd9, 0xda, 0x7d,
+	0xa0, 0xee, 0xb3, 0xe4, 0x48, 0xd2, 0xef, 0x0a, 0x9c, 0xa1, 0x44, 0xaf, 0xc1, 0xb4, 0x67, 0xed,
+	0x11, 0x6f, 0x87, 0x78, 0xc4, 0x66, 0x01, 0x15, 0xb6, 0x53, 0x69, 0x3e, 0xad, 0x86, 0x4e, 0x6f,
+	0xea, 0x48, 0x9c, 0xa5, 0x45, 0x07, 0x50, 0xe6, 0xb3, 0xe1, 0x47, 0x60, 0xf1, 0x0a, 0x0d, 0x2e,
+	0x09, 0x1d, 0xf9, 0xaf, 0x08, 0x4b, 0x19, 0x3c, 0x01, 0x78, 0x76, 0xf4, 0x49, 0x80, 0x3e, 0xe0,
+	0x6b, 0xee, 0x44, 0x75, 0xe3, 0x76, 0xf1, 0xd2, 0x9e, 0xa6, 0xed, 0x98, 0x13, 0x61, 0xc1, 0x97,
+	0xcf, 0xd5, 0x26, 0x9e, 0x17, 0xdf, 0x76, 0x57, 0x30, 0x57, 0x7e, 0x1a, 0xa5, 0x73, 0xe5, 0xbf,
+	0x22, 0x2c, 0x65, 0x98, 0x7b, 0x50, 0x5d, 0xdb, 0xde, 0x69, 0x6b, 0xf6, 0xa4, 0x05, 0x63, 0x89,
+	0x76, 0xdc, 0x14, 0xb0, 0xc0, 0xa0, 0x65, 0xa8, 0x52, 0x62, 0x07, 0xd4, 0xd9, 0x3d, 0x0a, 0x89,
+	0xd4, 0xb1, 0xd2, 0x9c, 0xe5, 0x87, 0x05, 0x4e, 0xc1, 0x58, 0xa7, 0x31, 0xff, 0xd9, 0x80, 0xea,
+	0x7a, 0xf7, 0x13, 0x50, 0x9b, 0xf8, 0x1b, 0x03, 0x66, 0xb5, 0x89, 0x5e, 0x43, 0x2a, 0xf5, 0x8d,
+	0x6c, 0x2a, 0x35, 0xf6, 0x0c, 0x35, 0x6d, 0x47, 0xe4, 0x51, 0x7f, 0x50, 0x84, 0x39, 0x8d, 0x4a,
+	0x26, 0x51, 0x0e, 0x40, 0x90, 0xac, 0xfb, 0x95, 0xee, 0xa1, 0xc6, 0xf7, 0xd3, 0x44, 0x6a, 0x10,
+	0x68, 0x5a, 0x30, 0xb1, 0xee, 0x33, 0x97, 0x1d, 0xa1, 0xaf, 0x40, 0x31, 0x0c, 0x9c, 0x2b, 0x09,
+	0x2d, 0x26, 0x79, 0x16, 0xc4, 0x21, 0x9c, 0xa3, 0xe9, 0xc1, 0xad, 0xf5, 0x87, 0x8c, 0x50, 0xdf,
+	0xf2, 0xa4, 0xa8, 0x84, 0xf0, 0x1c, 0xc7, 0x43, 0xe6, 0x42, 0x29, 0x9c, 0x7d, 0xa1, 0x98, 0x7f,
+	0x6d, 0x40, 0x6d, 0x03, 0xb7, 0x57, 0x93, 0x23, 0xe8, 0x97, 0x60, 0x32, 0x22, 0xf4, 0xd0, 0x4d,
+	0x2e, 0xa4, 0xd9, 0xf8, 0xd2, 0xdf, 0x91, 0x60, 0x1c, 0xe3, 0x79, 0x5a, 0xd5, 0x23, 0x6c, 0x3f,
+	0x70, 0xf2, 0x69, 0xd5, 0x96, 0x80, 0x62, 0x85, 0x45, 0xdf, 0x84, 0xc9, 0x7d, 0x62, 0x39, 0xe9,
+	0xa6, 0xfd, 0xc6, 0xb8, 0xcb, 0xf5, 0xe6, 0xee, 0x6e, 0xfb, 0x4d, 0xc1, 0x62, 0x8b, 0x3b, 0x40,
+	0xaa, 0x93, 0x04, 0x46, 0x38, 0x16, 0x60, 0xfe, 0xa7, 0x01, 0x73, 0x62, 0xc7, 0x56, 0xa2, 0x28,
+	0xb0, 0x5d, 0x19, 0x2e, 0x5d, 0x4b, 0xd1, 0x61, 0xce, 0x52, 0x12, 0x95, 0xc9, 0x5c, 0xb8, 0xbe,
+	0x22, 0x6f, 0xef, 0xc4, 0x3a, 0x92, 0x8c, 0x69, 0x25, 0xc7, 0x1f, 0x0f, 0x48, 0x34, 0x7f, 0x52,
+	0x82, 0xaa, 0x66, 0xaf, 0x4f, 0xcc, 0x48, 0xd1, 0xef, 0x1a, 0x30, 0x43, 0x32, 0x56, 0xaa, 0x22,
+	0xdf, 0x8d, 0xb1, 0x8f, 0xc0, 0xe1, 0xb6, 0xde, 0x44, 0x27, 0xc7, 0x8b, 0x33, 0x39, 0x64, 0x4e,
+	0x24, 0xfa, 0x3c, 0x14, 0xdd, 0x50, 0x1a, 0x55, 0xad, 0xf9, 0x14, 0x57, 0xb0, 0xd5, 0x8e, 0x4e,
+	0x8f, 0x17, 0x2b, 0xad, 0xb6, 0xaa, 0xe6, 0x62, 0x4e, 0x80, 0x3e, 0xc8, 0x86, 0x2f, 0xbf, 0x36,
+	0x76, 0xa8, 0x69, 0xf5, 0x88, 0x33, 0x3a, 0x62, 0x41, 0x5f, 0x83, 0x92, 0x1f, 0x38, 0x71, 0x24,
+	0xfb, 0xfa, 0xd8, 0xec, 0x03, 0x87, 0xa4, 0x13, 0x17, 0x71, 0xab, 0x00, 0x09, 0xa6, 0xa8, 0x9b,
+	0x3a, 0xe4, 0x84, 0xe0, 0xff, 0xa5, 0x71, 0xf9, 0xc7, 0x8e, 0x9b, 0x88, 0xa8, 0x0e, 0x73, 0x67,
+	0xf3, 0x07, 0x25, 0xa8, 0x7d, 0x5a, 0x65, 0xf8, 0xb4, 0xca, 0x30, 0xac, 0xca, 0xf0, 0x43, 0x03,
+	0x66, 0xb2, 0xe7, 0xd2, 0xf8, 0xb9, 0x4b, 0x7c, 0x7b, 0x15, 0x46, 0xde, 0x5e, 0x4d, 0x28, 0xf6,
+	0x5d, 0x47, 0x65, 0x26, 0x2f, 0x25, 0xf5, 0xc1, 0xd6, 0xda, 0xe9, 0xf1, 0xe2, 0x0b, 0xa3, 0xfa,
+	0x72, 0x8c, 0x07, 0xb9, 0x8d, 0x77, 0x5b, 0x6b, 0x98, 0x0f, 0x36, 0x7f, 0x6c, 0xc0, 0x6c, 0xee,
+	0xba, 0x38, 0xc7, 0xbd, 0xf9, 0x9b, 0x50, 0xe2, 0x7c, 0x94, 0x6e, 0xeb, 0x31, 0x05, 0x0f, 0xa0,
+	0x4f, 0x8f, 0x17, 0x5f, 0x39, 0x5f, 0x92, 0xbb, 0xc3, 0xa8, 0xeb, 0x77, 0x85, 0x48, 0x3e, 0x10,
+	0x0b, 0x96, 0xe8, 0x73, 0x50, 0xe6, 0xc9, 0x29, 0x51, 0xd3, 0x4a, 0x4e, 0x90, 0xf7, 0x38, 0x10,
+	0x4b, 0x9c, 0x79, 0x52, 0x80, 0x1a, 0xd7, 0x5a, 0xcf, 0x04, 0xf6, 0x83, 0x88, 0xe5, 0x55, 0x7e,
+	0x33, 0x88, 0x18, 0x16, 0x98, 0x73, 0xdf, 0xbe, 0x3c, 0x47, 0xb5, 0xd8, 0x7e, 0xbd, 0x98, 0xe5,
+	0xd4, 0xb6, 0xd8, 0x3e, 0x16, 0x18, 0xfd, 0x7e, 0x2e, 0x3d, 0xe1, 0xfb, 0x19, 0x3d, 0x82, 0xea,
+	0x83, 0x3e, 0xa1, 0x47, 0x6d, 0x8b, 0x5a, 0x3d, 0x6e, 0xb4, 0xc5, 0x8b, 0x54, 0x56, 0xb9, 0xbc,
+	0x77, 0x12, 0x36, 0x52, 0x66, 0x62, 0xc4, 0x29, 0x22, 0xc2, 0xba, 0x30, 0xf3, 0x2f, 0x0d, 0x98,
+	0x1f, 0x32, 0xf2, 0xff, 0x81, 0x79, 0xfc, 0x95, 0x01, 0x93, 0xea, 0xc0, 0x40, 0x5f, 0x81, 0x92,
+	0xed, 0x3a, 0x54, 0x9d, 0xc8, 0x17, 0x3c, 0xa2, 0x92, 0x49, 0xae, 0xb6, 0xd6, 0x30, 0x16, 0x0c,
+	0xd1, 0xfb, 0x30, 0x41, 0x1e, 0xda, 0x24, 0x64, 0xea, 0x04, 0xbe, 0x20, 0xeb, 0xc4, 0x0e, 0xd7,
+	0x05, 0x33, 0xac, 0x98, 0x9a, 0xff, 0x6d, 0x00, 0x6a, 0xb5, 0x3f, 0xb9, 0xb1, 0x59, 0x07, 0xca,
+	0x62, 0x81, 0xd0, 0xe7, 0xa0, 0xe0, 0x86, 0x62, 0xae, 0xb5, 0xe6, 0xfc, 0xc9, 0xf1, 0x62, 0xa1,
+	0xd5, 0xce, 0xc6, 0x2c, 0x05, 0x37, 0xe4, 0xb7, 0x42, 0x48, 0x49, 0xc7, 0x7d, 0xb8, 0x49, 0xfc,
+	0x2e, 0xdb, 0x57, 0x15, 0xa6, 0xe4, 0x56, 0x68, 0x6b, 0x38, 0x9c, 0xa1, 0x34, 0xff, 0xbd, 0x00,
+	0xb0, 0x79, 0x37, 0x39, 0x48, 0xbe, 0x0a, 0xa5, 0x7d, 0xc6, 0xc2, 0x8b, 0xc6, 0x80, 0xfa, 0xa1,
+	0x24, 0x43, 0x13, 0x0e, 0xc1, 0x82, 0x27, 0x7a, 0x0f, 0x8a, 0x4c, 0x14, 0x4a, 0x8c, 0x8b, 0x5c,
+	0xd8, 0xbb, 0x9b, 0x49, 0xe1, 0x43, 0x46, 0x97, 0xbb, 0x9b, 0x3b, 0x98, 0x33, 0xe4, 0x3a, 0x77,
+	0x69, 0x68, 0xd7, 0x8b, 0x17, 0xd3, 0x59, 0xcf, 0x67, 0xa4, 0xce, 0x1c, 0x82, 0x05, 0x4f, 0xae,
+	0xb3, 0xe3, 0xcb, 0x5b, 0xf6, 0x02, 0x3a, 0xaf, 0x6d, 0xe7, 0x74, 0x5e, 0xdb, 0xde, 0xc1, 0x9c,
+	0xa1, 0xf9, 0x03, 0x03, 0xd0, 0x56, 0xdf, 0x63, 0xae, 0x6d, 0x45, 0x4c, 0x6c, 0x79, 0xcb, 0xef,
+	0x04, 0xdc, 0xbd, 0x45, 0x8d, 0xa2, 0x6e, 0x64, 0xdd, 0x5b, 0x1a, 0x92, 0xc4, 0x25, 0x25, 0xad,
+	0xc2, 0x93, 0x29, 0x69, 0x99, 0xdf, 0x35, 0xa0, 0x92, 0xc4, 0xb0, 0x49, 0xd1, 0xd2, 0x18, 0x55,
+	0xb4, 0x3c, 0xc7, 0x4d, 0xad, 0x97, 0x4c, 0x8b, 0xe3, 0x94, 0x4c, 0xcd, 0x0f, 0xcb, 0x30, 0x9d,
+	0x29, 0xdd, 0x5e, 0xc3, 0x09, 0xd0, 0x81, 0x32, 0x2f, 0x01, 0xc7, 0x0b, 0xbc, 0x72, 0xa9, 0x52,
+	0x33, 0x2f, 0x29, 0xa7, 0xfb, 0xc8, 0x7f, 0x45, 0x58, 0xb2, 0x47, 0xaf, 0xc3, 0xac, 0x95, 0xe9,
+	0x79, 0xcb, 0x40, 0xb2, 0x22, 0xdc, 0x7c, 0x36, 0xdb, 0x0e, 0x8f, 0x70, 0x9e, 0x16, 0xbd, 0xc8,
+	0x17, 0xd5, 0x0d, 0x28, 0xcf, 0xa6, 0xb8, 0x7d, 0x1a, 0xb2, 0xeb, 0xd1, 0x56, 0x30, 0x9c, 0x60,
+	0xd1, 0xcb, 0x50, 0x63, 0x2e, 0xa1, 0x31, 0x46, 0xc4, 0x7e, 0xe5, 0xe6, 0x9c, 0x88, 0x17, 0x35,
+	0x38, 0xce, 0x50, 0xa1, 0x08, 0x2a, 0xb2, 0x69, 0x81, 0x49, 0x47, 0xe5, 0x12, 0xf7, 0x2f, 0xb7,
+	0x14, 0x89, 0xd5, 0x4d, 0xf3, 0xa8, 0x6f, 0x27, 0x66, 0x8e, 0x53, 0x39, 0xe8, 0x11, 0xcc, 0x12,
+	0xbf, 0x13, 0x50, 0x9b, 0xf4, 0x88, 0xcf, 0xb6, 0x78, 0x9a, 0x34, 0x29, 0x0c, 0xa6, 0xad, 0x96,
+	0x70, 0x76, 0x3d, 0x8b, 0x3e, 0xff, 0x85, 0x9a, 0x1b, 0x88, 0xf3, 0x82, 0xb8, 0x1d, 0xf3, 0x05,
+	0xa8, 0x4f, 0x65, 0xed, 0x98, 0x2f, 0x11, 0x16, 0x18, 0xf3, 0xc3, 0x02, 0xdc, 0x1a, 0xd1, 0x48,
+	0x40, 0xfd, 0x7c, 0x0b, 0x6d, 0xfb, 0xca, 0x5a, 0x14, 0x8f, 0xeb, 0xa3, 0x1d, 0x0d, 0xf4, 0xd1,
+	0xae, 0xbc, 0x35, 0x32, 0xaa, 0x99, 0xf6, 0x17, 0x05, 0x58, 0x78, 0xbc, 0xce, 0xe8, 0x83, 0x5c,
+	0x53, 0xed, 0xd5, 0xb1, 0xf3, 0x7d, 0x91, 0xba, 0x8f, 0x6c, 0xa7, 0xf5, 0x86, 0xb5, 0xd3, 0x2e,
+	0x2a, 0xe4, 0xec, 0x46, 0xda, 0x97, 0x60, 0x2e, 0xa4, 0x41, 0x18, 0x44, 0xfc, 0x6c, 0xf4, 0x5c,
+	0xdb, 0x25, 0xb1, 0xcb, 0xf2, 0x72, 0xc2, 0x5c, 0x3b, 0x87, 0xc3, 0x03, 0xd4, 0xe6, 0x8f, 0x0b,
+	0xb0, 0x78, 0xc6, 0x7a, 0xf3, 0x6a, 0xc9, 0xb4, 0xaf, 0xd3, 0xd4, 0x8d, 0x2b, 0xf5, 0xbe, 0xa4,
+	0x89, 0x93, 0xc5, 0x67, 0x65, 0xf2, 0x84, 0x8d, 0x1f, 0x53, 0x2d, 0xdf, 0x21, 0x0f, 0x55, 0x3c,
+	0x91, 0x24, 0x6c, 0x38, 0x46, 0xe0, 0x94, 0x86, 0x47, 0xbd, 0xfc, 0x87, 0xba, 0x86, 0xef, 0x8e,
+	0xab, 0x2c, 0xe7, 0x89, 0x49, 0x27, 0xf5, 0x3b, 0xad, 0x19, 0xf7, 0x77, 0x06, 0xdc, 0xcc, 0x28,
+	0x7b, 0x0d, 0xc5, 0xfa, 0xbd, 0x6c, 0xb1, 0xfe, 0xf5, 0x4b, 0x2d, 0xfe, 0x88, 0x72, 0xfd, 0xbf,
+	0x19, 0xb9, 0xf3, 0x84, 0x17, 0x72, 0x76, 0x98, 0xc5, 0xfa, 0x11, 0x7f, 0x3f, 0xc3, 0x0b, 0x3a,
+	0xdb, 0x43, 0x5e, 0xdb, 0x6c, 0x2b, 0x38, 0x4e, 0x28, 0x78, 0x72, 0xaf, 0x5e, 0x99, 0xc6, 0x7e,
+	0xa0, 0x25, 0xf7, 0x1b, 0x09, 0x06, 0x6b, 0x54, 0xe8, 0x2d, 0x40, 0x94, 0x58, 0x9e, 0xfb, 0x48,
+	0xfc, 0xbc, 0x6f, 0xb9, 0x5e, 0x9f, 0xca, 0xed, 0x9b, 0x6a, 0x3e, 0xab, 0xc6, 0x22, 0x3c, 0x40,
+	0x81, 0x87, 0x8c, 0xe2, 0x75, 0xe0, 0x1e, 0x89, 0x22, 0x5e, 0x24, 0x28, 0x65, 0xeb, 0xc0, 0x5b,
+	0x12, 0x8c, 0x63, 0xbc, 0x78, 0x3d, 0x99, 0x99, 0x74, 0x9b, 0x10, 0x8a, 0xee, 0xc2, 0xb4, 0xa5,
+	0x3d, 0xa9, 0x94, 0x0d, 0xbb, 0x4a, 0xf3, 0x26, 0xb7, 0x53, 0xfd, 0xad, 0x65, 0x84, 0xb3, 0x74,
+	0x88, 0xc0, 0x94, 0x1b, 0xaa, 0x3a, 0x8c, 0xdc, 0xaa, 0xbb, 0xe3, 0x67, 0x22, 0x62, 0x7c, 0xba,
+	0xc0, 0x49, 0x01, 0x26, 0x61, 0x8d, 0x16, 0xa1, 0xdc, 0x79, 0xe0, 0xf8, 0xb1, 0xbf, 0x57, 0xf8,
+	0x5e, 0xde, 0x7f, 0x67, 0x6d, 0x3b, 0xc2, 0x12, 0x8e, 0x18, 0x2f, 0xaf, 0xa8, 0x2a, 0x59, 0x9c,
+	0x19, 0x5f, 0xbe, 0xf6, 0xa6, 0x15, 0x68, 0x62, 0xde, 0x58, 0x93, 0xc3, 0x63, 0x08, 0xd1, 0x7b,
+	0x6d, 0x39, 0x84, 0x1f, 0x62, 0x2e, 0x91, 0x49, 0xf2, 0xb4, 0x8c, 0x21, 0x36, 0xb3, 0x28, 0x9c,
+	0xa7, 0xe5, 0xcd, 0xbe, 0x67, 0x86, 0x9f, 0x12, 0xe8, 0x15, 0x95, 0xc4, 0x4a, 0xdb, 0x7b, 0x21,
+	0x97, 0xc4, 0x66, 0x77, 0x50, 0x4b, 0x50, 0xc7, 0x6d, 0x29, 0x24, 0xd1, 0x63, 0xf1, 0xac, 0x3a,
+	0x4f, 0xe9, 0x32, 0x75, 0x9e, 0x3f, 0xab, 0xe4, 0x8c, 0x8e, 0x9f, 0x2e, 0xe8, 0x8b, 0x50, 0x71,
+	0x5c, 0x4a, 0xc4, 0x4b, 0x03, 0x35, 0xd1, 0x85, 0x58, 0xd9, 0xb5, 0x18, 0x71, 0xaa, 0xff, 0xc0,
+	0xe9, 0x00, 0x64, 0x43, 0xa9, 0x43, 0x83, 0x9e, 0xba, 0x75, 0x2e, 0x17, 0x26, 0x72, 0x1f, 0x48,
+	0x27, 0x7f, 0x9f, 0x06, 0x3d, 0x2c, 0x98, 0xa3, 0xf7, 0xa1, 0xc0, 0x82, 0x7a, 0xf1, 0xaa, 0x44,
+	0x80, 0x12, 0x51, 0xd8, 0x0d, 0x70, 0x81, 0x05, 0xdc, 0x7b, 0xa2, 0xac, 0xcd, 0xde, 0xbd, 0xa0,
+	0xcd, 0xa6, 0xde, 0x93, 0x18, 0x6a, 0xc2, 0x5a, 0x3c, 0x06, 0xcc, 0x45, 0x9f, 0x69, 0x02, 0x30,
+	0x10, 0xaf, 0xbe, 0x07, 0x13, 0x96, 0xdc, 0x93, 0x09, 0xb1, 0x27, 0x6f, 0x88, 0xc7, 0x77, 0xf1,
+	0x66, 0x8c, 0xff, 0x86, 0x44, 0x71, 0xe3, 0x8f, 0x1a, 0x88, 0x6f, 0xed, 0x79, 0x64, 0x33, 0xe8,
+	0x76, 0x5d, 0xbf, 0x2b, 0x42, 0xcb, 0xa9, 0xf4, 0x3e, 0x5c, 0xd7, 0x91, 0x38, 0x4b, 0x3b, 0x2c,
+	0x5a, 0x9f, 0x1a, 0x23, 0x5a, 0x8f, 0xcd, 0xbc, 0x32, 0xd2, 0xcc, 0x1f, 0x40, 0xd5, 0x4b, 0x12,
+	0xf1, 0xa8, 0x0e, 0x62, 0x37, 0x7e, 0x7d, 0xdc, 0xdd, 0x48, 0x73, 0xf9, 0x34, 0x9e, 0x49, 0x61,
+	0x11, 0xd6, 0x65, 0xf0, 0x6d, 0xf1, 0x82, 0xae, 0x38, 0x25, 0xea, 0xd5, 0xec, 0x1d, 0xb3, 0xa9,
+	0xe0, 0x38, 0xa1, 0x40, 0x1d, 0xa8, 0x50, 0x8b, 0x91, 0x4d, 0xb7, 0xe7, 0xb2, 0x7a, 0xed, 0xb6,
+	0x71, 0x91, 0xde, 0x08, 0x8e, 0x19, 0xc8, 0x1c, 0x20, 0xf9, 0x89, 0x53, 0xd6, 0xe8, 0x11, 0x4c,
+	0x87, 0x96, 0x7d, 0x40, 0xd8, 0xaa, 0x15, 0x32, 0x7e, 0x25, 0x4d, 0x5f, 0xcc, 0xfa, 0xb9, 0x05,
+	0xb4, 0x75, 0x46, 0xf2, 0x36, 0xc9, 0x80, 0x70, 0x56, 0x14, 0x7a, 0x04, 0x33, 0x94, 0xf0, 0x9c,
+	0x30, 0x8e, 0xc6, 0xea, 0x33, 0x62, 0x5d, 0xb0, 0x5a, 0x97, 0x19, 0x9c, 0xc1, 0x9e, 0x1e, 0x2f,
+	0xde, 0x3b, 0xa7, 0x39, 0x66, 0xc6, 0x89, 0x03, 0x33, 0x27, 0xc9, 0xfc, 0x7e, 0x09, 0x50, 0xc6,
+	0x63, 0x79, 0x24, 0x10, 0xfd, 0x1f, 0x09, 0x07, 0x43, 0xa8, 0x31, 0x6a, 0x75, 0x3a, 0xae, 0x2d,
+	0xb4, 0x3a, 0x47, 0xa8, 0x2d, 0xbe, 0x03, 0x6a, 0xc4, 0xdf, 0x01, 0x35, 0x76, 0xb5, 0xd1, 0x5a,
+	0xbf, 0x42, 0x83, 0xe2, 0x8c, 0x04, 0xf4, 0x6d, 0x03, 0xe6, 0x78, 0xf4, 0xa7, 0x93, 0xd4, 0x8b,
+	0x67, 0x7a, 0x45, 0x4e, 0x2c, 0xce, 0x71, 0x48, 0x8b, 0x70, 0x79, 0x0c, 0x1e, 0x90, 0x26, 0x54,
+	0x08, 0x09, 0xa1, 0x19, 0x15, 0x4a, 0xe3, 0xaa, 0xd0, 0xce, 0x71, 0x48, 0x55, 0xc8, 0x63, 0xf0,
+	0x80, 0x34, 0xf3, 0x9f, 0x0c, 0x98, 0x1f, 0x30, 0x8a, 0xfe, 0x75, 0x74, 0xdb, 0x3c, 0x28, 0xf3,
+	0xf0, 0x32, 0x8e, 0xaa, 0x36, 0x2e, 0x65, 0x6e, 0x69, 0x60, 0x9b, 0x86, 0xc2, 0x1c, 0x16, 0x61,
+	0x29, 0xc4, 0x5c, 0x86, 0xe9, 0x4c, 0x63, 0xf3, 0xec, 0x32, 0xbb, 0xf9, 0x93, 0x32, 0xcc, 0xc5,
+	0x7c, 0xa3, 0x9d, 0x7e, 0xaf, 0x67, 0xd1, 0xeb, 0x28, 0x0f, 0x7d, 0xc7, 0x80, 0x59, 0xdd, 0x37,
+	0xdc, 0x64, 0x89, 0x9a, 0x97, 0x5a, 0x22, 0x69, 0x1b, 0xb7, 0xe2, 0x3a, 0xc7, 0x76, 0x56, 0x04,
+	0xce, 0xcb, 0x44, 0x3f, 0x32, 0xe0, 0x39, 0x29, 0x45, 0xbd, 0xe3, 0xcb, 0x8d, 0xa8, 0x17, 0xaf,
+	0x4c, 0xa9, 0x5f, 0x50, 0x4a, 0x3d, 0xb7, 0xf2, 0x18, 0x79, 0xf8, 0xb1, 0xda, 0xa0, 0x3f, 0x31,
+	0xe0, 0x69, 0x49, 0x90, 0xd7, 0xb3, 0x74, 0x65, 0x7a, 0x3e, 0xaf, 0xf4, 0x7c, 0x7a, 0x65, 0x98,
+	0x20, 0x3c, 0x5c, 0x3e, 0x2f, 0x74, 0xf5, 0xe2, 0x52, 0x6c, 0xbd, 0x7c, 0x31, 0x65, 0x06, 0x6b,
+	0xb9, 0x69, 0xd8, 0x9b, 0xe0, 0x70, 0x2a, 0xc7, 0x7c, 0x1f, 0x9e, 0x6a, 0x5b, 0x5d, 0x55, 0x58,
+	0xd8, 0x20, 0xec, 0xed, 0x90, 0xff, 0x11, 0xc9, 0xfe, 0x5b, 0x57, 0x9a, 0x7d, 0x51, 0xef, 0xbf,
+	0x75, 0x09, 0x16, 0x18, 0x5e, 0x23, 0xf6, 0xc4, 0x15, 0x2c, 0xb3, 0xbc, 0xc4, 0x9d, 0xe4, 0x3d,
+	0x2a, 0x71, 0xa6, 0x05, 0x35, 0xbd, 0xce, 0xfb, 0x24, 0xde, 0x02, 0x7d, 0xc7, 0x80, 0xf4, 0xfe,
+	0x46, 0xcb, 0x50, 0xea, 0xfb, 0x6e, 0xdc, 0x81, 0x8c, 0x37, 0xa2, 0xf4, 0xae, 0xef, 0xf2, 0x77,
+	0xbf, 0xd3, 0x09, 0x21, 0x07, 0x60, 0x41, 0xca, 0x75, 0xa2, 0x16, 0x93, 0xc2, 0xa6, 0xb5, 0xbc,
+	0xdf, 0x62, 0x3c, 0xef, 0xb7, 0x98, 0x98, 0xea, 0x5e, 0x9f, 0x46, 0xf2, 0xe5, 0xf2, 0x74, 0x3a,
+	0xd5, 0x26, 0x07, 0x62, 0x89, 0x33, 0x37, 0xe0, 0xe6, 0xc0, 0x4d, 0xcf, 0xf3, 0xe1, 0x9e, 0xf5,
+	0x50, 0xc2, 0x22, 0x55, 0xbb, 0x4e, 0xdc, 0x7a, 0x2b, 0xc1, 0x60, 0x8d, 0x4a, 0xb4, 0xcd, 0x54,
+	0x15, 0xe2, 0x92, 0x99, 0xc1, 0xd9, 0x15, 0xf1, 0x34, 0xc4, 0x2d, 0x5e, 0x65, 0x88, 0x6b, 0xfe,
+	0x68, 0x02, 0xe2, 0xa7, 0x1a, 0xe8, 0xe5, 0x81, 0x87, 0xca, 0xf5, 0x73, 0x3c, 0x52, 0xde, 0xd6,
+	0x1e, 0x29, 0x3f, 0xee, 0xf0, 0xe4, 0x1f, 0xb8, 0x36, 0xe4, 0x07, 0xae, 0x8d, 0x96, 0xcf, 0xde,
+	0xa6, 0xb2, 0x61, 0x39, 0xf0, 0x2c, 0xfc, 0x17, 0x61, 0x92, 0xf8, 0xa2, 0x95, 0x20, 0xa6, 0x5a,
+	0x96, 0x75, 0xcc, 0x75, 0x09, 0xc2, 0x31, 0x8e, 0x57, 0xb3, 0x5d, 0xbb, 0x17, 0xf2, 0xc0, 0x48,
+	0x64, 0x7a, 0x65, 0x59, 0x76, 0x6c, 0xad, 0x6e, 0xb5, 0x39, 0x0c, 0x27, 0xd8, 0x98, 0x72, 0x35,
+	0x7e, 0x42, 0xa3, 0x51, 0x72, 0x18, 0x4e, 0xb0, 0x82, 0xb2, 0xab, 0x78, 0x4e, 0x68, 0x94, 0x1b,
+	0x09, 0x4f, 0x85, 0xe5, 0xfd, 0x33, 0xd1, 0x5b, 0x51, 0x95, 0x06, 0x55, 0x73, 0xce, 0xbe, 0x22,
+	0x55, 0x38, 0x9c, 0xa1, 0xe4, 0xd3, 0x8b, 0xa8, 0x2d, 0xa6, 0x37, 0x95, 0x4e, 0x6f, 0x47, 0x82,
+	0x70, 0x8c, 0x43, 0x0d, 0x80, 0x88, 0xda, 0x6a, 0xd6, 0x22, 0x09, 0x28, 0x37, 0x67, 0xb8, 0x2d,
+	0xee, 0x24, 0x50, 0xac, 0x51, 0xf0, 0x87, 0xbb, 0xc4, 0x77, 0x5a, 0xf1, 0x8a, 0x80, 0x18, 0x20,
+	0x1e, 0xee, 0xae, 0xfb, 0x4e, 0xb2, 0x28, 0x3a, 0x8d, 0x36, 0x44, 0x2c, 0x4d, 0x75, 0x60, 0x88,
+	0x58, 0x1d, 0x9d, 0x06, 0xf9, 0x50, 0x8b, 0x97, 0x55, 0xd4, 0x99, 0x6a, 0x62, 0xda, 0x6f, 0xc5,
+	0xd3, 0x8e, 0xe5, 0x70, 0xdc, 0xe9, 0xf1, 0xe2, 0x9d, 0xf3, 0x59, 0xa5, 0x3e, 0x0a, 0x67, 0xf8,
+	0x8b, 0x2f, 0xa6, 0xdc, 0xf0, 0xf0, 0x55, 0xfe, 0x50, 0xcb, 0x8f, 0xdc, 0xc0, 0x97, 0x0d, 0x7f,
+	0x15, 0x63, 0x7f, 0x3d, 0x7e, 0x5f, 0xd9, 0x6a, 0x0f, 0x90, 0x9c, 0x1e, 0x2f, 0xbe, 0x7e, 0x4e,
+	0xf1, 0x83, 0x83, 0xc5, 0x5a, 0x0d, 0x13, 0x6c, 0x12, 0x98, 0xcb, 0x97, 0x5c, 0x9e, 0xc4, 0x51,
+	0xf9, 0xbd, 0x12, 0xdc, 0xda, 0xe9, 0x87, 0xdc, 0x1f, 0xe4, 0x17, 0x6b, 0xab, 0x81, 0xe7, 0xa9,
+	0xb3, 0xe2, 0xc9, 0x07, 0x2c, 0x5f, 0x83, 0x0a, 0x79, 0x18, 0xba, 0x94, 0x38, 0x2b, 0xb1, 0x5b,
+	0xff, 0xf2, 0xf9, 0x44, 0xec, 0xba, 0x3d, 0x92, 0x4e, 0x6d, 0x3d, 0x66, 0x82, 0x53, 0x7e, 0x7c,
+	0x2d, 0x22, 0xd7, 0xb7, 0x09, 0x27, 0x55, 0x67, 0x59, 0x32, 0x60, 0x27, 0x46, 0xe0, 0x94, 0x86,
+	0xd7, 0xc9, 0x3a, 0xc9, 0x37, 0x7e, 0xaa, 0xb1, 0x3a, 0x76, 0x9d, 0x2c, 0xff, 0xad, 0x60, 0xba,
+	0x02, 0x29, 0x0c, 0x6b, 0x72, 0xd0, 0x1f, 0x1a, 0x30, 0x63, 0x65, 0x3f, 0xd3, 0x93, 0xcf, 0xef,
+	0xb6, 0x2e, 0x26, 0x7a, 0xc4, 0x27, 0x87, 0xcd, 0x67, 0xe2, 0x3c, 0x31, 0xf7, 0xbd, 0x5e, 0x4e,
+	0x38, 0xff, 0x6a, 0xe1, 0xb3, 0x23, 0x2c, 0xe2, 0x1a, 0x6a, 0xdb, 0x5e, 0xb6, 0xb6, 0x3d, 0x76,
+	0x68, 0x3f, 0x42, 0xf3, 0x11, 0x55, 0xee, 0xef, 0x17, 0xe0, 0x85, 0x11, 0x23, 0x2e, 0x5c, 0xef,
+	0x7e, 0x0d, 0xa6, 0xe3, 0xbf, 0x75, 0x37, 0x4c, 0x73, 0x59, 0x1d, 0x89, 0xb3, 0xb4, 0xb1, 0x28,
+	0x71, 0xb2, 0x16, 0x07, 0x45, 0xc9, 0xbb, 0x21, 0xa6, 0xe0, 0x16, 0x6e, 0x07, 0xbd, 0xd0, 0x23,
+	0x8c, 0xc8, 0x22, 0xe4, 0x54, 0x6a, 0xe1, 0xab, 0x31, 0x02, 0xa7, 0x34, 0x3c, 0x6a, 0x21, 0x94,
+	0x06, 0xb4, 0x5e, 0xce, 0x36, 0xf1, 0xd7, 0x39, 0x10, 0x4b, 0x9c, 0xf9, 0x1f, 0x06, 0x3c, 0x3f,
+	0x62, 0x51, 0xae, 0x2d, 0xc3, 0x3b, 0xcc, 0x66, 0x78, 0xef, 0x5c, 0x91, 0x19, 0x9c, 0x99, 0xeb,
+	0x7d, 0x01, 0xaa, 0xda, 0x6b, 0x0e, 0xfe, 0x9d, 0x6f, 0xe4, 0xbb, 0xf9, 0xef, 0x7c, 0x77, 0xb6,
+	0x5b, 0x98, 0xc3, 0x9b, 0xbb, 0x1f, 0x7d, 0xbc, 0x70, 0xe3, 0xa7, 0x1f, 0x2f, 0xdc, 0xf8, 0xd9,
+	0xc7, 0x0b, 0x37, 0xbe, 0x7d, 0xb2, 0x60, 0x7c, 0x74, 0xb2, 0x60, 0xfc, 0xf4, 0x64, 0xc1, 0xf8,
+	0xd9, 0xc9, 0x82, 0xf1, 0x0f, 0x27, 0x0b, 0xc6, 0x1f, 0xff, 0xe3, 0xc2, 0x8d, 0xaf, 0x36, 0xc6,
+	0xfb, 0x07, 0x28, 0xff, 0x33, 0x00, 0x8f, 0xb9, 0x26, 0x16, 0x31, 0x45, 0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2156,7 +2525,7 @@ func (m *ClusterGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
+func (m *ConnectivityMatrix) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2166,7 +2535,297 @@ func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EgressGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectivityMatrix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivityMatrix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectivityMatrixCell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectivityMatrixCell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivityMatrixCell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Verdict)
+	copy(dAtA[i:], m.Verdict)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Verdict)))
+	i--
+	dAtA[i] = 0x22
+	if m.Port != nil {
+		{
+			size, err := m.Port.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectivityMatrixPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectivityMatrixPort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivityMatrixPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Protocol)
+	copy(dAtA[i:], m.Protocol)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Protocol)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectivityMatrixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectivityMatrixRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivityMatrixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ClusterGroup)
+	copy(dAtA[i:], m.ClusterGroup)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterGroup)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectivityMatrixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectivityMatrixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectivityMatrixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cells) > 0 {
+		for iNdEx := len(m.Cells) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cells[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pods) > 0 {
+		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DNSProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordTypes[iNdEx])
+			copy(dAtA[i:], m.RecordTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RecordTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2379,7 +3038,54 @@ func (m *ExternalEntityReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+func (m *GRPCProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2628,6 +3334,44 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHeaderMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2648,6 +3392,34 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryParams) > 0 {
+		for iNdEx := len(m.QueryParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -2666,6 +3438,44 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPQueryParamMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPQueryParamMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPQueryParamMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IPBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2813,6 +3623,30 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -2938,6 +3772,16 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Tier)
+	copy(dAtA[i:], m.Tier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tier)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.EnforcementMode)
+	copy(dAtA[i:], m.EnforcementMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementMode)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SourceRef != nil {
 		{
 			size, err := m.SourceRef.MarshalToSizedBuffer(dAtA[:i])
@@ -3064,6 +3908,15 @@ func (m *NetworkPolicyEvaluationRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposedPolicies) > 0 {
+		for iNdEx := len(m.ProposedPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposedPolicies[iNdEx])
+			copy(dAtA[i:], m.ProposedPolicies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProposedPolicies[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3363,6 +4216,35 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RejectResponse)
+	copy(dAtA[i:], m.RejectResponse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RejectResponse)))
+	i--
+	dAtA[i] = 0x72
+	if m.PacketCapture != nil {
+		{
+			size, err := m.PacketCapture.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.LogLabel)
 	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
@@ -3476,6 +4358,20 @@ func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeerTrafficStats) > 0 {
+		for iNdEx := len(m.PeerTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerTrafficStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.RuleTrafficStats) > 0 {
 		for iNdEx := len(m.RuleTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3739,6 +4635,66 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Rate))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Unit)
+	copy(dAtA[i:], m.Unit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RulePacketCapture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RulePacketCapture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RulePacketCapture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxPackets))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RuleRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3799,6 +4755,26 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.IPv6ExtensionHeader)
+	copy(dAtA[i:], m.IPv6ExtensionHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IPv6ExtensionHeader)))
+	i--
+	dAtA[i] = 0x72
+	i -= len(m.ICMPTypeName)
+	copy(dAtA[i:], m.ICMPTypeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ICMPTypeName)))
+	i--
+	dAtA[i] = 0x62
+	if m.EndICMPCode != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.EndICMPCode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EndICMPType != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.EndICMPType))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.SrcEndPort != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.SrcEndPort))
 		i--
@@ -4322,34 +5298,145 @@ func (m *ClusterGroupMembers) Size() (n int) {
 	return n
 }
 
-func (m *EgressGroup) Size() (n int) {
+func (m *ConnectivityMatrix) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.GroupMembers) > 0 {
-		for _, e := range m.GroupMembers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *EgressGroupList) Size() (n int) {
+func (m *ConnectivityMatrixCell) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Port != nil {
+		l = m.Port.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Verdict)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectivityMatrixPort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Port))
+	return n
+}
+
+func (m *ConnectivityMatrixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterGroup)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectivityMatrixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pods) > 0 {
+		for _, e := range m.Pods {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Cells) > 0 {
+		for _, e := range m.Cells {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DNSProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EgressGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.GroupMembers) > 0 {
+		for _, e := range m.GroupMembers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EgressGroupList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
@@ -4405,6 +5492,25 @@ func (m *ExternalEntityReference) Size() (n int) {
 	return n
 }
 
+func (m *GRPCProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GroupAssociation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4500,6 +5606,21 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeaderMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4512,6 +5633,33 @@ func (m *HTTPProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.QueryParams) > 0 {
+		for _, e := range m.QueryParams {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HTTPQueryParamMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4577,6 +5725,14 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4641,6 +5797,10 @@ func (m *NetworkPolicy) Size() (n int) {
 		l = m.SourceRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.EnforcementMode)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tier)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4671,6 +5831,12 @@ func (m *NetworkPolicyEvaluationRequest) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Destination.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ProposedPolicies) > 0 {
+		for _, s := range m.ProposedPolicies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4815,6 +5981,16 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PacketCapture != nil {
+		l = m.PacketCapture.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RejectResponse)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4834,6 +6010,12 @@ func (m *NetworkPolicyStats) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.PeerTrafficStats) > 0 {
+		for _, e := range m.PeerTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4924,6 +6106,29 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Rate))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	return n
+}
+
+func (m *RulePacketCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxPackets))
+	return n
+}
+
 func (m *RuleRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4975,6 +6180,16 @@ func (m *Service) Size() (n int) {
 	if m.SrcEndPort != nil {
 		n += 1 + sovGenerated(uint64(*m.SrcEndPort))
 	}
+	if m.EndICMPType != nil {
+		n += 1 + sovGenerated(uint64(*m.EndICMPType))
+	}
+	if m.EndICMPCode != nil {
+		n += 1 + sovGenerated(uint64(*m.EndICMPCode))
+	}
+	l = len(m.ICMPTypeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.IPv6ExtensionHeader)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5245,6 +6460,92 @@ func (this *ClusterGroupMembers) String() string {
 	}, "")
 	return s
 }
+func (this *ConnectivityMatrix) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectivityMatrix{`,
+		`Request:` + strings.Replace(this.Request.String(), "ConnectivityMatrixRequest", "ConnectivityMatrixRequest", 1) + `,`,
+		`Response:` + strings.Replace(this.Response.String(), "ConnectivityMatrixResponse", "ConnectivityMatrixResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectivityMatrixCell) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectivityMatrixCell{`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + `,`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + `,`,
+		`Port:` + strings.Replace(this.Port.String(), "ConnectivityMatrixPort", "ConnectivityMatrixPort", 1) + `,`,
+		`Verdict:` + fmt.Sprintf("%v", this.Verdict) + `,`,
+		`Rule:` + strings.Replace(this.Rule.String(), "NetworkPolicyEvaluationResponse", "NetworkPolicyEvaluationResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectivityMatrixPort) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectivityMatrixPort{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectivityMatrixRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPorts := "[]ConnectivityMatrixPort{"
+	for _, f := range this.Ports {
+		repeatedStringForPorts += strings.Replace(strings.Replace(f.String(), "ConnectivityMatrixPort", "ConnectivityMatrixPort", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPorts += "}"
+	s := strings.Join([]string{`&ConnectivityMatrixRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ClusterGroup:` + fmt.Sprintf("%v", this.ClusterGroup) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`Ports:` + repeatedStringForPorts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectivityMatrixResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPods := "[]PodReference{"
+	for _, f := range this.Pods {
+		repeatedStringForPods += strings.Replace(strings.Replace(f.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPods += "}"
+	repeatedStringForCells := "[]ConnectivityMatrixCell{"
+	for _, f := range this.Cells {
+		repeatedStringForCells += strings.Replace(strings.Replace(f.String(), "ConnectivityMatrixCell", "ConnectivityMatrixCell", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCells += "}"
+	s := strings.Join([]string{`&ConnectivityMatrixResponse{`,
+		`Pods:` + repeatedStringForPods + `,`,
+		`Cells:` + repeatedStringForCells + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DNSProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSProtocol{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RecordTypes:` + fmt.Sprintf("%v", this.RecordTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EgressGroup) String() string {
 	if this == nil {
 		return "nil"
@@ -5320,6 +6621,23 @@ func (this *ExternalEntityReference) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatch{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatch", "HTTPHeaderMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&GRPCProtocol{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupAssociation) String() string {
 	if this == nil {
 		return "nil"
@@ -5393,14 +6711,50 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeaderMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatch{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatch", "HTTPHeaderMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	repeatedStringForQueryParams := "[]HTTPQueryParamMatch{"
+	for _, f := range this.QueryParams {
+		repeatedStringForQueryParams += strings.Replace(strings.Replace(f.String(), "HTTPQueryParamMatch", "HTTPQueryParamMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQueryParams += "}"
 	s := strings.Join([]string{`&HTTPProtocol{`,
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`QueryParams:` + repeatedStringForQueryParams + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPQueryParamMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPQueryParamMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5455,6 +6809,8 @@ func (this *L7Protocol) String() string {
 	s := strings.Join([]string{`&L7Protocol{`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProtocol", "GRPCProtocol", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSProtocol", "DNSProtocol", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5503,6 +6859,8 @@ func (this *NetworkPolicy) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`TierPriority:` + valueToStringGenerated(this.TierPriority) + `,`,
 		`SourceRef:` + strings.Replace(this.SourceRef.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1) + `,`,
+		`EnforcementMode:` + fmt.Sprintf("%v", this.EnforcementMode) + `,`,
+		`Tier:` + fmt.Sprintf("%v", this.Tier) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5525,6 +6883,7 @@ func (this *NetworkPolicyEvaluationRequest) String() string {
 	s := strings.Join([]string{`&NetworkPolicyEvaluationRequest{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "Entity", "Entity", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "Entity", "Entity", 1), `&`, ``, 1) + `,`,
+		`ProposedPolicies:` + fmt.Sprintf("%v", this.ProposedPolicies) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5633,6 +6992,9 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`PacketCapture:` + strings.Replace(this.PacketCapture.String(), "RulePacketCapture", "RulePacketCapture", 1) + `,`,
+		`RejectResponse:` + fmt.Sprintf("%v", this.RejectResponse) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5646,10 +7008,16 @@ func (this *NetworkPolicyStats) String() string {
 		repeatedStringForRuleTrafficStats += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForRuleTrafficStats += "}"
+	repeatedStringForPeerTrafficStats := "[]PeerTrafficStats{"
+	for _, f := range this.PeerTrafficStats {
+		repeatedStringForPeerTrafficStats += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPeerTrafficStats += "}"
 	s := strings.Join([]string{`&NetworkPolicyStats{`,
 		`NetworkPolicy:` + strings.Replace(strings.Replace(this.NetworkPolicy.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1), `&`, ``, 1) + `,`,
 		`TrafficStats:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TrafficStats), "TrafficStats", "v1alpha1.TrafficStats", 1), `&`, ``, 1) + `,`,
 		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
+		`PeerTrafficStats:` + repeatedStringForPeerTrafficStats + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5736,6 +7104,28 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RulePacketCapture) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RulePacketCapture{`,
+		`MaxPackets:` + fmt.Sprintf("%v", this.MaxPackets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RuleRef) String() string {
 	if this == nil {
 		return "nil"
@@ -5762,6 +7152,10 @@ func (this *Service) String() string {
 		`GroupAddress:` + fmt.Sprintf("%v", this.GroupAddress) + `,`,
 		`SrcPort:` + valueToStringGenerated(this.SrcPort) + `,`,
 		`SrcEndPort:` + valueToStringGenerated(this.SrcEndPort) + `,`,
+		`EndICMPType:` + valueToStringGenerated(this.EndICMPType) + `,`,
+		`EndICMPCode:` + valueToStringGenerated(this.EndICMPCode) + `,`,
+		`ICMPTypeName:` + fmt.Sprintf("%v", this.ICMPTypeName) + `,`,
+		`IPv6ExtensionHeader:` + fmt.Sprintf("%v", this.IPv6ExtensionHeader) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5913,13 +7307,1163 @@ func (m *AddressGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupMembers = append(m.GroupMembers, GroupMember{})
+			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AddressGroup{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressGroupPatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
+			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
+			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedToGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedToGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedToGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupMembers = append(m.GroupMembers, GroupMember{})
+			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedToGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedToGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AppliedToGroup{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedToGroupPatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedToGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
+			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
+			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleFileServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleFileServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleFileServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleServerAuthConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleServerAuthConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuthentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuthentication == nil {
+				m.BasicAuthentication = &BasicAuthentication{}
+			}
+			if err := m.BasicAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterGroupMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterGroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveMembers = append(m.EffectiveMembers, GroupMember{})
+			if err := m.EffectiveMembers[len(m.EffectiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveIPBlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5946,11 +8490,68 @@ func (m *AddressGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupMembers = append(m.GroupMembers, GroupMember{})
-			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EffectiveIPBlocks = append(m.EffectiveIPBlocks, IPNet{})
+			if err := m.EffectiveIPBlocks[len(m.EffectiveIPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMembers", wireType)
+			}
+			m.TotalMembers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMembers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPage", wireType)
+			}
+			m.CurrentPage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5972,7 +8573,7 @@ func (m *AddressGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
+func (m *ConnectivityMatrix) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5995,15 +8596,15 @@ func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressGroupList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectivityMatrix: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectivityMatrix: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6030,13 +8631,16 @@ func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &ConnectivityMatrixRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6063,8 +8667,10 @@ func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AddressGroup{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Response == nil {
+				m.Response = &ConnectivityMatrixResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6089,7 +8695,7 @@ func (m *AddressGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
+func (m *ConnectivityMatrixCell) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6112,15 +8718,15 @@ func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressGroupPatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectivityMatrixCell: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectivityMatrixCell: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6147,13 +8753,13 @@ func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6180,14 +8786,13 @@ func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
-			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6214,66 +8819,18 @@ func (m *AddressGroupPatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
-			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Port == nil {
+				m.Port = &ConnectivityMatrixPort{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Port.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppliedToGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppliedToGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppliedToGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verdict", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6283,28 +8840,27 @@ func (m *AppliedToGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Verdict = antrea_io_antrea_pkg_apis_crd_v1beta1.RuleAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6331,8 +8887,10 @@ func (m *AppliedToGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupMembers = append(m.GroupMembers, GroupMember{})
-			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Rule == nil {
+				m.Rule = &NetworkPolicyEvaluationResponse{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6357,7 +8915,7 @@ func (m *AppliedToGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
+func (m *ConnectivityMatrixPort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6380,17 +8938,17 @@ func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppliedToGroupList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectivityMatrixPort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppliedToGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectivityMatrixPort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6400,30 +8958,29 @@ func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Protocol = Protocol(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6433,26 +8990,11 @@ func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, AppliedToGroup{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6474,7 +9016,7 @@ func (m *AppliedToGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
+func (m *ConnectivityMatrixRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6497,17 +9039,17 @@ func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppliedToGroupPatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectivityMatrixRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppliedToGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectivityMatrixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6517,30 +9059,61 @@ func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6550,29 +9123,27 @@ func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
-			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6599,8 +9170,8 @@ func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
-			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ports = append(m.Ports, ConnectivityMatrixPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6625,7 +9196,7 @@ func (m *AppliedToGroupPatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
+func (m *ConnectivityMatrixResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6648,17 +9219,17 @@ func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthentication: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectivityMatrixResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectivityMatrixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6668,29 +9239,31 @@ func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Pods = append(m.Pods, PodReference{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6700,23 +9273,25 @@ func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Cells = append(m.Cells, ConnectivityMatrixCell{})
+			if err := m.Cells[len(m.Cells)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6739,7 +9314,7 @@ func (m *BasicAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BundleFileServer) Unmarshal(dAtA []byte) error {
+func (m *DNSProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6762,15 +9337,15 @@ func (m *BundleFileServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BundleFileServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BundleFileServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6798,7 +9373,39 @@ func (m *BundleFileServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6821,7 +9428,7 @@ func (m *BundleFileServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
+func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6844,17 +9451,17 @@ func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BundleServerAuthConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: EgressGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BundleServerAuthConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EgressGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6864,59 +9471,28 @@ func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.APIKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuthentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6943,10 +9519,8 @@ func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuthentication == nil {
-				m.BasicAuthentication = &BasicAuthentication{}
-			}
-			if err := m.BasicAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroupMembers = append(m.GroupMembers, GroupMember{})
+			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6971,7 +9545,7 @@ func (m *BundleServerAuthConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
+func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6994,15 +9568,15 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterGroupMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EgressGroupList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterGroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EgressGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7029,13 +9603,13 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7062,14 +9636,64 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveMembers = append(m.EffectiveMembers, GroupMember{})
-			if err := m.EffectiveMembers[len(m.EffectiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, EgressGroup{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressGroupPatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveIPBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7096,35 +9720,15 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveIPBlocks = append(m.EffectiveIPBlocks, IPNet{})
-			if err := m.EffectiveIPBlocks[len(m.EffectiveIPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMembers", wireType)
-			}
-			m.TotalMembers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalMembers |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TotalPages = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7134,16 +9738,31 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPage", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.CurrentPage = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
+			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7153,11 +9772,26 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentPage |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
+			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7179,7 +9813,7 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EgressGroup) Unmarshal(dAtA []byte) error {
+func (m *Entity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7202,15 +9836,15 @@ func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EgressGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EgressGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7237,41 +9871,10 @@ func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pod == nil {
+				m.Pod = &PodReference{}
 			}
-			m.GroupMembers = append(m.GroupMembers, GroupMember{})
-			if err := m.GroupMembers[len(m.GroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7296,7 +9899,7 @@ func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
+func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7319,17 +9922,17 @@ func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EgressGroupList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalEntityReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EgressGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalEntityReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7339,30 +9942,29 @@ func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7372,25 +9974,23 @@ func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, EgressGroup{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7413,7 +10013,7 @@ func (m *EgressGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
+func (m *GRPCProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7436,17 +10036,17 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EgressGroupPatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: GRPCProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EgressGroupPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GRPCProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7456,30 +10056,29 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7489,29 +10088,27 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedGroupMembers = append(m.AddedGroupMembers, GroupMember{})
-			if err := m.AddedGroupMembers[len(m.AddedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedGroupMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7538,8 +10135,8 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedGroupMembers = append(m.RemovedGroupMembers, GroupMember{})
-			if err := m.RemovedGroupMembers[len(m.RemovedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, HTTPHeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7564,7 +10161,7 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Entity) Unmarshal(dAtA []byte) error {
+func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7587,15 +10184,15 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupAssociation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7622,10 +10219,41 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pod == nil {
-				m.Pod = &PodReference{}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
+			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7650,7 +10278,7 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
+func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7673,17 +10301,53 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExternalEntityReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalEntityReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pod == nil {
+				m.Pod = &PodReference{}
+			}
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalEntity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7693,29 +10357,33 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ExternalEntity == nil {
+				m.ExternalEntity = &ExternalEntityReference{}
+			}
+			if err := m.ExternalEntity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7725,77 +10393,61 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.IPs = append(m.IPs, make([]byte, postIndex-iNdEx))
+			copy(m.IPs[len(m.IPs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Ports = append(m.Ports, NamedPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAssociation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7822,13 +10474,16 @@ func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Node == nil {
+				m.Node = &NodeReference{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7855,8 +10510,10 @@ func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
-			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &ServiceReference{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7881,7 +10538,7 @@ func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMember) Unmarshal(dAtA []byte) error {
+func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7904,15 +10561,15 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupMembers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7939,16 +10596,13 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pod == nil {
-				m.Pod = &PodReference{}
-			}
-			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalEntity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMembers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7975,18 +10629,16 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalEntity == nil {
-				m.ExternalEntity = &ExternalEntityReference{}
-			}
-			if err := m.ExternalEntity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EffectiveMembers = append(m.EffectiveMembers, GroupMember{})
+			if err := m.EffectiveMembers[len(m.EffectiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveIPBlocks", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7996,29 +10648,138 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IPs = append(m.IPs, make([]byte, postIndex-iNdEx))
-			copy(m.IPs[len(m.IPs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.EffectiveIPBlocks = append(m.EffectiveIPBlocks, IPNet{})
+			if err := m.EffectiveIPBlocks[len(m.EffectiveIPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMembers", wireType)
+			}
+			m.TotalMembers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMembers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPage", wireType)
+			}
+			m.CurrentPage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8028,31 +10789,29 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ports = append(m.Ports, NamedPort{})
-			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8062,33 +10821,29 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Node == nil {
-				m.Node = &NodeReference{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8098,27 +10853,23 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &ServiceReference{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8141,7 +10892,7 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMembers) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8164,17 +10915,17 @@ func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8184,30 +10935,29 @@ func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8217,31 +10967,29 @@ func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveMembers = append(m.EffectiveMembers, GroupMember{})
-			if err := m.EffectiveMembers[len(m.EffectiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = antrea_io_antrea_pkg_apis_crd_v1beta1.StringMatchType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveIPBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8251,83 +10999,24 @@ func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveIPBlocks = append(m.EffectiveIPBlocks, IPNet{})
-			if err := m.EffectiveIPBlocks[len(m.EffectiveIPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMembers", wireType)
-			}
-			m.TotalMembers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalMembers |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
-			}
-			m.TotalPages = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPage", wireType)
-			}
-			m.CurrentPage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentPage |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8349,7 +11038,7 @@ func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupReference) Unmarshal(dAtA []byte) error {
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8372,15 +11061,15 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8408,11 +11097,11 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8440,11 +11129,11 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8472,7 +11161,75 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryParams = append(m.QueryParams, HTTPQueryParamMatch{})
+			if err := m.QueryParams[len(m.QueryParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8495,7 +11252,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+func (m *HTTPQueryParamMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8518,15 +11275,15 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPQueryParamMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPQueryParamMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8554,11 +11311,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8586,11 +11343,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Type = antrea_io_antrea_pkg_apis_crd_v1beta1.StringMatchType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8618,7 +11375,7 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9079,6 +11836,78 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProtocol{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNS == nil {
+				m.DNS = &DNSProtocol{}
+			}
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9545,6 +12374,70 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnforcementMode = antrea_io_antrea_pkg_apis_crd_v1beta1.EnforcementMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9783,6 +12676,38 @@ func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPolicies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedPolicies = append(m.ProposedPolicies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10877,10 +13802,108 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableLogging = bool(v != 0)
-		case 8:
+			m.EnableLogging = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L7Protocols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L7Protocols = append(m.L7Protocols, L7Protocol{})
+			if err := m.L7Protocols[len(m.L7Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10908,13 +13931,13 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			m.LogLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10924,27 +13947,31 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L7Protocols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCapture", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10971,14 +13998,16 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L7Protocols = append(m.L7Protocols, L7Protocol{})
-			if err := m.L7Protocols[len(m.L7Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PacketCapture == nil {
+				m.PacketCapture = &RulePacketCapture{}
+			}
+			if err := m.PacketCapture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectResponse", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11006,7 +14035,7 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogLabel = string(dAtA[iNdEx:postIndex])
+			m.RejectResponse = antrea_io_antrea_pkg_apis_crd_v1beta1.RejectResponseType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11158,6 +14187,40 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerTrafficStats = append(m.PeerTrafficStats, v1alpha1.PeerTrafficStats{})
+			if err := m.PeerTrafficStats[len(m.PeerTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11500,19 +14563,225 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AntreaClusterNetworkPolicies = append(m.AntreaClusterNetworkPolicies, NetworkPolicyStats{})
+			if err := m.AntreaClusterNetworkPolicies[len(m.AntreaClusterNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntreaNetworkPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AntreaNetworkPolicies = append(m.AntreaNetworkPolicies, NetworkPolicyStats{})
+			if err := m.AntreaNetworkPolicies[len(m.AntreaNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multicast = append(m.Multicast, MulticastGroupInfo{})
+			if err := m.Multicast[len(m.Multicast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AntreaClusterNetworkPolicies = append(m.AntreaClusterNetworkPolicies, NetworkPolicyStats{})
-			if err := m.AntreaClusterNetworkPolicies[len(m.AntreaClusterNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntreaNetworkPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11522,31 +14791,29 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AntreaNetworkPolicies = append(m.AntreaNetworkPolicies, NetworkPolicyStats{})
-			if err := m.AntreaNetworkPolicies[len(m.AntreaNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11556,25 +14823,23 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multicast = append(m.Multicast, MulticastGroupInfo{})
-			if err := m.Multicast[len(m.Multicast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11597,7 +14862,7 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11620,17 +14885,17 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11640,16 +14905,29 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = RateLimitUnit(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			m.Limit = 0
+			m.Rate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11659,7 +14937,26 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				m.Rate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11685,7 +14982,7 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodReference) Unmarshal(dAtA []byte) error {
+func (m *RulePacketCapture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11708,49 +15005,17 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: RulePacketCapture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RulePacketCapture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
 			}
-			var stringLen uint64
+			m.MaxPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11760,24 +15025,11 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPackets |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12196,6 +15448,110 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SrcEndPort = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndICMPType", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndICMPType = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndICMPCode", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndICMPCode = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICMPTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ICMPTypeName = antrea_io_antrea_pkg_apis_crd_v1beta1.ICMPTypeName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPv6ExtensionHeader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPv6ExtensionHeader = antrea_io_antrea_pkg_apis_crd_v1beta1.IPv6ExtensionHeaderType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -235,6 +235,12 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// EnforcementMode represents how the rules of this Network Policy are enforced.
+	// Empty means the rules are enforced, which is always the case for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.EnforcementMode `json:"enforcementMode,omitempty" protobuf:"bytes,7,opt,name=enforcementMode,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.EnforcementMode"`
+	// Tier is the name of the Tier associated with this Network Policy. It is only set for
+	// Antrea-native policies, and empty means the default Application Tier.
+	Tier string `json:"tier,omitempty" protobuf:"bytes,8,opt,name=tier"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +283,43 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// RateLimit is the maximum rate of the traffic matching the rule. It is set
+	// if and only if Action is RateLimit.
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,12,opt,name=rateLimit"`
+	// PacketCapture enables capturing the packets matching the rule. It can only
+	// be set when Action is Drop or Reject.
+	PacketCapture *RulePacketCapture `json:"packetCapture,omitempty" protobuf:"bytes,13,opt,name=packetCapture"`
+	// RejectResponse is the response sent to the client when its traffic is
+	// rejected by the rule. Empty means the default response of the protocol.
+	RejectResponse crdv1beta1.RejectResponseType `json:"rejectResponse,omitempty" protobuf:"bytes,14,opt,name=rejectResponse,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.RejectResponseType"`
+}
+
+// RulePacketCapture describes the packet capture of a rule.
+type RulePacketCapture struct {
+	// MaxPackets is the maximum number of packets captured for the rule on each Node.
+	MaxPackets int32 `json:"maxPackets,omitempty" protobuf:"varint,1,opt,name=maxPackets"`
+}
+
+// RateLimitUnit is the unit of the rate and burst of a RateLimit.
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitPacketsPerSecond means that the rate is in packets per
+	// second, and the burst is in packets.
+	RateLimitUnitPacketsPerSecond RateLimitUnit = "pps"
+	// RateLimitUnitKbitsPerSecond means that the rate is in kilobits per
+	// second, and the burst is in kilobits.
+	RateLimitUnitKbitsPerSecond RateLimitUnit = "kbps"
+)
+
+// RateLimit describes the maximum rate of the traffic matching a rule.
+type RateLimit struct {
+	// Unit is the unit of Rate and Burst.
+	Unit RateLimitUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit,casttype=RateLimitUnit"`
+	// Rate is the maximum rate of the traffic.
+	Rate uint32 `json:"rate,omitempty" protobuf:"varint,2,opt,name=rate"`
+	// Burst is the maximum size of the traffic which can exceed the rate at once.
+	Burst uint32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -293,6 +336,8 @@ const (
 	ProtocolICMP Protocol = "ICMP"
 
 	ProtocolIGMP Protocol = "IGMP"
+	// ProtocolIPv6ExtensionHeader matches IPv6 packets carrying a specific extension header.
+	ProtocolIPv6ExtensionHeader Protocol = "IPv6ExtensionHeader"
 )
 
 // Service describes a port to allow traffic on.
@@ -324,16 +369,30 @@ type Service struct {
 	// +optional
 	SrcPort    *int32 `json:"srcPort,omitempty" protobuf:"bytes,8,opt,name=srcPort"`
 	SrcEndPort *int32 `json:"srcEndPort,omitempty" protobuf:"bytes,9,opt,name=srcEndPort"`
+	// EndICMPType and EndICMPCode define the end of the ICMP type and code ranges,
+	// inclusive. They can only be specified when ICMPType and ICMPCode are specified.
+	// +optional
+	EndICMPType *int32 `json:"endIcmpType,omitempty" protobuf:"bytes,10,opt,name=endIcmpType"`
+	EndICMPCode *int32 `json:"endIcmpCode,omitempty" protobuf:"bytes,11,opt,name=endIcmpCode"`
+	// ICMPTypeName matches the ICMP or ICMPv6 type with the given name. It cannot be
+	// specified together with ICMPType.
+	// +optional
+	ICMPTypeName crdv1beta1.ICMPTypeName `json:"icmpTypeName,omitempty" protobuf:"bytes,12,opt,name=icmpTypeName,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPTypeName"`
+	// IPv6ExtensionHeader can only be specified when the Protocol is IPv6ExtensionHeader.
+	// +optional
+	IPv6ExtensionHeader crdv1beta1.IPv6ExtensionHeaderType `json:"ipv6ExtensionHeader,omitempty" protobuf:"bytes,14,opt,name=ipv6ExtensionHeader,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6ExtensionHeaderType"`
 }
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
 	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	DNS  *DNSProtocol  `json:"dns,omitempty" protobuf:"bytes,4,opt,name=dns"`
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
-// If all fields are not provided, it matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could
+// be used alone or together. If all fields are not provided, it matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -343,6 +402,53 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// Headers represents the HTTP request headers to match. A request matches only if all the headers match.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,4,rep,name=headers"`
+	// QueryParams represents the query parameters of the URI to match. A request matches only if all the
+	// query parameters match.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" protobuf:"bytes,5,rep,name=queryParams"`
+}
+
+// HTTPHeaderMatch describes how to match an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match. The match is case-insensitive.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Type specifies how to match the value of the header.
+	Type crdv1beta1.StringMatchType `json:"type,omitempty" protobuf:"bytes,2,opt,name=type,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.StringMatchType"`
+	// Value is the value of the header to match.
+	Value string `json:"value,omitempty" protobuf:"bytes,3,opt,name=value"`
+}
+
+// HTTPQueryParamMatch describes how to match a query parameter of the URI of an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. The match is case-sensitive.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Type specifies how to match the value of the query parameter.
+	Type crdv1beta1.StringMatchType `json:"type,omitempty" protobuf:"bytes,2,opt,name=type,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.StringMatchType"`
+	// Value is the value of the query parameter to match.
+	Value string `json:"value,omitempty" protobuf:"bytes,3,opt,name=value"`
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers represents the request metadata to match, which is sent as HTTP/2 headers. A request matches only
+	// if all the headers match.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+}
+
+// DNSProtocol matches DNS queries with specific queried name and record types. All fields could be used alone or
+// together. If all fields are not provided, it matches all DNS queries.
+type DNSProtocol struct {
+	// Name represents the queried domain name to match, without the trailing dot.
+	// Both exact matches and wildcards are supported (Ex. "*.foo.com", "foo.bar.com").
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// RecordTypes represents the record types of the query to match (Ex. "A", "AAAA").
+	RecordTypes []string `json:"recordTypes,omitempty" protobuf:"bytes,2,rep,name=recordTypes"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -426,6 +532,9 @@ type NetworkPolicyStats struct {
 	TrafficStats statsv1alpha1.TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
 	// The stats of the NetworkPolicy rules. It's empty for K8s NetworkPolicies as they don't have rule name to identify a rule.
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
+	// The stats of the NetworkPolicy from peer perspective. It's only set for K8s NetworkPolicies, the stats of the
+	// rules of Antrea-native policies include their peer stats.
+	PeerTrafficStats []statsv1alpha1.PeerTrafficStats `json:"peerTrafficStats,omitempty" protobuf:"bytes,4,rep,name=peerTrafficStats"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -471,6 +580,10 @@ type Entity struct {
 type NetworkPolicyEvaluationRequest struct {
 	Source      Entity `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	Destination Entity `json:"destination,omitempty" protobuf:"bytes,2,opt,name=destination"`
+	// ProposedPolicies are YAML or JSON manifests of K8s NetworkPolicies, Antrea NetworkPolicies,
+	// Antrea ClusterNetworkPolicies and Tiers, which are evaluated as if they were applied. A
+	// proposed policy or Tier replaces the existing one with the same kind, Namespace and name.
+	ProposedPolicies []string `json:"proposedPolicies,omitempty" protobuf:"bytes,3,rep,name=proposedPolicies"`
 }
 
 // RuleRef contains basic information for the rule.
@@ -489,6 +602,59 @@ type NetworkPolicyEvaluationResponse struct {
 	Rule RuleRef `json:"rule,omitempty" protobuf:"bytes,3,opt,name=rule"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConnectivityMatrix contains the request and response for computing the effective NetworkPolicy
+// rules between all pairs of Pods of a set.
+type ConnectivityMatrix struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConnectivityMatrixRequest  `json:"request,omitempty" protobuf:"bytes,1,opt,name=request"`
+	Response        *ConnectivityMatrixResponse `json:"response,omitempty" protobuf:"bytes,2,opt,name=response"`
+}
+
+// ConnectivityMatrixRequest selects the Pods of a connectivity matrix, either by ClusterGroup, or
+// by Namespace and/or label selector. Without Namespace, the label selector selects Pods in all
+// Namespaces.
+type ConnectivityMatrixRequest struct {
+	Namespace    string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	ClusterGroup string `json:"clusterGroup,omitempty" protobuf:"bytes,2,opt,name=clusterGroup"`
+	// LabelSelector selects Pods by labels, in the same format as label selectors of list options.
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,3,opt,name=labelSelector"`
+	// Ports are the destination ports to compute verdicts for. If empty, a single verdict is
+	// computed for each pair of Pods, regardless of the ports selected by rules.
+	Ports []ConnectivityMatrixPort `json:"ports,omitempty" protobuf:"bytes,4,rep,name=ports"`
+}
+
+// ConnectivityMatrixPort is a destination port of a connectivity matrix.
+type ConnectivityMatrixPort struct {
+	Protocol Protocol `json:"protocol,omitempty" protobuf:"bytes,1,opt,name=protocol"`
+	Port     int32    `json:"port,omitempty" protobuf:"varint,2,opt,name=port"`
+}
+
+// ConnectivityMatrixResponse is the response of a connectivity matrix computation.
+type ConnectivityMatrixResponse struct {
+	// Pods are the selected Pods, sorted by Namespace and name.
+	Pods []PodReference `json:"pods,omitempty" protobuf:"bytes,1,rep,name=pods"`
+	// Cells contain the verdicts for each pair of different Pods and each requested port.
+	Cells []ConnectivityMatrixCell `json:"cells,omitempty" protobuf:"bytes,2,rep,name=cells"`
+}
+
+// ConnectivityMatrixCell is the verdict for the traffic from a source Pod to a destination Pod.
+type ConnectivityMatrixCell struct {
+	Source      PodReference `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Destination PodReference `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// Port is the destination port, nil when no port is requested.
+	Port *ConnectivityMatrixPort `json:"port,omitempty" protobuf:"bytes,3,opt,name=port"`
+	// Verdict is the action applied to the traffic: Allow, Drop or Reject.
+	Verdict crdv1beta1.RuleAction `json:"verdict" protobuf:"bytes,4,opt,name=verdict,casttype=antrea.io/antrea/pkg/apis/security/v1beta1.RuleAction"`
+	// Rule is the effective rule which decides the verdict, nil when no rule applies and the
+	// traffic is allowed by default.
+	Rule *NetworkPolicyEvaluationResponse `json:"rule,omitempty" protobuf:"bytes,5,opt,name=rule"`
+}
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
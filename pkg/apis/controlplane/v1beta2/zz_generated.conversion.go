@@ -139,6 +139,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrix)(nil), (*controlplane.ConnectivityMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(a.(*ConnectivityMatrix), b.(*controlplane.ConnectivityMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrix)(nil), (*ConnectivityMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(a.(*controlplane.ConnectivityMatrix), b.(*ConnectivityMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixCell)(nil), (*controlplane.ConnectivityMatrixCell)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixCell_To_controlplane_ConnectivityMatrixCell(a.(*ConnectivityMatrixCell), b.(*controlplane.ConnectivityMatrixCell), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixCell)(nil), (*ConnectivityMatrixCell)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixCell_To_v1beta2_ConnectivityMatrixCell(a.(*controlplane.ConnectivityMatrixCell), b.(*ConnectivityMatrixCell), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixPort)(nil), (*controlplane.ConnectivityMatrixPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixPort_To_controlplane_ConnectivityMatrixPort(a.(*ConnectivityMatrixPort), b.(*controlplane.ConnectivityMatrixPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixPort)(nil), (*ConnectivityMatrixPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixPort_To_v1beta2_ConnectivityMatrixPort(a.(*controlplane.ConnectivityMatrixPort), b.(*ConnectivityMatrixPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixRequest)(nil), (*controlplane.ConnectivityMatrixRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(a.(*ConnectivityMatrixRequest), b.(*controlplane.ConnectivityMatrixRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixRequest)(nil), (*ConnectivityMatrixRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(a.(*controlplane.ConnectivityMatrixRequest), b.(*ConnectivityMatrixRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityMatrixResponse)(nil), (*controlplane.ConnectivityMatrixResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(a.(*ConnectivityMatrixResponse), b.(*controlplane.ConnectivityMatrixResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ConnectivityMatrixResponse)(nil), (*ConnectivityMatrixResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(a.(*controlplane.ConnectivityMatrixResponse), b.(*ConnectivityMatrixResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -189,6 +249,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -229,6 +299,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatch)(nil), (*controlplane.HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(a.(*HTTPHeaderMatch), b.(*controlplane.HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatch)(nil), (*HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(a.(*controlplane.HTTPHeaderMatch), b.(*HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -239,6 +319,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPQueryParamMatch)(nil), (*controlplane.HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(a.(*HTTPQueryParamMatch), b.(*controlplane.HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPQueryParamMatch)(nil), (*HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(a.(*controlplane.HTTPQueryParamMatch), b.(*HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -449,6 +539,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*controlplane.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RateLimit_To_controlplane_RateLimit(a.(*RateLimit), b.(*controlplane.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RateLimit_To_v1beta2_RateLimit(a.(*controlplane.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RulePacketCapture)(nil), (*controlplane.RulePacketCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RulePacketCapture_To_controlplane_RulePacketCapture(a.(*RulePacketCapture), b.(*controlplane.RulePacketCapture), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RulePacketCapture)(nil), (*RulePacketCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RulePacketCapture_To_v1beta2_RulePacketCapture(a.(*controlplane.RulePacketCapture), b.(*RulePacketCapture), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RuleRef)(nil), (*controlplane.RuleRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_RuleRef_To_controlplane_RuleRef(a.(*RuleRef), b.(*controlplane.RuleRef), scope)
 	}); err != nil {
@@ -769,6 +879,156 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in *ConnectivityMatrix, out *controlplane.ConnectivityMatrix, s conversion.Scope) error {
+	out.Request = (*controlplane.ConnectivityMatrixRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*controlplane.ConnectivityMatrixResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in *ConnectivityMatrix, out *controlplane.ConnectivityMatrix, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrix_To_controlplane_ConnectivityMatrix(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in *controlplane.ConnectivityMatrix, out *ConnectivityMatrix, s conversion.Scope) error {
+	out.Request = (*ConnectivityMatrixRequest)(unsafe.Pointer(in.Request))
+	out.Response = (*ConnectivityMatrixResponse)(unsafe.Pointer(in.Response))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in *controlplane.ConnectivityMatrix, out *ConnectivityMatrix, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrix_To_v1beta2_ConnectivityMatrix(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixCell_To_controlplane_ConnectivityMatrixCell(in *ConnectivityMatrixCell, out *controlplane.ConnectivityMatrixCell, s conversion.Scope) error {
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Port = (*controlplane.ConnectivityMatrixPort)(unsafe.Pointer(in.Port))
+	out.Verdict = v1beta1.RuleAction(in.Verdict)
+	out.Rule = (*controlplane.NetworkPolicyEvaluationResponse)(unsafe.Pointer(in.Rule))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixCell_To_controlplane_ConnectivityMatrixCell is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixCell_To_controlplane_ConnectivityMatrixCell(in *ConnectivityMatrixCell, out *controlplane.ConnectivityMatrixCell, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixCell_To_controlplane_ConnectivityMatrixCell(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixCell_To_v1beta2_ConnectivityMatrixCell(in *controlplane.ConnectivityMatrixCell, out *ConnectivityMatrixCell, s conversion.Scope) error {
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Port = (*ConnectivityMatrixPort)(unsafe.Pointer(in.Port))
+	out.Verdict = v1beta1.RuleAction(in.Verdict)
+	out.Rule = (*NetworkPolicyEvaluationResponse)(unsafe.Pointer(in.Rule))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixCell_To_v1beta2_ConnectivityMatrixCell is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixCell_To_v1beta2_ConnectivityMatrixCell(in *controlplane.ConnectivityMatrixCell, out *ConnectivityMatrixCell, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixCell_To_v1beta2_ConnectivityMatrixCell(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixPort_To_controlplane_ConnectivityMatrixPort(in *ConnectivityMatrixPort, out *controlplane.ConnectivityMatrixPort, s conversion.Scope) error {
+	out.Protocol = controlplane.Protocol(in.Protocol)
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixPort_To_controlplane_ConnectivityMatrixPort is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixPort_To_controlplane_ConnectivityMatrixPort(in *ConnectivityMatrixPort, out *controlplane.ConnectivityMatrixPort, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixPort_To_controlplane_ConnectivityMatrixPort(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixPort_To_v1beta2_ConnectivityMatrixPort(in *controlplane.ConnectivityMatrixPort, out *ConnectivityMatrixPort, s conversion.Scope) error {
+	out.Protocol = Protocol(in.Protocol)
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixPort_To_v1beta2_ConnectivityMatrixPort is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixPort_To_v1beta2_ConnectivityMatrixPort(in *controlplane.ConnectivityMatrixPort, out *ConnectivityMatrixPort, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixPort_To_v1beta2_ConnectivityMatrixPort(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in *ConnectivityMatrixRequest, out *controlplane.ConnectivityMatrixRequest, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.ClusterGroup = in.ClusterGroup
+	out.LabelSelector = in.LabelSelector
+	out.Ports = *(*[]controlplane.ConnectivityMatrixPort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in *ConnectivityMatrixRequest, out *controlplane.ConnectivityMatrixRequest, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixRequest_To_controlplane_ConnectivityMatrixRequest(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in *controlplane.ConnectivityMatrixRequest, out *ConnectivityMatrixRequest, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.ClusterGroup = in.ClusterGroup
+	out.LabelSelector = in.LabelSelector
+	out.Ports = *(*[]ConnectivityMatrixPort)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in *controlplane.ConnectivityMatrixRequest, out *ConnectivityMatrixRequest, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixRequest_To_v1beta2_ConnectivityMatrixRequest(in, out, s)
+}
+
+func autoConvert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in *ConnectivityMatrixResponse, out *controlplane.ConnectivityMatrixResponse, s conversion.Scope) error {
+	out.Pods = *(*[]controlplane.PodReference)(unsafe.Pointer(&in.Pods))
+	out.Cells = *(*[]controlplane.ConnectivityMatrixCell)(unsafe.Pointer(&in.Cells))
+	return nil
+}
+
+// Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse is an autogenerated conversion function.
+func Convert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in *ConnectivityMatrixResponse, out *controlplane.ConnectivityMatrixResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_ConnectivityMatrixResponse_To_controlplane_ConnectivityMatrixResponse(in, out, s)
+}
+
+func autoConvert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in *controlplane.ConnectivityMatrixResponse, out *ConnectivityMatrixResponse, s conversion.Scope) error {
+	out.Pods = *(*[]PodReference)(unsafe.Pointer(&in.Pods))
+	out.Cells = *(*[]ConnectivityMatrixCell)(unsafe.Pointer(&in.Cells))
+	return nil
+}
+
+// Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse is an autogenerated conversion function.
+func Convert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in *controlplane.ConnectivityMatrixResponse, out *ConnectivityMatrixResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_ConnectivityMatrixResponse_To_v1beta2_ConnectivityMatrixResponse(in, out, s)
+}
+
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RecordTypes = *(*[]string)(unsafe.Pointer(&in.RecordTypes))
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RecordTypes = *(*[]string)(unsafe.Pointer(&in.RecordTypes))
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -879,6 +1139,30 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -985,10 +1269,36 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = v1beta1.StringMatchType(in.Type)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = v1beta1.StringMatchType(in.Type)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]controlplane.HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1001,6 +1311,8 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1009,6 +1321,30 @@ func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.
 	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = v1beta1.StringMatchType(in.Type)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = v1beta1.StringMatchType(in.Type)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1082,6 +1418,8 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1093,6 +1431,8 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1164,6 +1504,8 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.EnforcementMode(in.EnforcementMode)
+	out.Tier = in.Tier
 	return nil
 }
 
@@ -1189,6 +1531,8 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.EnforcementMode(in.EnforcementMode)
+	out.Tier = in.Tier
 	return nil
 }
 
@@ -1226,6 +1570,7 @@ func autoConvert_v1beta2_NetworkPolicyEvaluationRequest_To_controlplane_NetworkP
 	if err := Convert_v1beta2_Entity_To_controlplane_Entity(&in.Destination, &out.Destination, s); err != nil {
 		return err
 	}
+	out.ProposedPolicies = *(*[]string)(unsafe.Pointer(&in.ProposedPolicies))
 	return nil
 }
 
@@ -1241,6 +1586,7 @@ func autoConvert_controlplane_NetworkPolicyEvaluationRequest_To_v1beta2_NetworkP
 	if err := Convert_controlplane_Entity_To_v1beta2_Entity(&in.Destination, &out.Destination, s); err != nil {
 		return err
 	}
+	out.ProposedPolicies = *(*[]string)(unsafe.Pointer(&in.ProposedPolicies))
 	return nil
 }
 
@@ -1429,6 +1775,9 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*controlplane.RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.PacketCapture = (*controlplane.RulePacketCapture)(unsafe.Pointer(in.PacketCapture))
+	out.RejectResponse = v1beta1.RejectResponseType(in.RejectResponse)
 	return nil
 }
 
@@ -1463,6 +1812,9 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.PacketCapture = (*RulePacketCapture)(unsafe.Pointer(in.PacketCapture))
+	out.RejectResponse = v1beta1.RejectResponseType(in.RejectResponse)
 	return nil
 }
 
@@ -1477,6 +1829,7 @@ func autoConvert_v1beta2_NetworkPolicyStats_To_controlplane_NetworkPolicyStats(i
 	}
 	out.TrafficStats = in.TrafficStats
 	out.RuleTrafficStats = *(*[]v1alpha1.RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	out.PeerTrafficStats = *(*[]v1alpha1.PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -1491,6 +1844,7 @@ func autoConvert_controlplane_NetworkPolicyStats_To_v1beta2_NetworkPolicyStats(i
 	}
 	out.TrafficStats = in.TrafficStats
 	out.RuleTrafficStats = *(*[]v1alpha1.RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	out.PeerTrafficStats = *(*[]v1alpha1.PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -1638,6 +1992,50 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	out.Unit = controlplane.RateLimitUnit(in.Unit)
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1beta2_RateLimit_To_controlplane_RateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.Unit = RateLimitUnit(in.Unit)
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_controlplane_RateLimit_To_v1beta2_RateLimit is an autogenerated conversion function.
+func Convert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in, out, s)
+}
+
+func autoConvert_v1beta2_RulePacketCapture_To_controlplane_RulePacketCapture(in *RulePacketCapture, out *controlplane.RulePacketCapture, s conversion.Scope) error {
+	out.MaxPackets = in.MaxPackets
+	return nil
+}
+
+// Convert_v1beta2_RulePacketCapture_To_controlplane_RulePacketCapture is an autogenerated conversion function.
+func Convert_v1beta2_RulePacketCapture_To_controlplane_RulePacketCapture(in *RulePacketCapture, out *controlplane.RulePacketCapture, s conversion.Scope) error {
+	return autoConvert_v1beta2_RulePacketCapture_To_controlplane_RulePacketCapture(in, out, s)
+}
+
+func autoConvert_controlplane_RulePacketCapture_To_v1beta2_RulePacketCapture(in *controlplane.RulePacketCapture, out *RulePacketCapture, s conversion.Scope) error {
+	out.MaxPackets = in.MaxPackets
+	return nil
+}
+
+// Convert_controlplane_RulePacketCapture_To_v1beta2_RulePacketCapture is an autogenerated conversion function.
+func Convert_controlplane_RulePacketCapture_To_v1beta2_RulePacketCapture(in *controlplane.RulePacketCapture, out *RulePacketCapture, s conversion.Scope) error {
+	return autoConvert_controlplane_RulePacketCapture_To_v1beta2_RulePacketCapture(in, out, s)
+}
+
 func autoConvert_v1beta2_RuleRef_To_controlplane_RuleRef(in *RuleRef, out *controlplane.RuleRef, s conversion.Scope) error {
 	out.Direction = controlplane.Direction(in.Direction)
 	out.Name = in.Name
@@ -1672,6 +2070,10 @@ func autoConvert_v1beta2_Service_To_controlplane_Service(in *Service, out *contr
 	out.GroupAddress = in.GroupAddress
 	out.SrcPort = (*int32)(unsafe.Pointer(in.SrcPort))
 	out.SrcEndPort = (*int32)(unsafe.Pointer(in.SrcEndPort))
+	out.EndICMPType = (*int32)(unsafe.Pointer(in.EndICMPType))
+	out.EndICMPCode = (*int32)(unsafe.Pointer(in.EndICMPCode))
+	out.ICMPTypeName = v1beta1.ICMPTypeName(in.ICMPTypeName)
+	out.IPv6ExtensionHeader = v1beta1.IPv6ExtensionHeaderType(in.IPv6ExtensionHeader)
 	return nil
 }
 
@@ -1688,8 +2090,12 @@ func autoConvert_controlplane_Service_To_v1beta2_Service(in *controlplane.Servic
 	out.SrcEndPort = (*int32)(unsafe.Pointer(in.SrcEndPort))
 	out.ICMPType = (*int32)(unsafe.Pointer(in.ICMPType))
 	out.ICMPCode = (*int32)(unsafe.Pointer(in.ICMPCode))
+	out.EndICMPType = (*int32)(unsafe.Pointer(in.EndICMPType))
+	out.EndICMPCode = (*int32)(unsafe.Pointer(in.EndICMPCode))
+	out.ICMPTypeName = v1beta1.ICMPTypeName(in.ICMPTypeName)
 	out.IGMPType = (*int32)(unsafe.Pointer(in.IGMPType))
 	out.GroupAddress = in.GroupAddress
+	out.IPv6ExtensionHeader = v1beta1.IPv6ExtensionHeaderType(in.IPv6ExtensionHeader)
 	return nil
 }
 
@@ -55,6 +55,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SupportBundleCollectionList{},
 		&NodeLatencyMonitor{},
 		&NodeLatencyMonitorList{},
+		&IPSet{},
+		&IPSetList{},
+		&TraceflowProbe{},
+		&TraceflowProbeList{},
 	)
 
 	metav1.AddToGroupVersion(
@@ -17,6 +17,8 @@ package v1alpha1
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 type TraceflowPhase string
@@ -325,3 +327,131 @@ type NodeLatencyMonitorList struct {
 
 	Items []NodeLatencyMonitor `json:"items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPSet holds a potentially large list of CIDRs which can be referenced by
+// ClusterGroups, to be used as IPBlock peers in Antrea-native policies. The
+// CIDRs can be specified inline, or loaded from a ConfigMap. The Antrea
+// Controller aggregates the CIDRs from all sources before computing policy
+// rules.
+type IPSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPSetSpec   `json:"spec"`
+	Status IPSetStatus `json:"status,omitempty"`
+}
+
+type IPSetSpec struct {
+	// CIDRs is a list of CIDRs (Ex. "10.0.0.0/8") or IP addresses specified
+	// inline.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+	// ConfigMap refers to a ConfigMap whose data holds CIDRs, separated by
+	// whitespaces or newlines. Text following a '#' on a line is ignored.
+	// +optional
+	ConfigMap *IPSetConfigMapSource `json:"configMap,omitempty"`
+}
+
+// IPSetConfigMapSource refers to the data of a ConfigMap.
+type IPSetConfigMapSource struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Key selects a single entry of the ConfigMap data. All entries are
+	// used if it is empty.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type IPSetStatus struct {
+	// The number of CIDRs loaded from all sources.
+	CIDRs int32 `json:"cidrs"`
+	// The number of CIDRs remaining after removing duplicated or covered
+	// CIDRs and merging adjacent ones.
+	AggregatedCIDRs int32 `json:"aggregatedCIDRs"`
+	// LastUpdateTime is the last time the CIDRs loaded from the sources
+	// changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Message describes why loading the sources failed, if it did.
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type IPSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []IPSet `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TraceflowProbe periodically starts a Traceflow from the same template, to
+// continuously verify a datapath between two endpoints. The outcome of each
+// run is recorded in the status and exposed as Prometheus metrics by the
+// Antrea Controller.
+type TraceflowProbe struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TraceflowProbeSpec   `json:"spec"`
+	Status TraceflowProbeStatus `json:"status,omitempty"`
+}
+
+type TraceflowProbeSpec struct {
+	// IntervalSeconds is the interval between the starts of two consecutive
+	// runs, in seconds.
+	IntervalSeconds int32 `json:"intervalSeconds"`
+	// Traceflow is the spec of the Traceflow started for each run.
+	// Live-traffic Traceflow is not supported.
+	Traceflow crdv1beta1.TraceflowSpec `json:"traceflow"`
+}
+
+type TraceflowProbeResult string
+
+const (
+	// TraceflowProbeSucceeded means the Traceflow packets were delivered or
+	// forwarded out of the overlay.
+	TraceflowProbeSucceeded TraceflowProbeResult = "Succeeded"
+	// TraceflowProbeDropped means a Traceflow packet was dropped or
+	// rejected.
+	TraceflowProbeDropped TraceflowProbeResult = "Dropped"
+	// TraceflowProbeFailed means the Traceflow could not be started or did
+	// not complete, e.g. because of a timeout.
+	TraceflowProbeFailed TraceflowProbeResult = "Failed"
+)
+
+type TraceflowProbeStatus struct {
+	// LastRunTime is the time when the last Traceflow was started.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// LastTraceflow is the name of the Traceflow of the last completed run.
+	LastTraceflow string `json:"lastTraceflow,omitempty"`
+	// Result is the outcome of the last completed run.
+	Result TraceflowProbeResult `json:"result,omitempty"`
+	// Reason describes why the last completed run did not succeed.
+	Reason string `json:"reason,omitempty"`
+	// DropNode and DropComponent locate where the Traceflow packet was
+	// dropped in the last completed run.
+	DropNode      string                        `json:"dropNode,omitempty"`
+	DropComponent crdv1beta1.TraceflowComponent `json:"dropComponent,omitempty"`
+	// ConsecutiveFailures is the number of consecutive runs which did not
+	// succeed.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type TraceflowProbeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TraceflowProbe `json:"items"`
+}
@@ -191,6 +191,25 @@ type TierSpec struct {
 	// Description is an optional field to add more information regarding
 	// the purpose of this Tier.
 	Description string `json:"description,omitempty"`
+	// EnforcementMode specifies how the policies in this Tier are enforced.
+	// If set to Audit, all policies in this Tier are enforced in Audit mode,
+	// regardless of their own EnforcementMode. Defaults to Enforce.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+	// Delegation carves out a priority band right after this Tier and delegates it to the
+	// Namespaces selected by NamespaceSelector, in which NamespacedTiers can be created
+	// with priorities in the band. No other Tier can be created in the band.
+	// +optional
+	Delegation *TierDelegation `json:"delegation,omitempty"`
+}
+
+// TierDelegation defines the priority band delegated by a Tier to tenant Namespaces.
+type TierDelegation struct {
+	// Select the Namespaces to which the priority band is delegated.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+	// MaxPriority is the highest priority number of the band. The band ranges from the
+	// priority of the Tier, exclusive, to MaxPriority, inclusive.
+	MaxPriority int32 `json:"maxPriority"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -203,6 +222,42 @@ type TierList struct {
 	Items []Tier `json:"items"`
 }
 
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespacedTier is a Tier scoped to a Namespace, which allows the tenant of the Namespace to
+// order its Antrea NetworkPolicies within the priority band delegated to the Namespace by a
+// Tier. It can only be referenced by the Antrea NetworkPolicies in the same Namespace.
+type NamespacedTier struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard metadata of the object.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of NamespacedTier.
+	Spec NamespacedTierSpec `json:"spec"`
+}
+
+// NamespacedTierSpec defines the desired state for NamespacedTier.
+type NamespacedTierSpec struct {
+	// Priority specfies the order of the NamespacedTier relative to other Tiers. It must
+	// be in a priority band delegated to the Namespace.
+	Priority int32 `json:"priority"`
+	// Description is an optional field to add more information regarding
+	// the purpose of this NamespacedTier.
+	Description string `json:"description,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NamespacedTierList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NamespacedTier `json:"items"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -402,16 +457,29 @@ type GroupSpec struct {
 	// Cannot be set with any selector/IPBlock/ServiceReference.
 	// +optional
 	ChildGroups []ClusterGroupReference `json:"childGroups,omitempty"`
+	// Select the CIDRs held by the referred IPSet, as IPBlocks in To/From
+	// fields. Only supported in ClusterGroups.
+	// Cannot be set with any other selector, IPBlock, ServiceReference or
+	// ChildGroups.
+	// +optional
+	IPSet string `json:"ipSet,omitempty"`
 }
 
 type GroupConditionType string
 
-const GroupMembersComputed GroupConditionType = "GroupMembersComputed"
+const (
+	GroupMembersComputed GroupConditionType = "GroupMembersComputed"
+	// GroupRuleConflict reports the rules referencing the ClusterGroup which are shadowed by,
+	// redundant with, or conflicting with rules enforced before them.
+	GroupRuleConflict GroupConditionType = "RuleConflict"
+)
 
 type GroupCondition struct {
 	Type               GroupConditionType     `json:"type"`
 	Status             corev1.ConditionStatus `json:"status"`
 	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
 }
 
 // GroupStatus represents information about the status of a Group.
@@ -498,6 +566,16 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// Schedules restrict the enforcement of the policy to the declared time
+	// windows. The policy is enforced as long as at least one of the windows
+	// is active. If this field is empty, the policy is always enforced.
+	// +optional
+	Schedules []PolicySchedule `json:"schedules,omitempty"`
+	// EnforcementMode specifies how the policy is enforced. In Audit mode,
+	// traffic matching Drop or Reject rules is logged and counted but not
+	// denied. Defaults to Enforce.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
@@ -524,6 +602,12 @@ const (
 	NetworkPolicyConditionRealizable NetworkPolicyConditionType = "Realizable"
 	// NetworkPolicyConditionRealizationFailure reports information about a failure when realizing the NetworkPolicy on a Node.
 	NetworkPolicyConditionRealizationFailure NetworkPolicyConditionType = "RealizationFailure"
+	// NetworkPolicyConditionRuleConflict reports the rules of the NetworkPolicy which are shadowed by, redundant with,
+	// or conflicting with rules enforced before them.
+	NetworkPolicyConditionRuleConflict NetworkPolicyConditionType = "RuleConflict"
+	// NetworkPolicyConditionStaleRule reports the rules of the NetworkPolicy which have not matched any traffic
+	// during the configured stale rule detection window.
+	NetworkPolicyConditionStaleRule NetworkPolicyConditionType = "StaleRule"
 )
 
 // NetworkPolicyCondition describes the state of a NetworkPolicy at a certain point.
@@ -555,6 +639,42 @@ type NetworkPolicyStatus struct {
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
 	// Represents the latest available observations of a NetworkPolicy current state.
 	Conditions []NetworkPolicyCondition `json:"conditions"`
+	// The activation state of the NetworkPolicy. Only set when the NetworkPolicy
+	// or any of its rules has Schedules.
+	// +optional
+	Schedule *NetworkPolicyScheduleStatus `json:"schedule,omitempty"`
+}
+
+// PolicySchedule describes a recurring time window during which a policy or
+// a rule is enforced.
+type PolicySchedule struct {
+	// Schedule is a cron expression in the standard 5-field format
+	// ("minute hour day-of-month month day-of-week") which specifies when
+	// each window starts, e.g. "0 22 * * 6" for every Saturday at 22:00.
+	Schedule string `json:"schedule"`
+	// Duration is how long each window lasts after it starts, e.g. "48h".
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA name of the time zone in which Schedule is
+	// interpreted, e.g. "America/Los_Angeles". Defaults to "UTC".
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// NetworkPolicyScheduleStatus reports the activation state of a NetworkPolicy
+// with Schedules.
+type NetworkPolicyScheduleStatus struct {
+	// Active is true if the policy is enforced at the moment. For a policy with
+	// only rule-level Schedules, it is true if at least one of these rules is
+	// enforced.
+	Active bool `json:"active"`
+	// ActiveRules is the number of rules with Schedules which are enforced at
+	// the moment.
+	// +optional
+	ActiveRules int32 `json:"activeRules,omitempty"`
+	// NextTransitionTime is the time at which the activation state of the
+	// policy or any of its rules is expected to change next.
+	// +optional
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -611,6 +731,79 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// Schedules restrict the enforcement of this rule to the declared time
+	// windows. The rule is enforced as long as at least one of the windows is
+	// active. If this field is empty, the rule is always enforced.
+	// +optional
+	Schedules []PolicySchedule `json:"schedules,omitempty"`
+	// RateLimit specifies the maximum rate of the traffic matching this rule.
+	// It must be set if and only if Action is RateLimit.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+	// PacketCapture enables capturing the packets matching this rule to a pcap
+	// file on the Node where the rule is enforced. It can only be set when
+	// Action is Drop or Reject.
+	// +optional
+	PacketCapture *RulePacketCapture `json:"packetCapture,omitempty"`
+	// RejectResponse specifies the response sent to the client when its
+	// traffic is rejected by this rule. It can only be set when Action is
+	// Reject, or for rules with L7Protocols, in which case the response is
+	// sent when the traffic doesn't match the layer 7 criteria. If not set,
+	// TCP traffic is rejected with a TCP RST and other traffic with an ICMP
+	// administratively prohibited message.
+	// +optional
+	RejectResponse RejectResponseType `json:"rejectResponse,omitempty"`
+}
+
+// RulePacketCapture describes the packet capture of a rule.
+type RulePacketCapture struct {
+	// MaxPackets specifies the maximum number of packets captured for the rule
+	// on each Node. Packets matching the rule are no longer captured once it
+	// is reached.
+	MaxPackets int32 `json:"maxPackets"`
+}
+
+// RejectResponseType describes the response sent to the client when its traffic
+// is rejected.
+type RejectResponseType string
+
+const (
+	// RejectResponseTCPReset means that TCP traffic is rejected with a TCP RST.
+	// Other traffic is rejected with an ICMP administratively prohibited message.
+	RejectResponseTCPReset RejectResponseType = "TCPReset"
+	// RejectResponseICMPAdminProhibited means that traffic is rejected with an
+	// ICMP destination unreachable message with the communication
+	// administratively prohibited code.
+	RejectResponseICMPAdminProhibited RejectResponseType = "ICMPAdminProhibited"
+	// RejectResponseICMPHostUnreachable means that traffic is rejected with an
+	// ICMP destination unreachable message with the host unreachable code, or
+	// the address unreachable code for IPv6.
+	RejectResponseICMPHostUnreachable RejectResponseType = "ICMPHostUnreachable"
+	// RejectResponseHTTPForbidden means that HTTP requests are rejected with an
+	// HTTP 403 Forbidden response. It can only be used in rules with HTTP
+	// L7Protocols.
+	RejectResponseHTTPForbidden RejectResponseType = "HTTPForbidden"
+)
+
+// RateLimit describes the maximum rate of the traffic matching a rule with the
+// RateLimit action. Exactly one of PacketRate and Bandwidth must be set.
+type RateLimit struct {
+	// PacketRate limits the traffic in packets per second.
+	// +optional
+	PacketRate *PacketRate `json:"packetRate,omitempty"`
+	// Bandwidth limits the traffic in bits per second.
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+}
+
+// PacketRate describes a rate limit in packets per second.
+type PacketRate struct {
+	// Rate specifies the maximum number of packets per second.
+	Rate int32 `json:"rate"`
+	// Burst specifies the maximum number of packets which can exceed the rate
+	// at once. Defaults to Rate.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
 }
 
 // NetworkPolicyPeer describes the grouping selector of workloads.
@@ -662,6 +855,9 @@ type NetworkPolicyPeer struct {
 	FQDN string `json:"fqdn,omitempty"`
 	// Select all Pods with the ServiceAccount matched by this field, as
 	// workloads in To/From fields.
+	// The Namespace can be set to "*" to match the ServiceAccounts with the
+	// name in all Namespaces. In NetworkPolicy, it defaults to the Namespace
+	// of the policy.
 	// Cannot be set with any other selector.
 	// +optional
 	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
@@ -669,6 +865,15 @@ type NetworkPolicyPeer struct {
 	// A NodeSelector cannot be set with any other selector.
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// Select Pods running on Nodes whose labels match this selector, e.g.
+	// Nodes in a given topology zone. It further restricts the Pods matched
+	// by PodSelector and NamespaceSelector, or selects all Pods on the
+	// matched Nodes if set alone, following the same Namespace semantics as
+	// PodSelector.
+	// Cannot be set with any other selector except PodSelector,
+	// NamespaceSelector or Namespaces. Cannot be used with ClusterSet scope.
+	// +optional
+	PodNodeSelector *metav1.LabelSelector `json:"podNodeSelector,omitempty"`
 	// Define scope of the Pod/NamespaceSelector(s) of this peer.
 	// Can only be used in ingress NetworkPolicyPeers.
 	// Defaults to "Cluster".
@@ -705,6 +910,9 @@ type AppliedTo struct {
 	Group string `json:"group,omitempty"`
 	// Select all Pods with the ServiceAccount matched by this field, as
 	// workloads in AppliedTo fields.
+	// In ClusterNetworkPolicy, the Namespace can be set to "*" to match the
+	// ServiceAccounts with the name in all Namespaces. In NetworkPolicy, the
+	// Namespace must be empty or the Namespace of the policy.
 	// Cannot be set with any other selector.
 	// +optional
 	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
@@ -719,6 +927,15 @@ type AppliedTo struct {
 	// Cannot be set with any other selector.
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// Select Pods running on Nodes whose labels match this selector, e.g.
+	// Nodes in a given topology zone, as workloads in AppliedTo fields. It
+	// further restricts the Pods matched by PodSelector and
+	// NamespaceSelector, or selects all Pods on the matched Nodes if set
+	// alone, following the same Namespace semantics as PodSelector.
+	// Cannot be set with any other selector except PodSelector or
+	// NamespaceSelector.
+	// +optional
+	PodNodeSelector *metav1.LabelSelector `json:"podNodeSelector,omitempty"`
 }
 
 // PeerNamespaces describes criteria for selecting Pod/ExternalEntity
@@ -778,6 +995,18 @@ type NetworkPolicyPort struct {
 	SourceEndPort *int32 `json:"sourceEndPort,omitempty"`
 }
 
+// EnforcementMode describes how an Antrea-native policy is enforced.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce means the rules of the policy are enforced.
+	EnforcementModeEnforce EnforcementMode = "Enforce"
+	// EnforcementModeAudit means the rules of the policy are evaluated without
+	// affecting traffic: packets matching Drop or Reject rules are logged and
+	// counted, and are then processed as if the policy did not exist.
+	EnforcementModeAudit EnforcementMode = "Audit"
+)
+
 // RuleAction describes the action to be applied on traffic matching a rule.
 type RuleAction string
 
@@ -793,6 +1022,10 @@ const (
 	// RuleActionReject indicates that the traffic matching the rule must be rejected and the
 	// client will receive a response.
 	RuleActionReject RuleAction = "Reject"
+	// RuleActionRateLimit indicates that the traffic matching the rule must be allowed up to
+	// the rate specified by the RateLimit of the rule, and that the traffic exceeding it must
+	// be dropped.
+	RuleActionRateLimit RuleAction = "RateLimit"
 
 	IGMPQuery    int32 = 0x11
 	IGMPReportV1 int32 = 0x12
@@ -850,6 +1083,16 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// Schedules restrict the enforcement of the policy to the declared time
+	// windows. The policy is enforced as long as at least one of the windows
+	// is active. If this field is empty, the policy is always enforced.
+	// +optional
+	Schedules []PolicySchedule `json:"schedules,omitempty"`
+	// EnforcementMode specifies how the policy is enforced. In Audit mode,
+	// traffic matching Drop or Reject rules is logged and counted but not
+	// denied. Defaults to Enforce.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -882,6 +1125,9 @@ type PeerService struct {
 type NetworkPolicyProtocol struct {
 	ICMP *ICMPProtocol `json:"icmp,omitempty"`
 	IGMP *IGMPProtocol `json:"igmp,omitempty"`
+	// IPv6ExtensionHeader matches IPv6 packets carrying a specific extension
+	// header.
+	IPv6ExtensionHeader *IPv6ExtensionHeaderProtocol `json:"ipv6ExtensionHeader,omitempty"`
 }
 
 // ICMPProtocol matches ICMP traffic with specific ICMPType and/or ICMPCode. All
@@ -890,8 +1136,70 @@ type NetworkPolicyProtocol struct {
 type ICMPProtocol struct {
 	ICMPType *int32 `json:"icmpType,omitempty"`
 	ICMPCode *int32 `json:"icmpCode,omitempty"`
+	// EndICMPType defines the end of the ICMP type range, being the end included
+	// within the range. It can only be specified when ICMPType is specified.
+	EndICMPType *int32 `json:"endIcmpType,omitempty"`
+	// EndICMPCode defines the end of the ICMP code range, being the end included
+	// within the range. It can only be specified when ICMPCode is specified, and
+	// cannot be used together with an ICMP type range.
+	EndICMPCode *int32 `json:"endIcmpCode,omitempty"`
+	// ICMPTypeName matches ICMP traffic by the name of its type instead of its
+	// number, which allows the same rule to match the corresponding type of both
+	// ICMP and ICMPv6. It cannot be used together with ICMPType. If the named type
+	// only exists in one of ICMP and ICMPv6, only traffic of that IP family is
+	// matched.
+	ICMPTypeName ICMPTypeName `json:"icmpTypeName,omitempty"`
+}
+
+type ICMPTypeName string
+
+const (
+	ICMPTypeEchoReply              ICMPTypeName = "EchoReply"
+	ICMPTypeDestinationUnreachable ICMPTypeName = "DestinationUnreachable"
+	ICMPTypeSourceQuench           ICMPTypeName = "SourceQuench"
+	ICMPTypeRedirect               ICMPTypeName = "Redirect"
+	ICMPTypeEchoRequest            ICMPTypeName = "EchoRequest"
+	ICMPTypeRouterAdvertisement    ICMPTypeName = "RouterAdvertisement"
+	ICMPTypeRouterSolicitation     ICMPTypeName = "RouterSolicitation"
+	ICMPTypeTimeExceeded           ICMPTypeName = "TimeExceeded"
+	ICMPTypeParameterProblem       ICMPTypeName = "ParameterProblem"
+	ICMPTypeTimestamp              ICMPTypeName = "Timestamp"
+	ICMPTypeTimestampReply         ICMPTypeName = "TimestampReply"
+	ICMPTypePacketTooBig           ICMPTypeName = "PacketTooBig"
+	ICMPTypeNeighborSolicitation   ICMPTypeName = "NeighborSolicitation"
+	ICMPTypeNeighborAdvertisement  ICMPTypeName = "NeighborAdvertisement"
+)
+
+// IPv6ExtensionHeaderProtocol matches IPv6 packets with the extension header of
+// the specified Type.
+type IPv6ExtensionHeaderProtocol struct {
+	Type IPv6ExtensionHeaderType `json:"type"`
 }
 
+// IPv6ExtensionHeaderType is the type of IPv6 extension header. Only extension
+// headers which can be identified by the datapath are supported: the Hop-by-Hop
+// Options, Routing, Fragment, Destination Options and Authentication headers are
+// skipped when the packet is parsed and cannot be matched.
+type IPv6ExtensionHeaderType string
+
+const (
+	// IPv6ExtensionHeaderESP matches IPv6 packets whose Next Header is
+	// Encapsulating Security Payload (50).
+	IPv6ExtensionHeaderESP IPv6ExtensionHeaderType = "ESP"
+	// IPv6ExtensionHeaderNoNextHeader matches IPv6 packets whose Next Header is
+	// No Next Header (59).
+	IPv6ExtensionHeaderNoNextHeader IPv6ExtensionHeaderType = "NoNextHeader"
+	// IPv6ExtensionHeaderMobility matches IPv6 packets whose Next Header is
+	// Mobility (135).
+	IPv6ExtensionHeaderMobility IPv6ExtensionHeaderType = "Mobility"
+	// IPv6ExtensionHeaderHIP matches IPv6 packets whose Next Header is Host
+	// Identity Protocol (139).
+	IPv6ExtensionHeaderHIP IPv6ExtensionHeaderType = "HIP"
+	// IPv6ExtensionHeaderShim6 matches IPv6 packets whose Next Header is Shim6
+	// (140).
+	IPv6ExtensionHeaderShim6 IPv6ExtensionHeaderType = "Shim6"
+)
+
 // IGMPProtocol matches IGMP traffic with IGMPType and GroupAddress. IGMPType must
 // be filled with:
 // IGMPQuery    int32 = 0x11
@@ -907,10 +1215,12 @@ type IGMPProtocol struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty"`
 	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty"`
+	DNS  *DNSProtocol  `json:"dns,omitempty"`
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
-// If all fields are not provided, it matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could
+// be used alone or together. If all fields are not provided, it matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -920,6 +1230,68 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// Headers represents the HTTP request headers to match. A request matches only if all the headers match.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams represents the query parameters of the URI to match. A request matches only if all the
+	// query parameters match.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+}
+
+// StringMatchType specifies the semantics of how a string value is matched.
+type StringMatchType string
+
+const (
+	// StringMatchExact matches values equal to the specified value.
+	StringMatchExact StringMatchType = "Exact"
+	// StringMatchPrefix matches values starting with the specified value.
+	StringMatchPrefix StringMatchType = "Prefix"
+	// StringMatchRegex matches values entirely matched by the specified regular expression.
+	StringMatchRegex StringMatchType = "Regex"
+)
+
+// HTTPHeaderMatch describes how to match an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match. The match is case-insensitive.
+	Name string `json:"name"`
+	// Type specifies how to match the value of the header. Defaults to Exact.
+	// +optional
+	Type StringMatchType `json:"type,omitempty"`
+	// Value is the value of the header to match.
+	Value string `json:"value"`
+}
+
+// HTTPQueryParamMatch describes how to match a query parameter of the URI of an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. The match is case-sensitive.
+	Name string `json:"name"`
+	// Type specifies how to match the value of the query parameter. Defaults to Exact.
+	// +optional
+	Type StringMatchType `json:"type,omitempty"`
+	// Value is the value of the query parameter to match.
+	Value string `json:"value"`
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty"`
+	// Headers represents the request metadata to match, which is sent as HTTP/2 headers. A request matches only
+	// if all the headers match.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific queried name and record types. All fields could be used alone or
+// together. If all fields are not provided, this matches all DNS queries.
+type DNSProtocol struct {
+	// Name represents the queried domain name to match, without the trailing dot. Both exact matches and wildcards
+	// are supported (Ex. "*.foo.com", "*.foo.*", "foo.bar.com"). The match is case-insensitive.
+	Name string `json:"name,omitempty"`
+	// RecordTypes represents the record types of the query to match (Ex. "A", "AAAA"). The query matches if its
+	// record type is any of them.
+	RecordTypes []string `json:"recordTypes,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -1022,6 +1394,10 @@ type EgressList struct {
 type TraceflowPhase string
 
 const (
+	// Pending means the data plane tag is allocated to a multi-cluster
+	// Traceflow, and the packet is not injected until the peer member
+	// clusters are ready to trace it.
+	Pending   TraceflowPhase = "Pending"
 	Running   TraceflowPhase = "Running"
 	Succeeded TraceflowPhase = "Succeeded"
 	Failed    TraceflowPhase = "Failed"
@@ -1031,6 +1407,7 @@ type TraceflowComponent string
 
 const (
 	ComponentSpoofGuard    TraceflowComponent = "SpoofGuard"
+	ComponentClassifier    TraceflowComponent = "Classifier"
 	ComponentLB            TraceflowComponent = "LB"
 	ComponentRouting       TraceflowComponent = "Routing"
 	ComponentNetworkPolicy TraceflowComponent = "NetworkPolicy"
@@ -1051,6 +1428,9 @@ const (
 	ActionForwardedOutOfOverlay TraceflowAction = "ForwardedOutOfOverlay"
 	ActionMarkedForSNAT         TraceflowAction = "MarkedForSNAT"
 	ActionForwardedToEgressNode TraceflowAction = "ForwardedToEgressNode"
+	// ActionForwardedToRemoteCluster indicates that the packet has been forwarded by the
+	// Multi-cluster Gateway to a peer member cluster of the ClusterSet.
+	ActionForwardedToRemoteCluster TraceflowAction = "ForwardedToRemoteCluster"
 )
 
 // List the supported protocols and their codes in traceflow.
@@ -1093,6 +1473,23 @@ var SupportedDestinationTypes = []string{
 // Default timeout in seconds.
 const DefaultTraceflowTimeout int32 = 20
 
+type TraceflowSessionMode string
+
+const (
+	// TraceflowSessionTCPHandshake traces a TCP handshake: the SYN sent by the source Pod, the
+	// SYN-ACK or RST replied by the destination, and the ACK completing the handshake.
+	TraceflowSessionTCPHandshake TraceflowSessionMode = "TCPHandshake"
+	// TraceflowSessionICMPEcho traces a sequence of ICMP Echo Requests sent by the source Pod and
+	// the Echo Replies of the destination.
+	TraceflowSessionICMPEcho TraceflowSessionMode = "ICMPEcho"
+)
+
+// Default and maximum number of request packets of an ICMPEcho session Traceflow.
+const (
+	DefaultTraceflowSessionPackets int32 = 3
+	MaxTraceflowSessionPackets     int32 = 10
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1120,6 +1517,46 @@ type TraceflowSpec struct {
 	// Timeout specifies the timeout of the Traceflow in seconds. Defaults
 	// to 20 seconds if not set.
 	Timeout int32 `json:"timeout,omitempty"`
+	// Session, when set, traces the packets of a connection in both
+	// directions instead of a single injected packet, so that issues
+	// depending on the connection state (e.g. a lost reply) can be
+	// observed. It is supported only for a non-live-traffic Traceflow
+	// from a source Pod.
+	Session *TraceflowSession `json:"session,omitempty"`
+	// Multicluster, when set to true in a member cluster of an Antrea
+	// Multi-cluster ClusterSet, follows the packet through the Multi-cluster
+	// Gateway into the peer member clusters, and adds the results reported
+	// in the peer member clusters to the Traceflow. It requires the Antrea
+	// Multi-cluster Controller, and is not supported for a live-traffic or
+	// session Traceflow.
+	Multicluster bool `json:"multicluster,omitempty"`
+	// RemoteSource is set by the Antrea Multi-cluster Controller of a peer
+	// member cluster, to trace the packet of a multi-cluster Traceflow
+	// created in another member cluster. No packet is injected for such a
+	// Traceflow.
+	RemoteSource *TraceflowRemoteSource `json:"remoteSource,omitempty"`
+}
+
+// TraceflowRemoteSource describes the multi-cluster Traceflow traced by a Traceflow in a peer
+// member cluster.
+type TraceflowRemoteSource struct {
+	// ClusterID is the ClusterID of the member cluster where the multi-cluster
+	// Traceflow was created.
+	ClusterID string `json:"clusterID"`
+	// Traceflow is the name of the multi-cluster Traceflow.
+	Traceflow string `json:"traceflow"`
+	// DataplaneTag is the data plane tag of the multi-cluster Traceflow,
+	// which must be used by the Traceflow to identify the packet.
+	DataplaneTag int8 `json:"dataplaneTag"`
+}
+
+// TraceflowSession describes the packets traced by a session Traceflow.
+type TraceflowSession struct {
+	// Mode is the kind of exchange to trace.
+	Mode TraceflowSessionMode `json:"mode"`
+	// Packets is the number of Echo Requests sent in ICMPEcho mode.
+	// Defaults to 3 if not set. It is ignored in TCPHandshake mode.
+	Packets int32 `json:"packets,omitempty"`
 }
 
 // Source describes the source spec of the traceflow.
@@ -1129,8 +1566,15 @@ type Source struct {
 	// Pod is the source pod.
 	Pod string `json:"pod,omitempty"`
 	// IP is the source IPv4 or IPv6 address. IP as the source is supported
-	// only for live-traffic Traceflow.
+	// only for live-traffic Traceflow, or together with Node for a packet
+	// from an external client.
 	IP string `json:"ip,omitempty"`
+	// Node is the Node on which the packet of a non-live-traffic Traceflow
+	// is injected. When IP is not set, the packet is sent from the host
+	// network of the Node. Otherwise, the packet appears to come from an
+	// external client with the IP, entering the cluster through the Node.
+	// Node is exclusive with source Pod.
+	Node string `json:"node,omitempty"`
 }
 
 // Destination describes the destination spec of the traceflow.
@@ -1235,6 +1679,16 @@ type NodeResult struct {
 	Role string `json:"role,omitempty" yaml:"role,omitempty"`
 	// Timestamp is the timestamp of the observations on the node.
 	Timestamp int64 `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	// Packet is the index, starting from 1, of the request packet the
+	// observations belong to in a session Traceflow.
+	Packet int32 `json:"packet,omitempty" yaml:"packet,omitempty"`
+	// Reply indicates the observations are of the reply to the request
+	// packet in a session Traceflow.
+	Reply bool `json:"reply,omitempty" yaml:"reply,omitempty"`
+	// Cluster is the ClusterID of the peer member cluster of the Node, for
+	// the results reported in a peer member cluster in a multi-cluster
+	// Traceflow.
+	Cluster string `json:"cluster,omitempty" yaml:"cluster,omitempty"`
 	// Observations includes all observations from sender nodes, receiver ones, etc.
 	Observations []Observation `json:"observations,omitempty" yaml:"observations,omitempty"`
 }
@@ -37,3 +37,71 @@ func CompareSubnetInfo(a, b *SubnetInfo, ignoringGateway bool) bool {
 	}
 	return a.VLAN == b.VLAN && a.PrefixLength == b.PrefixLength
 }
+
+var (
+	icmpTypeValues = map[ICMPTypeName]int32{
+		ICMPTypeEchoReply:              0,
+		ICMPTypeDestinationUnreachable: 3,
+		ICMPTypeSourceQuench:           4,
+		ICMPTypeRedirect:               5,
+		ICMPTypeEchoRequest:            8,
+		ICMPTypeRouterAdvertisement:    9,
+		ICMPTypeRouterSolicitation:     10,
+		ICMPTypeTimeExceeded:           11,
+		ICMPTypeParameterProblem:       12,
+		ICMPTypeTimestamp:              13,
+		ICMPTypeTimestampReply:         14,
+	}
+	icmpv6TypeValues = map[ICMPTypeName]int32{
+		ICMPTypeDestinationUnreachable: 1,
+		ICMPTypePacketTooBig:           2,
+		ICMPTypeTimeExceeded:           3,
+		ICMPTypeParameterProblem:       4,
+		ICMPTypeEchoRequest:            128,
+		ICMPTypeEchoReply:              129,
+		ICMPTypeRouterSolicitation:     133,
+		ICMPTypeRouterAdvertisement:    134,
+		ICMPTypeNeighborSolicitation:   135,
+		ICMPTypeNeighborAdvertisement:  136,
+		ICMPTypeRedirect:               137,
+	}
+	ipv6ExtensionHeaderNextHeaders = map[IPv6ExtensionHeaderType]int32{
+		IPv6ExtensionHeaderESP:          50,
+		IPv6ExtensionHeaderNoNextHeader: 59,
+		IPv6ExtensionHeaderMobility:     135,
+		IPv6ExtensionHeaderHIP:          139,
+		IPv6ExtensionHeaderShim6:        140,
+	}
+)
+
+// IsValid returns whether the ICMPTypeName is known for at least one of ICMP and ICMPv6.
+func (n ICMPTypeName) IsValid() bool {
+	_, isIPv4 := icmpTypeValues[n]
+	_, isIPv6 := icmpv6TypeValues[n]
+	return isIPv4 || isIPv6
+}
+
+// Value returns the ICMP type number of the ICMPTypeName for ICMPv6 if isIPv6 is
+// true, for ICMP otherwise. The second return value is false if the named type
+// doesn't exist in the requested IP family.
+func (n ICMPTypeName) Value(isIPv6 bool) (int32, bool) {
+	if isIPv6 {
+		v, ok := icmpv6TypeValues[n]
+		return v, ok
+	}
+	v, ok := icmpTypeValues[n]
+	return v, ok
+}
+
+// IsValid returns whether the IPv6ExtensionHeaderType is supported.
+func (t IPv6ExtensionHeaderType) IsValid() bool {
+	_, ok := ipv6ExtensionHeaderNextHeaders[t]
+	return ok
+}
+
+// NextHeader returns the Next Header value identifying the extension header. The
+// second return value is false if the extension header is not supported.
+func (t IPv6ExtensionHeaderType) NextHeader() (int32, bool) {
+	v, ok := ipv6ExtensionHeaderNextHeaders[t]
+	return v, ok
+}
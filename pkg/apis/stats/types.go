@@ -106,6 +106,9 @@ type NetworkPolicyStats struct {
 
 	// The traffic stats of the K8s NetworkPolicy.
 	TrafficStats TrafficStats
+	// The traffic stats of the K8s NetworkPolicy, from peer perspective. It's only
+	// populated when the collection of peer stats is enabled in the antrea-agents.
+	PeerTrafficStats []PeerTrafficStats
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -133,4 +136,18 @@ type TrafficStats struct {
 type RuleTrafficStats struct {
 	Name         string
 	TrafficStats TrafficStats
+	// The traffic stats of the rule, from peer perspective. It's only populated when
+	// the collection of peer stats is enabled in the antrea-agents.
+	PeerTrafficStats []PeerTrafficStats
+}
+
+// PeerTrafficStats contains TrafficStats of the traffic between a NetworkPolicy and one of
+// its peers, i.e. the source of the traffic for ingress rules and the destination of the
+// traffic for egress rules.
+type PeerTrafficStats struct {
+	// Pod is the peer Pod. It is set when the peer is a Pod.
+	Pod *PodReference
+	// IP is the IP of the peer. It is set when the peer is not a Pod.
+	IP           string
+	TrafficStats TrafficStats
 }
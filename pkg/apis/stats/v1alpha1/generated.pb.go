@@ -264,10 +264,38 @@ func (m *NetworkPolicyStatsList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetworkPolicyStatsList proto.InternalMessageInfo
 
+func (m *PeerTrafficStats) Reset()      { *m = PeerTrafficStats{} }
+func (*PeerTrafficStats) ProtoMessage() {}
+func (*PeerTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{8}
+}
+func (m *PeerTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PeerTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerTrafficStats.Merge(m, src)
+}
+func (m *PeerTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerTrafficStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerTrafficStats proto.InternalMessageInfo
+
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{8}
+	return fileDescriptor_91b517c6fa558473, []int{9}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +323,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *RuleTrafficStats) Reset()      { *m = RuleTrafficStats{} }
 func (*RuleTrafficStats) ProtoMessage() {}
 func (*RuleTrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{9}
+	return fileDescriptor_91b517c6fa558473, []int{10}
 }
 func (m *RuleTrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +351,7 @@ var xxx_messageInfo_RuleTrafficStats proto.InternalMessageInfo
 func (m *TrafficStats) Reset()      { *m = TrafficStats{} }
 func (*TrafficStats) ProtoMessage() {}
 func (*TrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{10}
+	return fileDescriptor_91b517c6fa558473, []int{11}
 }
 func (m *TrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,6 +385,7 @@ func init() {
 	proto.RegisterType((*MulticastGroupList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroupList")
 	proto.RegisterType((*NetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStats")
 	proto.RegisterType((*NetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStatsList")
+	proto.RegisterType((*PeerTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PeerTrafficStats")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PodReference")
 	proto.RegisterType((*RuleTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.RuleTrafficStats")
 	proto.RegisterType((*TrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TrafficStats")
@@ -367,51 +396,55 @@ func init() {
 }
 
 var fileDescriptor_91b517c6fa558473 = []byte{
-	// 704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6b, 0x13, 0x4d,
-	0x18, 0xcf, 0x34, 0x2d, 0x6d, 0xa6, 0x79, 0xdf, 0xb7, 0xef, 0x20, 0x12, 0x8a, 0x6c, 0x4b, 0x7a,
-	0x89, 0xa0, 0xb3, 0xb6, 0x48, 0x29, 0xe2, 0xc5, 0xf5, 0x20, 0x05, 0x1b, 0xc3, 0xd4, 0x83, 0x88,
-	0xa2, 0x93, 0xcd, 0x64, 0x33, 0x26, 0xbb, 0xb3, 0xec, 0xcc, 0x56, 0x7a, 0xeb, 0x07, 0xf0, 0xe0,
-	0xa7, 0xf0, 0xb3, 0xf4, 0x58, 0x6f, 0xf5, 0x52, 0x6c, 0x44, 0xf0, 0x2a, 0x5e, 0x3c, 0xca, 0xcc,
-	0x6e, 0xba, 0xd9, 0x86, 0xd2, 0xed, 0x25, 0x1e, 0xf4, 0x94, 0x9d, 0xe7, 0xdf, 0xef, 0xf7, 0x3c,
-	0xcf, 0x6f, 0x86, 0xc0, 0x2d, 0x1a, 0xa8, 0x88, 0x51, 0xcc, 0x85, 0x9d, 0x7c, 0xd9, 0x61, 0xdf,
-	0xb3, 0x69, 0xc8, 0xa5, 0x2d, 0x15, 0x55, 0xd2, 0xde, 0x5b, 0xa7, 0x83, 0xb0, 0x47, 0xd7, 0x6d,
-	0x8f, 0x05, 0x2c, 0xa2, 0x8a, 0x75, 0x70, 0x18, 0x09, 0x25, 0x50, 0x23, 0x89, 0x7f, 0xc5, 0x05,
-	0x4e, 0x6b, 0x84, 0x7d, 0x0f, 0xeb, 0x4c, 0x6c, 0x32, 0xf1, 0x28, 0x73, 0xf9, 0xb6, 0xc7, 0x55,
-	0x2f, 0x6e, 0x63, 0x57, 0xf8, 0xb6, 0x27, 0x3c, 0x61, 0x9b, 0x02, 0xed, 0xb8, 0x6b, 0x4e, 0xe6,
-	0x60, 0xbe, 0x92, 0xc2, 0xcb, 0x77, 0xfb, 0x5b, 0xd2, 0xf0, 0x09, 0xb9, 0x4f, 0xdd, 0x1e, 0x0f,
-	0x58, 0xb4, 0x9f, 0xb1, 0xf2, 0x99, 0xa2, 0xf6, 0xde, 0x04, 0x9d, 0x65, 0xfb, 0xa2, 0xac, 0x28,
-	0x0e, 0x14, 0xf7, 0xd9, 0x44, 0xc2, 0xe6, 0x65, 0x09, 0xd2, 0xed, 0x31, 0x9f, 0x9e, 0xcf, 0xab,
-	0xff, 0x9c, 0x81, 0x2b, 0x0f, 0x4c, 0xc3, 0x0f, 0x07, 0xb1, 0x54, 0x2c, 0x6a, 0x32, 0xf5, 0x56,
-	0x44, 0xfd, 0x96, 0x18, 0x70, 0x77, 0x7f, 0x57, 0xb7, 0x8e, 0x5e, 0xc3, 0x05, 0xcd, 0xb3, 0x43,
-	0x15, 0xad, 0x81, 0x55, 0xd0, 0x58, 0xdc, 0xb8, 0x83, 0x13, 0x38, 0x3c, 0x0e, 0x97, 0x4d, 0x4c,
-	0x47, 0xe3, 0xbd, 0x75, 0xfc, 0xa4, 0xfd, 0x86, 0xb9, 0x6a, 0x87, 0x29, 0xea, 0xa0, 0xc3, 0x93,
-	0x95, 0xd2, 0xf0, 0x64, 0x05, 0x66, 0x36, 0x72, 0x56, 0x15, 0x85, 0xb0, 0xaa, 0x22, 0xda, 0xed,
-	0x72, 0xd7, 0x20, 0xd6, 0x66, 0x0c, 0xca, 0x26, 0x2e, 0xba, 0x14, 0xfc, 0x74, 0x2c, 0xdb, 0xb9,
-	0x96, 0x62, 0x55, 0xc7, 0xad, 0x24, 0x87, 0x80, 0x0e, 0x00, 0x5c, 0x8a, 0xe2, 0x01, 0x1b, 0x0f,
-	0xa9, 0x95, 0x57, 0xcb, 0x8d, 0xc5, 0x8d, 0x7b, 0xc5, 0x61, 0xc9, 0xb9, 0x0a, 0x4e, 0x2d, 0x85,
-	0x5e, 0x3a, 0xef, 0x21, 0x13, 0x68, 0xf5, 0x1f, 0x00, 0xae, 0x5d, 0x32, 0xfa, 0xc7, 0x5c, 0x2a,
-	0xf4, 0x62, 0x62, 0xfc, 0xb8, 0xd8, 0xf8, 0x75, 0xb6, 0x19, 0xfe, 0x52, 0xca, 0x6a, 0x61, 0x64,
-	0x19, 0x1b, 0x7d, 0x00, 0xe7, 0xb8, 0x62, 0xbe, 0x9e, 0xb9, 0x6e, 0x7e, 0xbb, 0x78, 0xf3, 0x97,
-	0x70, 0x77, 0xfe, 0x49, 0x51, 0xe7, 0xb6, 0x75, 0x7d, 0x92, 0xc0, 0xd4, 0xbf, 0xcf, 0xc0, 0x5a,
-	0x92, 0xf9, 0x57, 0x69, 0xd3, 0x52, 0xda, 0x57, 0x00, 0x6f, 0x5c, 0x34, 0xf3, 0x29, 0x48, 0xcc,
-	0xcb, 0x4b, 0xcc, 0xb9, 0xaa, 0xc4, 0x8a, 0x6b, 0x0b, 0xc0, 0x7f, 0x77, 0xe2, 0x81, 0xe2, 0x2e,
-	0x95, 0xea, 0x51, 0x24, 0xe2, 0x70, 0x0a, 0x8a, 0x5a, 0x83, 0x73, 0x9e, 0x86, 0x32, 0x52, 0xaa,
-	0x64, 0xcc, 0x0c, 0x3e, 0x49, 0x7c, 0xe8, 0x19, 0x9c, 0x0d, 0x45, 0x67, 0xb4, 0xf7, 0x2b, 0xc8,
-	0xad, 0x25, 0x3a, 0x84, 0x75, 0x59, 0xc4, 0x02, 0x97, 0x39, 0xd5, 0xb4, 0xf6, 0x6c, 0x4b, 0x74,
-	0x24, 0x31, 0x15, 0xeb, 0x1f, 0x01, 0x44, 0xf9, 0x9e, 0xa7, 0xb0, 0xd1, 0x97, 0xf9, 0x8d, 0x6e,
-	0x15, 0xef, 0x27, 0x4f, 0xf5, 0x82, 0x3d, 0x7e, 0x03, 0x10, 0xfd, 0x19, 0xaf, 0x43, 0xfd, 0x13,
-	0x80, 0xd7, 0x7f, 0xcb, 0xa5, 0xa4, 0xf9, 0x15, 0xde, 0x2f, 0xde, 0x63, 0xe1, 0xeb, 0x48, 0x61,
-	0x75, 0x5c, 0xbe, 0x68, 0x15, 0xce, 0x06, 0xd4, 0x67, 0xa6, 0x99, 0x4a, 0x26, 0xe6, 0x26, 0xf5,
-	0x19, 0x31, 0x1e, 0x64, 0xc3, 0x8a, 0xfe, 0x95, 0x21, 0x75, 0x59, 0x7a, 0x9f, 0xfe, 0x4f, 0xc3,
-	0x2a, 0xcd, 0x91, 0x83, 0x64, 0x31, 0xf5, 0x0f, 0x00, 0x4e, 0x3c, 0x80, 0x05, 0x70, 0xa6, 0xbf,
-	0xe7, 0x77, 0x00, 0xe6, 0xdc, 0xe8, 0x26, 0x9c, 0x0f, 0xa9, 0xdb, 0x67, 0x4a, 0x1a, 0x9e, 0x65,
-	0xe7, 0xbf, 0xb4, 0xca, 0x7c, 0x2b, 0x31, 0x93, 0x91, 0x5f, 0xbf, 0x30, 0xed, 0x7d, 0xc5, 0x12,
-	0x9a, 0xe5, 0x6c, 0xd8, 0x8e, 0x36, 0x92, 0xc4, 0x87, 0x6e, 0xc1, 0x05, 0xc9, 0xa4, 0xe4, 0x22,
-	0xd0, 0xaf, 0x8c, 0x8e, 0x3b, 0xdb, 0xfe, 0x6e, 0x6a, 0x27, 0x67, 0x11, 0x4e, 0xf3, 0xf0, 0xd4,
-	0x2a, 0x1d, 0x9d, 0x5a, 0xa5, 0xe3, 0x53, 0xab, 0x74, 0x30, 0xb4, 0xc0, 0xe1, 0xd0, 0x02, 0x47,
-	0x43, 0x0b, 0x1c, 0x0f, 0x2d, 0xf0, 0x79, 0x68, 0x81, 0xf7, 0x5f, 0xac, 0xd2, 0xf3, 0x46, 0xd1,
-	0xbf, 0xd3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x73, 0x1f, 0x1a, 0xcb, 0x79, 0x0b, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x6f, 0xd3, 0x4a,
+	0x10, 0xce, 0xda, 0xad, 0xda, 0x6c, 0xf3, 0xde, 0xeb, 0x5b, 0x3d, 0x3d, 0x45, 0x11, 0x72, 0xaa,
+	0xf4, 0x12, 0x24, 0xb0, 0x69, 0x85, 0xaa, 0x0a, 0x71, 0xc1, 0x1c, 0x50, 0x25, 0x1a, 0xc2, 0x96,
+	0x03, 0x42, 0x20, 0xd8, 0x38, 0x1b, 0x67, 0x49, 0xec, 0xb5, 0xec, 0x4d, 0x51, 0x6f, 0xfd, 0x01,
+	0x1c, 0xf8, 0x1b, 0xfc, 0x93, 0x1e, 0xcb, 0xad, 0x5c, 0x2a, 0x6a, 0xc4, 0x89, 0x13, 0xea, 0x85,
+	0x23, 0xf2, 0xda, 0xa9, 0xe3, 0x58, 0x55, 0x5d, 0x21, 0xc2, 0xa1, 0x9c, 0x62, 0xcf, 0xec, 0xcc,
+	0x37, 0xf3, 0xcd, 0xb7, 0x63, 0x05, 0x6e, 0x12, 0x57, 0xf8, 0x94, 0xe8, 0x8c, 0x1b, 0xf1, 0x93,
+	0xe1, 0x0d, 0x6c, 0x83, 0x78, 0x2c, 0x30, 0x02, 0x41, 0x44, 0x60, 0xec, 0xae, 0x91, 0xa1, 0xd7,
+	0x27, 0x6b, 0x86, 0x4d, 0x5d, 0xea, 0x13, 0x41, 0xbb, 0xba, 0xe7, 0x73, 0xc1, 0x51, 0x33, 0x3e,
+	0xff, 0x92, 0x71, 0x3d, 0xc9, 0xe1, 0x0d, 0x6c, 0x3d, 0x8a, 0xd4, 0x65, 0xa4, 0x3e, 0x8e, 0xac,
+	0xdd, 0xb4, 0x99, 0xe8, 0x8f, 0x3a, 0xba, 0xc5, 0x1d, 0xc3, 0xe6, 0x36, 0x37, 0x64, 0x82, 0xce,
+	0xa8, 0x27, 0xdf, 0xe4, 0x8b, 0x7c, 0x8a, 0x13, 0xd7, 0x6e, 0x0f, 0x36, 0x03, 0x59, 0x8f, 0xc7,
+	0x1c, 0x62, 0xf5, 0x99, 0x4b, 0xfd, 0xbd, 0xb4, 0x2a, 0x87, 0x0a, 0x62, 0xec, 0xe6, 0xca, 0xa9,
+	0x19, 0xe7, 0x45, 0xf9, 0x23, 0x57, 0x30, 0x87, 0xe6, 0x02, 0x36, 0x2e, 0x0a, 0x08, 0xac, 0x3e,
+	0x75, 0xc8, 0x74, 0x5c, 0xe3, 0xbb, 0x02, 0xeb, 0xf7, 0x64, 0xc3, 0xf7, 0x87, 0xa3, 0x40, 0x50,
+	0xbf, 0x45, 0xc5, 0x1b, 0xee, 0x0f, 0xda, 0x7c, 0xc8, 0xac, 0xbd, 0x9d, 0xa8, 0x75, 0xf4, 0x0a,
+	0x2e, 0x46, 0x75, 0x76, 0x89, 0x20, 0x55, 0xb0, 0x02, 0x9a, 0x4b, 0xeb, 0xb7, 0xf4, 0x18, 0x4e,
+	0x9f, 0x84, 0x4b, 0x19, 0x8b, 0x4e, 0xeb, 0xbb, 0x6b, 0xfa, 0xa3, 0xce, 0x6b, 0x6a, 0x89, 0x6d,
+	0x2a, 0x88, 0x89, 0x0e, 0x8e, 0xeb, 0xa5, 0xf0, 0xb8, 0x0e, 0x53, 0x1b, 0x3e, 0xcb, 0x8a, 0x3c,
+	0x58, 0x11, 0x3e, 0xe9, 0xf5, 0x98, 0x25, 0x11, 0xab, 0x8a, 0x44, 0xd9, 0xd0, 0x8b, 0x0e, 0x45,
+	0x7f, 0x32, 0x11, 0x6d, 0xfe, 0x97, 0x60, 0x55, 0x26, 0xad, 0x38, 0x83, 0x80, 0xf6, 0x01, 0x5c,
+	0xf6, 0x47, 0x43, 0x3a, 0x79, 0xa4, 0xaa, 0xae, 0xa8, 0xcd, 0xa5, 0xf5, 0x3b, 0xc5, 0x61, 0xf1,
+	0x54, 0x06, 0xb3, 0x9a, 0x40, 0x2f, 0x4f, 0x7b, 0x70, 0x0e, 0xad, 0x71, 0x0a, 0xe0, 0xea, 0x05,
+	0xd4, 0x3f, 0x64, 0x81, 0x40, 0xcf, 0x73, 0xf4, 0xeb, 0xc5, 0xe8, 0x8f, 0xa2, 0x25, 0xf9, 0xcb,
+	0x49, 0x55, 0x8b, 0x63, 0xcb, 0x04, 0xf5, 0x2e, 0x9c, 0x67, 0x82, 0x3a, 0x11, 0xe7, 0x51, 0xf3,
+	0x5b, 0xc5, 0x9b, 0xbf, 0xa0, 0x76, 0xf3, 0xaf, 0x04, 0x75, 0x7e, 0x2b, 0xca, 0x8f, 0x63, 0x98,
+	0xc6, 0x37, 0x05, 0x56, 0xe3, 0xc8, 0x3f, 0x4a, 0x9b, 0x95, 0xd2, 0xbe, 0x00, 0x78, 0xed, 0x3c,
+	0xce, 0x67, 0x20, 0x31, 0x3b, 0x2b, 0x31, 0xf3, 0xb2, 0x12, 0x2b, 0xae, 0x2d, 0x00, 0xff, 0xde,
+	0x1e, 0x0d, 0x05, 0xb3, 0x48, 0x20, 0x1e, 0xf8, 0x7c, 0xe4, 0xcd, 0x40, 0x51, 0xab, 0x70, 0xde,
+	0x8e, 0xa0, 0xa4, 0x94, 0xca, 0x69, 0x65, 0x12, 0x1f, 0xc7, 0x3e, 0xf4, 0x14, 0xce, 0x79, 0xbc,
+	0x3b, 0x9e, 0xfb, 0x25, 0xe4, 0xd6, 0xe6, 0x5d, 0x4c, 0x7b, 0xd4, 0xa7, 0xae, 0x45, 0xcd, 0x4a,
+	0x92, 0x7b, 0xae, 0xcd, 0xbb, 0x01, 0x96, 0x19, 0x1b, 0x1f, 0x00, 0x44, 0xd9, 0x9e, 0x67, 0x30,
+	0xd1, 0x17, 0xd9, 0x89, 0x6e, 0x16, 0xef, 0x27, 0x5b, 0xea, 0x39, 0x73, 0xfc, 0xaa, 0x40, 0x74,
+	0x85, 0xb6, 0x83, 0x47, 0xa9, 0xff, 0x73, 0xdb, 0xa1, 0x3d, 0x95, 0x21, 0xdd, 0x0e, 0xd3, 0x1e,
+	0x9c, 0x43, 0x6b, 0x7c, 0x04, 0xf0, 0xff, 0xdf, 0xb2, 0x17, 0x48, 0x56, 0x45, 0x77, 0x8b, 0xf7,
+	0x5b, 0x78, 0x23, 0x9c, 0x02, 0x98, 0xa3, 0x00, 0x3d, 0x86, 0xaa, 0xc7, 0xbb, 0x55, 0x70, 0xd9,
+	0xe1, 0x66, 0xee, 0xe2, 0x42, 0x78, 0x5c, 0x57, 0x23, 0x4b, 0x94, 0x0b, 0xd5, 0xa0, 0xc2, 0xc6,
+	0x1b, 0x00, 0x26, 0x95, 0x28, 0x5b, 0x6d, 0xac, 0x30, 0x2f, 0x27, 0x2a, 0xf5, 0x57, 0x8b, 0xaa,
+	0x41, 0x60, 0x65, 0xb2, 0x56, 0xb4, 0x02, 0xe7, 0x5c, 0xe2, 0x50, 0xd9, 0x71, 0x39, 0xdd, 0x22,
+	0x2d, 0xe2, 0x50, 0x2c, 0x3d, 0xc8, 0x80, 0xe5, 0xe8, 0x37, 0xf0, 0x88, 0x45, 0x93, 0x36, 0xfe,
+	0x4d, 0x8e, 0x95, 0x5b, 0x63, 0x07, 0x4e, 0xcf, 0x34, 0xde, 0x2b, 0x30, 0xf7, 0xe5, 0x29, 0x80,
+	0x73, 0x25, 0x2f, 0xd8, 0x5b, 0x00, 0x33, 0x15, 0xa2, 0xeb, 0x70, 0xc1, 0x23, 0xd6, 0x80, 0x8a,
+	0x40, 0x52, 0xa5, 0x9a, 0xff, 0x24, 0xd9, 0x16, 0xda, 0xb1, 0x19, 0x8f, 0xfd, 0xd1, 0xd7, 0xa5,
+	0xb3, 0x27, 0x68, 0xcc, 0x94, 0x9a, 0xaa, 0xdc, 0x8c, 0x8c, 0x38, 0xf6, 0xa1, 0x1b, 0x70, 0x31,
+	0xa0, 0x41, 0xc0, 0xb8, 0x1b, 0xab, 0x4b, 0x4d, 0xaf, 0xdd, 0x4e, 0x62, 0xc7, 0x67, 0x27, 0xcc,
+	0xd6, 0xc1, 0x89, 0x56, 0x3a, 0x3c, 0xd1, 0x4a, 0x47, 0x27, 0x5a, 0x69, 0x3f, 0xd4, 0xc0, 0x41,
+	0xa8, 0x81, 0xc3, 0x50, 0x03, 0x47, 0xa1, 0x06, 0x3e, 0x85, 0x1a, 0x78, 0xf7, 0x59, 0x2b, 0x3d,
+	0x6b, 0x16, 0xfd, 0x2b, 0xf5, 0x63, 0x00, 0xc5, 0x65, 0x9c, 0xbf, 0x75, 0x0d, 0x00, 0x00,
 }
 
 func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
@@ -741,6 +774,20 @@ func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeerTrafficStats) > 0 {
+		for iNdEx := len(m.PeerTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerTrafficStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -811,6 +858,56 @@ func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PeerTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.IP)
+	copy(dAtA[i:], m.IP)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IP)))
+	i--
+	dAtA[i] = 0x12
+	if m.Pod != nil {
+		{
+			size, err := m.Pod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PodReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -864,6 +961,20 @@ func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeerTrafficStats) > 0 {
+		for iNdEx := len(m.PeerTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerTrafficStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1043,6 +1154,12 @@ func (m *NetworkPolicyStats) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.TrafficStats.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PeerTrafficStats) > 0 {
+		for _, e := range m.PeerTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1063,6 +1180,23 @@ func (m *NetworkPolicyStatsList) Size() (n int) {
 	return n
 }
 
+func (m *PeerTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pod != nil {
+		l = m.Pod.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.IP)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PodReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1086,6 +1220,12 @@ func (m *RuleTrafficStats) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.TrafficStats.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PeerTrafficStats) > 0 {
+		for _, e := range m.PeerTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1210,9 +1350,15 @@ func (this *NetworkPolicyStats) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPeerTrafficStats := "[]PeerTrafficStats{"
+	for _, f := range this.PeerTrafficStats {
+		repeatedStringForPeerTrafficStats += strings.Replace(strings.Replace(f.String(), "PeerTrafficStats", "PeerTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPeerTrafficStats += "}"
 	s := strings.Join([]string{`&NetworkPolicyStats{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`PeerTrafficStats:` + repeatedStringForPeerTrafficStats + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1233,6 +1379,18 @@ func (this *NetworkPolicyStatsList) String() string {
 	}, "")
 	return s
 }
+func (this *PeerTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PeerTrafficStats{`,
+		`Pod:` + strings.Replace(this.Pod.String(), "PodReference", "PodReference", 1) + `,`,
+		`IP:` + fmt.Sprintf("%v", this.IP) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PodReference) String() string {
 	if this == nil {
 		return "nil"
@@ -1248,9 +1406,15 @@ func (this *RuleTrafficStats) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPeerTrafficStats := "[]PeerTrafficStats{"
+	for _, f := range this.PeerTrafficStats {
+		repeatedStringForPeerTrafficStats += strings.Replace(strings.Replace(f.String(), "PeerTrafficStats", "PeerTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPeerTrafficStats += "}"
 	s := strings.Join([]string{`&RuleTrafficStats{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`PeerTrafficStats:` + repeatedStringForPeerTrafficStats + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2170,6 +2334,40 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerTrafficStats = append(m.PeerTrafficStats, PeerTrafficStats{})
+			if err := m.PeerTrafficStats[len(m.PeerTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2308,6 +2506,157 @@ func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeerTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pod == nil {
+				m.Pod = &PodReference{}
+			}
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PodReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2516,6 +2865,40 @@ func (m *RuleTrafficStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerTrafficStats = append(m.PeerTrafficStats, PeerTrafficStats{})
+			if err := m.PeerTrafficStats[len(m.PeerTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -83,6 +83,9 @@ type NetworkPolicyStats struct {
 
 	// The traffic stats of the K8s NetworkPolicy.
 	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+	// The traffic stats of the K8s NetworkPolicy, from peer perspective. It's only
+	// populated when the collection of peer stats is enabled in the antrea-agents.
+	PeerTrafficStats []PeerTrafficStats `json:"peerTrafficStats,omitempty" protobuf:"bytes,3,rep,name=peerTrafficStats"`
 }
 
 // +genclient
@@ -145,4 +148,18 @@ type TrafficStats struct {
 type RuleTrafficStats struct {
 	Name         string       `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+	// The traffic stats of the rule, from peer perspective. It's only populated when
+	// the collection of peer stats is enabled in the antrea-agents.
+	PeerTrafficStats []PeerTrafficStats `json:"peerTrafficStats,omitempty" protobuf:"bytes,3,rep,name=peerTrafficStats"`
+}
+
+// PeerTrafficStats contains TrafficStats of the traffic between a NetworkPolicy and one of
+// its peers, i.e. the source of the traffic for ingress rules and the destination of the
+// traffic for egress rules.
+type PeerTrafficStats struct {
+	// Pod is the peer Pod. It is set when the peer is a Pod.
+	Pod *PodReference `json:"pod,omitempty" protobuf:"bytes,1,opt,name=pod"`
+	// IP is the IP of the peer. It is set when the peer is not a Pod.
+	IP           string       `json:"ip,omitempty" protobuf:"bytes,2,opt,name=ip"`
+	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,3,opt,name=trafficStats"`
 }
@@ -114,6 +114,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PeerTrafficStats)(nil), (*stats.PeerTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PeerTrafficStats_To_stats_PeerTrafficStats(a.(*PeerTrafficStats), b.(*stats.PeerTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.PeerTrafficStats)(nil), (*PeerTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_PeerTrafficStats_To_v1alpha1_PeerTrafficStats(a.(*stats.PeerTrafficStats), b.(*PeerTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodReference)(nil), (*stats.PodReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodReference_To_stats_PodReference(a.(*PodReference), b.(*stats.PodReference), scope)
 	}); err != nil {
@@ -298,6 +308,7 @@ func autoConvert_v1alpha1_NetworkPolicyStats_To_stats_NetworkPolicyStats(in *Net
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.PeerTrafficStats = *(*[]stats.PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -311,6 +322,7 @@ func autoConvert_stats_NetworkPolicyStats_To_v1alpha1_NetworkPolicyStats(in *sta
 	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.PeerTrafficStats = *(*[]PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -341,6 +353,34 @@ func Convert_stats_NetworkPolicyStatsList_To_v1alpha1_NetworkPolicyStatsList(in
 	return autoConvert_stats_NetworkPolicyStatsList_To_v1alpha1_NetworkPolicyStatsList(in, out, s)
 }
 
+func autoConvert_v1alpha1_PeerTrafficStats_To_stats_PeerTrafficStats(in *PeerTrafficStats, out *stats.PeerTrafficStats, s conversion.Scope) error {
+	out.Pod = (*stats.PodReference)(unsafe.Pointer(in.Pod))
+	out.IP = in.IP
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PeerTrafficStats_To_stats_PeerTrafficStats is an autogenerated conversion function.
+func Convert_v1alpha1_PeerTrafficStats_To_stats_PeerTrafficStats(in *PeerTrafficStats, out *stats.PeerTrafficStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PeerTrafficStats_To_stats_PeerTrafficStats(in, out, s)
+}
+
+func autoConvert_stats_PeerTrafficStats_To_v1alpha1_PeerTrafficStats(in *stats.PeerTrafficStats, out *PeerTrafficStats, s conversion.Scope) error {
+	out.Pod = (*PodReference)(unsafe.Pointer(in.Pod))
+	out.IP = in.IP
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_stats_PeerTrafficStats_To_v1alpha1_PeerTrafficStats is an autogenerated conversion function.
+func Convert_stats_PeerTrafficStats_To_v1alpha1_PeerTrafficStats(in *stats.PeerTrafficStats, out *PeerTrafficStats, s conversion.Scope) error {
+	return autoConvert_stats_PeerTrafficStats_To_v1alpha1_PeerTrafficStats(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodReference_To_stats_PodReference(in *PodReference, out *stats.PodReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -368,6 +408,7 @@ func autoConvert_v1alpha1_RuleTrafficStats_To_stats_RuleTrafficStats(in *RuleTra
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.PeerTrafficStats = *(*[]stats.PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -381,6 +422,7 @@ func autoConvert_stats_RuleTrafficStats_To_v1alpha1_RuleTrafficStats(in *stats.R
 	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.PeerTrafficStats = *(*[]PeerTrafficStats)(unsafe.Pointer(&in.PeerTrafficStats))
 	return nil
 }
 
@@ -41,3 +41,26 @@ type FeatureGateResponse struct {
 	Status    string `json:"status,omitempty"`
 	Version   string `json:"version,omitempty"`
 }
+
+// RuleAnalysisResponse describes a rule of an Antrea-native policy found shadowed by, redundant with, or
+// conflicting with a rule enforced before it.
+type RuleAnalysisResponse struct {
+	Type               string                         `json:"type,omitempty"`
+	PolicyRef          v1beta2.NetworkPolicyReference `json:"policyRef,omitempty"`
+	Direction          v1beta2.Direction              `json:"direction,omitempty"`
+	RuleName           string                         `json:"ruleName,omitempty"`
+	PrecedingPolicyRef v1beta2.NetworkPolicyReference `json:"precedingPolicyRef,omitempty"`
+	PrecedingRuleName  string                         `json:"precedingRuleName,omitempty"`
+}
+
+func (r RuleAnalysisResponse) GetTableHeader() []string {
+	return []string{"TYPE", "POLICY", "DIRECTION", "RULE", "PRECEDING-POLICY", "PRECEDING-RULE"}
+}
+
+func (r RuleAnalysisResponse) GetTableRow(_ int) []string {
+	return []string{r.Type, r.PolicyRef.ToString(), string(r.Direction), r.RuleName, r.PrecedingPolicyRef.ToString(), r.PrecedingRuleName}
+}
+
+func (r RuleAnalysisResponse) SortRows() bool {
+	return false
+}
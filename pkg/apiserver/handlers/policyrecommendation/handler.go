@@ -0,0 +1,99 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"antrea.io/antrea/pkg/controller/policyrecommendation"
+)
+
+const (
+	// maxRequestBodyBytes is the maximum size of the flows provided in the request body.
+	maxRequestBodyBytes = 64 << 20
+	// maxFlows is the maximum number of flows of a request, as all of them are kept in memory while recommending
+	// policies.
+	maxFlows = 100000
+)
+
+// parseOptions parses the options of policy recommendation from the query parameters of a request.
+func parseOptions(query url.Values) (policyrecommendation.Options, error) {
+	options := policyrecommendation.Options{
+		PolicyType: policyrecommendation.PolicyType(query.Get("type")),
+		Isolation:  policyrecommendation.IsolationMethod(query.Get("isolation")),
+		Tier:       query.Get("tier"),
+		Namespaces: query["namespace"],
+	}
+	switch options.PolicyType {
+	case "", policyrecommendation.PolicyTypeANNP, policyrecommendation.PolicyTypeACNP:
+	default:
+		return options, fmt.Errorf("unsupported policy type %q, must be annp or acnp", options.PolicyType)
+	}
+	switch options.Isolation {
+	case "", policyrecommendation.IsolationApplied, policyrecommendation.IsolationNone:
+	default:
+		return options, fmt.Errorf("unsupported isolation %q, must be applied or none", options.Isolation)
+	}
+	if priority := query.Get("priority"); priority != "" {
+		var err error
+		if options.Priority, err = strconv.ParseFloat(priority, 64); err != nil || options.Priority <= 0 {
+			return options, fmt.Errorf("invalid priority %q, must be a positive number", priority)
+		}
+	}
+	return options, nil
+}
+
+// HandleFunc creates a http.HandlerFunc which recommends Antrea-native policies allowing exactly the flows
+// provided in the request body. The labels of the Pods which are not included in the flows are retrieved
+// with the provided PodLister.
+func HandleFunc(podLister corelisters.PodLister) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		options, err := parseOptions(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var flows []*policyrecommendation.Flow
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(&flows); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, fmt.Sprintf("the flows are larger than the maximum of %d bytes", maxBytesErr.Limit), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "invalid flows: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(flows) > maxFlows {
+			http.Error(w, fmt.Sprintf("the request has %d flows, which is more than the maximum of %d", len(flows), maxFlows), http.StatusBadRequest)
+			return
+		}
+		policyrecommendation.FillMissingLabels(flows, podLister)
+		recommendation := policyrecommendation.Recommend(flows, options)
+		if err := json.NewEncoder(w).Encode(recommendation); err != nil {
+			http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/controller/policyrecommendation"
+)
+
+func TestPolicyRecommendation(t *testing.T) {
+	clientPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "client",
+			Labels:    map[string]string{"app": "client"},
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(clientPod))
+	podLister := corelisters.NewPodLister(indexer)
+	// The labels of the client Pod are not included in the flow.
+	flow := `{"sourcePodNamespace":"ns1","sourcePodName":"client","destinationIP":"8.8.8.8","destinationPort":53,"protocol":17}`
+	flows := "[" + flow + "]"
+
+	tests := []struct {
+		name                  string
+		method                string
+		query                 string
+		body                  string
+		expectedStatus        int
+		expectedNPs           int
+		expectedCNPs          int
+		expectedEgressActions int
+	}{
+		{
+			name:                  "default options",
+			method:                http.MethodPost,
+			body:                  flows,
+			expectedStatus:        http.StatusOK,
+			expectedNPs:           1,
+			expectedEgressActions: 2,
+		},
+		{
+			name:                  "ACNP without isolation",
+			method:                http.MethodPost,
+			query:                 "?type=acnp&isolation=none&tier=securityops&priority=10&namespace=ns1",
+			body:                  flows,
+			expectedStatus:        http.StatusOK,
+			expectedCNPs:          1,
+			expectedEgressActions: 1,
+		},
+		{
+			name:           "filtered by Namespace",
+			method:         http.MethodPost,
+			query:          "?namespace=ns2&namespace=ns3",
+			body:           flows,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "invalid type",
+			method:         http.MethodPost,
+			query:          "?type=k8s",
+			body:           flows,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid isolation",
+			method:         http.MethodPost,
+			query:          "?isolation=all",
+			body:           flows,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid priority",
+			method:         http.MethodPost,
+			query:          "?priority=-1",
+			body:           flows,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid flows",
+			method:         http.MethodPost,
+			body:           "{",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "too many flows",
+			method:         http.MethodPost,
+			body:           "[" + strings.Repeat(flow+",", maxFlows) + flow + "]",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "too large body",
+			method:         http.MethodPost,
+			body:           fmt.Sprintf(`[{"sourcePodName":"%s"}]`, strings.Repeat("a", maxRequestBodyBytes)),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.query, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			HandleFunc(podLister).ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var received policyrecommendation.Recommendation
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			require.Len(t, received.NetworkPolicies, tt.expectedNPs)
+			require.Len(t, received.ClusterNetworkPolicies, tt.expectedCNPs)
+			if tt.expectedNPs > 0 {
+				np := received.NetworkPolicies[0]
+				assert.Equal(t, clientPod.Labels, np.Spec.AppliedTo[0].PodSelector.MatchLabels)
+				assert.Len(t, np.Spec.Egress, tt.expectedEgressActions)
+			}
+			if tt.expectedCNPs > 0 {
+				cnp := received.ClusterNetworkPolicies[0]
+				assert.Equal(t, "securityops", cnp.Spec.Tier)
+				assert.Equal(t, float64(10), cnp.Spec.Priority)
+				assert.Len(t, cnp.Spec.Egress, tt.expectedEgressActions)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruleanalysis
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/apiserver/apis"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+// From user shorthand input to v1beta2.NetworkPolicyType.
+var mapToNetworkPolicyType = map[string]v1beta2.NetworkPolicyType{
+	"ACNP": v1beta2.AntreaClusterNetworkPolicy,
+	"ANNP": v1beta2.AntreaNetworkPolicy,
+}
+
+// HandleFunc creates a http.HandlerFunc which uses a RuleAnalysisQuerier to find the rules of
+// Antrea-native policies which are shadowed, redundant or conflicting.
+func HandleFunc(q networkpolicy.RuleAnalysisQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strPolicyType := strings.ToUpper(r.URL.Query().Get("type"))
+		policyType, ok := mapToNetworkPolicyType[strPolicyType]
+		if strPolicyType != "" && !ok {
+			http.Error(w, "invalid policy type. Valid values are ACNP and ANNP", http.StatusBadRequest)
+			return
+		}
+		namespace := r.URL.Query().Get("namespace")
+		name := r.URL.Query().Get("name")
+		responses := []apis.RuleAnalysisResponse{}
+		for _, finding := range q.QueryRuleAnalysis() {
+			var response apis.RuleAnalysisResponse
+			v1beta2.Convert_controlplane_NetworkPolicyReference_To_v1beta2_NetworkPolicyReference(finding.Rule.Policy.SourceRef, &response.PolicyRef, nil)
+			if (policyType != "" && response.PolicyRef.Type != policyType) ||
+				(namespace != "" && response.PolicyRef.Namespace != namespace) ||
+				(name != "" && response.PolicyRef.Name != name) {
+				continue
+			}
+			v1beta2.Convert_controlplane_NetworkPolicyReference_To_v1beta2_NetworkPolicyReference(finding.PrecedingRule.Policy.SourceRef, &response.PrecedingPolicyRef, nil)
+			response.Type = string(finding.Type)
+			response.Direction = v1beta2.Direction(finding.Rule.Rule.Direction)
+			response.RuleName = finding.Rule.Rule.Name
+			response.PrecedingRuleName = finding.PrecedingRule.Rule.Name
+			responses = append(responses, response)
+		}
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruleanalysis
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/apiserver/apis"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+type fakeQuerier struct {
+	findings []*networkpolicy.RuleAnalysisFinding
+}
+
+func (q *fakeQuerier) QueryRuleAnalysis() []*networkpolicy.RuleAnalysisFinding {
+	return q.findings
+}
+
+func TestRuleAnalysisQuery(t *testing.T) {
+	acnp := &antreatypes.NetworkPolicy{SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"}}
+	annp := &antreatypes.NetworkPolicy{SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp1"}}
+	querier := &fakeQuerier{findings: []*networkpolicy.RuleAnalysisFinding{
+		{
+			Type:          networkpolicy.RuleShadowed,
+			Rule:          &antreatypes.RuleInfo{Policy: annp, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "drop-web"}},
+			PrecedingRule: &antreatypes.RuleInfo{Policy: acnp, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "allow-all"}},
+		},
+	}}
+	annpResponse := apis.RuleAnalysisResponse{
+		Type:               "Shadowed",
+		PolicyRef:          v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaNetworkPolicy, Namespace: "ns1", Name: "annp1"},
+		Direction:          v1beta2.DirectionIn,
+		RuleName:           "drop-web",
+		PrecedingPolicyRef: v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaClusterNetworkPolicy, Name: "acnp1"},
+		PrecedingRuleName:  "allow-all",
+	}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedStatus   int
+		expectedResponse []apis.RuleAnalysisResponse
+	}{
+		{
+			name:             "all findings",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.RuleAnalysisResponse{annpResponse},
+		},
+		{
+			name:             "filter by type and Namespace",
+			query:            "?type=annp&namespace=ns1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.RuleAnalysisResponse{annpResponse},
+		},
+		{
+			name:             "filter by name",
+			query:            "?type=ACNP&name=acnp1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.RuleAnalysisResponse{},
+		},
+		{
+			name:           "invalid type",
+			query:          "?type=K8sNP",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			HandleFunc(querier).ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var received []apis.RuleAnalysisResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			assert.Equal(t, tt.expectedResponse, received)
+		})
+	}
+}
+
+func TestRuleAnalysisResponseTableRow(t *testing.T) {
+	response := apis.RuleAnalysisResponse{
+		Type:               "Redundant",
+		PolicyRef:          v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaClusterNetworkPolicy, Name: "acnp1"},
+		Direction:          v1beta2.DirectionOut,
+		RuleName:           "allow-dns-2",
+		PrecedingPolicyRef: v1beta2.NetworkPolicyReference{Type: v1beta2.AntreaClusterNetworkPolicy, Name: "acnp1"},
+		PrecedingRuleName:  "allow-dns",
+	}
+	assert.Equal(t, []string{"Redundant", "AntreaClusterNetworkPolicy:acnp1", "Out", "allow-dns-2", "AntreaClusterNetworkPolicy:acnp1", "allow-dns"}, response.GetTableRow(0))
+}
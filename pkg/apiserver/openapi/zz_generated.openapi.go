@@ -40,16 +40,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrix":                schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrix(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixCell":            schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixCell(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixPort":            schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixPort(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest":         schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixRequest(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse":        schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixResponse(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity":                            schema_pkg_apis_controlplane_v1beta2_Entity(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch":                   schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch":               schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
@@ -71,6 +80,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeStatsSummary":                  schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PaginationGetOptions":              schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference":                      schema_pkg_apis_controlplane_v1beta2_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit":                         schema_pkg_apis_controlplane_v1beta2_RateLimit(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RulePacketCapture":                 schema_pkg_apis_controlplane_v1beta2_RulePacketCapture(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef":                           schema_pkg_apis_controlplane_v1beta2_RuleRef(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service":                           schema_pkg_apis_controlplane_v1beta2_Service(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference":                  schema_pkg_apis_controlplane_v1beta2_ServiceReference(ref),
@@ -92,6 +103,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
@@ -102,12 +114,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch":                            schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch":                        schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
@@ -121,9 +136,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolStatus":                               schema_pkg_apis_crd_v1beta1_IPPoolStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage":                                schema_pkg_apis_crd_v1beta1_IPPoolUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6ExtensionHeaderProtocol":                schema_pkg_apis_crd_v1beta1_IPv6ExtensionHeaderProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTier":                             schema_pkg_apis_crd_v1beta1_NamespacedTier(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTierList":                         schema_pkg_apis_crd_v1beta1_NamespacedTierList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTierSpec":                         schema_pkg_apis_crd_v1beta1_NamespacedTierSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition":                     schema_pkg_apis_crd_v1beta1_NetworkPolicyCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyControllerInfo":                schema_pkg_apis_crd_v1beta1_NetworkPolicyControllerInfo(ref),
@@ -131,26 +150,34 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer":                          schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort":                          schema_pkg_apis_crd_v1beta1_NetworkPolicyPort(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol":                      schema_pkg_apis_crd_v1beta1_NetworkPolicyProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyScheduleStatus":                schema_pkg_apis_crd_v1beta1_NetworkPolicyScheduleStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicySpec":                          schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyStatus":                        schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult":                                 schema_pkg_apis_crd_v1beta1_NodeResult(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.OVSInfo":                                    schema_pkg_apis_crd_v1beta1_OVSInfo(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Observation":                                schema_pkg_apis_crd_v1beta1_Observation(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Packet":                                     schema_pkg_apis_crd_v1beta1_Packet(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.PacketRate":                                 schema_pkg_apis_crd_v1beta1_PacketRate(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces":                             schema_pkg_apis_crd_v1beta1_PeerNamespaces(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService":                                schema_pkg_apis_crd_v1beta1_PeerService(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PodOwner":                                   schema_pkg_apis_crd_v1beta1_PodOwner(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule":                             schema_pkg_apis_crd_v1beta1_PolicySchedule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit":                                  schema_pkg_apis_crd_v1beta1_RateLimit(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Rule":                                       schema_pkg_apis_crd_v1beta1_Rule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RulePacketCapture":                          schema_pkg_apis_crd_v1beta1_RulePacketCapture(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Source":                                     schema_pkg_apis_crd_v1beta1_Source(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.StatefulSetOwner":                           schema_pkg_apis_crd_v1beta1_StatefulSetOwner(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo":                                 schema_pkg_apis_crd_v1beta1_SubnetInfo(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader":                                  schema_pkg_apis_crd_v1beta1_TCPHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol":                                schema_pkg_apis_crd_v1beta1_TLSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Tier":                                       schema_pkg_apis_crd_v1beta1_Tier(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierDelegation":                             schema_pkg_apis_crd_v1beta1_TierDelegation(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierList":                                   schema_pkg_apis_crd_v1beta1_TierList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierSpec":                                   schema_pkg_apis_crd_v1beta1_TierSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Traceflow":                                  schema_pkg_apis_crd_v1beta1_Traceflow(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowList":                              schema_pkg_apis_crd_v1beta1_TraceflowList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRemoteSource":                      schema_pkg_apis_crd_v1beta1_TraceflowRemoteSource(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSession":                           schema_pkg_apis_crd_v1beta1_TraceflowSession(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSpec":                              schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowStatus":                            schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader":                            schema_pkg_apis_crd_v1beta1_TransportHeader(ref),
@@ -163,6 +190,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.MulticastGroupList":                      schema_pkg_apis_stats_v1alpha1_MulticastGroupList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NetworkPolicyStats":                      schema_pkg_apis_stats_v1alpha1_NetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NetworkPolicyStatsList":                  schema_pkg_apis_stats_v1alpha1_NetworkPolicyStatsList(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats":                        schema_pkg_apis_stats_v1alpha1_PeerTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference":                            schema_pkg_apis_stats_v1alpha1_PodReference(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats":                        schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats":                            schema_pkg_apis_stats_v1alpha1_TrafficStats(ref),
@@ -921,6 +949,244 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrix(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrix contains the request and response for computing the effective NetworkPolicy rules between all pairs of Pods of a set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixRequest", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixResponse"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixCell(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrixCell is the verdict for the traffic from a source Pod to a destination Pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the destination port, nil when no port is requested.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixPort"),
+						},
+					},
+					"verdict": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verdict is the action applied to the traffic: Allow, Drop or Reject.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rule is the effective rule which decides the verdict, nil when no rule applies and the traffic is allowed by default.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse"),
+						},
+					},
+				},
+				Required: []string{"source", "destination", "verdict"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixPort", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrixPort is a destination port of a connectivity matrix.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrixRequest selects the Pods of a connectivity matrix, either by ClusterGroup, or by Namespace and/or label selector. Without Namespace, the label selector selects Pods in all Namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterGroup": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects Pods by labels, in the same format as label selectors of list options.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports are the destination ports to compute verdicts for. If empty, a single verdict is computed for each pair of Pods, regardless of the ports selected by rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixPort"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixPort"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ConnectivityMatrixResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityMatrixResponse is the response of a connectivity matrix computation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods are the selected Pods, sorted by Namespace and name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"),
+									},
+								},
+							},
+						},
+					},
+					"cells": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cells contain the verdicts for each pair of different Pods and each requested port.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixCell"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ConnectivityMatrixCell", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific queried name and record types. All fields could be used alone or together. If all fields are not provided, it matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the queried domain name to match, without the trailing dot. Both exact matches and wildcards are supported (Ex. \"*.foo.com\", \"foo.bar.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recordTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordTypes represents the record types of the query to match (Ex. \"A\", \"AAAA\").",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1119,6 +1385,49 @@ func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the request metadata to match, which is sent as HTTP/2 headers. A request matches only if all the headers match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1352,11 +1661,45 @@ func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch describes how to match an HTTP request header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match. The match is case-insensitive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies how to match the value of the header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"host": {
@@ -1380,6 +1723,70 @@ func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request matches only if all the headers match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the query parameters of the URI to match. A request matches only if all the query parameters match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch describes how to match a query parameter of the URI of an HTTP request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the query parameter to match. The match is case-sensitive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies how to match the value of the query parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the query parameter to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1514,11 +1921,21 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
 	}
 }
 
@@ -1668,6 +2085,20 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCall
 							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode represents how the rules of this Network Policy are enforced. Empty means the rules are enforced, which is always the case for K8s NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tier is the name of the Tier associated with this Network Policy. It is only set for Antrea-native policies, and empty means the default Application Tier.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1734,6 +2165,21 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationRequest(ref com
 							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity"),
 						},
 					},
+					"proposedPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProposedPolicies are YAML or JSON manifests of K8s NetworkPolicies, Antrea NetworkPolicies, Antrea ClusterNetworkPolicies and Tiers, which are evaluated as if they were applied. A proposed policy or Tier replaces the existing one with the same kind, Namespace and name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -2104,12 +2550,31 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit is the maximum rate of the traffic matching the rule. It is set if and only if Action is RateLimit.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit"),
+						},
+					},
+					"packetCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketCapture enables capturing the packets matching the rule. It can only be set when Action is Drop or Reject.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RulePacketCapture"),
+						},
+					},
+					"rejectResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponse is the response sent to the client when its traffic is rejected by the rule. Empty means the default response of the protocol.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RulePacketCapture", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
@@ -2142,7 +2607,21 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStats(ref common.Referenc
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats"),
+									},
+								},
+							},
+						},
+					},
+					"peerTrafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The stats of the NetworkPolicy from peer perspective. It's only set for K8s NetworkPolicies, the stats of the rules of Antrea-native policies include their peer stats.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats"),
 									},
 								},
 							},
@@ -2152,7 +2631,7 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyStats(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
 	}
 }
 
@@ -2384,6 +2863,60 @@ func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit describes the maximum rate of the traffic matching a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is the unit of Rate and Burst.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate is the maximum rate of the traffic.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum size of the traffic which can exceed the rate at once.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_RulePacketCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RulePacketCapture describes the packet capture of a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPackets is the maximum number of packets captured for the rule on each Node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_RuleRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2481,6 +3014,33 @@ func schema_pkg_apis_controlplane_v1beta2_Service(ref common.ReferenceCallback)
 							Format: "int32",
 						},
 					},
+					"endIcmpType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndICMPType and EndICMPCode define the end of the ICMP type and code ranges, inclusive. They can only be specified when ICMPType and ICMPCode are specified.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endIcmpCode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"icmpTypeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMPTypeName matches the ICMP or ICMPv6 type with the given name. It cannot be specified together with ICMPType.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6ExtensionHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6ExtensionHeader can only be specified when the Protocol is IPv6ExtensionHeader.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3143,7 +3703,7 @@ func schema_pkg_apis_crd_v1beta1_AppliedTo(ref common.ReferenceCallback) common.
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Select all Pods with the ServiceAccount matched by this field, as workloads in AppliedTo fields. Cannot be set with any other selector.",
+							Description: "Select all Pods with the ServiceAccount matched by this field, as workloads in AppliedTo fields. In ClusterNetworkPolicy, the Namespace can be set to \"*\" to match the ServiceAccounts with the name in all Namespaces. In NetworkPolicy, the Namespace must be empty or the Namespace of the policy. Cannot be set with any other selector.",
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"),
 						},
 					},
@@ -3159,6 +3719,12 @@ func schema_pkg_apis_crd_v1beta1_AppliedTo(ref common.ReferenceCallback) common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"podNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select Pods running on Nodes whose labels match this selector, e.g. Nodes in a given topology zone, as workloads in AppliedTo fields. It further restricts the Pods matched by PodSelector and NamespaceSelector, or selects all Pods on the matched Nodes if set alone, following the same Namespace semantics as PodSelector. Cannot be set with any other selector except PodSelector or NamespaceSelector.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 				},
 			},
 		},
@@ -3455,12 +4021,33 @@ func schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules restrict the enforcement of the policy to the declared time windows. The policy is enforced as long as at least one of the windows is active. If this field is empty, the policy is always enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule"),
+									},
+								},
+							},
+						},
+					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the policy is enforced. In Audit mode, traffic matching Drop or Reject rules is logged and counted but not denied. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -3515,6 +4102,41 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific queried name and record types. All fields could be used alone or together. If all fields are not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the queried domain name to match, without the trailing dot. Both exact matches and wildcards are supported (Ex. \"*.foo.com\", \"*.foo.*\", \"foo.bar.com\"). The match is case-insensitive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recordTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordTypes represents the record types of the query to match (Ex. \"A\", \"AAAA\"). The query matches if its record type is any of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3977,6 +4599,49 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the request metadata to match, which is sent as HTTP/2 headers. A request matches only if all the headers match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4053,6 +4718,18 @@ func schema_pkg_apis_crd_v1beta1_GroupCondition(ref common.ReferenceCallback) co
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 				Required: []string{"type", "status"},
 			},
@@ -4168,6 +4845,13 @@ func schema_pkg_apis_crd_v1beta1_GroupSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"ipSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select the CIDRs held by the referred IPSet, as IPBlocks in To/From fields. Only supported in ClusterGroups. Cannot be set with any other selector, IPBlock, ServiceReference or ChildGroups.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4190,7 +4874,108 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch describes how to match an HTTP request header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match. The match is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies how to match the value of the header. Defaults to Exact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header to match.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request matches only if all the headers match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the query parameters of the URI to match. A request matches only if all the query parameters match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"),
 									},
 								},
 							},
@@ -4200,39 +4985,42 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"},
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "HTTPQueryParamMatch describes how to match a query parameter of the URI of an HTTP request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Description: "Name is the name of the query parameter to match. The match is case-sensitive.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"method": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Description: "Type specifies how to match the value of the query parameter. Defaults to Exact.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Description: "Value is the value of the query parameter to match.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "value"},
 			},
 		},
 	}
@@ -4284,6 +5072,27 @@ func schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref common.ReferenceCallback) comm
 							Format: "int32",
 						},
 					},
+					"endIcmpType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndICMPType defines the end of the ICMP type range, being the end included within the range. It can only be specified when ICMPType is specified.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endIcmpCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndICMPCode defines the end of the ICMP code range, being the end included within the range. It can only be specified when ICMPCode is specified, and cannot be used together with an ICMP type range.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"icmpTypeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMPTypeName matches ICMP traffic by the name of its type instead of its number, which allows the same rule to match the corresponding type of both ICMP and ICMPv6. It cannot be used together with ICMPType. If the named type only exists in one of ICMP and ICMPv6, only traffic of that IP family is matched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4664,6 +5473,27 @@ func schema_pkg_apis_crd_v1beta1_IPRange(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_IPv6ExtensionHeaderProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPv6ExtensionHeaderProtocol matches IPv6 packets with the extension header of the specified Type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_IPv6Header(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4707,11 +5537,21 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
 	}
 }
 
@@ -4740,6 +5580,127 @@ func schema_pkg_apis_crd_v1beta1_NamespacedName(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_NamespacedTier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedTier is a Tier scoped to a Namespace, which allows the tenant of the Namespace to order its Antrea NetworkPolicies within the priority band delegated to the Namespace by a Tier. It can only be referenced by the Antrea NetworkPolicies in the same Namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard metadata of the object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired behavior of NamespacedTier.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTierSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTierSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_NamespacedTierList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTier"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedTier", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_NamespacedTierSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedTierSpec defines the desired state for NamespacedTier.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority specfies the order of the NamespacedTier relative to other Tiers. It must be in a priority band delegated to the Namespace.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is an optional field to add more information regarding the purpose of this NamespacedTier.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"priority"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4973,7 +5934,7 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Select all Pods with the ServiceAccount matched by this field, as workloads in To/From fields. Cannot be set with any other selector.",
+							Description: "Select all Pods with the ServiceAccount matched by this field, as workloads in To/From fields. The Namespace can be set to \"*\" to match the ServiceAccounts with the name in all Namespaces. In NetworkPolicy, it defaults to the Namespace of the policy. Cannot be set with any other selector.",
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName"),
 						},
 					},
@@ -4983,6 +5944,12 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"podNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select Pods running on Nodes whose labels match this selector, e.g. Nodes in a given topology zone. It further restricts the Pods matched by PodSelector and NamespaceSelector, or selects all Pods on the matched Nodes if set alone, following the same Namespace semantics as PodSelector. Cannot be set with any other selector except PodSelector, NamespaceSelector or Namespaces. Cannot be used with ClusterSet scope.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 					"scope": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Define scope of the Pod/NamespaceSelector(s) of this peer. Can only be used in ingress NetworkPolicyPeers. Defaults to \"Cluster\".",
@@ -5065,11 +6032,54 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyProtocol(ref common.ReferenceCallb
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol"),
 						},
 					},
+					"ipv6ExtensionHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6ExtensionHeader matches IPv6 packets carrying a specific extension header.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6ExtensionHeaderProtocol"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6ExtensionHeaderProtocol"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_NetworkPolicyScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyScheduleStatus reports the activation state of a NetworkPolicy with Schedules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Active is true if the policy is enforced at the moment. For a policy with only rule-level Schedules, it is true if at least one of these rules is enforced.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"activeRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveRules is the number of rules with Schedules which are enforced at the moment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextTransitionTime is the time at which the activation state of the policy or any of its rules is expected to change next.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
+				Required: []string{"active"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5137,12 +6147,33 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules restrict the enforcement of the policy to the declared time windows. The policy is enforced as long as at least one of the windows is active. If this field is empty, the policy is always enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule"),
+									},
+								},
+							},
+						},
+					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the policy is enforced. In Audit mode, traffic matching Drop or Reject rules is logged and counted but not denied. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -5199,12 +6230,18 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The activation state of the NetworkPolicy. Only set when the NetworkPolicy or any of its rules has Schedules.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyScheduleStatus"),
+						},
+					},
 				},
 				Required: []string{"phase", "observedGeneration", "currentNodesRealized", "desiredNodesRealized", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyScheduleStatus"},
 	}
 }
 
@@ -5235,6 +6272,27 @@ func schema_pkg_apis_crd_v1beta1_NodeResult(ref common.ReferenceCallback) common
 							Format:      "int64",
 						},
 					},
+					"packet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packet is the index, starting from 1, of the request packet the observations belong to in a session Traceflow.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reply indicates the observations are of the reply to the request packet in a session Traceflow.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster is the ClusterID of the peer member cluster of the Node, for the results reported in a peer member cluster in a multi-cluster Traceflow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"observations": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Observations includes all observations from sender nodes, receiver ones, etc.",
@@ -5447,20 +6505,49 @@ func schema_pkg_apis_crd_v1beta1_Packet(ref common.ReferenceCallback) common.Ope
 					},
 					"ipv6Header": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header"),
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header"),
+						},
+					},
+					"transportHeader": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header", "antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_PacketRate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketRate describes a rate limit in packets per second.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate specifies the maximum number of packets per second.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"transportHeader": {
+					"burst": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"),
+							Description: "Burst specifies the maximum number of packets which can exceed the rate at once. Defaults to Rate.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"rate"},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header", "antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"},
 	}
 }
 
@@ -5572,6 +6659,70 @@ func schema_pkg_apis_crd_v1beta1_PodOwner(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_PolicySchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicySchedule describes a recurring time window during which a policy or a rule is enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression in the standard 5-field format (\"minute hour day-of-month month day-of-week\") which specifies when each window starts, e.g. \"0 22 * * 6\" for every Saturday at 22:00.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long each window lasts after it starts, e.g. \"48h\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which Schedule is interpreted, e.g. \"America/Los_Angeles\". Defaults to \"UTC\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit describes the maximum rate of the traffic matching a rule with the RateLimit action. Exactly one of PacketRate and Bandwidth must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"packetRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketRate limits the traffic in packets per second.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PacketRate"),
+						},
+					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limits the traffic in bits per second.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth", "antrea.io/antrea/pkg/apis/crd/v1beta1.PacketRate"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5706,12 +6857,67 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules restrict the enforcement of this rule to the declared time windows. The rule is enforced as long as at least one of the windows is active. If this field is empty, the rule is always enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule"),
+									},
+								},
+							},
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the maximum rate of the traffic matching this rule. It must be set if and only if Action is RateLimit.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit"),
+						},
+					},
+					"packetCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketCapture enables capturing the packets matching this rule to a pcap file on the Node where the rule is enforced. It can only be set when Action is Drop or Reject.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RulePacketCapture"),
+						},
+					},
+					"rejectResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectResponse specifies the response sent to the client when its traffic is rejected by this rule. It can only be set when Action is Reject, or for rules with L7Protocols, in which case the response is sent when the traffic doesn't match the layer 7 criteria. If not set, TCP traffic is rejected with a TCP RST and other traffic with an ICMP administratively prohibited message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService", "antrea.io/antrea/pkg/apis/crd/v1beta1.PolicySchedule", "antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit", "antrea.io/antrea/pkg/apis/crd/v1beta1.RulePacketCapture"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RulePacketCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RulePacketCapture describes the packet capture of a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPackets specifies the maximum number of packets captured for the rule on each Node. Packets matching the rule are no longer captured once it is reached.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxPackets"},
+			},
+		},
 	}
 }
 
@@ -5738,7 +6944,14 @@ func schema_pkg_apis_crd_v1beta1_Source(ref common.ReferenceCallback) common.Ope
 					},
 					"ip": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IP is the source IPv4 or IPv6 address. IP as the source is supported only for live-traffic Traceflow.",
+							Description: "IP is the source IPv4 or IPv6 address. IP as the source is supported only for live-traffic Traceflow, or together with Node for a packet from an external client.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the Node on which the packet of a non-live-traffic Traceflow is injected. When IP is not set, the packet is sent from the host network of the Node. Otherwise, the packet appears to come from an external client with the IP, entering the cluster through the Node. Node is exclusive with source Pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5918,6 +7131,36 @@ func schema_pkg_apis_crd_v1beta1_Tier(ref common.ReferenceCallback) common.OpenA
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TierDelegation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TierDelegation defines the priority band delegated by a Tier to tenant Namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select the Namespaces to which the priority band is delegated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPriority is the highest priority number of the band. The band ranges from the priority of the Tier, exclusive, to MaxPriority, inclusive.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"namespaceSelector", "maxPriority"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_TierList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5988,10 +7231,25 @@ func schema_pkg_apis_crd_v1beta1_TierSpec(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies how the policies in this Tier are enforced. If set to Audit, all policies in this Tier are enforced in Audit mode, regardless of their own EnforcementMode. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"delegation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delegation carves out a priority band right after this Tier and delegates it to the Namespaces selected by NamespaceSelector, in which NamespacedTiers can be created with priorities in the band. No other Tier can be created in the band.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TierDelegation"),
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.TierDelegation"},
 	}
 }
 
@@ -6089,6 +7347,73 @@ func schema_pkg_apis_crd_v1beta1_TraceflowList(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TraceflowRemoteSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TraceflowRemoteSource describes the multi-cluster Traceflow traced by a Traceflow in a peer member cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterID is the ClusterID of the member cluster where the multi-cluster Traceflow was created.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"traceflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Traceflow is the name of the multi-cluster Traceflow.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataplaneTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataplaneTag is the data plane tag of the multi-cluster Traceflow, which must be used by the Traceflow to identify the packet.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"clusterID", "traceflow", "dataplaneTag"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_TraceflowSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TraceflowSession describes the packets traced by a session Traceflow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the kind of exchange to trace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"packets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packets is the number of Echo Requests sent in ICMPEcho mode. Defaults to 3 if not set. It is ignored in TCPHandshake mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6135,11 +7460,30 @@ func schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref common.ReferenceCallback) com
 							Format:      "int32",
 						},
 					},
+					"session": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Session, when set, traces the packets of a connection in both directions instead of a single injected packet, so that issues depending on the connection state (e.g. a lost reply) can be observed. It is supported only for a non-live-traffic Traceflow from a source Pod.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSession"),
+						},
+					},
+					"multicluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multicluster, when set to true in a member cluster of an Antrea Multi-cluster ClusterSet, follows the packet through the Multi-cluster Gateway into the peer member clusters, and adds the results reported in the peer member clusters to the Traceflow. It requires the Antrea Multi-cluster Controller, and is not supported for a live-traffic or session Traceflow.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"remoteSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteSource is set by the Antrea Multi-cluster Controller of a peer member cluster, to trace the packet of a multi-cluster Traceflow created in another member cluster. No packet is injected for such a Traceflow.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRemoteSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "antrea.io/antrea/pkg/apis/crd/v1beta1.Source"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "antrea.io/antrea/pkg/apis/crd/v1beta1.Source", "antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRemoteSource", "antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSession"},
 	}
 }
 
@@ -6615,11 +7959,25 @@ func schema_pkg_apis_stats_v1alpha1_NetworkPolicyStats(ref common.ReferenceCallb
 							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
 						},
 					},
+					"peerTrafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the K8s NetworkPolicy, from peer perspective. It's only populated when the collection of peer stats is enabled in the antrea-agents.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -6673,6 +8031,40 @@ func schema_pkg_apis_stats_v1alpha1_NetworkPolicyStatsList(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_PeerTrafficStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PeerTrafficStats contains TrafficStats of the traffic between a NetworkPolicy and one of its peers, i.e. the source of the traffic for ingress rules and the destination of the traffic for egress rules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod is the peer Pod. It is set when the peer is a Pod.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference"),
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the IP of the peer. It is set when the peer is not a Pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_PodReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6719,11 +8111,25 @@ func schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref common.ReferenceCallbac
 							Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
 						},
 					},
+					"peerTrafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the rule, from peer perspective. It's only populated when the collection of peer stats is enabled in the antrea-agents.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerTrafficStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
 	}
 }
 
@@ -0,0 +1,103 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+type REST struct {
+	querier networkpolicy.ConnectivityMatrixQuerier
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Creater              = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(querier networkpolicy.ConnectivityMatrixQuerier) *REST {
+	return &REST{querier}
+}
+
+func (r *REST) New() runtime.Object {
+	return &controlplane.ConnectivityMatrix{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func validateRequest(request *controlplane.ConnectivityMatrixRequest) error {
+	if request == nil {
+		return errors.New("request must be provided")
+	}
+	if request.ClusterGroup != "" {
+		if request.Namespace != "" || request.LabelSelector != "" {
+			return errors.New("clusterGroup cannot be set with namespace or labelSelector")
+		}
+	} else if request.Namespace == "" && request.LabelSelector == "" {
+		return errors.New("one of clusterGroup, namespace and labelSelector must be set")
+	}
+	if _, err := metav1.ParseToLabelSelector(request.LabelSelector); err != nil {
+		return fmt.Errorf("invalid labelSelector %q: %v", request.LabelSelector, err)
+	}
+	for _, port := range request.Ports {
+		switch port.Protocol {
+		case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+		default:
+			return fmt.Errorf("unsupported protocol %q, must be TCP, UDP or SCTP", port.Protocol)
+		}
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("invalid port %d, must be between 1 and 65535", port.Port)
+		}
+	}
+	return nil
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	matrix, ok := obj.(*controlplane.ConnectivityMatrix)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ConnectivityMatrix object: %T", obj))
+	}
+	if err := validateRequest(matrix.Request); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	response, err := r.querier.QueryConnectivityMatrix(matrix.Request)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	matrix.Response = response
+	return matrix, nil
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "connectivitymatrix"
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitymatrix
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &controlplane.ConnectivityMatrix{}, r.New())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTCreate(t *testing.T) {
+	request := controlplane.ConnectivityMatrixRequest{Namespace: "ns", Ports: []controlplane.ConnectivityMatrixPort{{Protocol: controlplane.ProtocolTCP, Port: 80}}}
+	pod1 := controlplane.PodReference{Namespace: "ns", Name: "pod1"}
+	pod2 := controlplane.PodReference{Namespace: "ns", Name: "pod2"}
+	response := &controlplane.ConnectivityMatrixResponse{
+		Pods: []controlplane.PodReference{pod1, pod2},
+		Cells: []controlplane.ConnectivityMatrixCell{
+			{Source: pod1, Destination: pod2, Port: &request.Ports[0], Verdict: crdv1beta1.RuleActionAllow},
+			{Source: pod2, Destination: pod1, Port: &request.Ports[0], Verdict: crdv1beta1.RuleActionDrop},
+		},
+	}
+	tests := []struct {
+		name                string
+		obj                 runtime.Object
+		expectedReturnedObj runtime.Object
+		expectedErr         error
+		mockResponse        *controlplane.ConnectivityMatrixResponse
+		mockErr             error
+	}{
+		{
+			name:                "Succeed",
+			obj:                 &controlplane.ConnectivityMatrix{Request: &request},
+			expectedReturnedObj: &controlplane.ConnectivityMatrix{Request: &request, Response: response},
+			mockResponse:        response,
+		},
+		{
+			name:        "Query error",
+			obj:         &controlplane.ConnectivityMatrix{Request: &request},
+			mockErr:     fmt.Errorf("querier error"),
+			expectedErr: errors.NewInternalError(fmt.Errorf("querier error")),
+		},
+		{
+			name: "Unexpected type",
+			obj: &controlplane.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: errors.NewBadRequest("not a ConnectivityMatrix object: *controlplane.NetworkPolicy"),
+		},
+		{
+			name:        "Missing request",
+			obj:         &controlplane.ConnectivityMatrix{},
+			expectedErr: errors.NewBadRequest("request must be provided"),
+		},
+		{
+			name:        "Missing selector",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{}},
+			expectedErr: errors.NewBadRequest("one of clusterGroup, namespace and labelSelector must be set"),
+		},
+		{
+			name:        "ClusterGroup with Namespace",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{ClusterGroup: "cg", Namespace: "ns"}},
+			expectedErr: errors.NewBadRequest("clusterGroup cannot be set with namespace or labelSelector"),
+		},
+		{
+			name:        "Unsupported protocol",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{Namespace: "ns", Ports: []controlplane.ConnectivityMatrixPort{{Protocol: controlplane.ProtocolICMP, Port: 80}}}},
+			expectedErr: errors.NewBadRequest(`unsupported protocol "ICMP", must be TCP, UDP or SCTP`),
+		},
+		{
+			name:        "Invalid port",
+			obj:         &controlplane.ConnectivityMatrix{Request: &controlplane.ConnectivityMatrixRequest{Namespace: "ns", Ports: []controlplane.ConnectivityMatrixPort{{Protocol: controlplane.ProtocolUDP, Port: 70000}}}},
+			expectedErr: errors.NewBadRequest("invalid port 70000, must be between 1 and 65535"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockQuerier := queriermock.NewMockConnectivityMatrixQuerier(mockCtrl)
+			if tt.mockResponse != nil || tt.mockErr != nil {
+				mockQuerier.EXPECT().QueryConnectivityMatrix(tt.obj.(*controlplane.ConnectivityMatrix).Request).Return(tt.mockResponse, tt.mockErr)
+			}
+			r := NewREST(mockQuerier)
+			actualObj, err := r.Create(context.TODO(), tt.obj, nil, &v1.CreateOptions{})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedReturnedObj, actualObj)
+		})
+	}
+}
@@ -29,6 +29,7 @@ type ControlplaneV1beta2Interface interface {
 	AddressGroupsGetter
 	AppliedToGroupsGetter
 	ClusterGroupMembersGetter
+	ConnectivityMatricesGetter
 	EgressGroupsGetter
 	GroupAssociationsGetter
 	GroupMembersGetter
@@ -56,6 +57,10 @@ func (c *ControlplaneV1beta2Client) ClusterGroupMembers() ClusterGroupMembersInt
 	return newClusterGroupMembers(c)
 }
 
+func (c *ControlplaneV1beta2Client) ConnectivityMatrices() ConnectivityMatrixInterface {
+	return newConnectivityMatrices(c)
+}
+
 func (c *ControlplaneV1beta2Client) EgressGroups() EgressGroupInterface {
 	return newEgressGroups(c)
 }
@@ -38,6 +38,10 @@ func (c *FakeControlplaneV1beta2) ClusterGroupMembers() v1beta2.ClusterGroupMemb
 	return &FakeClusterGroupMembers{c}
 }
 
+func (c *FakeControlplaneV1beta2) ConnectivityMatrices() v1beta2.ConnectivityMatrixInterface {
+	return &FakeConnectivityMatrices{c}
+}
+
 func (c *FakeControlplaneV1beta2) EgressGroups() v1beta2.EgressGroupInterface {
 	return &FakeEgressGroups{c}
 }
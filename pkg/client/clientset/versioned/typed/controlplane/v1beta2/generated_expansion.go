@@ -20,6 +20,8 @@ type AddressGroupExpansion interface{}
 
 type AppliedToGroupExpansion interface{}
 
+type ConnectivityMatrixExpansion interface{}
+
 type EgressGroupExpansion interface{}
 
 type GroupAssociationExpansion interface{}
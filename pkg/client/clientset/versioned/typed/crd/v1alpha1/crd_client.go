@@ -27,8 +27,10 @@ import (
 type CrdV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ExternalNodesGetter
+	IPSetsGetter
 	NodeLatencyMonitorsGetter
 	SupportBundleCollectionsGetter
+	TraceflowProbesGetter
 }
 
 // CrdV1alpha1Client is used to interact with features provided by the crd.antrea.io group.
@@ -40,6 +42,10 @@ func (c *CrdV1alpha1Client) ExternalNodes(namespace string) ExternalNodeInterfac
 	return newExternalNodes(c, namespace)
 }
 
+func (c *CrdV1alpha1Client) IPSets() IPSetInterface {
+	return newIPSets(c)
+}
+
 func (c *CrdV1alpha1Client) NodeLatencyMonitors() NodeLatencyMonitorInterface {
 	return newNodeLatencyMonitors(c)
 }
@@ -48,6 +54,10 @@ func (c *CrdV1alpha1Client) SupportBundleCollections() SupportBundleCollectionIn
 	return newSupportBundleCollections(c)
 }
 
+func (c *CrdV1alpha1Client) TraceflowProbes() TraceflowProbeInterface {
+	return newTraceflowProbes(c)
+}
+
 // NewForConfig creates a new CrdV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
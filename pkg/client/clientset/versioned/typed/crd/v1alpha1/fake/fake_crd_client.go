@@ -30,6 +30,10 @@ func (c *FakeCrdV1alpha1) ExternalNodes(namespace string) v1alpha1.ExternalNodeI
 	return &FakeExternalNodes{c, namespace}
 }
 
+func (c *FakeCrdV1alpha1) IPSets() v1alpha1.IPSetInterface {
+	return &FakeIPSets{c}
+}
+
 func (c *FakeCrdV1alpha1) NodeLatencyMonitors() v1alpha1.NodeLatencyMonitorInterface {
 	return &FakeNodeLatencyMonitors{c}
 }
@@ -38,6 +42,10 @@ func (c *FakeCrdV1alpha1) SupportBundleCollections() v1alpha1.SupportBundleColle
 	return &FakeSupportBundleCollections{c}
 }
 
+func (c *FakeCrdV1alpha1) TraceflowProbes() v1alpha1.TraceflowProbeInterface {
+	return &FakeTraceflowProbes{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCrdV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,130 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeIPSets implements IPSetInterface
+type FakeIPSets struct {
+	Fake *FakeCrdV1alpha1
+}
+
+var ipsetsResource = v1alpha1.SchemeGroupVersion.WithResource("ipsets")
+
+var ipsetsKind = v1alpha1.SchemeGroupVersion.WithKind("IPSet")
+
+// Get takes name of the iPSet, and returns the corresponding iPSet object, and an error if there is any.
+func (c *FakeIPSets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.IPSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(ipsetsResource, name), &v1alpha1.IPSet{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IPSet), err
+}
+
+// List takes label and field selectors, and returns the list of IPSets that match those selectors.
+func (c *FakeIPSets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.IPSetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(ipsetsResource, ipsetsKind, opts), &v1alpha1.IPSetList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.IPSetList{ListMeta: obj.(*v1alpha1.IPSetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.IPSetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested iPSets.
+func (c *FakeIPSets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(ipsetsResource, opts))
+}
+
+// Create takes the representation of a iPSet and creates it.  Returns the server's representation of the iPSet, and an error, if there is any.
+func (c *FakeIPSets) Create(ctx context.Context, iPSet *v1alpha1.IPSet, opts v1.CreateOptions) (result *v1alpha1.IPSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(ipsetsResource, iPSet), &v1alpha1.IPSet{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IPSet), err
+}
+
+// Update takes the representation of a iPSet and updates it. Returns the server's representation of the iPSet, and an error, if there is any.
+func (c *FakeIPSets) Update(ctx context.Context, iPSet *v1alpha1.IPSet, opts v1.UpdateOptions) (result *v1alpha1.IPSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(ipsetsResource, iPSet), &v1alpha1.IPSet{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IPSet), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeIPSets) UpdateStatus(ctx context.Context, iPSet *v1alpha1.IPSet, opts v1.UpdateOptions) (*v1alpha1.IPSet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(ipsetsResource, "status", iPSet), &v1alpha1.IPSet{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IPSet), err
+}
+
+// Delete takes name of the iPSet and deletes it. Returns an error if one occurs.
+func (c *FakeIPSets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(ipsetsResource, name, opts), &v1alpha1.IPSet{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeIPSets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(ipsetsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.IPSetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched iPSet.
+func (c *FakeIPSets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.IPSet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(ipsetsResource, name, pt, data, subresources...), &v1alpha1.IPSet{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IPSet), err
+}
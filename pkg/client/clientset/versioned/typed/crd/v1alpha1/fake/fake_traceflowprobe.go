@@ -0,0 +1,130 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTraceflowProbes implements TraceflowProbeInterface
+type FakeTraceflowProbes struct {
+	Fake *FakeCrdV1alpha1
+}
+
+var traceflowprobesResource = v1alpha1.SchemeGroupVersion.WithResource("traceflowprobes")
+
+var traceflowprobesKind = v1alpha1.SchemeGroupVersion.WithKind("TraceflowProbe")
+
+// Get takes name of the traceflowProbe, and returns the corresponding traceflowProbe object, and an error if there is any.
+func (c *FakeTraceflowProbes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(traceflowprobesResource, name), &v1alpha1.TraceflowProbe{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TraceflowProbe), err
+}
+
+// List takes label and field selectors, and returns the list of TraceflowProbes that match those selectors.
+func (c *FakeTraceflowProbes) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TraceflowProbeList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(traceflowprobesResource, traceflowprobesKind, opts), &v1alpha1.TraceflowProbeList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.TraceflowProbeList{ListMeta: obj.(*v1alpha1.TraceflowProbeList).ListMeta}
+	for _, item := range obj.(*v1alpha1.TraceflowProbeList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested traceflowProbes.
+func (c *FakeTraceflowProbes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(traceflowprobesResource, opts))
+}
+
+// Create takes the representation of a traceflowProbe and creates it.  Returns the server's representation of the traceflowProbe, and an error, if there is any.
+func (c *FakeTraceflowProbes) Create(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.CreateOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(traceflowprobesResource, traceflowProbe), &v1alpha1.TraceflowProbe{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TraceflowProbe), err
+}
+
+// Update takes the representation of a traceflowProbe and updates it. Returns the server's representation of the traceflowProbe, and an error, if there is any.
+func (c *FakeTraceflowProbes) Update(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(traceflowprobesResource, traceflowProbe), &v1alpha1.TraceflowProbe{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TraceflowProbe), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTraceflowProbes) UpdateStatus(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (*v1alpha1.TraceflowProbe, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(traceflowprobesResource, "status", traceflowProbe), &v1alpha1.TraceflowProbe{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TraceflowProbe), err
+}
+
+// Delete takes name of the traceflowProbe and deletes it. Returns an error if one occurs.
+func (c *FakeTraceflowProbes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(traceflowprobesResource, name, opts), &v1alpha1.TraceflowProbe{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTraceflowProbes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(traceflowprobesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.TraceflowProbeList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched traceflowProbe.
+func (c *FakeTraceflowProbes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TraceflowProbe, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(traceflowprobesResource, name, pt, data, subresources...), &v1alpha1.TraceflowProbe{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TraceflowProbe), err
+}
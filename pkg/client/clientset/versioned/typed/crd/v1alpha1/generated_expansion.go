@@ -18,6 +18,10 @@ package v1alpha1
 
 type ExternalNodeExpansion interface{}
 
+type IPSetExpansion interface{}
+
 type NodeLatencyMonitorExpansion interface{}
 
 type SupportBundleCollectionExpansion interface{}
+
+type TraceflowProbeExpansion interface{}
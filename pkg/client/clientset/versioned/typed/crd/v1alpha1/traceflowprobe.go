@@ -0,0 +1,182 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TraceflowProbesGetter has a method to return a TraceflowProbeInterface.
+// A group's client should implement this interface.
+type TraceflowProbesGetter interface {
+	TraceflowProbes() TraceflowProbeInterface
+}
+
+// TraceflowProbeInterface has methods to work with TraceflowProbe resources.
+type TraceflowProbeInterface interface {
+	Create(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.CreateOptions) (*v1alpha1.TraceflowProbe, error)
+	Update(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (*v1alpha1.TraceflowProbe, error)
+	UpdateStatus(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (*v1alpha1.TraceflowProbe, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.TraceflowProbe, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.TraceflowProbeList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TraceflowProbe, err error)
+	TraceflowProbeExpansion
+}
+
+// traceflowProbes implements TraceflowProbeInterface
+type traceflowProbes struct {
+	client rest.Interface
+}
+
+// newTraceflowProbes returns a TraceflowProbes
+func newTraceflowProbes(c *CrdV1alpha1Client) *traceflowProbes {
+	return &traceflowProbes{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the traceflowProbe, and returns the corresponding traceflowProbe object, and an error if there is any.
+func (c *traceflowProbes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	result = &v1alpha1.TraceflowProbe{}
+	err = c.client.Get().
+		Resource("traceflowprobes").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TraceflowProbes that match those selectors.
+func (c *traceflowProbes) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TraceflowProbeList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.TraceflowProbeList{}
+	err = c.client.Get().
+		Resource("traceflowprobes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested traceflowProbes.
+func (c *traceflowProbes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("traceflowprobes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a traceflowProbe and creates it.  Returns the server's representation of the traceflowProbe, and an error, if there is any.
+func (c *traceflowProbes) Create(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.CreateOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	result = &v1alpha1.TraceflowProbe{}
+	err = c.client.Post().
+		Resource("traceflowprobes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(traceflowProbe).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a traceflowProbe and updates it. Returns the server's representation of the traceflowProbe, and an error, if there is any.
+func (c *traceflowProbes) Update(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	result = &v1alpha1.TraceflowProbe{}
+	err = c.client.Put().
+		Resource("traceflowprobes").
+		Name(traceflowProbe.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(traceflowProbe).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *traceflowProbes) UpdateStatus(ctx context.Context, traceflowProbe *v1alpha1.TraceflowProbe, opts v1.UpdateOptions) (result *v1alpha1.TraceflowProbe, err error) {
+	result = &v1alpha1.TraceflowProbe{}
+	err = c.client.Put().
+		Resource("traceflowprobes").
+		Name(traceflowProbe.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(traceflowProbe).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the traceflowProbe and deletes it. Returns an error if one occurs.
+func (c *traceflowProbes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("traceflowprobes").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *traceflowProbes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("traceflowprobes").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched traceflowProbe.
+func (c *traceflowProbes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TraceflowProbe, err error) {
+	result = &v1alpha1.TraceflowProbe{}
+	err = c.client.Patch(pt).
+		Resource("traceflowprobes").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
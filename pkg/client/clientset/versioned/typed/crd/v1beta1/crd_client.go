@@ -34,6 +34,7 @@ type CrdV1beta1Interface interface {
 	ExternalIPPoolsGetter
 	GroupsGetter
 	IPPoolsGetter
+	NamespacedTiersGetter
 	NetworkPoliciesGetter
 	TiersGetter
 	TraceflowsGetter
@@ -76,6 +77,10 @@ func (c *CrdV1beta1Client) IPPools() IPPoolInterface {
 	return newIPPools(c)
 }
 
+func (c *CrdV1beta1Client) NamespacedTiers(namespace string) NamespacedTierInterface {
+	return newNamespacedTiers(c, namespace)
+}
+
 func (c *CrdV1beta1Client) NetworkPolicies(namespace string) NetworkPolicyInterface {
 	return newNetworkPolicies(c, namespace)
 }
@@ -58,6 +58,10 @@ func (c *FakeCrdV1beta1) IPPools() v1beta1.IPPoolInterface {
 	return &FakeIPPools{c}
 }
 
+func (c *FakeCrdV1beta1) NamespacedTiers(namespace string) v1beta1.NamespacedTierInterface {
+	return &FakeNamespacedTiers{c, namespace}
+}
+
 func (c *FakeCrdV1beta1) NetworkPolicies(namespace string) v1beta1.NetworkPolicyInterface {
 	return &FakeNetworkPolicies{c, namespace}
 }
@@ -0,0 +1,127 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNamespacedTiers implements NamespacedTierInterface
+type FakeNamespacedTiers struct {
+	Fake *FakeCrdV1beta1
+	ns   string
+}
+
+var namespacedtiersResource = v1beta1.SchemeGroupVersion.WithResource("namespacedtiers")
+
+var namespacedtiersKind = v1beta1.SchemeGroupVersion.WithKind("NamespacedTier")
+
+// Get takes name of the namespacedTier, and returns the corresponding namespacedTier object, and an error if there is any.
+func (c *FakeNamespacedTiers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.NamespacedTier, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(namespacedtiersResource, c.ns, name), &v1beta1.NamespacedTier{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NamespacedTier), err
+}
+
+// List takes label and field selectors, and returns the list of NamespacedTiers that match those selectors.
+func (c *FakeNamespacedTiers) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.NamespacedTierList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(namespacedtiersResource, namespacedtiersKind, c.ns, opts), &v1beta1.NamespacedTierList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.NamespacedTierList{ListMeta: obj.(*v1beta1.NamespacedTierList).ListMeta}
+	for _, item := range obj.(*v1beta1.NamespacedTierList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested namespacedTiers.
+func (c *FakeNamespacedTiers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(namespacedtiersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a namespacedTier and creates it.  Returns the server's representation of the namespacedTier, and an error, if there is any.
+func (c *FakeNamespacedTiers) Create(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.CreateOptions) (result *v1beta1.NamespacedTier, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(namespacedtiersResource, c.ns, namespacedTier), &v1beta1.NamespacedTier{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NamespacedTier), err
+}
+
+// Update takes the representation of a namespacedTier and updates it. Returns the server's representation of the namespacedTier, and an error, if there is any.
+func (c *FakeNamespacedTiers) Update(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.UpdateOptions) (result *v1beta1.NamespacedTier, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(namespacedtiersResource, c.ns, namespacedTier), &v1beta1.NamespacedTier{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NamespacedTier), err
+}
+
+// Delete takes name of the namespacedTier and deletes it. Returns an error if one occurs.
+func (c *FakeNamespacedTiers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(namespacedtiersResource, c.ns, name, opts), &v1beta1.NamespacedTier{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNamespacedTiers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(namespacedtiersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.NamespacedTierList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched namespacedTier.
+func (c *FakeNamespacedTiers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NamespacedTier, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(namespacedtiersResource, c.ns, name, pt, data, subresources...), &v1beta1.NamespacedTier{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NamespacedTier), err
+}
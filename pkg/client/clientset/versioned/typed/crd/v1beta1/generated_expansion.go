@@ -32,6 +32,8 @@ type GroupExpansion interface{}
 
 type IPPoolExpansion interface{}
 
+type NamespacedTierExpansion interface{}
+
 type NetworkPolicyExpansion interface{}
 
 type TierExpansion interface{}
@@ -0,0 +1,176 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NamespacedTiersGetter has a method to return a NamespacedTierInterface.
+// A group's client should implement this interface.
+type NamespacedTiersGetter interface {
+	NamespacedTiers(namespace string) NamespacedTierInterface
+}
+
+// NamespacedTierInterface has methods to work with NamespacedTier resources.
+type NamespacedTierInterface interface {
+	Create(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.CreateOptions) (*v1beta1.NamespacedTier, error)
+	Update(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.UpdateOptions) (*v1beta1.NamespacedTier, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.NamespacedTier, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.NamespacedTierList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NamespacedTier, err error)
+	NamespacedTierExpansion
+}
+
+// namespacedTiers implements NamespacedTierInterface
+type namespacedTiers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNamespacedTiers returns a NamespacedTiers
+func newNamespacedTiers(c *CrdV1beta1Client, namespace string) *namespacedTiers {
+	return &namespacedTiers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the namespacedTier, and returns the corresponding namespacedTier object, and an error if there is any.
+func (c *namespacedTiers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.NamespacedTier, err error) {
+	result = &v1beta1.NamespacedTier{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NamespacedTiers that match those selectors.
+func (c *namespacedTiers) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.NamespacedTierList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.NamespacedTierList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested namespacedTiers.
+func (c *namespacedTiers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a namespacedTier and creates it.  Returns the server's representation of the namespacedTier, and an error, if there is any.
+func (c *namespacedTiers) Create(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.CreateOptions) (result *v1beta1.NamespacedTier, err error) {
+	result = &v1beta1.NamespacedTier{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespacedTier).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a namespacedTier and updates it. Returns the server's representation of the namespacedTier, and an error, if there is any.
+func (c *namespacedTiers) Update(ctx context.Context, namespacedTier *v1beta1.NamespacedTier, opts v1.UpdateOptions) (result *v1beta1.NamespacedTier, err error) {
+	result = &v1beta1.NamespacedTier{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		Name(namespacedTier.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespacedTier).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the namespacedTier and deletes it. Returns an error if one occurs.
+func (c *namespacedTiers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *namespacedTiers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched namespacedTier.
+func (c *namespacedTiers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NamespacedTier, err error) {
+	result = &v1beta1.NamespacedTier{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("namespacedtiers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
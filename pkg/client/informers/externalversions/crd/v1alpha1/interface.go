@@ -24,10 +24,14 @@ import (
 type Interface interface {
 	// ExternalNodes returns a ExternalNodeInformer.
 	ExternalNodes() ExternalNodeInformer
+	// IPSets returns a IPSetInformer.
+	IPSets() IPSetInformer
 	// NodeLatencyMonitors returns a NodeLatencyMonitorInformer.
 	NodeLatencyMonitors() NodeLatencyMonitorInformer
 	// SupportBundleCollections returns a SupportBundleCollectionInformer.
 	SupportBundleCollections() SupportBundleCollectionInformer
+	// TraceflowProbes returns a TraceflowProbeInformer.
+	TraceflowProbes() TraceflowProbeInformer
 }
 
 type version struct {
@@ -46,6 +50,11 @@ func (v *version) ExternalNodes() ExternalNodeInformer {
 	return &externalNodeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// IPSets returns a IPSetInformer.
+func (v *version) IPSets() IPSetInformer {
+	return &iPSetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // NodeLatencyMonitors returns a NodeLatencyMonitorInformer.
 func (v *version) NodeLatencyMonitors() NodeLatencyMonitorInformer {
 	return &nodeLatencyMonitorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -55,3 +64,8 @@ func (v *version) NodeLatencyMonitors() NodeLatencyMonitorInformer {
 func (v *version) SupportBundleCollections() SupportBundleCollectionInformer {
 	return &supportBundleCollectionInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// TraceflowProbes returns a TraceflowProbeInformer.
+func (v *version) TraceflowProbes() TraceflowProbeInformer {
+	return &traceflowProbeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
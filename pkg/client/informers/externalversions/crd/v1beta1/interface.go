@@ -38,6 +38,8 @@ type Interface interface {
 	Groups() GroupInformer
 	// IPPools returns a IPPoolInformer.
 	IPPools() IPPoolInformer
+	// NamespacedTiers returns a NamespacedTierInformer.
+	NamespacedTiers() NamespacedTierInformer
 	// NetworkPolicies returns a NetworkPolicyInformer.
 	NetworkPolicies() NetworkPolicyInformer
 	// Tiers returns a TierInformer.
@@ -97,6 +99,11 @@ func (v *version) IPPools() IPPoolInformer {
 	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// NamespacedTiers returns a NamespacedTierInformer.
+func (v *version) NamespacedTiers() NamespacedTierInformer {
+	return &namespacedTierInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // NetworkPolicies returns a NetworkPolicyInformer.
 func (v *version) NetworkPolicies() NetworkPolicyInformer {
 	return &networkPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -55,10 +55,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=crd.antrea.io, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("externalnodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().ExternalNodes().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("ipsets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().IPSets().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nodelatencymonitors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().NodeLatencyMonitors().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("supportbundlecollections"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().SupportBundleCollections().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("traceflowprobes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().TraceflowProbes().Informer()}, nil
 
 		// Group=crd.antrea.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("externalentities"):
@@ -85,6 +89,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1beta1().Groups().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("ippools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1beta1().IPPools().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("namespacedtiers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1beta1().NamespacedTiers().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("networkpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1beta1().NetworkPolicies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("tiers"):
@@ -24,6 +24,10 @@ type ExternalNodeListerExpansion interface{}
 // ExternalNodeNamespaceLister.
 type ExternalNodeNamespaceListerExpansion interface{}
 
+// IPSetListerExpansion allows custom methods to be added to
+// IPSetLister.
+type IPSetListerExpansion interface{}
+
 // NodeLatencyMonitorListerExpansion allows custom methods to be added to
 // NodeLatencyMonitorLister.
 type NodeLatencyMonitorListerExpansion interface{}
@@ -31,3 +35,7 @@ type NodeLatencyMonitorListerExpansion interface{}
 // SupportBundleCollectionListerExpansion allows custom methods to be added to
 // SupportBundleCollectionLister.
 type SupportBundleCollectionListerExpansion interface{}
+
+// TraceflowProbeListerExpansion allows custom methods to be added to
+// TraceflowProbeLister.
+type TraceflowProbeListerExpansion interface{}
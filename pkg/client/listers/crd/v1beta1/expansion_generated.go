@@ -52,6 +52,14 @@ type GroupNamespaceListerExpansion interface{}
 // IPPoolLister.
 type IPPoolListerExpansion interface{}
 
+// NamespacedTierListerExpansion allows custom methods to be added to
+// NamespacedTierLister.
+type NamespacedTierListerExpansion interface{}
+
+// NamespacedTierNamespaceListerExpansion allows custom methods to be added to
+// NamespacedTierNamespaceLister.
+type NamespacedTierNamespaceListerExpansion interface{}
+
 // NetworkPolicyListerExpansion allows custom methods to be added to
 // NetworkPolicyLister.
 type NetworkPolicyListerExpansion interface{}
@@ -0,0 +1,97 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NamespacedTierLister helps list NamespacedTiers.
+// All objects returned here must be treated as read-only.
+type NamespacedTierLister interface {
+	// List lists all NamespacedTiers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.NamespacedTier, err error)
+	// NamespacedTiers returns an object that can list and get NamespacedTiers.
+	NamespacedTiers(namespace string) NamespacedTierNamespaceLister
+	NamespacedTierListerExpansion
+}
+
+// namespacedTierLister implements the NamespacedTierLister interface.
+type namespacedTierLister struct {
+	indexer cache.Indexer
+}
+
+// NewNamespacedTierLister returns a new NamespacedTierLister.
+func NewNamespacedTierLister(indexer cache.Indexer) NamespacedTierLister {
+	return &namespacedTierLister{indexer: indexer}
+}
+
+// List lists all NamespacedTiers in the indexer.
+func (s *namespacedTierLister) List(selector labels.Selector) (ret []*v1beta1.NamespacedTier, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.NamespacedTier))
+	})
+	return ret, err
+}
+
+// NamespacedTiers returns an object that can list and get NamespacedTiers.
+func (s *namespacedTierLister) NamespacedTiers(namespace string) NamespacedTierNamespaceLister {
+	return namespacedTierNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// NamespacedTierNamespaceLister helps list and get NamespacedTiers.
+// All objects returned here must be treated as read-only.
+type NamespacedTierNamespaceLister interface {
+	// List lists all NamespacedTiers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.NamespacedTier, err error)
+	// Get retrieves the NamespacedTier from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.NamespacedTier, error)
+	NamespacedTierNamespaceListerExpansion
+}
+
+// namespacedTierNamespaceLister implements the NamespacedTierNamespaceLister
+// interface.
+type namespacedTierNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all NamespacedTiers in the indexer for a given namespace.
+func (s namespacedTierNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.NamespacedTier, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.NamespacedTier))
+	})
+	return ret, err
+}
+
+// Get retrieves the NamespacedTier from the indexer for a given namespace and name.
+func (s namespacedTierNamespaceLister) Get(name string) (*v1beta1.NamespacedTier, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("namespacedtier"), name)
+	}
+	return obj.(*v1beta1.NamespacedTier), nil
+}
@@ -189,6 +189,8 @@ type AgentConfig struct {
 	ExternalNode ExternalNodeConfig `yaml:"externalNode,omitempty"`
 	// AuditLogging supports configuring log rotation for audit logs.
 	AuditLogging AuditLoggingConfig `yaml:"auditLogging,omitempty"`
+	// NetworkPolicyStats related configurations.
+	NetworkPolicyStats NetworkPolicyStatsConfig `yaml:"networkPolicyStats,omitempty"`
 	// Antrea's native secondary network configuration.
 	SecondaryNetwork SecondaryNetworkConfig `yaml:"secondaryNetwork,omitempty"`
 	// PacketInRate defines the OVS controller packet rate limits for different
@@ -379,6 +381,30 @@ type AuditLoggingConfig struct {
 	MaxAge *int32 `yaml:"maxAge,omitempty"`
 	// Compress enables gzip compression on rotated files. Defaults to true.
 	Compress *bool `yaml:"compress,omitempty"`
+	// Format is the format of the audit log entries. Supported values are "text" and
+	// "json". The JSON format includes the Pods, Service, Node and Tier of the logged
+	// traffic in addition to the fields of the text format. Defaults to "text".
+	Format string `yaml:"format,omitempty"`
+	// Syslog configures the forwarding of the audit log entries to a syslog server.
+	Syslog AuditLoggingSyslogConfig `yaml:"syslog,omitempty"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// Address is the address of the syslog server, in the "<host>:<port>" format. The
+	// audit log entries are forwarded to the server, in the RFC 5424 format, only when
+	// it is set.
+	Address string `yaml:"address,omitempty"`
+	// Transport is the transport protocol used to forward the audit log entries to the
+	// syslog server. Supported values are "udp" and "tcp". Defaults to "udp".
+	Transport string `yaml:"transport,omitempty"`
+}
+
+type NetworkPolicyStatsConfig struct {
+	// Enable breaking down the stats of NetworkPolicies and their rules by peer, i.e. by
+	// the source of the traffic for ingress rules and by its destination for egress rules.
+	// The stats of the peers are collected from the conntrack connections. It is only
+	// effective when the NetworkPolicyStats feature gate is enabled. Defaults to false.
+	EnablePeerStats bool `yaml:"enablePeerStats,omitempty"`
 }
 
 type SecondaryNetworkConfig struct {
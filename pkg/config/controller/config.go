@@ -75,6 +75,20 @@ type ControllerConfig struct {
 	IPsecCSRSignerConfig IPsecCSRSignerConfig `yaml:"ipsecCSRSigner"`
 	// Multicluster configuration options.
 	Multicluster MulticlusterConfig `yaml:"multicluster,omitempty"`
+	// Stale rule detection configuration for Antrea-native policies.
+	StaleRuleDetection StaleRuleDetectionConfig `yaml:"staleRuleDetection,omitempty"`
+}
+
+type StaleRuleDetectionConfig struct {
+	// Enable reporting the rules of Antrea-native policies which have not matched any traffic
+	// during Window, through a StaleRule condition in the policy status and a Prometheus
+	// metric. It requires the AntreaPolicy and NetworkPolicyStats features to be enabled.
+	// Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The duration without any traffic matched after which a rule is reported as stale. Valid
+	// time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// Defaults to "720h".
+	Window string `yaml:"window,omitempty"`
 }
 
 type MulticlusterConfig struct {
@@ -112,12 +112,19 @@ type GroupEntityController struct {
 	// namespaceAddEvents tracks the number of Namespace Add events that have been processed.
 	namespaceAddEvents *eventsCounter
 
+	nodeInformer coreinformers.NodeInformer
+	// nodeListerSynced is a function which returns true if the Node shared informer has been synced at least once.
+	nodeListerSynced cache.InformerSynced
+	// nodeAddEvents tracks the number of Node Add events that have been processed.
+	nodeAddEvents *eventsCounter
+
 	groupEntityIndex *GroupEntityIndex
 }
 
 func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 	podInformer coreinformers.PodInformer,
 	namespaceInformer coreinformers.NamespaceInformer,
+	nodeInformer coreinformers.NodeInformer,
 	externalEntityInformer crdv1a2informers.ExternalEntityInformer) *GroupEntityController {
 	c := &GroupEntityController{
 		groupEntityIndex:           groupEntityIndex,
@@ -127,6 +134,9 @@ func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 		namespaceInformer:          namespaceInformer,
 		namespaceListerSynced:      namespaceInformer.Informer().HasSynced,
 		namespaceAddEvents:         new(eventsCounter),
+		nodeInformer:               nodeInformer,
+		nodeListerSynced:           nodeInformer.Informer().HasSynced,
+		nodeAddEvents:              new(eventsCounter),
 		externalEntityInformer:     externalEntityInformer,
 		externalEntityListerSynced: externalEntityInformer.Informer().HasSynced,
 		externalEntityAddEvents:    new(eventsCounter),
@@ -149,6 +159,15 @@ func NewGroupEntityController(groupEntityIndex *GroupEntityIndex,
 		},
 		resyncPeriod,
 	)
+	// Add handlers for Node events.
+	nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addNode,
+			UpdateFunc: c.updateNode,
+			DeleteFunc: c.deleteNode,
+		},
+		resyncPeriod,
+	)
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		// Add handlers for ExternalEntity events.
 		externalEntityInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -167,7 +186,7 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
 
-	cacheSyncs := []cache.InformerSynced{c.podListerSynced, c.namespaceListerSynced}
+	cacheSyncs := []cache.InformerSynced{c.podListerSynced, c.namespaceListerSynced, c.nodeListerSynced}
 	// Wait for externalEntityListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, c.externalEntityListerSynced)
@@ -179,6 +198,7 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 	// the groupEntityIndex has been initialized with the full list of each kind.
 	initialPodCount := len(c.podInformer.Informer().GetStore().List())
 	initialNamespaceCount := len(c.namespaceInformer.Informer().GetStore().List())
+	initialNodeCount := len(c.nodeInformer.Informer().GetStore().List())
 	initialExternalEntityCount := 0
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		initialExternalEntityCount = len(c.externalEntityInformer.Informer().GetStore().List())
@@ -192,6 +212,9 @@ func (c *GroupEntityController) Run(stopCh <-chan struct{}) {
 		if uint64(initialNamespaceCount) > c.namespaceAddEvents.Load() {
 			return false, nil
 		}
+		if uint64(initialNodeCount) > c.nodeAddEvents.Load() {
+			return false, nil
+		}
 		if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 			if uint64(initialExternalEntityCount) > c.externalEntityAddEvents.Load() {
 				return false, nil
@@ -266,6 +289,37 @@ func (c *GroupEntityController) deleteNamespace(old interface{}) {
 	c.groupEntityIndex.DeleteNamespace(namespace)
 }
 
+func (c *GroupEntityController) addNode(obj interface{}) {
+	node := obj.(*v1.Node)
+	klog.V(2).Infof("Processing Node %s ADD event, labels: %v", node.Name, node.Labels)
+	c.groupEntityIndex.AddNode(node)
+	c.nodeAddEvents.Increment()
+}
+
+func (c *GroupEntityController) updateNode(_, curObj interface{}) {
+	curNode := curObj.(*v1.Node)
+	klog.V(2).Infof("Processing Node %s UPDATE event, labels: %v", curNode.Name, curNode.Labels)
+	c.groupEntityIndex.AddNode(curNode)
+}
+
+func (c *GroupEntityController) deleteNode(old interface{}) {
+	node, ok := old.(*v1.Node)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Node, invalid type: %v", old)
+			return
+		}
+		node, ok = tombstone.Obj.(*v1.Node)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Node, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.V(2).Infof("Processing Node %s DELETE event, labels: %v", node.Name, node.Labels)
+	c.groupEntityIndex.DeleteNode(node)
+}
+
 func (c *GroupEntityController) addExternalEntity(obj interface{}) {
 	ee := obj.(*v1alpha2.ExternalEntity)
 	klog.V(2).Infof("Processing ExternalEntity %s/%s ADD event, labels: %v", ee.GetNamespace(), ee.GetName(), ee.GetLabels())
@@ -40,6 +40,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 		initialPods             []*v1.Pod
 		initialExternalEntities []*v1alpha2.ExternalEntity
 		initialNamespaces       []*v1.Namespace
+		initialNodes            []*v1.Node
 		initialGroups           []*group
 		antreaPolicyEnabled     bool
 	}{
@@ -48,6 +49,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			initialPods:             []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace},
 			initialExternalEntities: []*v1alpha2.ExternalEntity{eeFoo1, eeFoo2, eeBar1, eeFoo1InOtherNamespace},
 			initialNamespaces:       []*v1.Namespace{nsDefault, nsOther},
+			initialNodes:            []*v1.Node{nodeA, nodeB},
 			initialGroups:           []*group{groupPodFooType1, groupPodFooType2, groupPodFooAllNamespaceType1, groupEEFooType1, groupEEFooType2, groupEEFooAllNamespaceType1},
 			antreaPolicyEnabled:     true,
 		},
@@ -55,6 +57,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			name:                "AntreaPolicy disabled",
 			initialPods:         []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace},
 			initialNamespaces:   []*v1.Namespace{nsDefault, nsOther},
+			initialNodes:        []*v1.Node{nodeA, nodeB},
 			initialGroups:       []*group{groupPodFooType1, groupPodFooType2, groupPodFooAllNamespaceType1},
 			antreaPolicyEnabled: false,
 		},
@@ -76,6 +79,9 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			for _, namespace := range tt.initialNamespaces {
 				objs = append(objs, namespace)
 			}
+			for _, node := range tt.initialNodes {
+				objs = append(objs, node)
+			}
 			var crdObjs []runtime.Object
 			for _, externalEntity := range tt.initialExternalEntities {
 				crdObjs = append(crdObjs, externalEntity)
@@ -90,7 +96,7 @@ func TestGroupEntityControllerRun(t *testing.T) {
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
 			stopCh := make(chan struct{})
 
-			c := NewGroupEntityController(index, informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces(), crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+			c := NewGroupEntityController(index, informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces(), informerFactory.Core().V1().Nodes(), crdInformerFactory.Crd().V1alpha2().ExternalEntities())
 			assert.False(t, index.HasSynced(), "GroupEntityIndex has been synced before starting InformerFactories")
 
 			informerFactory.Start(stopCh)
@@ -59,7 +59,7 @@ type Interface interface {
 	AddGroup(groupType GroupType, name string, selector *types.GroupSelector)
 	// DeleteGroup deletes a group from the index.
 	DeleteGroup(groupType GroupType, name string)
-	// AddEventHandler registers an eventHandler for the given type of groups. When any Pod/ExternelEntity/Namespace
+	// AddEventHandler registers an eventHandler for the given type of groups. When any Pod/ExternelEntity/Namespace/Node
 	// update affects the given kind of groups, the eventHandler will be called with the affected groups.
 	// The eventHandler is supposed to execute quickly and not perform blocking operation. Blocking operation should be
 	// deferred to a routine that is triggered by the eventHandler, like the eventHandler + workqueue pattern.
@@ -88,10 +88,16 @@ type Interface interface {
 	// DeleteNamespace deletes a Namespace to the index. If any existing groups are affected, eventHandlers will be
 	// called with the affected groups.
 	DeleteNamespace(namespace *v1.Namespace)
+	// AddNode adds or updates a Node to the index. If any existing groups are affected, eventHandlers will be called
+	// with the affected groups.
+	AddNode(node *v1.Node)
+	// DeleteNode deletes a Node from the index. If any existing groups are affected, eventHandlers will be called with
+	// the affected groups.
+	DeleteNode(node *v1.Node)
 	// Run starts the index.
 	Run(stopCh <-chan struct{})
-	// HasSynced returns true if the interface has been initialized with the full lists of Pods, Namespaces, and
-	// ExternalEntities.
+	// HasSynced returns true if the interface has been initialized with the full lists of Pods, Namespaces, Nodes,
+	// and ExternalEntities.
 	HasSynced() bool
 }
 
@@ -114,6 +120,8 @@ type entityItem struct {
 
 // labelItem represents an individual label set. It's the actual object that will be matched with label selectors.
 // Entities of same type in same Namespace having same labels will share a labelItem.
+// The Node of the Pods is not part of the labelItem, as few selectors have PodNodeSelector. For these selectors, the
+// labelItem only means that the labels of the Pods match, and the labels of their Nodes are matched per Pod.
 type labelItem struct {
 	// The label set that will be used for matching.
 	labels labels.Set
@@ -176,6 +184,9 @@ type GroupEntityIndex struct {
 	// labelItemIndex is nested map from entityType to Namespace to keys of labelItems.
 	// It's used to filter potential labelItems when matching a Namespace scoped selectorItem.
 	labelItemIndex map[entityType]map[string]sets.Set[string]
+	// nodePodIndex is a map from Node name to keys of the entityItems of the Pods running on the Node.
+	// It's used to find the selectorItems with PodNodeSelector affected when a Node's labels are updated.
+	nodePodIndex map[string]sets.Set[string]
 
 	// groupItems stores all groupItems.
 	groupItems map[string]*groupItem
@@ -189,6 +200,8 @@ type GroupEntityIndex struct {
 
 	// namespaceLabels stores label sets of all Namespaces.
 	namespaceLabels map[string]labels.Set
+	// nodeLabels stores label sets of all Nodes.
+	nodeLabels map[string]labels.Set
 
 	// eventHandlers is a map from group type to a list of handlers. When a type of group's updated, the corresponding
 	// event handlers will be called with the group name provided.
@@ -198,7 +211,7 @@ type GroupEntityIndex struct {
 	eventChan chan string
 
 	// synced stores a boolean value, which tracks if the GroupEntityIndex has been initialized with the full lists of
-	// Pods, Namespaces, and ExternalEntities.
+	// Pods, Namespaces, Nodes, and ExternalEntities.
 	synced *atomic.Value
 }
 
@@ -211,9 +224,11 @@ func NewGroupEntityIndex() *GroupEntityIndex {
 		groupItems:        map[string]*groupItem{},
 		labelItems:        map[string]*labelItem{},
 		labelItemIndex:    map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
+		nodePodIndex:      map[string]sets.Set[string]{},
 		selectorItems:     map[string]*selectorItem{},
 		selectorItemIndex: map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
 		namespaceLabels:   map[string]labels.Set{},
+		nodeLabels:        map[string]labels.Set{},
 		eventHandlers:     map[GroupType][]eventHandler{},
 		eventChan:         make(chan string, eventChanSize),
 		synced:            synced,
@@ -242,6 +257,9 @@ func (i *GroupEntityIndex) GetEntities(groupType GroupType, name string) ([]*v1.
 		// Collect the entityItems that share the labelItem.
 		for entityItemKey := range lItem.entityItemKeys {
 			eItem, _ := i.entityItems[entityItemKey]
+			if !i.matchPodNode(getEntityNodeName(eItem.entity), sItem.selector) {
+				continue
+			}
 			switch entity := eItem.entity.(type) {
 			case *v1.Pod:
 				pods = append(pods, entity)
@@ -278,6 +296,9 @@ func (i *GroupEntityIndex) getGroups(entityType entityType, namespace, name stri
 	// Get the keys of the selectorItems the labelItem matches.
 	for sKey := range lItem.selectorItemKeys {
 		sItem, _ := i.selectorItems[sKey]
+		if !i.matchPodNode(getEntityNodeName(eItem.entity), sItem.selector) {
+			continue
+		}
 		// Collect the groupItems that share the selectorItem.
 		for gKey := range sItem.groupItemKeys {
 			gItem, _ := i.groupItems[gKey]
@@ -334,6 +355,60 @@ func (i *GroupEntityIndex) DeleteNamespace(namespace *v1.Namespace) {
 	delete(i.namespaceLabels, namespace.Name)
 }
 
+func (i *GroupEntityIndex) AddNode(node *v1.Node) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	nodeLabels, exists := i.nodeLabels[node.Name]
+	// Do nothing if labels are not updated.
+	if exists && labels.Equals(nodeLabels, node.Labels) {
+		return
+	}
+
+	i.nodeLabels[node.Name] = node.Labels
+	i.notifyPodNodeSelectors(node.Name, nodeLabels, exists)
+}
+
+func (i *GroupEntityIndex) DeleteNode(node *v1.Node) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	nodeLabels, exists := i.nodeLabels[node.Name]
+	if !exists {
+		return
+	}
+	delete(i.nodeLabels, node.Name)
+	i.notifyPodNodeSelectors(node.Name, nodeLabels, true)
+}
+
+// notifyPodNodeSelectors notifies the selectorItems that have PodNodeSelector set and match the labels of Pods
+// running on the Node, if they start or stop matching the Node because of the label update.
+func (i *GroupEntityIndex) notifyPodNodeSelectors(nodeName string, oldNodeLabels labels.Set, oldNodeExists bool) {
+	newNodeLabels, newNodeExists := i.nodeLabels[nodeName]
+	// Only selectorItems matching the labelItems of the Pods running on this Node may be affected.
+	affectedSelectorItemKeys := sets.New[string]()
+	for eKey := range i.nodePodIndex[nodeName] {
+		lItem := i.labelItems[i.entityItems[eKey].labelItemKey]
+		for sKey := range lItem.selectorItemKeys {
+			if affectedSelectorItemKeys.Has(sKey) {
+				continue
+			}
+			podNodeSelector := i.selectorItems[sKey].selector.PodNodeSelector
+			if podNodeSelector == nil {
+				continue
+			}
+			oldMatched := oldNodeExists && podNodeSelector.Matches(oldNodeLabels)
+			newMatched := newNodeExists && podNodeSelector.Matches(newNodeLabels)
+			if oldMatched != newMatched {
+				affectedSelectorItemKeys.Insert(sKey)
+			}
+		}
+	}
+	for sKey := range affectedSelectorItemKeys {
+		i.notify(sKey)
+	}
+}
+
 // deleteEntityFromLabelItem disconnects an entityItem from a labelItem.
 // The labelItem will be deleted if it's no longer used by any entityItem.
 func (i *GroupEntityIndex) deleteEntityFromLabelItem(label, entity string) *labelItem {
@@ -400,6 +475,16 @@ func (i *GroupEntityIndex) createLabelItem(entityType entityType, eItem *entityI
 	return lItem
 }
 
+// ServiceAccountPodSelector returns a PodSelector which selects the Pods assigned the ServiceAccount
+// with the provided name, based on the custom label added to Pods by GroupEntityIndex. Combined with a
+// Namespace or an empty NamespaceSelector, it selects the Pods of the ServiceAccount in a single
+// Namespace or in all Namespaces.
+func ServiceAccountPodSelector(name string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{CustomLabelKeyPrefix + CustomLabelKeyServiceAccount: name},
+	}
+}
+
 func (i *GroupEntityIndex) AddPod(pod *v1.Pod) {
 	// Create a new map to add custom labels to avoid changing the original labels and
 	// introducing data race.
@@ -424,18 +509,23 @@ func (i *GroupEntityIndex) addEntity(entityType entityType, entity metav1.Object
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
+	var oldNodeName string
+	newNodeName := getEntityNodeName(entity)
 	eItem, exists := i.entityItems[eKey]
 	if exists {
 		entityUpdated = entityAttrsUpdated(eItem.entity, entity)
+		oldNodeName = getEntityNodeName(eItem.entity)
+		if oldNodeName != newNodeName {
+			i.deleteFromNodePodIndex(oldNodeName, eKey)
+			i.addToNodePodIndex(newNodeName, eKey)
+		}
 		eItem.entity = entity
 		// If its label doesn't change, its labelItem won't change. We still need to dispatch the updates of the groups
 		// that select the entity if the entity's attributes that we care about are updated.
 		if eItem.labelItemKey == lKey {
 			if entityUpdated {
 				lItem := i.labelItems[eItem.labelItemKey]
-				for sKey := range lItem.selectorItemKeys {
-					i.notify(sKey)
-				}
+				i.notifyEntitySelectorItems(lItem.selectorItemKeys, oldNodeName, newNodeName)
 			}
 			return
 		}
@@ -449,6 +539,7 @@ func (i *GroupEntityIndex) addEntity(entityType entityType, entity metav1.Object
 			labelItemKey: lKey,
 		}
 		i.entityItems[eKey] = eItem
+		i.addToNodePodIndex(newNodeName, eKey)
 	}
 
 	// Create a labelItem if it doesn't exist.
@@ -471,9 +562,7 @@ func (i *GroupEntityIndex) addEntity(entityType entityType, entity metav1.Object
 	} else {
 		affectedSelectorItemKeys = lItem.selectorItemKeys
 	}
-	for sKey := range affectedSelectorItemKeys {
-		i.notify(sKey)
-	}
+	i.notifyEntitySelectorItems(affectedSelectorItemKeys, oldNodeName, newNodeName)
 }
 
 func (i *GroupEntityIndex) DeletePod(pod *v1.Pod) {
@@ -498,10 +587,53 @@ func (i *GroupEntityIndex) deleteEntity(entityType entityType, entity metav1.Obj
 	// Delete the entity from its associated labelItem and entityItems.
 	lItem := i.deleteEntityFromLabelItem(eItem.labelItemKey, eKey)
 	delete(i.entityItems, eKey)
+	nodeName := getEntityNodeName(eItem.entity)
+	i.deleteFromNodePodIndex(nodeName, eKey)
 
 	// All selectorItems that match the labelItem are affected.
-	for sKey := range lItem.selectorItemKeys {
-		i.notify(sKey)
+	i.notifyEntitySelectorItems(lItem.selectorItemKeys, nodeName)
+}
+
+// notifyEntitySelectorItems notifies the selectorItems affected by the update of an entity. A selectorItem with
+// PodNodeSelector is only affected if it selects one of the Nodes the Pod was or is running on.
+func (i *GroupEntityIndex) notifyEntitySelectorItems(selectorItemKeys sets.Set[string], nodeNames ...string) {
+	for sKey := range selectorItemKeys {
+		sel := i.selectorItems[sKey].selector
+		affected := sel.PodNodeSelector == nil
+		for _, nodeName := range nodeNames {
+			if affected {
+				break
+			}
+			affected = i.matchPodNode(nodeName, sel)
+		}
+		if affected {
+			i.notify(sKey)
+		}
+	}
+}
+
+// addToNodePodIndex adds a Pod to the nodePodIndex. It does nothing for ExternalEntities and Pods not scheduled yet.
+func (i *GroupEntityIndex) addToNodePodIndex(nodeName, eKey string) {
+	if nodeName == "" {
+		return
+	}
+	podKeys, exists := i.nodePodIndex[nodeName]
+	if !exists {
+		podKeys = sets.New[string]()
+		i.nodePodIndex[nodeName] = podKeys
+	}
+	podKeys.Insert(eKey)
+}
+
+// deleteFromNodePodIndex deletes a Pod from the nodePodIndex.
+func (i *GroupEntityIndex) deleteFromNodePodIndex(nodeName, eKey string) {
+	podKeys, exists := i.nodePodIndex[nodeName]
+	if !exists {
+		return
+	}
+	podKeys.Delete(eKey)
+	if len(podKeys) == 0 {
+		delete(i.nodePodIndex, nodeName)
 	}
 }
 
@@ -693,7 +825,12 @@ func (i *GroupEntityIndex) setSynced(synced bool) {
 	i.synced.Store(synced)
 }
 
+// match returns whether the labels of the entities sharing a labelItem match the selector. For a selector with
+// PodNodeSelector, the labels of the Nodes of the Pods are matched per Pod with matchPodNode.
 func (i *GroupEntityIndex) match(entityType entityType, label labels.Set, namespace string, sel *types.GroupSelector) bool {
+	if sel.PodNodeSelector != nil && entityType != podEntityType {
+		return false
+	}
 	objSelector := sel.PodSelector
 	if entityType == externalEntityType {
 		objSelector = sel.ExternalEntitySelector
@@ -736,6 +873,11 @@ func (i *GroupEntityIndex) match(entityType entityType, label labels.Set, namesp
 		}
 		return true
 	}
+	if sel.PodNodeSelector != nil {
+		// Selector only has a PodNodeSelector and no sel.Namespace.
+		// Pods running on the selected Nodes must be matched from all Namespaces.
+		return true
+	}
 	// The group selects nothing when all selectors are missing.
 	return false
 }
@@ -763,6 +905,29 @@ func entityAttrsUpdated(oldEntity, newEntity metav1.Object) bool {
 	return false
 }
 
+// matchPodNode returns whether the labels of the Node a Pod is running on match the PodNodeSelector of the selector.
+// It always returns true for a selector without PodNodeSelector.
+func (i *GroupEntityIndex) matchPodNode(nodeName string, sel *types.GroupSelector) bool {
+	if sel.PodNodeSelector == nil {
+		return true
+	}
+	nodeLabels, exists := i.nodeLabels[nodeName]
+	if !exists {
+		// The Pod is not scheduled yet or its Node is unknown.
+		return false
+	}
+	return sel.PodNodeSelector.Matches(nodeLabels)
+}
+
+// getEntityNodeName returns the name of the Node the entity is running on. It's empty for ExternalEntities and Pods
+// not scheduled yet.
+func getEntityNodeName(entity metav1.Object) string {
+	if pod, ok := entity.(*v1.Pod); ok {
+		return pod.Spec.NodeName
+	}
+	return ""
+}
+
 // getEntityItemKey returns the entity key used in entityItems.
 func getEntityItemKey(entityType entityType, entity metav1.Object) string {
 	return fmt.Sprint(entityType) + "/" + entity.GetNamespace() + "/" + entity.GetName()
@@ -15,6 +15,7 @@
 package grouping
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +39,12 @@ var (
 	podFoo2                 = newPod("default", "podFoo2", map[string]string{"app": "foo"})
 	podBar1                 = newPod("default", "podBar1", map[string]string{"app": "bar"})
 	podFoo1InOtherNamespace = newPod("other", "podFoo1", map[string]string{"app": "foo"})
+	podSA1                  = copyAndMutatePod(newPod("default", "podSA1", nil), func(pod *v1.Pod) { pod.Spec.ServiceAccountName = "sa1" })
+	podSA1InOtherNamespace  = copyAndMutatePod(newPod("other", "podSA1", nil), func(pod *v1.Pod) { pod.Spec.ServiceAccountName = "sa1" })
+	podFooOnNodeA           = copyAndMutatePod(newPod("default", "podFooOnNodeA", map[string]string{"app": "foo"}), func(pod *v1.Pod) { pod.Spec.NodeName = "nodeA" })
+	podFooOnNodeB           = copyAndMutatePod(newPod("default", "podFooOnNodeB", map[string]string{"app": "foo"}), func(pod *v1.Pod) { pod.Spec.NodeName = "nodeB" })
+	podBarOnNodeA           = copyAndMutatePod(newPod("default", "podBarOnNodeA", map[string]string{"app": "bar"}), func(pod *v1.Pod) { pod.Spec.NodeName = "nodeA" })
+	podFooInOtherNsOnNodeA  = copyAndMutatePod(newPod("other", "podFooOnNodeA", map[string]string{"app": "foo"}), func(pod *v1.Pod) { pod.Spec.NodeName = "nodeA" })
 	// Fake ExternalEntities
 	eeFoo1                 = newExternalEntity("default", "eeFoo1", map[string]string{"app": "foo"})
 	eeFoo2                 = newExternalEntity("default", "eeFoo2", map[string]string{"app": "foo"})
@@ -46,6 +53,9 @@ var (
 	// Fake Namespaces
 	nsDefault = newNamespace("default", map[string]string{"company": "default"})
 	nsOther   = newNamespace("other", map[string]string{"company": "other"})
+	// Fake Nodes
+	nodeA = newNode("nodeA", map[string]string{"topology.kubernetes.io/zone": "zone-a"})
+	nodeB = newNode("nodeB", map[string]string{"topology.kubernetes.io/zone": "zone-b"})
 	// Fake groups
 	groupPodFooType1             = &group{groupType: groupType1, groupName: "groupPodFooType1", groupSelector: types.NewGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil)}
 	groupPodFooType2             = &group{groupType: groupType2, groupName: "groupPodFooType2", groupSelector: types.NewGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil)}
@@ -55,6 +65,8 @@ var (
 	groupPodFooAllNamespaceType1 = &group{groupType: groupType1, groupName: "groupPodFooAllNamespaceType1", groupSelector: types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil, nil)}
 	groupPodAllNamespaceType1    = &group{groupType: groupType1, groupName: "groupPodAllNamespaceType1", groupSelector: types.NewGroupSelector("", nil, &metav1.LabelSelector{}, nil, nil)}
 	groupEEFooAllNamespaceType1  = &group{groupType: groupType1, groupName: "groupEEFooAllNamespaceType1", groupSelector: types.NewGroupSelector("", nil, &metav1.LabelSelector{}, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil)}
+	groupPodFooZoneAType1        = &group{groupType: groupType1, groupName: "groupPodFooZoneAType1", groupSelector: newPodNodeGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, &metav1.LabelSelector{MatchLabels: nodeA.Labels})}
+	groupPodZoneBType1           = &group{groupType: groupType1, groupName: "groupPodZoneBType1", groupSelector: newPodNodeGroupSelector("", nil, nil, &metav1.LabelSelector{MatchLabels: nodeB.Labels})}
 )
 
 type group struct {
@@ -75,6 +87,12 @@ func copyAndMutateExternalEntity(ee *v1alpha2.ExternalEntity, mutateFunc func(*v
 	return newEE
 }
 
+func newPodNodeGroupSelector(namespace string, podSelector, nsSelector, podNodeSelector *metav1.LabelSelector) *types.GroupSelector {
+	groupSelector := types.NewGroupSelector(namespace, podSelector, nsSelector, nil, nil)
+	groupSelector.SetPodNodeSelector(podNodeSelector)
+	return groupSelector
+}
+
 func copyAndMutateNamespace(ns *v1.Namespace, mutateFunc func(*v1.Namespace)) *v1.Namespace {
 	newNS := ns.DeepCopy()
 	mutateFunc(newNS)
@@ -90,6 +108,15 @@ func newNamespace(name string, labels map[string]string) *v1.Namespace {
 	}
 }
 
+func newNode(name string, labels map[string]string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
 func newPod(namespace, name string, labels map[string]string) *v1.Pod {
 	return &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -116,6 +143,7 @@ func TestGroupEntityIndexGetEntities(t *testing.T) {
 		existingPods             []*v1.Pod
 		existingExternalEntities []*v1alpha2.ExternalEntity
 		existingNamespaces       []*v1.Namespace
+		existingNodes            []*v1.Node
 		inputGroupSelector       *types.GroupSelector
 		expectedPods             []*v1.Pod
 		expectedExternalEntities []*v1alpha2.ExternalEntity
@@ -156,6 +184,20 @@ func TestGroupEntityIndexGetEntities(t *testing.T) {
 			inputGroupSelector:       types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{MatchLabels: nsOther.Labels}, nil, nil),
 			expectedPods:             []*v1.Pod{podFoo1InOtherNamespace},
 		},
+		{
+			name:               "serviceAccount in a Namespace",
+			existingNamespaces: []*v1.Namespace{nsDefault, nsOther},
+			existingPods:       []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace, podSA1, podSA1InOtherNamespace},
+			inputGroupSelector: types.NewGroupSelector("default", ServiceAccountPodSelector("sa1"), nil, nil, nil),
+			expectedPods:       []*v1.Pod{podSA1},
+		},
+		{
+			name:               "serviceAccount in all Namespaces",
+			existingNamespaces: []*v1.Namespace{nsDefault, nsOther},
+			existingPods:       []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace, podSA1, podSA1InOtherNamespace},
+			inputGroupSelector: types.NewGroupSelector("", ServiceAccountPodSelector("sa1"), &metav1.LabelSelector{}, nil, nil),
+			expectedPods:       []*v1.Pod{podSA1, podSA1InOtherNamespace},
+		},
 		{
 			name:                     "cluster scoped pod selector with namespaceSelector but no namespaces",
 			existingPods:             []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace},
@@ -163,6 +205,35 @@ func TestGroupEntityIndexGetEntities(t *testing.T) {
 			inputGroupSelector:       types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{MatchLabels: nsOther.Labels}, nil, nil),
 			expectedPods:             []*v1.Pod{},
 		},
+		{
+			name:               "namespace scoped pod selector with podNodeSelector",
+			existingNodes:      []*v1.Node{nodeA, nodeB},
+			existingPods:       []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB, podBarOnNodeA, podFooInOtherNsOnNodeA},
+			inputGroupSelector: newPodNodeGroupSelector("default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, &metav1.LabelSelector{MatchLabels: nodeA.Labels}),
+			expectedPods:       []*v1.Pod{podFooOnNodeA},
+		},
+		{
+			name:               "cluster scoped podNodeSelector",
+			existingNodes:      []*v1.Node{nodeA, nodeB},
+			existingPods:       []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB, podBarOnNodeA, podFooInOtherNsOnNodeA},
+			inputGroupSelector: newPodNodeGroupSelector("", nil, nil, &metav1.LabelSelector{MatchLabels: nodeA.Labels}),
+			expectedPods:       []*v1.Pod{podFooOnNodeA, podBarOnNodeA, podFooInOtherNsOnNodeA},
+		},
+		{
+			name:                     "cluster scoped pod selector with namespaceSelector and podNodeSelector",
+			existingNamespaces:       []*v1.Namespace{nsDefault, nsOther},
+			existingNodes:            []*v1.Node{nodeA, nodeB},
+			existingPods:             []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB, podBarOnNodeA, podFooInOtherNsOnNodeA},
+			existingExternalEntities: []*v1alpha2.ExternalEntity{eeFoo1, eeFoo1InOtherNamespace},
+			inputGroupSelector:       newPodNodeGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, &metav1.LabelSelector{MatchLabels: nsOther.Labels}, &metav1.LabelSelector{MatchLabels: nodeA.Labels}),
+			expectedPods:             []*v1.Pod{podFooInOtherNsOnNodeA},
+		},
+		{
+			name:               "podNodeSelector but no nodes",
+			existingPods:       []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB},
+			inputGroupSelector: newPodNodeGroupSelector("default", nil, nil, &metav1.LabelSelector{MatchLabels: nodeA.Labels}),
+			expectedPods:       []*v1.Pod{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -174,6 +245,9 @@ func TestGroupEntityIndexGetEntities(t *testing.T) {
 			for _, ns := range tt.existingNamespaces {
 				index.AddNamespace(ns)
 			}
+			for _, node := range tt.existingNodes {
+				index.AddNode(node)
+			}
 			for _, ee := range tt.existingExternalEntities {
 				index.AddExternalEntity(ee)
 			}
@@ -407,6 +481,7 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 		name                     string
 		existingPods             []*v1.Pod
 		existingNamespaces       []*v1.Namespace
+		existingNodes            []*v1.Node
 		existingExternalEntities []*v1alpha2.ExternalEntity
 		existingGroups           []*group
 		inputEvent               func(*GroupEntityIndex)
@@ -516,6 +591,68 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 			},
 			expectedGroupsCalled: map[GroupType][]string{groupType1: {"groupCompanyDefault", "groupCompanyOther"}},
 		},
+		{
+			name:           "update an existing node's labels",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB, podBarOnNodeA},
+			existingGroups: []*group{groupPodFooType1, groupPodFooZoneAType1, groupPodZoneBType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.AddNode(newNode("nodeA", map[string]string{"topology.kubernetes.io/zone": "zone-b"}))
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupPodFooZoneAType1.groupName, groupPodZoneBType1.groupName}},
+		},
+		{
+			name:           "update an existing node's labels without affecting groups",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFoo1, podFooOnNodeA, podFooOnNodeB},
+			existingGroups: []*group{groupPodFooType1, groupPodFooZoneAType1, groupPodZoneBType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.AddNode(newNode("nodeA", map[string]string{"topology.kubernetes.io/zone": "zone-a", "foo": "bar"}))
+			},
+			expectedGroupsCalled: map[GroupType][]string{},
+		},
+		{
+			name:           "delete an existing node",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFooOnNodeA, podFooOnNodeB},
+			existingGroups: []*group{groupPodFooType1, groupPodFooZoneAType1, groupPodZoneBType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.DeleteNode(nodeA)
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupPodFooZoneAType1.groupName}},
+		},
+		{
+			name:           "add a pod on a node not selected by podNodeSelector",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFooOnNodeA},
+			existingGroups: []*group{groupPodFooType1, groupPodFooZoneAType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.AddPod(podFooOnNodeB)
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupPodFooType1.groupName}},
+		},
+		{
+			name:           "delete a pod on a node not selected by podNodeSelector",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFooOnNodeA, podFooOnNodeB},
+			existingGroups: []*group{groupPodFooType1, groupPodFooZoneAType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.DeletePod(podFooOnNodeB)
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupPodFooType1.groupName}},
+		},
+		{
+			name:           "schedule an existing pod",
+			existingNodes:  []*v1.Node{nodeA, nodeB},
+			existingPods:   []*v1.Pod{podFoo1, podFooOnNodeB},
+			existingGroups: []*group{groupPodFooZoneAType1, groupPodZoneBType1},
+			inputEvent: func(i *GroupEntityIndex) {
+				i.AddPod(copyAndMutatePod(podFoo1, func(pod *v1.Pod) {
+					pod.Spec.NodeName = "nodeA"
+				}))
+			},
+			expectedGroupsCalled: map[GroupType][]string{groupType1: {groupPodFooZoneAType1.groupName}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -542,6 +679,9 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 			for _, ns := range tt.existingNamespaces {
 				index.AddNamespace(ns)
 			}
+			for _, node := range tt.existingNodes {
+				index.AddNode(node)
+			}
 			for _, ee := range tt.existingExternalEntities {
 				index.AddExternalEntity(ee)
 			}
@@ -560,3 +700,60 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkGroupEntityIndexAddPods measures adding 10k Pods spread over 1k Nodes to an index with 1k label
+// selector groups, with and without additional groups selecting Pods by the labels of their Nodes. Pods sharing
+// the same Namespace and labels should share a labelItem regardless of the Nodes they are running on.
+func BenchmarkGroupEntityIndexAddPods(b *testing.B) {
+	var nodes []*v1.Node
+	for i := 0; i < 1000; i++ {
+		nodes = append(nodes, newNode(fmt.Sprintf("node-%d", i), map[string]string{"topology.kubernetes.io/zone": fmt.Sprintf("zone-%d", i%10)}))
+	}
+	var pods []*v1.Pod
+	var groups, podNodeGroups []*group
+	for i := 0; i < 100; i++ {
+		namespace := fmt.Sprintf("ns-%d", i)
+		for j := 0; j < 10; j++ {
+			labels := map[string]string{"app": fmt.Sprintf("app-%d", j)}
+			groups = append(groups, &group{groupType: groupType1, groupName: fmt.Sprintf("%s-app-%d", namespace, j), groupSelector: types.NewGroupSelector(namespace, &metav1.LabelSelector{MatchLabels: labels}, nil, nil, nil)})
+			for k := 0; k < 10; k++ {
+				pod := newPod(namespace, fmt.Sprintf("pod-%d-%d", j, k), labels)
+				pod.Spec.NodeName = nodes[(i*100+j*10+k)%len(nodes)].Name
+				pods = append(pods, pod)
+			}
+		}
+	}
+	for i := 0; i < 10; i++ {
+		podNodeGroups = append(podNodeGroups, &group{groupType: groupType1, groupName: fmt.Sprintf("zone-%d", i), groupSelector: newPodNodeGroupSelector("", nil, nil, &metav1.LabelSelector{MatchLabels: map[string]string{"topology.kubernetes.io/zone": fmt.Sprintf("zone-%d", i)}})})
+	}
+
+	benchmarkAddPods := func(b *testing.B, groups []*group) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			stopCh := make(chan struct{})
+			index := NewGroupEntityIndex()
+			go index.Run(stopCh)
+			for _, node := range nodes {
+				index.AddNode(node)
+			}
+			for _, g := range groups {
+				index.AddGroup(g.groupType, g.groupName, g.groupSelector)
+			}
+			b.StartTimer()
+			for _, pod := range pods {
+				index.AddPod(pod)
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(len(index.labelItems)), "labelItems")
+			close(stopCh)
+			b.StartTimer()
+		}
+	}
+	b.Run("without podNodeSelector", func(b *testing.B) {
+		benchmarkAddPods(b, groups)
+	})
+	b.Run("with podNodeSelector", func(b *testing.B) {
+		benchmarkAddPods(b, append(groups, podNodeGroups...))
+	})
+}
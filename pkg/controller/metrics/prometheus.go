@@ -117,6 +117,41 @@ var (
 		Help:           "The total number of actual status updates performed for Antrea ClusterNetworkPolicy Custom Resources",
 		StabilityLevel: metrics.ALPHA,
 	})
+	NetworkPolicyStaleRules = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_stale_rules",
+		Help:           "The number of rules of an Antrea-native policy which have not matched any traffic during the stale rule detection window",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"policy_type", "policy_namespace", "policy_name"})
+	TraceflowProbeRuns = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_probe_runs_total",
+		Help:           "The total number of completed runs of a TraceflowProbe, by result",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"probe", "result"})
+	TraceflowProbeSuccess = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_probe_success",
+		Help:           "Whether the last completed run of a TraceflowProbe succeeded (1) or not (0)",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"probe"})
+	TraceflowProbeDrops = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_probe_drops_total",
+		Help:           "The total number of runs of a TraceflowProbe in which the packet was dropped or rejected, by drop location",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"probe", "node", "component", "component_info"})
+	TraceflowProbeObservations = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_probe_observations_total",
+		Help:           "The total number of Observations reported in the runs of a TraceflowProbe, by hop",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"probe", "node", "component", "action"})
 )
 
 // Initialize Prometheus metrics collection.
@@ -156,4 +191,19 @@ func InitializePrometheusMetrics() {
 	if err := legacyregistry.Register(AntreaClusterNetworkPolicyStatusUpdates); err != nil {
 		klog.Errorf("Failed to register antrea_controller_acnp_status_updates with Prometheus: %s", err.Error())
 	}
+	if err := legacyregistry.Register(NetworkPolicyStaleRules); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_stale_rules with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowProbeRuns); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_probe_runs_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowProbeSuccess); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_probe_success with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowProbeDrops); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_probe_drops_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowProbeObservations); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_probe_observations_total with Prometheus: %s", err.Error())
+	}
 }
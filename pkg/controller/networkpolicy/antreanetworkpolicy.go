@@ -23,6 +23,7 @@ import (
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/grouping"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 )
 
@@ -91,11 +92,16 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 	// labelIdentityInterface and added to this set. By the end of the function, this set will
 	// be used to remove any stale selector from the policy in the labelIdentityInterface.
 	var clusterSetScopeSelectorKeys sets.Set[string]
+	// Rules are only enforced during the time windows declared by the Schedules of the policy and their own.
+	scheduleState := evaluateSchedules(np.Spec.Schedules, np.Spec.Ingress, np.Spec.Egress, n.clock.Now)
 	// Create AppliedToGroup for each AppliedTo present in AntreaNetworkPolicy spec.
 	atgs := n.processAppliedTo(np.Namespace, np.Spec.AppliedTo)
 	appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
 	// Compute NetworkPolicyRule for Ingress Rule.
 	for idx, ingressRule := range np.Spec.Ingress {
+		if !scheduleState.ruleActive(controlplane.DirectionIn, idx) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(ingressRule.Ports, ingressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the ingress rule.
@@ -117,10 +123,16 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(ingressRule.RateLimit),
+			PacketCapture:   toAntreaPacketCaptureForCRD(ingressRule.PacketCapture),
+			RejectResponse:  ingressRule.RejectResponse,
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
 	for idx, egressRule := range np.Spec.Egress {
+		if !scheduleState.ruleActive(controlplane.DirectionOut, idx) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(egressRule.Ports, egressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the egress rule.
@@ -149,9 +161,12 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
 			LogLabel:        egressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(egressRule.RateLimit),
+			PacketCapture:   toAntreaPacketCaptureForCRD(egressRule.PacketCapture),
+			RejectResponse:  egressRule.RejectResponse,
 		})
 	}
-	tierPriority := n.getTierPriority(np.Spec.Tier)
+	tierPriority := n.getTierPriority(np.Spec.Tier, np.Namespace)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
 		SourceRef: &controlplane.NetworkPolicyReference{
 			Type:      controlplane.AntreaNetworkPolicy,
@@ -166,7 +181,10 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 		Rules:            rules,
 		Priority:         &np.Spec.Priority,
 		TierPriority:     &tierPriority,
+		Tier:             np.Spec.Tier,
+		EnforcementMode:  n.getEnforcementMode(np.Spec.EnforcementMode, np.Spec.Tier, np.Namespace),
 		AppliedToPerRule: appliedToPerRule,
+		ScheduleState:    scheduleState.scheduleState(),
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(np))
@@ -180,8 +198,11 @@ func (n *NetworkPolicyController) processAppliedTo(namespace string, appliedTo [
 		var atg *antreatypes.AppliedToGroup
 		if at.Group != "" {
 			atg = n.createAppliedToGroupForGroup(namespace, at.Group)
+		} else if at.ServiceAccount != nil {
+			// The validation ensures that the ServiceAccount is in the Namespace of the policy.
+			atg = n.createAppliedToGroup(namespace, grouping.ServiceAccountPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 		} else {
-			atg = n.createAppliedToGroup(namespace, at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, nil)
+			atg = n.createAppliedToGroupWithPodNodeSelector(namespace, at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, at.PodNodeSelector)
 		}
 		if atg != nil {
 			appliedToGroups = append(appliedToGroups, atg)
@@ -26,6 +26,7 @@ import (
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/grouping"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 )
 
@@ -36,6 +37,7 @@ var (
 
 	icmpType8 = int32(8)
 	icmpCode0 = int32(0)
+	icmpCode3 = int32(3)
 )
 
 func TestProcessAntreaNetworkPolicy(t *testing.T) {
@@ -56,6 +58,11 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 	}
 	allowAction := crdv1beta1.RuleActionAllow
 	protocolTCP := controlplane.ProtocolTCP
+	zoneASelector := metav1.LabelSelector{MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"}}
+	zoneAAppliedToSelector := antreatypes.NewGroupSelector("ns1", &selectorA, nil, nil, nil)
+	zoneAAppliedToSelector.SetPodNodeSelector(&zoneASelector)
+	zoneAPeerSelector := antreatypes.NewGroupSelector("ns1", &selectorB, nil, nil, nil)
+	zoneAPeerSelector.SetPodNodeSelector(&zoneASelector)
 	tests := []struct {
 		name                    string
 		inputPolicy             *crdv1beta1.NetworkPolicy
@@ -63,6 +70,102 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 		expectedAppliedToGroups int
 		expectedAddressGroups   int
 	}{
+		{
+			name: "service-accounts",
+			inputPolicy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npSA", UID: "uidSA"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{ServiceAccount: &crdv1beta1.NamespacedName{Name: "ledger-db"}},
+					},
+					Priority: p10,
+					Ingress: []crdv1beta1.Rule{
+						{
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{ServiceAccount: &crdv1beta1.NamespacedName{Name: "payments-api"}},
+								{ServiceAccount: &crdv1beta1.NamespacedName{Name: "audit", Namespace: "ns2"}},
+								{ServiceAccount: &crdv1beta1.NamespacedName{Name: "monitoring", Namespace: "*"}},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidSA",
+				Name: "uidSA",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.AntreaNetworkPolicy,
+					Namespace: "ns1",
+					Name:      "npSA",
+					UID:       "uidSA",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{
+								getNormalizedUID(antreatypes.NewGroupSelector("ns1", grouping.ServiceAccountPodSelector("payments-api"), nil, nil, nil).NormalizedName),
+								getNormalizedUID(antreatypes.NewGroupSelector("ns2", grouping.ServiceAccountPodSelector("audit"), nil, nil, nil).NormalizedName),
+								getNormalizedUID(antreatypes.NewGroupSelector("", grouping.ServiceAccountPodSelector("monitoring"), &metav1.LabelSelector{}, nil, nil).NormalizedName),
+							},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns1", grouping.ServiceAccountPodSelector("ledger-db"), nil, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   3,
+		},
+		{
+			name: "pod-node-selectors",
+			inputPolicy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npZone", UID: "uidZone"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{PodSelector: &selectorA, PodNodeSelector: &zoneASelector},
+					},
+					Priority: p10,
+					Ingress: []crdv1beta1.Rule{
+						{
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{PodSelector: &selectorB, PodNodeSelector: &zoneASelector},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidZone",
+				Name: "uidZone",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.AntreaNetworkPolicy,
+					Namespace: "ns1",
+					Name:      "npZone",
+					UID:       "uidZone",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(zoneAPeerSelector.NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(zoneAAppliedToSelector.NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 		{
 			name: "rules-with-same-selectors",
 			inputPolicy: &crdv1beta1.NetworkPolicy{
@@ -83,7 +83,10 @@ func (c *NetworkPolicyController) updateClusterGroup(oldObj, curObj interface{})
 		}
 		return !oldChildGroups.Equal(newChildGroups)
 	}
-	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() {
+	ipSetUpdated := func() bool {
+		return oldGroup.IPSet != newGroup.IPSet
+	}
+	if !ipBlocksUpdated() && !svcRefUpdated() && !selectorUpdated() && !childGroupsUpdated() && !ipSetUpdated() {
 		// No change in the contents of the ClusterGroup. No need to enqueue for further sync.
 		return
 	}
@@ -137,6 +140,19 @@ func (c *NetworkPolicyController) processClusterGroup(cg *crdv1beta1.ClusterGrou
 		}
 		return &internalGroup
 	}
+	if cg.Spec.IPSet != "" {
+		internalGroup.IPSet = cg.Spec.IPSet
+		// The IPBlocks stay empty until the IPSet has been loaded, the Group will be processed
+		// again once it is.
+		cidrs, _ := c.getIPSetCIDRs(cg.Spec.IPSet)
+		for _, cidr := range cidrs {
+			ipNet, _ := cidrStrToIPNet(cidr.String())
+			internalGroup.IPBlocks = append(internalGroup.IPBlocks, controlplane.IPBlock{CIDR: *ipNet, Except: []controlplane.IPNet{}})
+			_, netIPNet, _ := net.ParseCIDR(cidr.String())
+			internalGroup.IPNets = append(internalGroup.IPNets, *netIPNet)
+		}
+		return &internalGroup
+	}
 	svcSelector := cg.Spec.ServiceReference
 	if svcSelector != nil {
 		// ServiceReference will be converted to groupSelector once the internalGroup is synced.
@@ -230,6 +246,12 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 			}
 		}
 	}
+	if grp.IPSet != "" {
+		// The members of a ClusterGroup referring to an IPSet are not computed until the IPSet is loaded.
+		if _, loaded := c.getIPSetCIDRs(grp.IPSet); !loaded {
+			membersComputed = false
+		}
+	}
 	if membersComputed {
 		klog.V(4).InfoS("Updating GroupMembersComputed Status for ClusterGroup", "ClusterGroup", cg.Name)
 		err = c.updateClusterGroupStatus(cg, v1.ConditionTrue)
@@ -250,6 +272,7 @@ func (c *NetworkPolicyController) syncInternalClusterGroup(grp *antreatypes.Grou
 			IPNets:           grp.IPNets,
 			ServiceReference: grp.ServiceReference,
 			ChildGroups:      grp.ChildGroups,
+			IPSet:            grp.IPSet,
 		}
 		klog.V(2).InfoS("Updating existing internal Group", "internalGroup", grp.SourceReference.ToGroupName())
 		c.internalGroupStore.Update(updatedGrp)
@@ -319,12 +342,16 @@ func (c *NetworkPolicyController) updateClusterGroupStatus(cg *crdv1beta1.Cluste
 		return nil
 	}
 	condStatus.LastTransitionTime = metav1.Now()
-	status := crdv1beta1.GroupStatus{
-		Conditions: []crdv1beta1.GroupCondition{condStatus},
+	// Keep the other conditions, e.g. the RuleConflict condition set by the RuleAnalyzer.
+	conditions := []crdv1beta1.GroupCondition{condStatus}
+	for _, c := range cg.Status.Conditions {
+		if c.Type != crdv1beta1.GroupMembersComputed {
+			conditions = append(conditions, c)
+		}
 	}
 	klog.V(4).Infof("Updating ClusterGroup %s status to %#v", cg.Name, condStatus)
 	toUpdate := cg.DeepCopy()
-	toUpdate.Status = status
+	toUpdate.Status = crdv1beta1.GroupStatus{Conditions: conditions}
 	_, err := c.crdClient.CrdV1beta1().ClusterGroups().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
 	return err
 }
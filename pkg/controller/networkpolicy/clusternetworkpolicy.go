@@ -37,6 +37,9 @@ import (
 
 const (
 	labelValueSeparator = ","
+	// serviceAccountNamespaceAll is the Namespace of a ServiceAccount which selects the
+	// ServiceAccounts with the same name in all Namespaces.
+	serviceAccountNamespaceAll = "*"
 )
 
 func getACNPReference(cnp *crdv1beta1.ClusterNetworkPolicy) *controlplane.NetworkPolicyReference {
@@ -222,6 +225,9 @@ func (n *NetworkPolicyController) updateNamespace(oldObj, curObj interface{}) {
 				n.enqueueInternalNetworkPolicy(getACNPReference(cnp))
 			}
 		}
+		// The Tiers delegating priority bands to the Namespace may change, and
+		// with them the EnforcementMode of the policies in its NamespacedTiers.
+		n.enqueueNamespacedTiersPolicies(curNamespace.Name)
 	}
 
 	if oldNamespace.Annotations[EnableNPLoggingAnnotationKey] != curNamespace.Annotations[EnableNPLoggingAnnotationKey] {
@@ -384,24 +390,30 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 	var clusterSetScopeSelectorKeys sets.Set[string]
 	if hasPerNamespaceRule && len(cnp.Spec.AppliedTo) > 0 {
 		for _, at := range cnp.Spec.AppliedTo {
+			at = expandServiceAccountWildcard(at)
 			if at.ServiceAccount != nil {
-				atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+				atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, grouping.ServiceAccountPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 				appliedToGroups = mergeAppliedToGroups(appliedToGroups, atg)
 				atgPerAffectedNS[at.ServiceAccount.Namespace] = atg
 				labelsPerAffectedNS[at.ServiceAccount.Namespace] = n.getNamespaceLabels(at.ServiceAccount.Namespace)
 			} else {
 				labelsPerAffectedNS = n.getAffectedNamespacesForAppliedTo(at)
 				for ns := range labelsPerAffectedNS {
-					atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector, nil)
+					atg := n.createAppliedToGroupWithPodNodeSelector(ns, at.PodSelector, nil, at.ExternalEntitySelector, at.PodNodeSelector)
 					appliedToGroups = mergeAppliedToGroups(appliedToGroups, atg)
 					atgPerAffectedNS[ns] = atg
 				}
 			}
 		}
 	}
+	// Rules are only enforced during the time windows declared by the Schedules of the policy and their own.
+	scheduleState := evaluateSchedules(cnp.Spec.Schedules, cnp.Spec.Ingress, cnp.Spec.Egress, n.clock.Now)
 	var rules []controlplane.NetworkPolicyRule
 	processRules := func(cnpRules []crdv1beta1.Rule, direction controlplane.Direction) {
 		for idx, cnpRule := range cnpRules {
+			if !scheduleState.ruleActive(direction, idx) {
+				continue
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
 			clusterPeers, perNSPeers, nsLabelPeers := splitPeersByScope(cnpRule, direction)
 			addRule := func(peer *controlplane.NetworkPolicyPeer, ruleAddressGroups []*antreatypes.AddressGroup, dir controlplane.Direction, ruleAppliedTos []*antreatypes.AppliedToGroup) {
@@ -415,6 +427,9 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					RateLimit:       toAntreaRateLimitForCRD(cnpRule.RateLimit),
+					PacketCapture:   toAntreaPacketCaptureForCRD(cnpRule.PacketCapture),
+					RejectResponse:  cnpRule.RejectResponse,
 				}
 				if dir == controlplane.DirectionIn {
 					rule.From = *peer
@@ -458,8 +473,9 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 				} else {
 					// Create a rule for each affected Namespace of appliedTo at rule level
 					for _, at := range cnpRule.AppliedTo {
+						at = expandServiceAccountWildcard(at)
 						if at.ServiceAccount != nil {
-							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, grouping.ServiceAccountPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 							klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 							peer, ags, selKeys := n.toNamespacedPeerForCRD(perNSPeers, cnp, at.ServiceAccount.Namespace)
 							clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
@@ -467,7 +483,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 						} else {
 							affectedNS := n.getAffectedNamespacesForAppliedTo(at)
 							for ns := range affectedNS {
-								atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector, nil)
+								atg := n.createAppliedToGroupWithPodNodeSelector(ns, at.PodSelector, nil, at.ExternalEntitySelector, at.PodNodeSelector)
 								klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 								peer, ags, selKeys := n.toNamespacedPeerForCRD(perNSPeers, cnp, ns)
 								clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
@@ -492,14 +508,15 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					atgPerRuleAffectedNS := map[string]*antreatypes.AppliedToGroup{}
 					labelsPerRuleAffectedNS := map[string]labels.Set{}
 					for _, at := range cnpRule.AppliedTo {
+						at = expandServiceAccountWildcard(at)
 						if at.ServiceAccount != nil {
-							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, grouping.ServiceAccountPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 							atgPerRuleAffectedNS[at.ServiceAccount.Namespace] = atg
 							labelsPerRuleAffectedNS[at.ServiceAccount.Namespace] = n.getNamespaceLabels(at.ServiceAccount.Namespace)
 						} else {
 							labelsPerRuleAffectedNS = n.getAffectedNamespacesForAppliedTo(at)
 							for ns := range labelsPerRuleAffectedNS {
-								atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector, nil)
+								atg := n.createAppliedToGroupWithPodNodeSelector(ns, at.PodSelector, nil, at.ExternalEntitySelector, at.PodNodeSelector)
 								atgPerRuleAffectedNS[ns] = atg
 							}
 						}
@@ -524,7 +541,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 	if !hasPerNamespaceRule {
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, n.processClusterAppliedTo(cnp.Spec.AppliedTo)...)
 	}
-	tierPriority := n.getTierPriority(cnp.Spec.Tier)
+	tierPriority := n.getTierPriority(cnp.Spec.Tier, "")
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
 		Name:       internalNetworkPolicyKeyFunc(cnp),
 		Generation: cnp.Generation,
@@ -538,7 +555,10 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 		Rules:            rules,
 		Priority:         &cnp.Spec.Priority,
 		TierPriority:     &tierPriority,
+		Tier:             cnp.Spec.Tier,
+		EnforcementMode:  n.getEnforcementMode(cnp.Spec.EnforcementMode, cnp.Spec.Tier, ""),
 		AppliedToPerRule: appliedToPerRule,
+		ScheduleState:    scheduleState.scheduleState(),
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(cnp))
@@ -546,12 +566,29 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 	return internalNetworkPolicy, appliedToGroups, addressGroups
 }
 
-// serviceAccountNameToPodSelector returns a PodSelector which could be used to
-// select Pods based on their ServiceAccountName.
-func serviceAccountNameToPodSelector(saName string) *metav1.LabelSelector {
-	return &metav1.LabelSelector{
-		MatchLabels: map[string]string{grouping.CustomLabelKeyPrefix + grouping.CustomLabelKeyServiceAccount: saName},
+// serviceAccountSelectors returns the Namespace, the PodSelector and the NamespaceSelector which
+// select the Pods assigned a ServiceAccount. An empty Namespace refers to the Namespace of the policy,
+// and the wildcard Namespace refers to all Namespaces.
+func serviceAccountSelectors(sa *crdv1beta1.NamespacedName, policyNamespace string) (string, *metav1.LabelSelector, *metav1.LabelSelector) {
+	podSelector := grouping.ServiceAccountPodSelector(sa.Name)
+	switch sa.Namespace {
+	case serviceAccountNamespaceAll:
+		return "", podSelector, &metav1.LabelSelector{}
+	case "":
+		return policyNamespace, podSelector, nil
+	}
+	return sa.Namespace, podSelector, nil
+}
+
+// expandServiceAccountWildcard converts an AppliedTo selecting a ServiceAccount in all Namespaces to
+// the equivalent AppliedTo with a PodSelector and a NamespaceSelector, so that it can be split by
+// Namespace like other selectors.
+func expandServiceAccountWildcard(at crdv1beta1.AppliedTo) crdv1beta1.AppliedTo {
+	if at.ServiceAccount == nil || at.ServiceAccount.Namespace != serviceAccountNamespaceAll {
+		return at
 	}
+	_, podSelector, nsSelector := serviceAccountSelectors(at.ServiceAccount, "")
+	return crdv1beta1.AppliedTo{PodSelector: podSelector, NamespaceSelector: nsSelector}
 }
 
 // hasPerNamespaceRule returns true if there is at least one per-namespace rule
@@ -666,7 +703,7 @@ func (n *NetworkPolicyController) toAntreaPeerForSameLabelNamespaces(peer crdv1b
 	clusterSetScopeSelectorKeys := sets.New[string]()
 	// select Namespaces who, for specific label keys, have the same values as the appliedTo Namespaces.
 	nsSelForSameLabels := convertSameLabelsToSelector(labelKeys, labelValues)
-	addressGroups := []*antreatypes.AddressGroup{n.createAddressGroup("", peer.PodSelector, nsSelForSameLabels, peer.ExternalEntitySelector, nil)}
+	addressGroups := []*antreatypes.AddressGroup{n.createAddressGroupWithPodNodeSelector("", peer.PodSelector, nsSelForSameLabels, peer.ExternalEntitySelector, peer.PodNodeSelector)}
 	if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet {
 		newClusterSetScopeSelector := antreatypes.NewGroupSelector("", peer.PodSelector, nsSelForSameLabels, peer.ExternalEntitySelector, nil)
 		clusterSetScopeSelectorKeys.Insert(newClusterSetScopeSelector.NormalizedName)
@@ -706,9 +743,10 @@ func (n *NetworkPolicyController) processClusterAppliedTo(appliedTo []crdv1beta1
 		} else if at.Service != nil {
 			atg = n.createAppliedToGroupForService(at.Service)
 		} else if at.ServiceAccount != nil {
-			atg = n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+			namespace, podSelector, nsSelector := serviceAccountSelectors(at.ServiceAccount, "")
+			atg = n.createAppliedToGroup(namespace, podSelector, nsSelector, nil, nil)
 		} else {
-			atg = n.createAppliedToGroup("", at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, nil)
+			atg = n.createAppliedToGroupWithPodNodeSelector("", at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, at.PodNodeSelector)
 		}
 		if atg != nil {
 			appliedToGroups = append(appliedToGroups, atg)
@@ -31,6 +31,7 @@ import (
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/grouping"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -97,6 +98,12 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
 	selectorC := metav1.LabelSelector{MatchLabels: map[string]string{"foo3": "bar3"}}
 	selectorD := metav1.LabelSelector{MatchLabels: map[string]string{"internal.antrea.io/service-account": saA.Name}}
+	zoneASelector := metav1.LabelSelector{MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"}}
+	zoneAGroupSelector := func(namespace string, podSelector *metav1.LabelSelector) *antreatypes.GroupSelector {
+		groupSelector := antreatypes.NewGroupSelector(namespace, podSelector, nil, nil, nil)
+		groupSelector.SetPodNodeSelector(&zoneASelector)
+		return groupSelector
+	}
 	queryAddr := "224.0.0.1"
 	reportAddr := "225.1.2.3"
 	cgA := crdv1beta1.ClusterGroup{
@@ -316,6 +323,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &tierA.Spec.Priority,
+				Tier:         tierA.Name,
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -846,6 +854,64 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 4,
 			expectedAddressGroups:   4,
 		},
+		{
+			name: "with-per-namespace-rule-and-pod-node-selector",
+			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cnpZone", UID: "uidZone"},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector:       &selectorB,
+							NamespaceSelector: &selectorA,
+							PodNodeSelector:   &zoneASelector,
+						},
+					},
+					Priority: p10,
+					Egress: []crdv1beta1.Rule{
+						{
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector:     &selectorC,
+									PodNodeSelector: &zoneASelector,
+									Namespaces: &crdv1beta1.PeerNamespaces{
+										Match: crdv1beta1.NamespaceMatchSelf,
+									},
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidZone",
+				Name: "uidZone",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AntreaClusterNetworkPolicy,
+					Name: "cnpZone",
+					UID:  "uidZone",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction:       controlplane.DirectionOut,
+						AppliedToGroups: []string{getNormalizedUID(zoneAGroupSelector("nsA", &selectorB).NormalizedName)},
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(zoneAGroupSelector("nsA", &selectorC).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{
+					getNormalizedUID(zoneAGroupSelector("nsA", &selectorB).NormalizedName),
+				},
+				AppliedToPerRule: true,
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 		{
 			name: "with-per-namespace-rule-applied-to-per-rule",
 			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1250,6 +1316,60 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   1,
 		},
+		{
+			name: "service-account-in-all-namespaces",
+			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cnpP2", UID: "uidP2"},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{
+								Name:      saA.Name,
+								Namespace: "*",
+							},
+						},
+					},
+					Priority: p10,
+					Ingress: []crdv1beta1.Rule{
+						{
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									ServiceAccount: &crdv1beta1.NamespacedName{
+										Name:      "saB",
+										Namespace: "*",
+									},
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidP2",
+				Name: "uidP2",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AntreaClusterNetworkPolicy,
+					Name: "cnpP2",
+					UID:  "uidP2",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", grouping.ServiceAccountPodSelector("saB"), &metav1.LabelSelector{}, nil, nil).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorD, &metav1.LabelSelector{}, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 		{
 			name: "rule-applied-to-with-service-account-namespaced-name",
 			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1950,10 +2070,13 @@ func TestDeleteACNP(t *testing.T) {
 
 func TestGetTierPriority(t *testing.T) {
 	p10 := int32(10)
+	p11 := int32(11)
 	tests := []struct {
-		name      string
-		inputTier *crdv1beta1.Tier
-		expPrio   int32
+		name                string
+		inputTier           *crdv1beta1.Tier
+		inputNamespacedTier *crdv1beta1.NamespacedTier
+		namespace           string
+		expPrio             int32
 	}{
 		{
 			name:      "empty-tier-name",
@@ -1971,6 +2094,32 @@ func TestGetTierPriority(t *testing.T) {
 			},
 			expPrio: p10,
 		},
+		{
+			name: "namespaced-tier11",
+			inputTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{Name: "tA", UID: "uidA"},
+				Spec:       crdv1beta1.TierSpec{Priority: p10},
+			},
+			inputNamespacedTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tA", UID: "uidB"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: p11},
+			},
+			namespace: "ns1",
+			expPrio:   p11,
+		},
+		{
+			name: "namespaced-tier-in-other-namespace",
+			inputTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{Name: "tA", UID: "uidA"},
+				Spec:       crdv1beta1.TierSpec{Priority: p10},
+			},
+			inputNamespacedTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tA", UID: "uidB"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: p11},
+			},
+			namespace: "ns2",
+			expPrio:   p10,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1980,12 +2129,133 @@ func TestGetTierPriority(t *testing.T) {
 				npc.tierStore.Add(tt.inputTier)
 				name = tt.inputTier.Name
 			}
-			actualPrio := npc.getTierPriority(name)
+			if tt.inputNamespacedTier != nil {
+				npc.namespacedTierStore.Add(tt.inputNamespacedTier)
+			}
+			actualPrio := npc.getTierPriority(name, tt.namespace)
 			assert.Equal(t, tt.expPrio, actualPrio, "tier priorities do not match")
 		})
 	}
 }
 
+func TestGetEnforcementMode(t *testing.T) {
+	auditTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit-tier"},
+		Spec:       crdv1beta1.TierSpec{Priority: 10, EnforcementMode: crdv1beta1.EnforcementModeAudit},
+	}
+	enforceTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "enforce-tier"},
+		Spec:       crdv1beta1.TierSpec{Priority: 20},
+	}
+	auditDefaultTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultTierName},
+		Spec:       crdv1beta1.TierSpec{Priority: crdv1beta1.DefaultTierPriority, EnforcementMode: crdv1beta1.EnforcementModeAudit},
+	}
+	namespacedTier := &crdv1beta1.NamespacedTier{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "audit-tier"},
+		Spec:       crdv1beta1.NamespacedTierSpec{Priority: 11},
+	}
+	delegation := &crdv1beta1.TierDelegation{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+		MaxPriority:       20,
+	}
+	auditDelegatingTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit-delegating-tier"},
+		Spec:       crdv1beta1.TierSpec{Priority: 10, EnforcementMode: crdv1beta1.EnforcementModeAudit, Delegation: delegation},
+	}
+	enforceDelegatingTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "enforce-delegating-tier"},
+		Spec:       crdv1beta1.TierSpec{Priority: 10, Delegation: delegation},
+	}
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"tenant": "a"}}}
+	tests := []struct {
+		name         string
+		inputTiers   []*crdv1beta1.Tier
+		inputMode    crdv1beta1.EnforcementMode
+		inputTier    string
+		namespace    string
+		expectedMode crdv1beta1.EnforcementMode
+	}{
+		{
+			name:         "enforced policy in enforced tier",
+			inputTiers:   []*crdv1beta1.Tier{enforceTier},
+			inputTier:    "enforce-tier",
+			expectedMode: "",
+		},
+		{
+			name:         "audited policy in enforced tier",
+			inputTiers:   []*crdv1beta1.Tier{enforceTier},
+			inputMode:    crdv1beta1.EnforcementModeAudit,
+			inputTier:    "enforce-tier",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+		{
+			name:         "enforced policy in audited tier",
+			inputTiers:   []*crdv1beta1.Tier{auditTier},
+			inputMode:    crdv1beta1.EnforcementModeEnforce,
+			inputTier:    "audit-tier",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+		{
+			name:         "policy in audited default tier",
+			inputTiers:   []*crdv1beta1.Tier{auditDefaultTier},
+			inputTier:    "",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+		{
+			name:         "policy in audited static tier",
+			inputTiers:   []*crdv1beta1.Tier{auditDefaultTier},
+			inputTier:    "Application",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+		{
+			name:         "policy in missing tier",
+			inputTier:    "missing-tier",
+			expectedMode: "",
+		},
+		{
+			name:         "enforced policy in namespaced tier",
+			inputTiers:   []*crdv1beta1.Tier{auditTier},
+			inputTier:    "audit-tier",
+			namespace:    "ns1",
+			expectedMode: "",
+		},
+		{
+			name:         "audited policy in namespaced tier",
+			inputTiers:   []*crdv1beta1.Tier{auditTier},
+			inputMode:    crdv1beta1.EnforcementModeAudit,
+			inputTier:    "audit-tier",
+			namespace:    "ns1",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+		{
+			name:         "enforced policy in namespaced tier delegated by enforced tier",
+			inputTiers:   []*crdv1beta1.Tier{auditTier, enforceDelegatingTier},
+			inputTier:    "audit-tier",
+			namespace:    "ns1",
+			expectedMode: "",
+		},
+		{
+			name:         "enforced policy in namespaced tier delegated by audited tier",
+			inputTiers:   []*crdv1beta1.Tier{enforceTier, auditDelegatingTier},
+			inputTier:    "audit-tier",
+			namespace:    "ns1",
+			expectedMode: crdv1beta1.EnforcementModeAudit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, npc := newController(nil, nil)
+			for _, tier := range tt.inputTiers {
+				npc.tierStore.Add(tier)
+			}
+			npc.namespaceStore.Add(namespace)
+			npc.namespacedTierStore.Add(namespacedTier)
+			assert.Equal(t, tt.expectedMode, npc.getEnforcementMode(tt.inputMode, tt.inputTier, tt.namespace))
+		})
+	}
+}
+
 func TestProcessRefGroupOrClusterGroup(t *testing.T) {
 	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
 	cidr := "10.0.0.0/24"
@@ -0,0 +1,233 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// maxConnectivityMatrixPods is the maximum number of Pods of a connectivity matrix, as the number of
+// cells grows quadratically with it.
+const maxConnectivityMatrixPods = 500
+
+// ConnectivityMatrixQuerier handles requests for computing the effective NetworkPolicy rules between
+// all pairs of Pods of a set.
+type ConnectivityMatrixQuerier interface {
+	QueryConnectivityMatrix(request *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error)
+}
+
+// connectivityMatrixQuerier implements the ConnectivityMatrixQuerier interface.
+type connectivityMatrixQuerier struct {
+	networkPolicyController *NetworkPolicyController
+	endpointQuerier         EndpointQuerier
+}
+
+// NewConnectivityMatrixQuerier returns a new *connectivityMatrixQuerier.
+func NewConnectivityMatrixQuerier(networkPolicyController *NetworkPolicyController, endpointQuerier EndpointQuerier) *connectivityMatrixQuerier {
+	return &connectivityMatrixQuerier{
+		networkPolicyController: networkPolicyController,
+		endpointQuerier:         endpointQuerier,
+	}
+}
+
+// selectPods returns the Pods selected by a connectivity matrix request, sorted by Namespace and
+// name.
+func (q *connectivityMatrixQuerier) selectPods(request *controlplane.ConnectivityMatrixRequest) ([]*controlplane.GroupMember, error) {
+	n := q.networkPolicyController
+	var members controlplane.GroupMemberSet
+	if request.ClusterGroup != "" {
+		var err error
+		members, _, err = n.GetGroupMembers(request.ClusterGroup)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		podSelector, err := metav1.ParseToLabelSelector(request.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", request.LabelSelector, err)
+		}
+		// The selector is registered with the grouping interface for the duration of the query,
+		// with a unique name as concurrent queries may use the same selector.
+		name := uuid.New().String()
+		n.groupingInterface.AddGroup(evaluationGroupType, name, antreatypes.NewGroupSelector(request.Namespace, podSelector, nil, nil, nil))
+		members = n.getMemberSetForGroupType(evaluationGroupType, name)
+		n.groupingInterface.DeleteGroup(evaluationGroupType, name)
+	}
+	var pods []*controlplane.GroupMember
+	for _, member := range members.Items() {
+		if member.Pod != nil {
+			pods = append(pods, member)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Pod.Namespace != pods[j].Pod.Namespace {
+			return pods[i].Pod.Namespace < pods[j].Pod.Namespace
+		}
+		return pods[i].Pod.Name < pods[j].Pod.Name
+	})
+	return pods, nil
+}
+
+// QueryConnectivityMatrix returns the verdict and the effective rule for the traffic between each
+// pair of different Pods selected by the request, for each requested destination port if any.
+func (q *connectivityMatrixQuerier) QueryConnectivityMatrix(request *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error) {
+	pods, err := q.selectPods(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) > maxConnectivityMatrixPods {
+		return nil, fmt.Errorf("the request selects %d Pods, which is more than the maximum of %d", len(pods), maxConnectivityMatrixPods)
+	}
+	response := &controlplane.ConnectivityMatrixResponse{}
+	// The rules of each Pod are only queried once, and shared by all the cells of the Pod.
+	endpointRules := make([]*antreatypes.EndpointNetworkPolicyRules, len(pods))
+	for i, pod := range pods {
+		response.Pods = append(response.Pods, *pod.Pod)
+		endpointRules[i], err = q.endpointQuerier.QueryNetworkPolicyRules(pod.Pod.Namespace, pod.Pod.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, src := range pods {
+		for j, dst := range pods {
+			if i == j {
+				continue
+			}
+			if len(request.Ports) == 0 {
+				rule := predictEndpointsRules(endpointRules[i], endpointRules[j])
+				response.Cells = append(response.Cells, newConnectivityMatrixCell(src.Pod, dst.Pod, nil, rule))
+				continue
+			}
+			for k := range request.Ports {
+				port := &request.Ports[k]
+				rule := predictEndpointsRules(rulesForPort(endpointRules[i], port, dst.Ports), rulesForPort(endpointRules[j], port, dst.Ports))
+				response.Cells = append(response.Cells, newConnectivityMatrixCell(src.Pod, dst.Pod, port, rule))
+			}
+		}
+	}
+	return response, nil
+}
+
+func newConnectivityMatrixCell(src, dst *controlplane.PodReference, port *controlplane.ConnectivityMatrixPort, rule *antreatypes.RuleInfo) controlplane.ConnectivityMatrixCell {
+	return controlplane.ConnectivityMatrixCell{
+		Source:      *src,
+		Destination: *dst,
+		Port:        port,
+		Verdict:     ruleVerdict(rule),
+		Rule:        newNetworkPolicyEvaluationResponse(rule),
+	}
+}
+
+// ruleVerdict returns the action applied to traffic by an effective rule.
+func ruleVerdict(rule *antreatypes.RuleInfo) crdv1beta1.RuleAction {
+	switch {
+	case rule == nil:
+		return crdv1beta1.RuleActionAllow
+	case rule.Rule.Action == nil:
+		// The default isolation rules of K8s NetworkPolicies have no action.
+		return crdv1beta1.RuleActionDrop
+	case *rule.Rule.Action == crdv1beta1.RuleActionPass:
+		// A Pass rule is only effective when no K8s NetworkPolicy or Baseline rule follows it.
+		return crdv1beta1.RuleActionAllow
+	}
+	return *rule.Rule.Action
+}
+
+// rulesForPort returns the rules of an endpoint which apply to the traffic to a destination port.
+// The applied policies are kept, as the default isolation of K8s NetworkPolicies applies to all
+// ports.
+func rulesForPort(endpointRules *antreatypes.EndpointNetworkPolicyRules, port *controlplane.ConnectivityMatrixPort, dstPorts []controlplane.NamedPort) *antreatypes.EndpointNetworkPolicyRules {
+	if endpointRules == nil {
+		return nil
+	}
+	filter := func(rules []*antreatypes.RuleInfo) []*antreatypes.RuleInfo {
+		var filtered []*antreatypes.RuleInfo
+		for _, rule := range rules {
+			if servicesMatchPort(ruleServices(rule), port, dstPorts) {
+				filtered = append(filtered, rule)
+			}
+		}
+		return filtered
+	}
+	return &antreatypes.EndpointNetworkPolicyRules{
+		Namespace:                 endpointRules.Namespace,
+		Name:                      endpointRules.Name,
+		AppliedPolicies:           endpointRules.AppliedPolicies,
+		EndpointAsIngressSrcRules: filter(endpointRules.EndpointAsIngressSrcRules),
+		EndpointAsEgressDstRules:  filter(endpointRules.EndpointAsEgressDstRules),
+	}
+}
+
+// ruleServices returns the Services of the rule a RuleInfo refers to, the index of which is its
+// index among the rules of the policy in the same direction.
+func ruleServices(ruleInfo *antreatypes.RuleInfo) []controlplane.Service {
+	index := int32(0)
+	for i := range ruleInfo.Policy.Rules {
+		rule := &ruleInfo.Policy.Rules[i]
+		if rule.Direction != ruleInfo.Rule.Direction {
+			continue
+		}
+		if index == ruleInfo.Index {
+			return rule.Services
+		}
+		index++
+	}
+	return nil
+}
+
+// servicesMatchPort returns whether the Services of a rule match a destination port. Named ports
+// are resolved with the ports of the destination Pod.
+func servicesMatchPort(services []controlplane.Service, port *controlplane.ConnectivityMatrixPort, dstPorts []controlplane.NamedPort) bool {
+	if len(services) == 0 {
+		return true
+	}
+	for _, service := range services {
+		protocol := controlplane.ProtocolTCP
+		if service.Protocol != nil {
+			protocol = *service.Protocol
+		}
+		if protocol != port.Protocol {
+			continue
+		}
+		if service.Port == nil {
+			return true
+		}
+		if service.Port.Type == intstr.String {
+			for _, namedPort := range dstPorts {
+				if namedPort.Name == service.Port.StrVal && namedPort.Protocol == protocol && namedPort.Port == port.Port {
+					return true
+				}
+			}
+			continue
+		}
+		endPort := service.Port.IntVal
+		if service.EndPort != nil {
+			endPort = *service.EndPort
+		}
+		if port.Port >= service.Port.IntVal && port.Port <= endPort {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// podsWithIPs returns copies of the Pods with PodIPs set, as Pods without PodIPs are not
+// group members.
+func podsWithIPs(pods ...*corev1.Pod) []runtime.Object {
+	var objs []runtime.Object
+	for _, pod := range pods {
+		pod = pod.DeepCopy()
+		pod.Status.PodIPs = []corev1.PodIP{{IP: pod.Status.PodIP}}
+		objs = append(objs, pod)
+	}
+	return objs
+}
+
+func TestServicesMatchPort(t *testing.T) {
+	protocolUDP := controlplane.ProtocolUDP
+	port80 := &controlplane.ConnectivityMatrixPort{Protocol: controlplane.ProtocolTCP, Port: 80}
+	dstPorts := []controlplane.NamedPort{{Name: "http", Protocol: controlplane.ProtocolTCP, Port: 80}}
+	tests := []struct {
+		name     string
+		services []controlplane.Service
+		port     *controlplane.ConnectivityMatrixPort
+		expected bool
+	}{
+		{
+			name:     "no services",
+			port:     port80,
+			expected: true,
+		},
+		{
+			name:     "default protocol",
+			services: []controlplane.Service{{Port: &int80}},
+			port:     port80,
+			expected: true,
+		},
+		{
+			name:     "protocol only",
+			services: []controlplane.Service{{Protocol: &protocolTCP}},
+			port:     port80,
+			expected: true,
+		},
+		{
+			name:     "different protocol",
+			services: []controlplane.Service{{Protocol: &protocolUDP, Port: &int80}},
+			port:     port80,
+			expected: false,
+		},
+		{
+			name:     "different port",
+			services: []controlplane.Service{{Protocol: &protocolTCP, Port: &int81}},
+			port:     port80,
+			expected: false,
+		},
+		{
+			name:     "port range",
+			services: []controlplane.Service{{Protocol: &protocolTCP, Port: &int81}, {Protocol: &protocolTCP, Port: &int80, EndPort: &int32For1999}},
+			port:     &controlplane.ConnectivityMatrixPort{Protocol: controlplane.ProtocolTCP, Port: 1000},
+			expected: true,
+		},
+		{
+			name:     "named port",
+			services: []controlplane.Service{{Protocol: &protocolTCP, Port: &strHTTP}},
+			port:     port80,
+			expected: true,
+		},
+		{
+			name:     "named port of another port",
+			services: []controlplane.Service{{Protocol: &protocolTCP, Port: &strHTTP}},
+			port:     &controlplane.ConnectivityMatrixPort{Protocol: controlplane.ProtocolTCP, Port: 8080},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, servicesMatchPort(tt.services, tt.port, dstPorts))
+		})
+	}
+}
+
+func TestRuleVerdict(t *testing.T) {
+	passAction := crdv1beta1.RuleActionPass
+	rejectAction := crdv1beta1.RuleActionReject
+	assert.Equal(t, crdv1beta1.RuleActionAllow, ruleVerdict(nil))
+	assert.Equal(t, crdv1beta1.RuleActionDrop, ruleVerdict(&antreatypes.RuleInfo{Rule: &controlplane.NetworkPolicyRule{}}))
+	assert.Equal(t, crdv1beta1.RuleActionAllow, ruleVerdict(&antreatypes.RuleInfo{Rule: &controlplane.NetworkPolicyRule{Action: &passAction}}))
+	assert.Equal(t, crdv1beta1.RuleActionReject, ruleVerdict(&antreatypes.RuleInfo{Rule: &controlplane.NetworkPolicyRule{Action: &rejectAction}}))
+}
+
+func TestQueryConnectivityMatrix(t *testing.T) {
+	// podB rejects ingress TCP traffic to port 80 from podA.
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp-reject", UID: "uid-acnp"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Priority:  1,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}},
+			Ingress: []crdv1beta1.Rule{{
+				Name:   "reject-80",
+				Action: func() *crdv1beta1.RuleAction { a := crdv1beta1.RuleActionReject; return &a }(),
+				From: []crdv1beta1.NetworkPolicyPeer{{
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					NamespaceSelector: &metav1.LabelSelector{},
+				}},
+				Ports: []crdv1beta1.NetworkPolicyPort{{Protocol: &k8sProtocolTCP, Port: &int80}},
+			}},
+		},
+	}
+	eq := makeControllerAndEndpointQuerierWithCRDs(append([]runtime.Object{namespaces[0]}, podsWithIPs(pods[0], pods[1])...), []runtime.Object{acnp})
+	querier := NewConnectivityMatrixQuerier(eq.networkPolicyController, eq)
+	podA := controlplane.PodReference{Namespace: "testNamespace", Name: "podA"}
+	podB := controlplane.PodReference{Namespace: "testNamespace", Name: "podB"}
+	acnpRef := controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: acnp.Name, UID: acnp.UID}
+	rejectResponse := func() *controlplane.NetworkPolicyEvaluationResponse {
+		action := crdv1beta1.RuleActionReject
+		return &controlplane.NetworkPolicyEvaluationResponse{
+			NetworkPolicy: acnpRef,
+			RuleIndex:     0,
+			Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "reject-80", Action: &action},
+		}
+	}
+	port80 := controlplane.ConnectivityMatrixPort{Protocol: controlplane.ProtocolTCP, Port: 80}
+	port443 := controlplane.ConnectivityMatrixPort{Protocol: controlplane.ProtocolTCP, Port: 443}
+
+	testCases := []struct {
+		name             string
+		request          *controlplane.ConnectivityMatrixRequest
+		expectedResponse *controlplane.ConnectivityMatrixResponse
+		expectedErr      string
+	}{
+		{
+			name:    "Namespace without ports",
+			request: &controlplane.ConnectivityMatrixRequest{Namespace: "testNamespace"},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{
+				Pods: []controlplane.PodReference{podA, podB},
+				Cells: []controlplane.ConnectivityMatrixCell{
+					{Source: podA, Destination: podB, Verdict: crdv1beta1.RuleActionReject, Rule: rejectResponse()},
+					{Source: podB, Destination: podA, Verdict: crdv1beta1.RuleActionReject, Rule: rejectResponse()},
+				},
+			},
+		},
+		{
+			name:    "Label selector with ports",
+			request: &controlplane.ConnectivityMatrixRequest{LabelSelector: "foo=bar", Ports: []controlplane.ConnectivityMatrixPort{port80, port443}},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{
+				Pods: []controlplane.PodReference{podA, podB},
+				Cells: []controlplane.ConnectivityMatrixCell{
+					{Source: podA, Destination: podB, Port: &port80, Verdict: crdv1beta1.RuleActionReject, Rule: rejectResponse()},
+					{Source: podA, Destination: podB, Port: &port443, Verdict: crdv1beta1.RuleActionAllow},
+					{Source: podB, Destination: podA, Port: &port80, Verdict: crdv1beta1.RuleActionReject, Rule: rejectResponse()},
+					{Source: podB, Destination: podA, Port: &port443, Verdict: crdv1beta1.RuleActionAllow},
+				},
+			},
+		},
+		{
+			name:             "No selected Pods",
+			request:          &controlplane.ConnectivityMatrixRequest{Namespace: "testNamespace", LabelSelector: "foo=baz"},
+			expectedResponse: &controlplane.ConnectivityMatrixResponse{},
+		},
+		{
+			name:        "Invalid label selector",
+			request:     &controlplane.ConnectivityMatrixRequest{LabelSelector: "foo in"},
+			expectedErr: "invalid label selector",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := querier.QueryConnectivityMatrix(tt.request)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, response)
+		})
+	}
+}
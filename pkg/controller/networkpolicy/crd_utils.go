@@ -18,6 +18,7 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/types"
@@ -44,12 +45,17 @@ var (
 // NetworkPolicyCondition but excludes LastTransitionTime. They are used when
 // comparing NetworkPolicyCondition in NetworkPolicyStatus objects to avoid
 // unnecessary updates caused different status generation time.
+// Other timestamps are compared regardless of their location, as the ones read
+// from kube-apiserver are in the local time zone.
 var semanticIgnoreLastTransitionTime = conversion.EqualitiesOrDie(
 	func(a, b crdv1beta1.NetworkPolicyCondition) bool {
 		a.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
 		b.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
 		return a == b
 	},
+	func(a, b metav1.Time) bool {
+		return a.Equal(&b)
+	},
 )
 
 // NetworkPolicyStatusEqual compares two NetworkPolicyStatus objects. It disregards
@@ -94,9 +100,12 @@ func toAntreaServicesForCRD(npPorts []crdv1beta1.NetworkPolicyPort, npProtocols
 		if npProtocol.ICMP != nil {
 			curProtocol := controlplane.ProtocolICMP
 			antreaServices = append(antreaServices, controlplane.Service{
-				Protocol: &curProtocol,
-				ICMPType: npProtocol.ICMP.ICMPType,
-				ICMPCode: npProtocol.ICMP.ICMPCode,
+				Protocol:     &curProtocol,
+				ICMPType:     npProtocol.ICMP.ICMPType,
+				ICMPCode:     npProtocol.ICMP.ICMPCode,
+				EndICMPType:  npProtocol.ICMP.EndICMPType,
+				EndICMPCode:  npProtocol.ICMP.EndICMPCode,
+				ICMPTypeName: npProtocol.ICMP.ICMPTypeName,
 			})
 		}
 		if npProtocol.IGMP != nil {
@@ -107,6 +116,13 @@ func toAntreaServicesForCRD(npPorts []crdv1beta1.NetworkPolicyPort, npProtocols
 				GroupAddress: npProtocol.IGMP.GroupAddress,
 			})
 		}
+		if npProtocol.IPv6ExtensionHeader != nil {
+			curProtocol := controlplane.ProtocolIPv6ExtensionHeader
+			antreaServices = append(antreaServices, controlplane.Service{
+				Protocol:            &curProtocol,
+				IPv6ExtensionHeader: npProtocol.IPv6ExtensionHeader.Type,
+			})
+		}
 	}
 	return antreaServices, namedPortExists
 }
@@ -117,13 +133,121 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
+			HTTP: toAntreaHTTPProtocolForCRD(l7p.HTTP),
 			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			GRPC: toAntreaGRPCProtocolForCRD(l7p.GRPC),
+			DNS:  (*controlplane.DNSProtocol)(l7p.DNS),
 		})
 	}
 	return antreaL7Protocols
 }
 
+// toAntreaStringMatchType returns the match type of a header or query
+// parameter, which defaults to Exact.
+func toAntreaStringMatchType(matchType crdv1beta1.StringMatchType) crdv1beta1.StringMatchType {
+	if matchType == "" {
+		return crdv1beta1.StringMatchExact
+	}
+	return matchType
+}
+
+// toAntreaHTTPHeadersForCRD converts a slice of v1beta1.HTTPHeaderMatch
+// objects to a slice of Antrea HTTPHeaderMatch objects.
+func toAntreaHTTPHeadersForCRD(headers []crdv1beta1.HTTPHeaderMatch) []controlplane.HTTPHeaderMatch {
+	var antreaHeaders []controlplane.HTTPHeaderMatch
+	for _, header := range headers {
+		antreaHeaders = append(antreaHeaders, controlplane.HTTPHeaderMatch{
+			Name:  header.Name,
+			Type:  toAntreaStringMatchType(header.Type),
+			Value: header.Value,
+		})
+	}
+	return antreaHeaders
+}
+
+// toAntreaHTTPProtocolForCRD converts a v1beta1.HTTPProtocol object to an
+// Antrea HTTPProtocol object.
+func toAntreaHTTPProtocolForCRD(http *crdv1beta1.HTTPProtocol) *controlplane.HTTPProtocol {
+	if http == nil {
+		return nil
+	}
+	antreaHTTP := &controlplane.HTTPProtocol{
+		Host:    http.Host,
+		Method:  http.Method,
+		Path:    http.Path,
+		Headers: toAntreaHTTPHeadersForCRD(http.Headers),
+	}
+	for _, param := range http.QueryParams {
+		antreaHTTP.QueryParams = append(antreaHTTP.QueryParams, controlplane.HTTPQueryParamMatch{
+			Name:  param.Name,
+			Type:  toAntreaStringMatchType(param.Type),
+			Value: param.Value,
+		})
+	}
+	return antreaHTTP
+}
+
+// toAntreaGRPCProtocolForCRD converts a v1beta1.GRPCProtocol object to an
+// Antrea GRPCProtocol object.
+func toAntreaGRPCProtocolForCRD(grpc *crdv1beta1.GRPCProtocol) *controlplane.GRPCProtocol {
+	if grpc == nil {
+		return nil
+	}
+	return &controlplane.GRPCProtocol{
+		Service: grpc.Service,
+		Method:  grpc.Method,
+		Headers: toAntreaHTTPHeadersForCRD(grpc.Headers),
+	}
+}
+
+// toAntreaRateLimitForCRD converts a v1beta1.RateLimit object to an Antrea
+// RateLimit object. Bandwidths are converted to kilobits per second, and the
+// burst of a packet rate defaults to its rate.
+func toAntreaRateLimitForCRD(rateLimit *crdv1beta1.RateLimit) *controlplane.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	if rateLimit.PacketRate != nil {
+		burst := rateLimit.PacketRate.Burst
+		if burst == 0 {
+			burst = rateLimit.PacketRate.Rate
+		}
+		return &controlplane.RateLimit{
+			Unit:  controlplane.RateLimitUnitPacketsPerSecond,
+			Rate:  uint32(rateLimit.PacketRate.Rate),
+			Burst: uint32(burst),
+		}
+	}
+	if rateLimit.Bandwidth != nil {
+		// The quantities have been validated by the webhook.
+		rate, err := resource.ParseQuantity(rateLimit.Bandwidth.Rate)
+		if err != nil {
+			klog.ErrorS(err, "Invalid bandwidth rate configured for rule", "rate", rateLimit.Bandwidth.Rate)
+			return nil
+		}
+		burst, err := resource.ParseQuantity(rateLimit.Bandwidth.Burst)
+		if err != nil {
+			klog.ErrorS(err, "Invalid bandwidth burst size configured for rule", "burst", rateLimit.Bandwidth.Burst)
+			return nil
+		}
+		return &controlplane.RateLimit{
+			Unit:  controlplane.RateLimitUnitKbitsPerSecond,
+			Rate:  uint32(rate.Value() / 1000),
+			Burst: uint32(burst.Value() / 1000),
+		}
+	}
+	return nil
+}
+
+// toAntreaPacketCaptureForCRD converts a v1beta1.RulePacketCapture object to an
+// Antrea RulePacketCapture object.
+func toAntreaPacketCaptureForCRD(packetCapture *crdv1beta1.RulePacketCapture) *controlplane.RulePacketCapture {
+	if packetCapture == nil {
+		return nil
+	}
+	return &controlplane.RulePacketCapture{MaxPackets: packetCapture.MaxPackets}
+}
+
 // toAntreaIPBlockForCRD converts a crdv1beta1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *crdv1beta1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
@@ -172,7 +296,7 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 	clusterSetScopeSelectorKeys := sets.New[string]()
 	for _, peer := range peers {
 		// A crdv1beta1.NetworkPolicyPeer will have exactly one of the following fields set:
-		// - podSelector and/or namespaceSelector (in-cluster scope or ClusterSet scope)
+		// - podSelector and/or namespaceSelector (in-cluster scope or ClusterSet scope), optionally with podNodeSelector
 		// - reference to a Group/ClusterGroup
 		// - IPBlocks
 		// - FQDNs
@@ -192,13 +316,14 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 		} else if peer.FQDN != "" {
 			fqdns = append(fqdns, peer.FQDN)
 		} else if peer.ServiceAccount != nil {
-			addressGroup := n.createAddressGroup(peer.ServiceAccount.Namespace, serviceAccountNameToPodSelector(peer.ServiceAccount.Name), nil, nil, nil)
+			namespace, podSelector, nsSelector := serviceAccountSelectors(peer.ServiceAccount, np.GetNamespace())
+			addressGroup := n.createAddressGroup(namespace, podSelector, nsSelector, nil, nil)
 			addressGroups = append(addressGroups, addressGroup)
 		} else if peer.NodeSelector != nil {
 			addressGroup := n.createAddressGroup("", nil, nil, nil, peer.NodeSelector)
 			addressGroups = append(addressGroups, addressGroup)
 		} else {
-			addressGroup := n.createAddressGroup(np.GetNamespace(), peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, nil)
+			addressGroup := n.createAddressGroupWithPodNodeSelector(np.GetNamespace(), peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, peer.PodNodeSelector)
 			addressGroups = append(addressGroups, addressGroup)
 		}
 		if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet {
@@ -233,7 +358,7 @@ func (n *NetworkPolicyController) toNamespacedPeerForCRD(peers []crdv1beta1.Netw
 	uniqueLabelIDs := map[uint32]struct{}{}
 	clusterSetScopeSelectorKeys := sets.New[string]()
 	for _, peer := range peers {
-		addressGroup := n.createAddressGroup(namespace, peer.PodSelector, nil, peer.ExternalEntitySelector, nil)
+		addressGroup := n.createAddressGroupWithPodNodeSelector(namespace, peer.PodSelector, nil, peer.ExternalEntitySelector, peer.PodNodeSelector)
 		addressGroups = append(addressGroups, addressGroup)
 		if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet {
 			newClusterSetScopeSelector := antreatypes.NewGroupSelector(namespace, peer.PodSelector, nil, peer.ExternalEntitySelector, nil)
@@ -327,11 +452,16 @@ func (n *NetworkPolicyController) createAppliedToGroupForGroup(namespace, group
 
 // getTierPriority retrieves the priority associated with the input Tier name.
 // If the Tier name is empty, by default, the lowest priority Application Tier
-// is returned.
-func (n *NetworkPolicyController) getTierPriority(tier string) int32 {
+// is returned. namespace is the Namespace of the policy referencing the Tier,
+// in which a NamespacedTier with the name takes precedence over the Tier. It
+// is empty for cluster-scoped policies.
+func (n *NetworkPolicyController) getTierPriority(tier, namespace string) int32 {
 	if tier == "" {
 		return crdv1beta1.DefaultTierPriority
 	}
+	if nt := n.getNamespacedTier(namespace, tier); nt != nil {
+		return nt.Spec.Priority
+	}
 	// If the tier name is part of the static tier name set, we need to convert
 	// tier name to lowercase to match the corresponding Tier CRD name. This is
 	// possible in case of upgrade where in a previously created Antrea Policy
@@ -350,6 +480,41 @@ func (n *NetworkPolicyController) getTierPriority(tier string) int32 {
 	return t.Spec.Priority
 }
 
+// getEnforcementMode returns the EnforcementMode of an Antrea-native policy
+// with the input EnforcementMode and Tier name. The policy is in Audit mode if
+// either itself or its Tier is in Audit mode. An empty EnforcementMode is
+// returned otherwise, which means the policy is enforced. NamespacedTiers
+// don't have an EnforcementMode, the policies referencing them are in Audit
+// mode if the Tier delegating the priority band of the NamespacedTier is.
+func (n *NetworkPolicyController) getEnforcementMode(mode crdv1beta1.EnforcementMode, tier, namespace string) crdv1beta1.EnforcementMode {
+	if mode == crdv1beta1.EnforcementModeAudit {
+		return crdv1beta1.EnforcementModeAudit
+	}
+	if tier != "" {
+		if nt := n.getNamespacedTier(namespace, tier); nt != nil {
+			if t := n.getDelegatingTier(nt); t != nil && t.Spec.EnforcementMode == crdv1beta1.EnforcementModeAudit {
+				return crdv1beta1.EnforcementModeAudit
+			}
+			return ""
+		}
+	}
+	if tier == "" {
+		tier = defaultTierName
+	} else if staticTierSet.Has(tier) {
+		tier = strings.ToLower(tier)
+	}
+	t, err := n.tierLister.Get(tier)
+	if err != nil {
+		// This error should ideally not occur as we perform validation, the
+		// policy is enforced in this case.
+		return ""
+	}
+	if t.Spec.EnforcementMode == crdv1beta1.EnforcementModeAudit {
+		return crdv1beta1.EnforcementModeAudit
+	}
+	return ""
+}
+
 // getNormalizedNameForSelector retrieves the normalized name for GroupSelector.
 // If the GroupSelector is nil, an empty string is returned.
 func getNormalizedNameForSelector(sel *antreatypes.GroupSelector) string {
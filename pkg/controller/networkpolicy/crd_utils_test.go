@@ -197,6 +197,38 @@ func TestToAntreaServicesForCRD(t *testing.T) {
 			},
 			expNamedPortExists: false,
 		},
+		{
+			protocols: []crdv1beta1.NetworkPolicyProtocol{
+				{
+					ICMP: &crdv1beta1.ICMPProtocol{
+						ICMPTypeName: crdv1beta1.ICMPTypeRedirect,
+						ICMPCode:     &icmpCode0,
+						EndICMPCode:  &icmpCode3,
+					},
+				},
+			},
+			expServices: []controlplane.Service{
+				{
+					Protocol:     &protocolICMP,
+					ICMPTypeName: crdv1beta1.ICMPTypeRedirect,
+					ICMPCode:     &icmpCode0,
+					EndICMPCode:  &icmpCode3,
+				},
+			},
+		},
+		{
+			protocols: []crdv1beta1.NetworkPolicyProtocol{
+				{
+					IPv6ExtensionHeader: &crdv1beta1.IPv6ExtensionHeaderProtocol{Type: crdv1beta1.IPv6ExtensionHeaderESP},
+				},
+			},
+			expServices: []controlplane.Service{
+				{
+					Protocol:            &protocolIPv6ExtensionHeader,
+					IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderESP,
+				},
+			},
+		},
 	}
 	for _, table := range tables {
 		services, namedPortExist := toAntreaServicesForCRD(table.ports, table.protocols)
@@ -226,6 +258,56 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{TLS: &controlplane.TLSProtocol{SNI: "test.com"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{
+					Path: "/api",
+					Headers: []crdv1beta1.HTTPHeaderMatch{
+						{Name: "X-Tenant", Value: "tenant1"},
+						{Name: "User-Agent", Type: crdv1beta1.StringMatchPrefix, Value: "curl/"},
+					},
+					QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+						{Name: "version", Type: crdv1beta1.StringMatchRegex, Value: "v[12]"},
+					},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{
+					Path: "/api",
+					Headers: []controlplane.HTTPHeaderMatch{
+						{Name: "X-Tenant", Type: crdv1beta1.StringMatchExact, Value: "tenant1"},
+						{Name: "User-Agent", Type: crdv1beta1.StringMatchPrefix, Value: "curl/"},
+					},
+					QueryParams: []controlplane.HTTPQueryParamMatch{
+						{Name: "version", Type: crdv1beta1.StringMatchRegex, Value: "v[12]"},
+					},
+				}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{GRPC: &crdv1beta1.GRPCProtocol{
+					Service: "helloworld.Greeter",
+					Method:  "SayHello",
+					Headers: []crdv1beta1.HTTPHeaderMatch{{Name: "x-tenant", Value: "tenant1"}},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{GRPC: &controlplane.GRPCProtocol{
+					Service: "helloworld.Greeter",
+					Method:  "SayHello",
+					Headers: []controlplane.HTTPHeaderMatch{{Name: "x-tenant", Type: crdv1beta1.StringMatchExact, Value: "tenant1"}},
+				}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{DNS: &crdv1beta1.DNSProtocol{Name: "*.test.com", RecordTypes: []string{"A", "AAAA"}}},
+			},
+			[]controlplane.L7Protocol{
+				{DNS: &controlplane.DNSProtocol{Name: "*.test.com", RecordTypes: []string{"A", "AAAA"}}},
+			},
+		},
 	}
 	for _, table := range tables {
 		gotValue := toAntreaL7ProtocolsForCRD(table.l7Protocol)
@@ -233,6 +315,38 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaRateLimitForCRD(t *testing.T) {
+	tables := []struct {
+		rateLimit *crdv1beta1.RateLimit
+		expValue  *controlplane.RateLimit
+	}{
+		{
+			nil,
+			nil,
+		},
+		{
+			&crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100, Burst: 200}},
+			&controlplane.RateLimit{Unit: controlplane.RateLimitUnitPacketsPerSecond, Rate: 100, Burst: 200},
+		},
+		{
+			&crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}},
+			&controlplane.RateLimit{Unit: controlplane.RateLimitUnitPacketsPerSecond, Rate: 100, Burst: 100},
+		},
+		{
+			&crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"}},
+			&controlplane.RateLimit{Unit: controlplane.RateLimitUnitKbitsPerSecond, Rate: 10000, Burst: 20000},
+		},
+		{
+			&crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10Mbps", Burst: "20M"}},
+			nil,
+		},
+	}
+	for _, table := range tables {
+		gotValue := toAntreaRateLimitForCRD(table.rateLimit)
+		assert.Equal(t, table.expValue, gotValue)
+	}
+}
+
 func TestToAntreaIPBlockForCRD(t *testing.T) {
 	expIPNet := controlplane.IPNet{
 		IP:           ipStrToIPAddress("10.0.0.0"),
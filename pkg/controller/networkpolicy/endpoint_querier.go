@@ -21,6 +21,7 @@ import (
 	"errors"
 	"math"
 	"sort"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -36,11 +37,16 @@ type EndpointQuerier interface {
 	// QueryNetworkPolicyRules returns the list of NetworkPolicies which apply to the provided Pod,
 	// along with the list of NetworkPolicy ingress/egress rules which select the provided Pod.
 	QueryNetworkPolicyRules(namespace, podName string) (*antreatypes.EndpointNetworkPolicyRules, error)
+	// QueryProposedNetworkPolicyRules returns the same information as QueryNetworkPolicyRules for each
+	// provided Pod, as if the proposed policy manifests were applied.
+	QueryProposedNetworkPolicyRules(manifests []string, pods []controlplane.PodReference) ([]*antreatypes.EndpointNetworkPolicyRules, error)
 }
 
 // EndpointQuerierImpl implements the EndpointQuerier interface
 type EndpointQuerierImpl struct {
 	networkPolicyController *NetworkPolicyController
+	// evaluationMutex serializes the evaluations of proposed policies.
+	evaluationMutex sync.Mutex
 }
 
 // NewEndpointQuerier returns a new *EndpointQuerierImpl.
@@ -298,26 +304,43 @@ func (eq *policyRuleQuerier) QueryNetworkPolicyEvaluation(entities *controlplane
 	if entities.Source.Pod == nil || entities.Destination.Pod == nil || entities.Source.Pod.Name == "" || entities.Destination.Pod.Name == "" {
 		return nil, errors.New("invalid NetworkPolicyEvaluation request entities")
 	}
-	// query endpoints and handle response errors
-	endpointAnalysisSource, err := eq.endpointQuerier.QueryNetworkPolicyRules(entities.Source.Pod.Namespace, entities.Source.Pod.Name)
-	if err != nil {
-		return nil, err
-	}
-	endpointAnalysisDestination, err := eq.endpointQuerier.QueryNetworkPolicyRules(entities.Destination.Pod.Namespace, entities.Destination.Pod.Name)
-	if err != nil {
-		return nil, err
+	var endpointAnalysisSource, endpointAnalysisDestination *antreatypes.EndpointNetworkPolicyRules
+	if len(entities.ProposedPolicies) > 0 {
+		// evaluate the proposed policies as if they were applied
+		endpointAnalyses, err := eq.endpointQuerier.QueryProposedNetworkPolicyRules(entities.ProposedPolicies, []controlplane.PodReference{*entities.Source.Pod, *entities.Destination.Pod})
+		if err != nil {
+			return nil, err
+		}
+		endpointAnalysisSource, endpointAnalysisDestination = endpointAnalyses[0], endpointAnalyses[1]
+	} else {
+		// query endpoints and handle response errors
+		var err error
+		endpointAnalysisSource, err = eq.endpointQuerier.QueryNetworkPolicyRules(entities.Source.Pod.Namespace, entities.Source.Pod.Name)
+		if err != nil {
+			return nil, err
+		}
+		endpointAnalysisDestination, err = eq.endpointQuerier.QueryNetworkPolicyRules(entities.Destination.Pod.Namespace, entities.Destination.Pod.Name)
+		if err != nil {
+			return nil, err
+		}
 	}
 	endpointAnalysisRule := predictEndpointsRules(endpointAnalysisSource, endpointAnalysisDestination)
-	if endpointAnalysisRule == nil {
-		return nil, nil
+	return newNetworkPolicyEvaluationResponse(endpointAnalysisRule), nil
+}
+
+// newNetworkPolicyEvaluationResponse returns the reference of an effective rule, or nil if no rule
+// is effective.
+func newNetworkPolicyEvaluationResponse(rule *antreatypes.RuleInfo) *controlplane.NetworkPolicyEvaluationResponse {
+	if rule == nil {
+		return nil
 	}
 	return &controlplane.NetworkPolicyEvaluationResponse{
-		NetworkPolicy: *endpointAnalysisRule.Policy.SourceRef,
-		RuleIndex:     endpointAnalysisRule.Index,
+		NetworkPolicy: *rule.Policy.SourceRef,
+		RuleIndex:     rule.Index,
 		Rule: controlplane.RuleRef{
-			Direction: endpointAnalysisRule.Rule.Direction,
-			Name:      endpointAnalysisRule.Rule.Name,
-			Action:    endpointAnalysisRule.Rule.Action,
+			Direction: rule.Rule.Direction,
+			Name:      rule.Rule.Name,
+			Action:    rule.Rule.Action,
 		},
-	}, nil
+	}
 }
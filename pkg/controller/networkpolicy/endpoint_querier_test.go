@@ -189,8 +189,12 @@ var namespaces = []*corev1.Namespace{
 }
 
 func makeControllerAndEndpointQuerier(objects ...runtime.Object) *EndpointQuerierImpl {
+	return makeControllerAndEndpointQuerierWithCRDs(objects, nil)
+}
+
+func makeControllerAndEndpointQuerierWithCRDs(objects, crdObjects []runtime.Object) *EndpointQuerierImpl {
 	// create controller
-	_, c := newController(objects, nil)
+	_, c := newController(objects, crdObjects)
 	c.heartbeatCh = make(chan heartbeat, 1000)
 	stopCh := make(chan struct{})
 	// create querier with stores inside controller
@@ -598,3 +602,144 @@ func TestQueryNetworkPolicyEvaluation(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryNetworkPolicyEvaluationWithProposedPolicies(t *testing.T) {
+	tier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "tier1"},
+		Spec:       crdv1beta1.TierSpec{Priority: 252},
+	}
+	// The ACNP is enforced after K8s NetworkPolicies, as its Tier has a lower precedence.
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp-drop", UID: "uid-acnp"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Tier:      "tier1",
+			Priority:  1,
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}},
+			Ingress: []crdv1beta1.Rule{{
+				Name:   "drop-bar",
+				Action: &dropAction,
+				From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}},
+			}},
+		},
+	}
+	querier := makeControllerAndEndpointQuerierWithCRDs([]runtime.Object{namespaces[0], pods[0], pods[1], policies[0]}, []runtime.Object{tier, acnp})
+	policyRuleQuerier := NewPolicyRuleQuerier(querier)
+	ns := "testNamespace"
+	acnpRef := controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: acnp.Name, UID: acnp.UID}
+
+	testCases := []struct {
+		name             string
+		proposedPolicies []string
+		expectedPolicy   controlplane.NetworkPolicyReference
+		expectedResponse *controlplane.NetworkPolicyEvaluationResponse
+		expectedErr      string
+	}{
+		{
+			name:           "No proposed policies",
+			expectedPolicy: controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: ns, Name: policies[0].Name, UID: policies[0].UID},
+		},
+		{
+			name: "Proposed Tier",
+			proposedPolicies: []string{`
+apiVersion: crd.antrea.io/v1beta1
+kind: Tier
+metadata:
+  name: tier1
+spec:
+  priority: 100
+`},
+			expectedResponse: &controlplane.NetworkPolicyEvaluationResponse{
+				NetworkPolicy: acnpRef,
+				RuleIndex:     0,
+				Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "drop-bar", Action: &dropAction},
+			},
+		},
+		{
+			name: "Proposed ACNP in proposed Tier",
+			proposedPolicies: []string{`
+apiVersion: crd.antrea.io/v1beta1
+kind: Tier
+metadata:
+  name: tier2
+spec:
+  priority: 50
+---
+apiVersion: crd.antrea.io/v1beta1
+kind: ClusterNetworkPolicy
+metadata:
+  name: acnp-allow
+spec:
+  tier: tier2
+  priority: 1
+  appliedTo:
+  - podSelector:
+      matchLabels:
+        foo: bar
+  egress:
+  - name: allow-bar
+    action: Allow
+    to:
+    - podSelector:
+        matchLabels:
+          foo: bar
+`},
+			expectedResponse: &controlplane.NetworkPolicyEvaluationResponse{
+				NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-allow", UID: proposedPolicyUID(controlplane.AntreaClusterNetworkPolicy, "", "acnp-allow")},
+				RuleIndex:     0,
+				Rule:          controlplane.RuleRef{Direction: controlplane.DirectionOut, Name: "allow-bar", Action: &allowAction},
+			},
+		},
+		{
+			name: "Proposed K8s NetworkPolicy replacing existing one",
+			proposedPolicies: []string{`{
+  "apiVersion": "networking.k8s.io/v1",
+  "kind": "NetworkPolicy",
+  "metadata": {"name": "test-ingress-egress", "namespace": "testNamespace"},
+  "spec": {"podSelector": {"matchLabels": {"foo": "bar"}}, "policyTypes": ["Ingress"]}
+}`},
+			expectedResponse: &controlplane.NetworkPolicyEvaluationResponse{
+				NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: ns, Name: policies[0].Name, UID: proposedPolicyUID(controlplane.K8sNetworkPolicy, ns, policies[0].Name)},
+				RuleIndex:     math.MaxInt32,
+				Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn},
+			},
+		},
+		{
+			name: "Unsupported kind",
+			proposedPolicies: []string{`
+apiVersion: crd.antrea.io/v1beta1
+kind: ClusterGroup
+metadata:
+  name: cg1
+`},
+			expectedErr: "unsupported kind ClusterGroup in proposed policies",
+		},
+		{
+			name:             "Invalid manifest",
+			proposedPolicies: []string{"kind: ["},
+			expectedErr:      "invalid proposed policy manifest",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := policyRuleQuerier.QueryNetworkPolicyEvaluation(&controlplane.NetworkPolicyEvaluationRequest{
+				Source:           controlplane.Entity{Pod: &controlplane.PodReference{Namespace: ns, Name: pods[0].Name}},
+				Destination:      controlplane.Entity{Pod: &controlplane.PodReference{Namespace: ns, Name: pods[1].Name}},
+				ProposedPolicies: tc.proposedPolicies,
+			})
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, response)
+			if tc.expectedResponse != nil {
+				assert.Equal(t, tc.expectedResponse, response)
+			} else {
+				assert.Equal(t, tc.expectedPolicy, response.NetworkPolicy)
+			}
+		})
+	}
+	// The groups of proposed policies are removed after the evaluations.
+	groups, _ := querier.networkPolicyController.groupingInterface.GetGroupsForPod(ns, pods[0].Name)
+	assert.Empty(t, groups[evaluationGroupType])
+}
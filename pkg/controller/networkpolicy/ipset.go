@@ -0,0 +1,296 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	utilip "antrea.io/antrea/pkg/util/ip"
+)
+
+// ipSetResyncPeriod is the interval at which IPSets whose CIDRs are loaded from a ConfigMap are
+// reloaded, as ConfigMaps are not watched.
+const ipSetResyncPeriod = 1 * time.Minute
+
+// addIPSet is responsible for processing the ADD event of an IPSet resource.
+func (n *NetworkPolicyController) addIPSet(obj interface{}) {
+	ipSet := obj.(*crdv1alpha1.IPSet)
+	klog.V(2).InfoS("Processing IPSet ADD event", "ipSet", ipSet.Name)
+	n.enqueueIPSet(ipSet.Name)
+}
+
+// updateIPSet is responsible for processing the UPDATE event of an IPSet resource.
+func (n *NetworkPolicyController) updateIPSet(oldObj, curObj interface{}) {
+	oldIPSet := oldObj.(*crdv1alpha1.IPSet)
+	curIPSet := curObj.(*crdv1alpha1.IPSet)
+	// Status updates are made by the controller itself and can be ignored.
+	if reflect.DeepEqual(oldIPSet.Spec, curIPSet.Spec) {
+		return
+	}
+	klog.V(2).InfoS("Processing IPSet UPDATE event", "ipSet", curIPSet.Name)
+	n.enqueueIPSet(curIPSet.Name)
+}
+
+// deleteIPSet is responsible for processing the DELETE event of an IPSet resource.
+func (n *NetworkPolicyController) deleteIPSet(oldObj interface{}) {
+	ipSet, ok := oldObj.(*crdv1alpha1.IPSet)
+	if !ok {
+		tombstone, ok := oldObj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting IPSet, invalid type: %v", oldObj)
+			return
+		}
+		ipSet, ok = tombstone.Obj.(*crdv1alpha1.IPSet)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting IPSet, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.V(2).InfoS("Processing IPSet DELETE event", "ipSet", ipSet.Name)
+	n.enqueueIPSet(ipSet.Name)
+}
+
+func (n *NetworkPolicyController) enqueueIPSet(name string) {
+	klog.V(4).Infof("Adding new key %s to IPSet queue", name)
+	n.ipSetQueue.Add(name)
+}
+
+// resyncIPSetSources enqueues all IPSets which load CIDRs from a ConfigMap.
+func (n *NetworkPolicyController) resyncIPSetSources() {
+	ipSets, err := n.ipSetLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list IPSets")
+		return
+	}
+	for _, ipSet := range ipSets {
+		if ipSet.Spec.ConfigMap != nil {
+			n.enqueueIPSet(ipSet.Name)
+		}
+	}
+}
+
+// loadIPSets syncs all IPSets synchronously. IPSets which fail to be synced are re-queued.
+func (n *NetworkPolicyController) loadIPSets() {
+	ipSets, err := n.ipSetLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list IPSets")
+		return
+	}
+	for _, ipSet := range ipSets {
+		if err := n.syncIPSet(ipSet.Name); err != nil {
+			klog.ErrorS(err, "Failed to sync IPSet", "ipSet", ipSet.Name)
+			n.ipSetQueue.AddRateLimited(ipSet.Name)
+		}
+	}
+}
+
+func (n *NetworkPolicyController) ipSetWorker() {
+	for n.processNextIPSetWorkItem() {
+	}
+}
+
+func (n *NetworkPolicyController) processNextIPSetWorkItem() bool {
+	key, quit := n.ipSetQueue.Get()
+	if quit {
+		return false
+	}
+	defer n.ipSetQueue.Done(key)
+
+	err := n.syncIPSet(key.(string))
+	if err != nil {
+		// Put the item back in the workqueue to handle any transient errors.
+		n.ipSetQueue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to sync IPSet", "ipSet", key)
+		return true
+	}
+	n.ipSetQueue.Forget(key)
+	return true
+}
+
+// getIPSetCIDRs returns the aggregated CIDRs of an IPSet, and whether the IPSet has been loaded.
+func (n *NetworkPolicyController) getIPSetCIDRs(name string) ([]netip.Prefix, bool) {
+	n.ipSetMutex.RLock()
+	defer n.ipSetMutex.RUnlock()
+	cidrs, loaded := n.ipSetCIDRs[name]
+	return cidrs, loaded
+}
+
+func (n *NetworkPolicyController) syncIPSet(name string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(2).InfoS("Finished syncing IPSet", "ipSet", name, "durationTime", time.Since(startTime))
+	}()
+
+	ipSet, err := n.ipSetLister.Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		n.ipSetMutex.Lock()
+		_, loaded := n.ipSetCIDRs[name]
+		delete(n.ipSetCIDRs, name)
+		n.ipSetMutex.Unlock()
+		if loaded {
+			n.syncClusterGroupsForIPSet(name)
+		}
+		return nil
+	}
+
+	cidrs, err := n.loadIPSetCIDRs(ipSet)
+	if err != nil {
+		// Keep the CIDRs loaded previously, if any, so that a source which is temporarily
+		// unavailable does not open up the policies using the IPSet.
+		if statusErr := n.updateIPSetStatus(ipSet, ipSet.Status.CIDRs, ipSet.Status.AggregatedCIDRs, false, err.Error()); statusErr != nil {
+			klog.ErrorS(statusErr, "Failed to update IPSet status", "ipSet", name)
+		}
+		return err
+	}
+	total := len(cidrs)
+	cidrs = utilip.AggregateCIDRs(cidrs)
+
+	n.ipSetMutex.Lock()
+	oldCIDRs, loaded := n.ipSetCIDRs[name]
+	changed := !loaded || !slices.Equal(oldCIDRs, cidrs)
+	n.ipSetCIDRs[name] = cidrs
+	n.ipSetMutex.Unlock()
+	if changed {
+		klog.InfoS("Loaded CIDRs of IPSet", "ipSet", name, "cidrs", total, "aggregatedCIDRs", len(cidrs))
+		n.syncClusterGroupsForIPSet(name)
+	}
+	return n.updateIPSetStatus(ipSet, int32(total), int32(len(cidrs)), changed, "")
+}
+
+// syncClusterGroupsForIPSet re-processes the ClusterGroups referring to the IPSet, so that their
+// IPBlocks and the policies using them are updated.
+func (n *NetworkPolicyController) syncClusterGroupsForIPSet(name string) {
+	groups, _ := n.internalGroupStore.GetByIndex(store.IPSetIndex, name)
+	for _, obj := range groups {
+		group := obj.(*antreatypes.Group)
+		cg, err := n.cgLister.Get(group.SourceReference.Name)
+		if err != nil {
+			continue
+		}
+		key := internalGroupKeyFunc(cg)
+		n.internalGroupStore.Update(n.processClusterGroup(cg))
+		n.enqueueInternalGroup(key)
+	}
+}
+
+// loadIPSetCIDRs returns the CIDRs from all the sources of an IPSet.
+func (n *NetworkPolicyController) loadIPSetCIDRs(ipSet *crdv1alpha1.IPSet) ([]netip.Prefix, error) {
+	cidrs := make([]netip.Prefix, 0, len(ipSet.Spec.CIDRs))
+	for _, entry := range ipSet.Spec.CIDRs {
+		cidr, ok := parseIPSetCIDR(entry)
+		if !ok {
+			return nil, fmt.Errorf("invalid CIDR or IP address %s", entry)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	if cmRef := ipSet.Spec.ConfigMap; cmRef != nil {
+		cm, err := n.kubeClient.CoreV1().ConfigMaps(cmRef.Namespace).Get(context.TODO(), cmRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", cmRef.Namespace, cmRef.Name, err)
+		}
+		var keys []string
+		if cmRef.Key != "" {
+			if _, ok := cm.Data[cmRef.Key]; !ok {
+				return nil, fmt.Errorf("key %s not found in ConfigMap %s/%s", cmRef.Key, cmRef.Namespace, cmRef.Name)
+			}
+			keys = []string{cmRef.Key}
+		} else {
+			for key := range cm.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+		}
+		for _, key := range keys {
+			cmCIDRs, err := parseIPSetData(cm.Data[key])
+			if err != nil {
+				return nil, fmt.Errorf("invalid data for key %s in ConfigMap %s/%s: %w", key, cmRef.Namespace, cmRef.Name, err)
+			}
+			cidrs = append(cidrs, cmCIDRs...)
+		}
+	}
+	return cidrs, nil
+}
+
+// parseIPSetData parses the content of a ConfigMap entry. CIDRs or IP addresses are
+// separated by whitespaces or newlines, and text following a '#' on a line is a comment. Invalid
+// entries are reported by line number, so that the content of arbitrary sources is not exposed
+// in the IPSet status.
+func parseIPSetData(data string) ([]netip.Prefix, error) {
+	var cidrs []netip.Prefix
+	for i, line := range strings.Split(data, "\n") {
+		if j := strings.IndexByte(line, '#'); j >= 0 {
+			line = line[:j]
+		}
+		for _, entry := range strings.Fields(line) {
+			cidr, ok := parseIPSetCIDR(entry)
+			if !ok {
+				return nil, fmt.Errorf("invalid CIDR or IP address on line %d", i+1)
+			}
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs, nil
+}
+
+// parseIPSetCIDR parses a CIDR or an IP address, the latter being converted to a host CIDR.
+func parseIPSetCIDR(entry string) (netip.Prefix, bool) {
+	if strings.Contains(entry, "/") {
+		cidr, err := netip.ParsePrefix(entry)
+		return cidr, err == nil
+	}
+	ip, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return netip.PrefixFrom(ip, ip.BitLen()), true
+}
+
+func (n *NetworkPolicyController) updateIPSetStatus(ipSet *crdv1alpha1.IPSet, cidrs, aggregatedCIDRs int32, changed bool, message string) error {
+	status := crdv1alpha1.IPSetStatus{
+		CIDRs:           cidrs,
+		AggregatedCIDRs: aggregatedCIDRs,
+		LastUpdateTime:  ipSet.Status.LastUpdateTime,
+		Message:         message,
+	}
+	if changed {
+		status.LastUpdateTime = metav1.Now()
+	}
+	if status == ipSet.Status {
+		return nil
+	}
+	toUpdate := ipSet.DeepCopy()
+	toUpdate.Status = status
+	_, err := n.crdClient.CrdV1alpha1().IPSets().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+	return err
+}
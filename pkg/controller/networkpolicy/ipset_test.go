@@ -0,0 +1,158 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestParseIPSetData(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedCIDRs []netip.Prefix
+		expectedErr   string
+	}{
+		{
+			name: "cidrs and addresses",
+			data: `# Blocked networks
+10.0.0.0/25 10.0.0.128/25
+  192.168.1.1	# a single host
+2001:db8::/32 #10.1.0.0/16
+
+`,
+			expectedCIDRs: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/25"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+				netip.MustParsePrefix("192.168.1.1/32"),
+				netip.MustParsePrefix("2001:db8::/32"),
+			},
+		},
+		{
+			name:        "invalid cidr",
+			data:        "10.0.0.0/24\n10.0.0.0/33",
+			expectedErr: "invalid CIDR or IP address on line 2",
+		},
+		{
+			name:        "invalid address",
+			data:        "10.0.0.256",
+			expectedErr: "invalid CIDR or IP address on line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidrs, err := parseIPSetData(tt.data)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCIDRs, cidrs)
+			}
+		})
+	}
+}
+
+func TestSyncIPSet(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocklist", Namespace: "kube-system"},
+		Data: map[string]string{
+			"feed-a": "10.0.0.0/25 10.0.0.128/25",
+			"feed-b": "10.0.1.0/24 # comment",
+		},
+	}
+	ipSet := &crdv1alpha1.IPSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocklist"},
+		Spec: crdv1alpha1.IPSetSpec{
+			CIDRs:     []string{"10.0.1.1", "10.0.2.0/24", "10.0.3.0/24", "192.168.0.0/16"},
+			ConfigMap: &crdv1alpha1.IPSetConfigMapSource{Name: "blocklist", Namespace: "kube-system"},
+		},
+	}
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgA", UID: "uidA"},
+		Spec:       crdv1beta1.GroupSpec{IPSet: "blocklist"},
+	}
+	client, c := newController([]runtime.Object{cm}, []runtime.Object{ipSet, cg})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	// The ClusterGroup is processed before the IPSet is loaded.
+	c.addClusterGroup(cg)
+	obj, _, _ := c.internalGroupStore.Get(cg.Name)
+	assert.Empty(t, obj.(*antreatypes.Group).IPBlocks)
+	assert.Equal(t, "blocklist", obj.(*antreatypes.Group).IPSet)
+	_, loaded := c.getIPSetCIDRs(ipSet.Name)
+	assert.False(t, loaded)
+	// Drain the internal Group queue.
+	key, _ := c.internalGroupQueue.Get()
+	c.internalGroupQueue.Done(key)
+
+	require.NoError(t, c.syncIPSet(ipSet.Name))
+	expectedCIDRs := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/22"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+	}
+	cidrs, loaded := c.getIPSetCIDRs(ipSet.Name)
+	assert.True(t, loaded)
+	assert.Equal(t, expectedCIDRs, cidrs)
+	obj, _, _ = c.internalGroupStore.Get(cg.Name)
+	group := obj.(*antreatypes.Group)
+	expectedIPBlocks := []controlplane.IPBlock{
+		{CIDR: controlplane.IPNet{IP: ipStrToIPAddress("10.0.0.0"), PrefixLength: 22}, Except: []controlplane.IPNet{}},
+		{CIDR: controlplane.IPNet{IP: ipStrToIPAddress("192.168.0.0"), PrefixLength: 16}, Except: []controlplane.IPNet{}},
+	}
+	assert.Equal(t, expectedIPBlocks, group.IPBlocks)
+	assert.Len(t, group.IPNets, 2)
+	// The ClusterGroup is enqueued again.
+	assert.Equal(t, 1, c.internalGroupQueue.Len())
+
+	updatedIPSet, err := c.crdClient.CrdV1alpha1().IPSets().Get(context.TODO(), ipSet.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), updatedIPSet.Status.CIDRs)
+	assert.Equal(t, int32(2), updatedIPSet.Status.AggregatedCIDRs)
+	assert.False(t, updatedIPSet.Status.LastUpdateTime.IsZero())
+	assert.Empty(t, updatedIPSet.Status.Message)
+
+	// A source which can not be read does not discard the CIDRs loaded previously.
+	require.NoError(t, client.CoreV1().ConfigMaps(cm.Namespace).Delete(context.TODO(), cm.Name, metav1.DeleteOptions{}))
+	assert.Error(t, c.syncIPSet(ipSet.Name))
+	cidrs, _ = c.getIPSetCIDRs(ipSet.Name)
+	assert.Equal(t, expectedCIDRs, cidrs)
+	updatedIPSet, err = c.crdClient.CrdV1alpha1().IPSets().Get(context.TODO(), ipSet.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, updatedIPSet.Status.Message, "failed to get ConfigMap")
+
+	// Deleting the IPSet empties the ClusterGroup.
+	require.NoError(t, c.crdInformerFactory.Crd().V1alpha1().IPSets().Informer().GetStore().Delete(ipSet))
+	require.NoError(t, c.syncIPSet(ipSet.Name))
+	_, loaded = c.getIPSetCIDRs(ipSet.Name)
+	assert.False(t, loaded)
+	obj, _, _ = c.internalGroupStore.Get(cg.Name)
+	assert.Empty(t, obj.(*antreatypes.Group).IPBlocks)
+}
@@ -20,6 +20,7 @@ package networkpolicy
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"reflect"
 	"strconv"
 	"strings"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	policyinformers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 	policylisters "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
 
@@ -51,7 +53,9 @@ import (
 	secv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	"antrea.io/antrea/pkg/client/clientset/versioned"
+	crdv1a1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	crdv1b1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdv1a1listers "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	crdv1b1listers "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/grouping"
 	"antrea.io/antrea/pkg/controller/labelidentity"
@@ -186,6 +190,14 @@ type NetworkPolicyController struct {
 	// tierListerSynced is a function which returns true if the Tiers shared informer has been synced at least once.
 	tierListerSynced cache.InformerSynced
 
+	namespacedTierInformer crdv1b1informers.NamespacedTierInformer
+	// namespacedTierLister is able to list/get NamespacedTiers and is populated by the shared informer passed to
+	// NewNetworkPolicyController.
+	namespacedTierLister crdv1b1listers.NamespacedTierLister
+	// namespacedTierListerSynced is a function which returns true if the NamespacedTiers shared informer has been
+	// synced at least once.
+	namespacedTierListerSynced cache.InformerSynced
+
 	cgInformer crdv1b1informers.ClusterGroupInformer
 	// cgLister is able to list/get ClusterGroups and is populated by the shared informer passed to
 	// NewClusterGroupController.
@@ -194,6 +206,18 @@ type NetworkPolicyController struct {
 	// once.
 	cgListerSynced cache.InformerSynced
 
+	ipSetInformer crdv1a1informers.IPSetInformer
+	// ipSetLister is able to list/get IPSets and is populated by the shared informer passed to
+	// NewNetworkPolicyController.
+	ipSetLister crdv1a1listers.IPSetLister
+	// ipSetListerSynced is a function which returns true if the IPSet shared informer has been synced at least
+	// once.
+	ipSetListerSynced cache.InformerSynced
+	// ipSetCIDRs caches the aggregated CIDRs loaded from the sources of each IPSet, keyed by IPSet name.
+	ipSetCIDRs map[string][]netip.Prefix
+	// ipSetMutex protects ipSetCIDRs.
+	ipSetMutex sync.RWMutex
+
 	nodeInformer coreinformers.NodeInformer
 	// nodeLister is able to list/get Nodes and is populated by the shared informer passed to
 	// NewNetworkPolicyController.
@@ -245,6 +269,8 @@ type NetworkPolicyController struct {
 	// internalGroupQueue maintains the networkpolicy.Group objects that needs to be
 	// synced.
 	internalGroupQueue workqueue.RateLimitingInterface
+	// ipSetQueue maintains the IPSet objects whose sources need to be loaded.
+	ipSetQueue workqueue.RateLimitingInterface
 
 	// internalNetworkPolicyMutex prevents concurrent processing of internal networkpolicies who refer
 	// to the same addressgroups/appliedtogroups.
@@ -262,6 +288,9 @@ type NetworkPolicyController struct {
 	// Enable Stretched Networkpolicy feature which allows Antrea-native policies to select peer
 	// from other clusters in a ClusterSet.
 	stretchNPEnabled bool
+	// clock is used to evaluate the Schedules of Antrea-native policies. It enables the use of a
+	// "virtual" clock for unit tests.
+	clock clock.Clock
 	// heartbeatCh is an internal channel for testing. It's used to know whether all tasks have been
 	// processed, and to count executions of each function.
 	heartbeatCh chan heartbeat
@@ -412,8 +441,10 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	adminNPInformer policyinformers.AdminNetworkPolicyInformer,
 	banpInformer policyinformers.BaselineAdminNetworkPolicyInformer,
 	tierInformer crdv1b1informers.TierInformer,
+	namespacedTierInformer crdv1b1informers.NamespacedTierInformer,
 	cgInformer crdv1b1informers.ClusterGroupInformer,
 	grpInformer crdv1b1informers.GroupInformer,
+	ipSetInformer crdv1a1informers.IPSetInformer,
 	addressGroupStore storage.Interface,
 	appliedToGroupStore storage.Interface,
 	internalNetworkPolicyStore storage.Interface,
@@ -439,11 +470,14 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		addressGroupQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "addressGroup"),
 		internalNetworkPolicyQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalNetworkPolicy"),
 		internalGroupQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
+		ipSetQueue:                     workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "ipSet"),
+		ipSetCIDRs:                     map[string][]netip.Prefix{},
 		groupingInterface:              groupingInterface,
 		groupingInterfaceSynced:        groupingInterface.HasSynced,
 		labelIdentityInterface:         labelIdentityInterface,
 		stretchNPEnabled:               stretchedNPEnabled,
 		appliedToGroupNotifier:         newNotifier(),
+		clock:                          clock.RealClock{},
 	}
 	n.groupingInterface.AddEventHandler(appliedToGroupType, n.enqueueAppliedToGroup)
 	n.groupingInterface.AddEventHandler(addressGroupType, n.enqueueAddressGroup)
@@ -496,12 +530,18 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		n.tierInformer = tierInformer
 		n.tierLister = tierInformer.Lister()
 		n.tierListerSynced = tierInformer.Informer().HasSynced
+		n.namespacedTierInformer = namespacedTierInformer
+		n.namespacedTierLister = namespacedTierInformer.Lister()
+		n.namespacedTierListerSynced = namespacedTierInformer.Informer().HasSynced
 		n.cgInformer = cgInformer
 		n.cgLister = cgInformer.Lister()
 		n.cgListerSynced = cgInformer.Informer().HasSynced
 		n.grpInformer = grpInformer
 		n.grpLister = grpInformer.Lister()
 		n.grpListerSynced = grpInformer.Informer().HasSynced
+		n.ipSetInformer = ipSetInformer
+		n.ipSetLister = ipSetInformer.Lister()
+		n.ipSetListerSynced = ipSetInformer.Informer().HasSynced
 		// Add handlers for Namespace events.
 		n.namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
 			cache.ResourceEventHandlerFuncs{
@@ -529,6 +569,20 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			resyncPeriod,
 		)
 		tierInformer.Informer().AddIndexers(tierIndexers)
+		tierInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				UpdateFunc: n.updateTierEvent,
+			},
+			resyncPeriod,
+		)
+		namespacedTierInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    n.addNamespacedTier,
+				UpdateFunc: n.updateNamespacedTier,
+				DeleteFunc: n.deleteNamespacedTier,
+			},
+			resyncPeriod,
+		)
 		acnpInformer.Informer().AddIndexers(acnpIndexers)
 		acnpInformer.Informer().AddEventHandlerWithResyncPeriod(
 			cache.ResourceEventHandlerFuncs{
@@ -565,6 +619,15 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			},
 			resyncPeriod,
 		)
+		// Add event handlers for IPSet notification.
+		ipSetInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    n.addIPSet,
+				UpdateFunc: n.updateIPSet,
+				DeleteFunc: n.deleteIPSet,
+			},
+			resyncPeriod,
+		)
 	}
 	return n
 }
@@ -619,6 +682,20 @@ func (n *NetworkPolicyController) createAppliedToGroup(npNsName string, pSel, nS
 	return appliedToGroup
 }
 
+// createAppliedToGroupWithPodNodeSelector creates an AppliedToGroup object corresponding to the provided selectors,
+// which only selects the Pods running on the Nodes selected by podNodeSel. It's equivalent to createAppliedToGroup if
+// podNodeSel is nil.
+func (n *NetworkPolicyController) createAppliedToGroupWithPodNodeSelector(npNsName string, pSel, nSel, eSel, podNodeSel *metav1.LabelSelector) *antreatypes.AppliedToGroup {
+	groupSelector := antreatypes.NewGroupSelector(npNsName, pSel, nSel, eSel, nil)
+	groupSelector.SetPodNodeSelector(podNodeSel)
+	appliedToGroupUID := getNormalizedUID(groupSelector.NormalizedName)
+	return &antreatypes.AppliedToGroup{
+		Name:     appliedToGroupUID,
+		UID:      types.UID(appliedToGroupUID),
+		Selector: groupSelector,
+	}
+}
+
 // createAddressGroup creates an AddressGroup object corresponding to a
 // NetworkPolicyPeer object in NetworkPolicyRule. This function simply
 // creates the object without actually populating the PodAddresses as the
@@ -635,6 +712,20 @@ func (n *NetworkPolicyController) createAddressGroup(namespace string, podSelect
 	return addressGroup
 }
 
+// createAddressGroupWithPodNodeSelector creates an AddressGroup object corresponding to the provided selectors,
+// which only selects the Pods running on the Nodes selected by podNodeSelector. It's equivalent to createAddressGroup
+// if podNodeSelector is nil.
+func (n *NetworkPolicyController) createAddressGroupWithPodNodeSelector(namespace string, podSelector, nsSelector, eeSelector, podNodeSelector *metav1.LabelSelector) *antreatypes.AddressGroup {
+	groupSelector := antreatypes.NewGroupSelector(namespace, podSelector, nsSelector, eeSelector, nil)
+	groupSelector.SetPodNodeSelector(podNodeSelector)
+	normalizedUID := getNormalizedUID(groupSelector.NormalizedName)
+	return &antreatypes.AddressGroup{
+		UID:      types.UID(normalizedUID),
+		Name:     normalizedUID,
+		Selector: groupSelector,
+	}
+}
+
 // toAntreaProtocol converts a v1.Protocol object to an Antrea Protocol object.
 func toAntreaProtocol(npProtocol *v1.Protocol) *controlplane.Protocol {
 	// If Protocol is unset, it must default to TCP protocol.
@@ -943,6 +1034,7 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	defer n.addressGroupQueue.ShutDown()
 	defer n.internalNetworkPolicyQueue.ShutDown()
 	defer n.internalGroupQueue.ShutDown()
+	defer n.ipSetQueue.ShutDown()
 
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
@@ -950,11 +1042,17 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	cacheSyncs := []cache.InformerSynced{n.networkPolicyListerSynced, n.groupingInterfaceSynced}
 	// Only wait for acnpListerSynced and annpListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		cacheSyncs = append(cacheSyncs, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced)
+		// The priorities of Antrea NetworkPolicies depend on the NamespacedTiers they reference.
+		cacheSyncs = append(cacheSyncs, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced, n.namespacedTierListerSynced, n.ipSetListerSynced)
 	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
 	}
+	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		// Load IPSets before syncing internal Groups, to avoid realizing ClusterGroups referring
+		// to IPSets without their CIDRs.
+		n.loadIPSets()
+	}
 
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(n.appliedToGroupWorker, time.Second, stopCh)
@@ -962,6 +1060,11 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 		go wait.Until(n.internalNetworkPolicyWorker, time.Second, stopCh)
 		go wait.Until(n.internalGroupWorker, time.Second, stopCh)
 	}
+	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		go wait.Until(n.ipSetWorker, time.Second, stopCh)
+		// Sources of IPSets, i.e. ConfigMaps and local files, are not watched, reload them periodically.
+		go wait.Until(n.resyncIPSetSources, ipSetResyncPeriod, stopCh)
+	}
 	<-stopCh
 }
 
@@ -1660,6 +1763,12 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			n.appliedToGroupNotifier.unsubscribe(name, internalNetworkPolicyName)
 		}
 	}
+	// Sync the NetworkPolicy again when its activation state is expected to change.
+	if state := newInternalNetworkPolicy.ScheduleState; state != nil && !state.NextTransitionTime.IsZero() {
+		delay := state.NextTransitionTime.Sub(n.clock.Now())
+		klog.V(2).InfoS("Scheduling next sync of internal NetworkPolicy", "key", key, "active", state.Active, "delay", delay)
+		n.internalNetworkPolicyQueue.AddAfter(*key, delay)
+	}
 	return nil
 }
 
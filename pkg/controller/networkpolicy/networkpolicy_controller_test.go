@@ -41,6 +41,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	fakepolicyversioned "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
 	policyv1a1informers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
@@ -69,9 +70,10 @@ var (
 	k8sProtocolTCP  = corev1.ProtocolTCP
 	k8sProtocolSCTP = corev1.ProtocolSCTP
 
-	protocolTCP  = controlplane.ProtocolTCP
-	protocolICMP = controlplane.ProtocolICMP
-	protocolIGMP = controlplane.ProtocolIGMP
+	protocolTCP                 = controlplane.ProtocolTCP
+	protocolICMP                = controlplane.ProtocolICMP
+	protocolIGMP                = controlplane.ProtocolIGMP
+	protocolIPv6ExtensionHeader = controlplane.ProtocolIPv6ExtensionHeader
 
 	int80   = intstr.FromInt(80)
 	int81   = intstr.FromInt(81)
@@ -94,6 +96,7 @@ type networkPolicyController struct {
 	anpStore                   cache.Store
 	banpStore                  cache.Store
 	tierStore                  cache.Store
+	namespacedTierStore        cache.Store
 	cgStore                    cache.Store
 	gStore                     cache.Store
 	appliedToGroupStore        storage.Interface
@@ -125,6 +128,7 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 	groupingController := grouping.NewGroupEntityController(groupEntityIndex,
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
+		informerFactory.Core().V1().Nodes(),
 		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
 	labelIndex := labelidentity.NewLabelIdentityIndex()
 	labelIdentityController := labelidentity.NewLabelIdentityController(
@@ -143,8 +147,10 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 		policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies(),
 		policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies(),
 		crdInformerFactory.Crd().V1beta1().Tiers(),
+		crdInformerFactory.Crd().V1beta1().NamespacedTiers(),
 		cgInformer,
 		gInformer,
+		crdInformerFactory.Crd().V1alpha1().IPSets(),
 		addressGroupStore,
 		appliedToGroupStore,
 		internalNetworkPolicyStore,
@@ -156,9 +162,11 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 	npController.acnpListerSynced = alwaysReady
 	npController.tierLister = crdInformerFactory.Crd().V1beta1().Tiers().Lister()
 	npController.tierListerSynced = alwaysReady
+	npController.namespacedTierListerSynced = alwaysReady
 	npController.cgInformer = cgInformer
 	npController.cgLister = cgInformer.Lister()
 	npController.cgListerSynced = alwaysReady
+	npController.ipSetListerSynced = alwaysReady
 	npController.serviceLister = informerFactory.Core().V1().Services().Lister()
 	npController.serviceListerSynced = alwaysReady
 	return client, &networkPolicyController{
@@ -171,6 +179,7 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 		policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies().Informer().GetStore(),
 		policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies().Informer().GetStore(),
 		crdInformerFactory.Crd().V1beta1().Tiers().Informer().GetStore(),
+		crdInformerFactory.Crd().V1beta1().NamespacedTiers().Informer().GetStore(),
 		crdInformerFactory.Crd().V1beta1().ClusterGroups().Informer().GetStore(),
 		crdInformerFactory.Crd().V1beta1().Groups().Informer().GetStore(),
 		appliedToGroupStore,
@@ -199,6 +208,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 	namespaceInformer := informerFactory.Core().V1().Namespaces()
 	networkPolicyInformer := informerFactory.Networking().V1().NetworkPolicies()
 	tierInformer := crdInformerFactory.Crd().V1beta1().Tiers()
+	namespacedTierInformer := crdInformerFactory.Crd().V1beta1().NamespacedTiers()
 	acnpInformer := crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies()
 	annpInformer := crdInformerFactory.Crd().V1beta1().NetworkPolicies()
 	anpInformer := policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies()
@@ -216,6 +226,9 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		tierInformer:               tierInformer,
 		tierLister:                 tierInformer.Lister(),
 		tierListerSynced:           tierInformer.Informer().HasSynced,
+		namespacedTierInformer:     namespacedTierInformer,
+		namespacedTierLister:       namespacedTierInformer.Lister(),
+		namespacedTierListerSynced: namespacedTierInformer.Informer().HasSynced,
 		acnpInformer:               acnpInformer,
 		acnpLister:                 acnpInformer.Lister(),
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
@@ -235,6 +248,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
 		groupingInterface:          groupEntityIndex,
 		appliedToGroupNotifier:     newNotifier(),
+		clock:                      clock.RealClock{},
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
 	npController.acnpInformer.Informer().AddIndexers(acnpIndexers)
@@ -249,6 +263,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		anpInformer.Informer().GetStore(),
 		banpInformer.Informer().GetStore(),
 		tierInformer.Informer().GetStore(),
+		namespacedTierInformer.Informer().GetStore(),
 		cgInformer.Informer().GetStore(),
 		groupInformer.Informer().GetStore(),
 		appliedToGroupStore,
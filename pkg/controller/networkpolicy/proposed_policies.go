@@ -0,0 +1,354 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/grouping"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// evaluationGroupType is the type of the groups which are registered with the grouping interface
+// for the duration of a NetworkPolicyEvaluation, to compute the Pods selected by proposed policies.
+const evaluationGroupType grouping.GroupType = "evaluationGroup"
+
+var proposedPolicyDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(networkingv1.AddToScheme(scheme))
+	utilruntime.Must(crdv1beta1.AddToScheme(scheme))
+	proposedPolicyDecoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// proposedPolicies contains the objects decoded from the manifests of a NetworkPolicyEvaluation
+// request.
+type proposedPolicies struct {
+	knps  []*networkingv1.NetworkPolicy
+	annps []*crdv1beta1.NetworkPolicy
+	acnps []*crdv1beta1.ClusterNetworkPolicy
+	// tierPriorities maps the names of the proposed Tiers to their priorities.
+	tierPriorities map[string]int32
+}
+
+// decodeProposedPolicies decodes YAML or JSON manifests, each of which may contain multiple
+// documents. Proposed policies are given a UID generated from their kind, Namespace and name, as
+// they may not exist yet.
+func decodeProposedPolicies(manifests []string) (*proposedPolicies, error) {
+	proposed := &proposedPolicies{tierPriorities: map[string]int32{}}
+	for _, manifest := range manifests {
+		decoder := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+		for {
+			var raw runtime.RawExtension
+			if err := decoder.Decode(&raw); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("invalid proposed policy manifest: %w", err)
+			}
+			// Skip empty documents.
+			if len(raw.Raw) == 0 {
+				continue
+			}
+			obj, gvk, err := proposedPolicyDecoder.Decode(raw.Raw, nil, nil)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proposed policy manifest: %w", err)
+			}
+			switch o := obj.(type) {
+			case *networkingv1.NetworkPolicy:
+				if o.Namespace == "" {
+					o.Namespace = "default"
+				}
+				o.UID = proposedPolicyUID(controlplane.K8sNetworkPolicy, o.Namespace, o.Name)
+				proposed.knps = append(proposed.knps, o)
+			case *crdv1beta1.NetworkPolicy:
+				if o.Namespace == "" {
+					o.Namespace = "default"
+				}
+				o.UID = proposedPolicyUID(controlplane.AntreaNetworkPolicy, o.Namespace, o.Name)
+				proposed.annps = append(proposed.annps, o)
+			case *crdv1beta1.ClusterNetworkPolicy:
+				o.UID = proposedPolicyUID(controlplane.AntreaClusterNetworkPolicy, "", o.Name)
+				proposed.acnps = append(proposed.acnps, o)
+			case *crdv1beta1.Tier:
+				proposed.tierPriorities[o.Name] = o.Spec.Priority
+			default:
+				return nil, fmt.Errorf("unsupported kind %s in proposed policies, supported kinds are NetworkPolicy, ClusterNetworkPolicy and Tier", gvk.Kind)
+			}
+		}
+	}
+	return proposed, nil
+}
+
+func proposedPolicyUID(policyType controlplane.NetworkPolicyType, namespace, name string) types.UID {
+	return types.UID(getNormalizedUID(fmt.Sprintf("proposed/%s/%s/%s", policyType, namespace, name)))
+}
+
+// replaces returns whether the provided existing policy is replaced by a proposed policy, i.e.
+// whether a proposed policy has the same type, Namespace and name.
+func (p *proposedPolicies) replaces(ref *controlplane.NetworkPolicyReference) bool {
+	switch ref.Type {
+	case controlplane.K8sNetworkPolicy:
+		for _, knp := range p.knps {
+			if knp.Namespace == ref.Namespace && knp.Name == ref.Name {
+				return true
+			}
+		}
+	case controlplane.AntreaNetworkPolicy:
+		for _, annp := range p.annps {
+			if annp.Namespace == ref.Namespace && annp.Name == ref.Name {
+				return true
+			}
+		}
+	case controlplane.AntreaClusterNetworkPolicy:
+		for _, acnp := range p.acnps {
+			if acnp.Name == ref.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeTierName returns the name of the Tier CRD referred to by a policy.
+func normalizeTierName(tier string) string {
+	if tier == "" {
+		return defaultTierName
+	}
+	if staticTierSet.Has(tier) {
+		return strings.ToLower(tier)
+	}
+	return tier
+}
+
+// proposedTierPriority returns the priority of the Tier of a policy if the Tier is proposed.
+func (p *proposedPolicies) proposedTierPriority(tier string) (int32, bool) {
+	priority, ok := p.tierPriorities[normalizeTierName(tier)]
+	return priority, ok
+}
+
+// existingPolicyTier returns the Tier of an existing Antrea-native policy, or false for other
+// policies.
+func (n *NetworkPolicyController) existingPolicyTier(ref *controlplane.NetworkPolicyReference) (string, bool) {
+	switch ref.Type {
+	case controlplane.AntreaNetworkPolicy:
+		annp, err := n.annpLister.NetworkPolicies(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			return "", false
+		}
+		return annp.Spec.Tier, true
+	case controlplane.AntreaClusterNetworkPolicy:
+		acnp, err := n.acnpLister.Get(ref.Name)
+		if err != nil {
+			return "", false
+		}
+		return acnp.Spec.Tier, true
+	}
+	return "", false
+}
+
+// proposedInternalPolicy is an internal NetworkPolicy computed from a proposed policy, along with
+// the groups it refers to.
+type proposedInternalPolicy struct {
+	policy          *antreatypes.NetworkPolicy
+	appliedToGroups map[string]*antreatypes.AppliedToGroup
+	addressGroups   map[string]*antreatypes.AddressGroup
+}
+
+// processProposedPolicies computes the internal NetworkPolicies of the proposed policies, the
+// same way as for existing policies, with the priorities of the proposed Tiers.
+func (n *NetworkPolicyController) processProposedPolicies(proposed *proposedPolicies) []*proposedInternalPolicy {
+	var internalPolicies []*proposedInternalPolicy
+	add := func(tier *string, policy *antreatypes.NetworkPolicy, atgs map[string]*antreatypes.AppliedToGroup, ags map[string]*antreatypes.AddressGroup) {
+		if tier != nil {
+			if priority, ok := proposed.proposedTierPriority(*tier); ok {
+				policy.TierPriority = &priority
+			}
+		}
+		internalPolicies = append(internalPolicies, &proposedInternalPolicy{policy: policy, appliedToGroups: atgs, addressGroups: ags})
+	}
+	for _, knp := range proposed.knps {
+		policy, atgs, ags := n.processNetworkPolicy(knp)
+		add(nil, policy, atgs, ags)
+	}
+	for _, annp := range proposed.annps {
+		policy, atgs, ags := n.processAntreaNetworkPolicy(annp)
+		add(&annp.Spec.Tier, policy, atgs, ags)
+	}
+	for _, acnp := range proposed.acnps {
+		policy, atgs, ags := n.processClusterNetworkPolicy(acnp)
+		add(&acnp.Spec.Tier, policy, atgs, ags)
+	}
+	if n.stretchNPEnabled {
+		// ClusterSet-scoped selectors of the proposed policies must not remain registered.
+		for _, p := range internalPolicies {
+			n.labelIdentityInterface.DeletePolicySelectors(p.policy.Name)
+		}
+	}
+	return internalPolicies
+}
+
+// QueryProposedNetworkPolicyRules returns the NetworkPolicy rules which apply to or select the
+// provided Pods, as if the proposed policies were applied. Existing policies which are replaced by
+// proposed policies are ignored, and the priorities of proposed Tiers take precedence over the
+// priorities of existing Tiers. The returned slice has a nil element for each unknown Pod.
+func (eq *EndpointQuerierImpl) QueryProposedNetworkPolicyRules(manifests []string, pods []controlplane.PodReference) ([]*antreatypes.EndpointNetworkPolicyRules, error) {
+	proposed, err := decodeProposedPolicies(manifests)
+	if err != nil {
+		return nil, err
+	}
+	n := eq.networkPolicyController
+	// Requests are serialized, as the groups of proposed policies with the same selectors have
+	// the same names, and proposed policies with the same references have the same UIDs.
+	eq.evaluationMutex.Lock()
+	defer eq.evaluationMutex.Unlock()
+
+	internalPolicies := n.processProposedPolicies(proposed)
+	// The groups of proposed policies are registered with the grouping interface, so that the
+	// Pods they select are computed the same way as for existing policies.
+	evaluationGroups := sets.New[string]()
+	registerGroup := func(name string, selector *antreatypes.GroupSelector) {
+		if selector != nil && !evaluationGroups.Has(name) {
+			n.groupingInterface.AddGroup(evaluationGroupType, name, selector)
+			evaluationGroups.Insert(name)
+		}
+	}
+	for _, p := range internalPolicies {
+		for name, atg := range p.appliedToGroups {
+			registerGroup(name, atg.Selector)
+		}
+		for name, ag := range p.addressGroups {
+			registerGroup(name, ag.Selector)
+		}
+	}
+	defer func() {
+		for name := range evaluationGroups {
+			n.groupingInterface.DeleteGroup(evaluationGroupType, name)
+		}
+	}()
+
+	// Existing policies are copied when the priority of their Tier is overridden by a proposed
+	// Tier, and the copies are shared by all the rules of a policy.
+	overriddenPolicies := map[types.UID]*antreatypes.NetworkPolicy{}
+	existingPolicy := func(policy *antreatypes.NetworkPolicy) *antreatypes.NetworkPolicy {
+		if copied, ok := overriddenPolicies[policy.SourceRef.UID]; ok {
+			return copied
+		}
+		tier, ok := n.existingPolicyTier(policy.SourceRef)
+		if !ok {
+			return policy
+		}
+		priority, ok := proposed.proposedTierPriority(tier)
+		if !ok {
+			return policy
+		}
+		copied := *policy
+		copied.TierPriority = &priority
+		overriddenPolicies[policy.SourceRef.UID] = &copied
+		return &copied
+	}
+	existingRules := func(rules []*antreatypes.RuleInfo) []*antreatypes.RuleInfo {
+		var filtered []*antreatypes.RuleInfo
+		for _, rule := range rules {
+			if proposed.replaces(rule.Policy.SourceRef) {
+				continue
+			}
+			filtered = append(filtered, &antreatypes.RuleInfo{Policy: existingPolicy(rule.Policy), Index: rule.Index, Rule: rule.Rule})
+		}
+		return filtered
+	}
+
+	results := make([]*antreatypes.EndpointNetworkPolicyRules, len(pods))
+	for i, pod := range pods {
+		endpointRules, err := eq.QueryNetworkPolicyRules(pod.Namespace, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+		if endpointRules == nil {
+			continue
+		}
+		result := &antreatypes.EndpointNetworkPolicyRules{
+			Namespace:                 endpointRules.Namespace,
+			Name:                      endpointRules.Name,
+			EndpointAsIngressSrcRules: existingRules(endpointRules.EndpointAsIngressSrcRules),
+			EndpointAsEgressDstRules:  existingRules(endpointRules.EndpointAsEgressDstRules),
+		}
+		for _, policy := range endpointRules.AppliedPolicies {
+			if !proposed.replaces(policy.SourceRef) {
+				result.AppliedPolicies = append(result.AppliedPolicies, existingPolicy(policy))
+			}
+		}
+
+		// Compute the names of the groups of proposed policies which select the Pod: groups
+		// registered for the evaluation, and groups derived from ClusterGroups and Groups.
+		memberOf := sets.New[string]()
+		if groups, exists := n.groupingInterface.GetGroupsForPod(result.Namespace, result.Name); exists {
+			memberOf.Insert(groups[evaluationGroupType]...)
+		}
+		for _, group := range n.GetAssociatedGroups(result.Name, result.Namespace) {
+			memberOf.Insert(group.SourceReference.ToGroupName())
+		}
+		for _, p := range internalPolicies {
+			for name, atg := range p.appliedToGroups {
+				if memberOf.Has(name) || memberOf.Has(atg.SourceGroup) {
+					result.AppliedPolicies = append(result.AppliedPolicies, p.policy)
+					break
+				}
+			}
+			selectsPod := func(addressGroups []string) bool {
+				for _, name := range addressGroups {
+					if ag, ok := p.addressGroups[name]; ok && (memberOf.Has(name) || memberOf.Has(ag.SourceGroup)) {
+						return true
+					}
+				}
+				return false
+			}
+			// As for existing policies, rule indexes are the indexes among the ingress or egress
+			// rules of the policy.
+			egressIndex, ingressIndex := int32(0), int32(0)
+			for j := range p.policy.Rules {
+				rule := &p.policy.Rules[j]
+				ruleRef := &controlplane.NetworkPolicyRule{Direction: rule.Direction, Name: rule.Name, Action: rule.Action}
+				if rule.Direction == controlplane.DirectionIn {
+					if selectsPod(rule.From.AddressGroups) {
+						result.EndpointAsIngressSrcRules = append(result.EndpointAsIngressSrcRules, &antreatypes.RuleInfo{Policy: p.policy, Index: ingressIndex, Rule: ruleRef})
+					}
+					ingressIndex++
+				} else {
+					if selectsPod(rule.To.AddressGroups) {
+						result.EndpointAsEgressDstRules = append(result.EndpointAsEgressDstRules, &antreatypes.RuleInfo{Policy: p.policy, Index: egressIndex, Rule: ruleRef})
+					}
+					egressIndex++
+				}
+			}
+		}
+		results[i] = result
+	}
+	return results, nil
+}
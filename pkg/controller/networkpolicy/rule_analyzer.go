@@ -0,0 +1,805 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	utilip "antrea.io/antrea/pkg/util/ip"
+)
+
+const (
+	ruleAnalyzerName = "RuleAnalyzer"
+	// ruleAnalysisPeriod is the period at which the rules of Antrea-native policies are analyzed.
+	ruleAnalysisPeriod = time.Minute
+)
+
+var (
+	allIPv4 = &net.IPNet{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 32)}
+	allIPv6 = &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}
+)
+
+// RuleAnalysisFindingType is the type of an issue found with a rule of an Antrea-native policy.
+type RuleAnalysisFindingType string
+
+const (
+	// RuleShadowed means that the rule never matches any traffic, as it is covered by a
+	// rule enforced before it with a different action.
+	RuleShadowed RuleAnalysisFindingType = "Shadowed"
+	// RuleRedundant means that the rule never matches any traffic, as it is covered by a
+	// rule enforced before it with the same action. It can be removed.
+	RuleRedundant RuleAnalysisFindingType = "Redundant"
+	// RuleConflicting means that part of the traffic matched by the rule is matched by a
+	// rule of a Tier enforced before it, which allows the traffic dropped by the rule or
+	// the other way around.
+	RuleConflicting RuleAnalysisFindingType = "Conflicting"
+)
+
+// RuleAnalysisFinding is an issue found with a rule of an Antrea-native policy.
+type RuleAnalysisFinding struct {
+	Type RuleAnalysisFindingType
+	// Rule is the rule the issue is found with.
+	Rule *antreatypes.RuleInfo
+	// PrecedingRule is the rule enforced before Rule, which covers or conflicts with it.
+	PrecedingRule *antreatypes.RuleInfo
+}
+
+// RuleAnalysisQuerier handles requests for analyzing the rules of Antrea-native policies.
+type RuleAnalysisQuerier interface {
+	QueryRuleAnalysis() []*RuleAnalysisFinding
+}
+
+// RuleAnalyzer finds the rules of Antrea-native policies which are shadowed by, redundant
+// with, or conflicting with rules enforced before them. The analysis is based on the
+// current members of the groups selected by the rules, and is run periodically to keep
+// the findings reported in the statuses of the policies and of the ClusterGroups referenced
+// by their rules up to date.
+type RuleAnalyzer struct {
+	networkPolicyController *NetworkPolicyController
+
+	mutex sync.RWMutex
+	// findings are the findings of the latest analysis, keyed by the name of the
+	// internal NetworkPolicy of the rule they are found with.
+	findings map[string][]*RuleAnalysisFinding
+	// eventHandlers are called with the name of an internal NetworkPolicy when its
+	// findings change.
+	eventHandlers []func(policyName string)
+}
+
+// NewRuleAnalyzer returns a new *RuleAnalyzer.
+func NewRuleAnalyzer(networkPolicyController *NetworkPolicyController) *RuleAnalyzer {
+	return &RuleAnalyzer{
+		networkPolicyController: networkPolicyController,
+		findings:                map[string][]*RuleAnalysisFinding{},
+	}
+}
+
+// AddEventHandler registers a handler called with the name of an internal NetworkPolicy
+// when its findings change.
+func (a *RuleAnalyzer) AddEventHandler(handler func(policyName string)) {
+	a.eventHandlers = append(a.eventHandlers, handler)
+}
+
+// Run analyzes the rules periodically until stopCh is closed.
+func (a *RuleAnalyzer) Run(stopCh <-chan struct{}) {
+	klog.Infof("Starting %s", ruleAnalyzerName)
+	defer klog.Infof("Shutting down %s", ruleAnalyzerName)
+
+	n := a.networkPolicyController
+	if !cache.WaitForNamedCacheSync(ruleAnalyzerName, stopCh, n.groupingInterfaceSynced, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced) {
+		return
+	}
+	wait.Until(func() {
+		findings, groupFindings := a.analyze()
+		a.updateFindings(findings)
+		a.updateClusterGroupStatuses(groupFindings)
+	}, ruleAnalysisPeriod, stopCh)
+}
+
+func (a *RuleAnalyzer) updateFindings(findings map[string][]*RuleAnalysisFinding) {
+	a.mutex.Lock()
+	var updated []string
+	for name, policyFindings := range findings {
+		if !reflect.DeepEqual(a.findings[name], policyFindings) {
+			updated = append(updated, name)
+		}
+	}
+	for name := range a.findings {
+		if _, exists := findings[name]; !exists {
+			updated = append(updated, name)
+		}
+	}
+	a.findings = findings
+	a.mutex.Unlock()
+
+	for _, name := range updated {
+		for _, handler := range a.eventHandlers {
+			handler(name)
+		}
+	}
+}
+
+// updateClusterGroupStatuses reports the findings for the rules referencing each ClusterGroup
+// in its status. The statuses are compared with the latest findings at every analysis, so
+// that a failed update is retried at the next one.
+func (a *RuleAnalyzer) updateClusterGroupStatuses(groupFindings map[string][]*RuleAnalysisFinding) {
+	n := a.networkPolicyController
+	cgs, err := n.cgLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list ClusterGroups")
+		return
+	}
+	for _, cg := range cgs {
+		condition := generateGroupRuleAnalysisCondition(groupFindings[cg.Name])
+		var conditions []crdv1beta1.GroupCondition
+		var existing *crdv1beta1.GroupCondition
+		for i := range cg.Status.Conditions {
+			if cg.Status.Conditions[i].Type == crdv1beta1.GroupRuleConflict {
+				existing = &cg.Status.Conditions[i]
+				continue
+			}
+			conditions = append(conditions, cg.Status.Conditions[i])
+		}
+		if (existing == nil && condition == nil) || (existing != nil && condition != nil &&
+			existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message) {
+			continue
+		}
+		if condition != nil {
+			conditions = append(conditions, *condition)
+		}
+		toUpdate := cg.DeepCopy()
+		toUpdate.Status.Conditions = conditions
+		klog.V(4).InfoS("Updating rule analysis condition of ClusterGroup", "ClusterGroup", cg.Name, "condition", condition)
+		if _, err := n.crdClient.CrdV1beta1().ClusterGroups().UpdateStatus(context.TODO(), toUpdate, v1.UpdateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to update rule analysis condition of ClusterGroup", "ClusterGroup", cg.Name)
+		}
+	}
+}
+
+// getPolicyFindings returns the findings of the latest analysis for the rules of an
+// internal NetworkPolicy.
+func (a *RuleAnalyzer) getPolicyFindings(policyName string) []*RuleAnalysisFinding {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.findings[policyName]
+}
+
+// QueryRuleAnalysis analyzes the current rules and returns all the findings.
+func (a *RuleAnalyzer) QueryRuleAnalysis() []*RuleAnalysisFinding {
+	findings, _ := a.analyze()
+	var all []*RuleAnalysisFinding
+	for _, policyFindings := range findings {
+		all = append(all, policyFindings...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return lessRuleInfo(all[i].Rule, all[j].Rule)
+	})
+	return all
+}
+
+func lessRuleInfo(r1, r2 *antreatypes.RuleInfo) bool {
+	if r1.Policy.Name != r2.Policy.Name {
+		return r1.Policy.Name < r2.Policy.Name
+	}
+	if r1.Rule.Direction != r2.Rule.Direction {
+		return r1.Rule.Direction < r2.Rule.Direction
+	}
+	return r1.Index < r2.Index
+}
+
+// analyzedIPBlock is an IPBlock of a rule peer.
+type analyzedIPBlock struct {
+	cidr   *net.IPNet
+	except []*net.IPNet
+}
+
+// analyzedPeer is the traffic source or destination of a rule, resolved with the current
+// group members.
+type analyzedPeer struct {
+	members  controlplane.GroupMemberSet
+	ipBlocks []analyzedIPBlock
+	// unresolved is true if the peer selects FQDNs, Services or label identities, which
+	// cannot be compared with IP addresses.
+	unresolved bool
+}
+
+// analyzedRule is a rule of an Antrea-native policy, resolved with the current group
+// members.
+type analyzedRule struct {
+	info           *antreatypes.RuleInfo
+	services       []controlplane.Service
+	tierPriority   int32
+	policyPriority float64
+	appliedTo      controlplane.GroupMemberSet
+	peer           analyzedPeer
+	// scheduled is true if the policy of the rule has Schedules. As it is not always
+	// enforced, it cannot make the rules enforced after it ineffective.
+	scheduled bool
+	// groups are the keys of the ClusterGroups and Groups referenced by the rule, in its
+	// appliedTo or its peer.
+	groups sets.Set[string]
+}
+
+func toNetIPNet(ipNet controlplane.IPNet) *net.IPNet {
+	ip := net.IP(ipNet.IP)
+	bits := 8 * net.IPv4len
+	if ip.To4() == nil {
+		bits = 8 * net.IPv6len
+	} else {
+		ip = ip.To4()
+	}
+	mask := net.CIDRMask(int(ipNet.PrefixLength), bits)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+func ipNetsOverlap(ipNet1, ipNet2 *net.IPNet) bool {
+	return utilip.IPNetContains(ipNet1, ipNet2) || utilip.IPNetContains(ipNet2, ipNet1)
+}
+
+func anyIPNetContains(ipNets []*net.IPNet, ipNet *net.IPNet) bool {
+	for _, n := range ipNets {
+		if utilip.IPNetContains(n, ipNet) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *analyzedIPBlock) containsIP(ip net.IP) bool {
+	if !b.cidr.Contains(ip) {
+		return false
+	}
+	for _, except := range b.except {
+		if except.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// covers returns whether all the addresses of an IPBlock are in this IPBlock.
+func (b *analyzedIPBlock) covers(o *analyzedIPBlock) bool {
+	if !utilip.IPNetContains(b.cidr, o.cidr) {
+		return false
+	}
+	for _, except := range b.except {
+		if ipNetsOverlap(except, o.cidr) && !anyIPNetContains(o.except, except) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *analyzedIPBlock) overlaps(o *analyzedIPBlock) bool {
+	return ipNetsOverlap(b.cidr, o.cidr) && !anyIPNetContains(b.except, o.cidr) && !anyIPNetContains(o.except, b.cidr)
+}
+
+func (p *analyzedPeer) isEmpty() bool {
+	return len(p.members) == 0 && len(p.ipBlocks) == 0 && !p.unresolved
+}
+
+func (p *analyzedPeer) blocksCover(block *analyzedIPBlock) bool {
+	for i := range p.ipBlocks {
+		if p.ipBlocks[i].covers(block) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *analyzedPeer) matchesAllIPs() bool {
+	return p.blocksCover(&analyzedIPBlock{cidr: allIPv4}) && p.blocksCover(&analyzedIPBlock{cidr: allIPv6})
+}
+
+// containsMemberIPs returns whether all the IPs of a group member are in the IPBlocks of
+// the peer if all is true, or any of them if all is false.
+func (p *analyzedPeer) containsMemberIPs(member *controlplane.GroupMember, all bool) bool {
+	if len(member.IPs) == 0 {
+		return false
+	}
+	for _, ip := range member.IPs {
+		contained := false
+		for i := range p.ipBlocks {
+			if p.ipBlocks[i].containsIP(net.IP(ip)) {
+				contained = true
+				break
+			}
+		}
+		if contained != all {
+			return contained
+		}
+	}
+	return all
+}
+
+// covers returns whether all the traffic sources or destinations of a peer are matched
+// by this peer.
+func (p *analyzedPeer) covers(o *analyzedPeer) bool {
+	if o.unresolved && !p.matchesAllIPs() {
+		return false
+	}
+	for _, member := range o.members {
+		if !p.members.Has(member) && !p.containsMemberIPs(member, true) {
+			return false
+		}
+	}
+	for i := range o.ipBlocks {
+		if !p.blocksCover(&o.ipBlocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps returns whether some traffic sources or destinations are matched by both
+// peers.
+func (p *analyzedPeer) overlaps(o *analyzedPeer) bool {
+	if (p.unresolved && o.matchesAllIPs()) || (o.unresolved && p.matchesAllIPs()) {
+		return true
+	}
+	for _, member := range o.members {
+		if p.members.Has(member) || p.containsMemberIPs(member, false) {
+			return true
+		}
+	}
+	for _, member := range p.members {
+		if o.containsMemberIPs(member, false) {
+			return true
+		}
+	}
+	for i := range p.ipBlocks {
+		for j := range o.ipBlocks {
+			if p.ipBlocks[i].overlaps(&o.ipBlocks[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func serviceProtocol(service *controlplane.Service) controlplane.Protocol {
+	if service.Protocol != nil {
+		return *service.Protocol
+	}
+	return controlplane.ProtocolTCP
+}
+
+// portRangeCovers returns whether the port range [port2, endPort2] is in the port range
+// [port1, endPort1]. A nil port matches all ports.
+func portRangeCovers(port1 *intstr.IntOrString, endPort1 *int32, port2 *intstr.IntOrString, endPort2 *int32) bool {
+	if port1 == nil {
+		return true
+	}
+	if port2 == nil || port1.Type != port2.Type {
+		return false
+	}
+	if port1.Type == intstr.String {
+		return port1.StrVal == port2.StrVal
+	}
+	start1, end1 := portRange(port1.IntVal, endPort1)
+	start2, end2 := portRange(port2.IntVal, endPort2)
+	return start1 <= start2 && end2 <= end1
+}
+
+// portRangeOverlaps returns whether the port ranges [port1, endPort1] and
+// [port2, endPort2] have common ports. Named ports are only known to overlap with
+// the same named ports.
+func portRangeOverlaps(port1 *intstr.IntOrString, endPort1 *int32, port2 *intstr.IntOrString, endPort2 *int32) bool {
+	if port1 == nil || port2 == nil {
+		return true
+	}
+	if port1.Type != port2.Type {
+		return false
+	}
+	if port1.Type == intstr.String {
+		return port1.StrVal == port2.StrVal
+	}
+	start1, end1 := portRange(port1.IntVal, endPort1)
+	start2, end2 := portRange(port2.IntVal, endPort2)
+	return start1 <= end2 && start2 <= end1
+}
+
+func portRange(port int32, endPort *int32) (int32, int32) {
+	if endPort != nil {
+		return port, *endPort
+	}
+	return port, port
+}
+
+func int32PtrToIntOrString(v *int32) *intstr.IntOrString {
+	if v == nil {
+		return nil
+	}
+	port := intstr.FromInt32(*v)
+	return &port
+}
+
+// int32FieldCovers returns whether a field of a Service matching a value, e.g. the IGMP
+// type, matches all the traffic of another Service. A nil field matches all values.
+func int32FieldCovers(v1, v2 *int32) bool {
+	return v1 == nil || (v2 != nil && *v1 == *v2)
+}
+
+func int32FieldOverlaps(v1, v2 *int32) bool {
+	return v1 == nil || v2 == nil || *v1 == *v2
+}
+
+func serviceCovers(s1, s2 *controlplane.Service) bool {
+	return serviceProtocol(s1) == serviceProtocol(s2) &&
+		portRangeCovers(s1.Port, s1.EndPort, s2.Port, s2.EndPort) &&
+		portRangeCovers(int32PtrToIntOrString(s1.SrcPort), s1.SrcEndPort, int32PtrToIntOrString(s2.SrcPort), s2.SrcEndPort) &&
+		portRangeCovers(int32PtrToIntOrString(s1.ICMPType), s1.EndICMPType, int32PtrToIntOrString(s2.ICMPType), s2.EndICMPType) &&
+		portRangeCovers(int32PtrToIntOrString(s1.ICMPCode), s1.EndICMPCode, int32PtrToIntOrString(s2.ICMPCode), s2.EndICMPCode) &&
+		(s1.ICMPTypeName == "" || s1.ICMPTypeName == s2.ICMPTypeName) &&
+		int32FieldCovers(s1.IGMPType, s2.IGMPType) &&
+		(s1.GroupAddress == "" || s1.GroupAddress == s2.GroupAddress) &&
+		s1.IPv6ExtensionHeader == s2.IPv6ExtensionHeader
+}
+
+func serviceOverlaps(s1, s2 *controlplane.Service) bool {
+	return serviceProtocol(s1) == serviceProtocol(s2) &&
+		portRangeOverlaps(s1.Port, s1.EndPort, s2.Port, s2.EndPort) &&
+		portRangeOverlaps(int32PtrToIntOrString(s1.SrcPort), s1.SrcEndPort, int32PtrToIntOrString(s2.SrcPort), s2.SrcEndPort) &&
+		portRangeOverlaps(int32PtrToIntOrString(s1.ICMPType), s1.EndICMPType, int32PtrToIntOrString(s2.ICMPType), s2.EndICMPType) &&
+		portRangeOverlaps(int32PtrToIntOrString(s1.ICMPCode), s1.EndICMPCode, int32PtrToIntOrString(s2.ICMPCode), s2.EndICMPCode) &&
+		(s1.ICMPTypeName == "" || s2.ICMPTypeName == "" || s1.ICMPTypeName == s2.ICMPTypeName) &&
+		int32FieldOverlaps(s1.IGMPType, s2.IGMPType) &&
+		(s1.GroupAddress == "" || s2.GroupAddress == "" || s1.GroupAddress == s2.GroupAddress) &&
+		s1.IPv6ExtensionHeader == s2.IPv6ExtensionHeader
+}
+
+// servicesCover returns whether all the traffic matched by the Services of a rule is
+// matched by the Services of another rule. No Services match all traffic.
+func servicesCover(services1, services2 []controlplane.Service) bool {
+	if len(services1) == 0 {
+		return true
+	}
+	if len(services2) == 0 {
+		return false
+	}
+	for i := range services2 {
+		covered := false
+		for j := range services1 {
+			if serviceCovers(&services1[j], &services2[i]) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func servicesOverlap(services1, services2 []controlplane.Service) bool {
+	if len(services1) == 0 || len(services2) == 0 {
+		return true
+	}
+	for i := range services1 {
+		for j := range services2 {
+			if serviceOverlaps(&services1[i], &services2[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// precedes returns whether rule r1 is always enforced before rule r2.
+func (r1 *analyzedRule) precedes(r2 *analyzedRule) bool {
+	if r1.info.Policy == r2.info.Policy {
+		return r1.info.Rule.Priority < r2.info.Rule.Priority
+	}
+	if r1.tierPriority != r2.tierPriority {
+		return r1.tierPriority < r2.tierPriority
+	}
+	// The rules of different policies with the same priority are not ordered.
+	return r1.policyPriority < r2.policyPriority
+}
+
+// covers returns whether all the traffic matched by rule r2 is matched by rule r1.
+func (r1 *analyzedRule) covers(r2 *analyzedRule) bool {
+	return r1.appliedTo.IsSuperset(r2.appliedTo) && r1.peer.covers(&r2.peer) && servicesCover(r1.services, r2.services)
+}
+
+func (r1 *analyzedRule) overlaps(r2 *analyzedRule) bool {
+	for _, member := range r2.appliedTo {
+		if r1.appliedTo.Has(member) {
+			return r1.peer.overlaps(&r2.peer) && servicesOverlap(r1.services, r2.services)
+		}
+	}
+	return false
+}
+
+func isAllowAction(action crdv1beta1.RuleAction) bool {
+	// Traffic matching a RateLimit rule is allowed up to the rate of the rule.
+	return action == crdv1beta1.RuleActionAllow || action == crdv1beta1.RuleActionRateLimit
+}
+
+func isDropAction(action crdv1beta1.RuleAction) bool {
+	return action == crdv1beta1.RuleActionDrop || action == crdv1beta1.RuleActionReject
+}
+
+// analyzeRule returns the finding for rule r2, given the rules enforced before it.
+func analyzeRule(r2 *analyzedRule, precedingRules []*analyzedRule) *RuleAnalysisFinding {
+	action2 := *r2.info.Rule.Action
+	var conflicting *analyzedRule
+	for _, r1 := range precedingRules {
+		if r1.info.Rule.Direction != r2.info.Rule.Direction || r1.scheduled || !r1.precedes(r2) {
+			continue
+		}
+		action1 := *r1.info.Rule.Action
+		// Traffic matching a Pass rule is still evaluated by the rules of the Baseline Tier.
+		if action1 == crdv1beta1.RuleActionPass && r2.tierPriority == crdv1beta1.BaselineTierPriority && r1.tierPriority != r2.tierPriority {
+			continue
+		}
+		if r1.covers(r2) {
+			findingType := RuleShadowed
+			if action1 == action2 {
+				findingType = RuleRedundant
+			}
+			return &RuleAnalysisFinding{Type: findingType, Rule: r2.info, PrecedingRule: r1.info}
+		}
+		if conflicting == nil && r1.tierPriority != r2.tierPriority &&
+			((isAllowAction(action1) && isDropAction(action2)) || (isDropAction(action1) && isAllowAction(action2))) &&
+			r1.overlaps(r2) {
+			conflicting = r1
+		}
+	}
+	if conflicting != nil {
+		return &RuleAnalysisFinding{Type: RuleConflicting, Rule: r2.info, PrecedingRule: conflicting.info}
+	}
+	return nil
+}
+
+func (a *RuleAnalyzer) resolvePeer(peer *controlplane.NetworkPolicyPeer, groups sets.Set[string]) analyzedPeer {
+	n := a.networkPolicyController
+	resolved := analyzedPeer{
+		members:    controlplane.GroupMemberSet{},
+		unresolved: len(peer.FQDNs) > 0 || len(peer.ToServices) > 0 || len(peer.LabelIdentities) > 0,
+	}
+	for _, name := range peer.AddressGroups {
+		obj, found, _ := n.addressGroupStore.Get(name)
+		if found {
+			addressGroup := obj.(*antreatypes.AddressGroup)
+			resolved.members.Merge(addressGroup.GroupMembers)
+			if addressGroup.SourceGroup != "" {
+				groups.Insert(addressGroup.SourceGroup)
+			}
+		}
+	}
+	for _, ipBlock := range peer.IPBlocks {
+		block := analyzedIPBlock{cidr: toNetIPNet(ipBlock.CIDR)}
+		for _, except := range ipBlock.Except {
+			block.except = append(block.except, toNetIPNet(except))
+		}
+		resolved.ipBlocks = append(resolved.ipBlocks, block)
+	}
+	return resolved
+}
+
+func (a *RuleAnalyzer) resolveAppliedTo(appliedToGroups []string, groups sets.Set[string]) controlplane.GroupMemberSet {
+	n := a.networkPolicyController
+	members := controlplane.GroupMemberSet{}
+	for _, name := range appliedToGroups {
+		obj, found, _ := n.appliedToGroupStore.Get(name)
+		if !found {
+			continue
+		}
+		appliedToGroup := obj.(*antreatypes.AppliedToGroup)
+		for _, memberSet := range appliedToGroup.GroupMemberByNode {
+			members.Merge(memberSet)
+		}
+		if appliedToGroup.SourceGroup != "" {
+			groups.Insert(appliedToGroup.SourceGroup)
+		}
+	}
+	return members
+}
+
+// collectRules returns the rules of Antrea-native policies which can be analyzed, sorted
+// by the order in which they are enforced.
+func (a *RuleAnalyzer) collectRules() []*analyzedRule {
+	var rules []*analyzedRule
+	for _, obj := range a.networkPolicyController.internalNetworkPolicyStore.List() {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		// K8s NetworkPolicies are not ordered by priority, and policies in Audit mode do
+		// not enforce their rules.
+		if policy.TierPriority == nil || policy.Priority == nil || policy.SyncError != nil ||
+			policy.EnforcementMode == crdv1beta1.EnforcementModeAudit {
+			continue
+		}
+		indexes := map[controlplane.Direction]int32{}
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			index := indexes[rule.Direction]
+			indexes[rule.Direction]++
+			// L7 rules match traffic with application layer attributes which cannot
+			// be compared.
+			if rule.Action == nil || len(rule.L7Protocols) > 0 {
+				continue
+			}
+			appliedToGroups := rule.AppliedToGroups
+			if len(appliedToGroups) == 0 {
+				appliedToGroups = policy.AppliedToGroups
+			}
+			peer := &rule.From
+			if rule.Direction == controlplane.DirectionOut {
+				peer = &rule.To
+			}
+			groups := sets.New[string]()
+			analyzed := &analyzedRule{
+				info:           &antreatypes.RuleInfo{Policy: policy, Index: index, Rule: rule},
+				services:       rule.Services,
+				tierPriority:   *policy.TierPriority,
+				policyPriority: *policy.Priority,
+				appliedTo:      a.resolveAppliedTo(appliedToGroups, groups),
+				peer:           a.resolvePeer(peer, groups),
+				scheduled:      policy.ScheduleState != nil,
+				groups:         groups,
+			}
+			// Rules which currently select nothing are not reported.
+			if len(analyzed.appliedTo) == 0 || analyzed.peer.isEmpty() {
+				continue
+			}
+			rules = append(rules, analyzed)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		r1, r2 := rules[i], rules[j]
+		if r1.tierPriority != r2.tierPriority {
+			return r1.tierPriority < r2.tierPriority
+		}
+		if r1.policyPriority != r2.policyPriority {
+			return r1.policyPriority < r2.policyPriority
+		}
+		if r1.info.Policy.Name != r2.info.Policy.Name {
+			return r1.info.Policy.Name < r2.info.Policy.Name
+		}
+		return r1.info.Rule.Priority < r2.info.Rule.Priority
+	})
+	return rules
+}
+
+// analyze returns the findings for the current rules, keyed by the name of the internal
+// NetworkPolicy of the rule they are found with, and keyed by the ClusterGroups and Groups
+// referenced by the rule they are found with.
+func (a *RuleAnalyzer) analyze() (map[string][]*RuleAnalysisFinding, map[string][]*RuleAnalysisFinding) {
+	rules := a.collectRules()
+	findings := map[string][]*RuleAnalysisFinding{}
+	groupFindings := map[string][]*RuleAnalysisFinding{}
+	for i, rule := range rules {
+		if finding := analyzeRule(rule, rules[:i]); finding != nil {
+			findings[rule.info.Policy.Name] = append(findings[rule.info.Policy.Name], finding)
+			for group := range rule.groups {
+				groupFindings[group] = append(groupFindings[group], finding)
+			}
+		}
+	}
+	return findings, groupFindings
+}
+
+// RuleInfoString returns a human-readable reference to the rule of a policy. The rule is
+// referenced by its name, which is always set for the rules of Antrea-native policies, as
+// the index of an internal rule doesn't always match the position of the rule in the
+// policy: rules inactive according to their Schedules are not included in the internal
+// policy, and a rule applied per Namespace is expanded into one internal rule per
+// Namespace.
+func RuleInfoString(rule *antreatypes.RuleInfo) string {
+	direction := "ingress"
+	if rule.Rule.Direction == controlplane.DirectionOut {
+		direction = "egress"
+	}
+	if rule.Rule.Name == "" {
+		return fmt.Sprintf("%s rule %d", direction, rule.Index)
+	}
+	return fmt.Sprintf("%s rule %s", direction, rule.Rule.Name)
+}
+
+// ruleAnalysisReasonAndMessage returns the reason and the message of the condition reporting
+// findings. If withPolicy is true, the policy of each rule is included in the message.
+func ruleAnalysisReasonAndMessage(findings []*RuleAnalysisFinding, withPolicy bool) (string, string) {
+	reasons := map[RuleAnalysisFindingType]string{
+		RuleShadowed:    "RulesShadowed",
+		RuleRedundant:   "RulesRedundant",
+		RuleConflicting: "RulesConflicting",
+	}
+	reason := reasons[RuleConflicting]
+	messages := make([]string, 0, len(findings))
+	reported := sets.New[string]()
+	for _, finding := range findings {
+		if finding.Type == RuleShadowed || (finding.Type == RuleRedundant && reason == reasons[RuleConflicting]) {
+			reason = reasons[finding.Type]
+		}
+		verb := map[RuleAnalysisFindingType]string{
+			RuleShadowed:    "is shadowed by",
+			RuleRedundant:   "is redundant with",
+			RuleConflicting: "conflicts with",
+		}[finding.Type]
+		precedingRule := RuleInfoString(finding.PrecedingRule)
+		if withPolicy || finding.PrecedingRule.Policy != finding.Rule.Policy {
+			precedingRule += " of " + finding.PrecedingRule.Policy.SourceRef.ToString()
+		}
+		rule := RuleInfoString(finding.Rule)
+		if withPolicy {
+			rule += " of " + finding.Rule.Policy.SourceRef.ToString()
+		}
+		// The internal rules expanded from a rule applied per Namespace have the same
+		// name, and are only reported once.
+		ruleMessage := fmt.Sprintf("%s %s %s", rule, verb, precedingRule)
+		if reported.Has(ruleMessage) {
+			continue
+		}
+		reported.Insert(ruleMessage)
+		messages = append(messages, ruleMessage)
+	}
+	message := strings.Join(messages, "; ")
+	if len(message) > maxConditionMessageLength {
+		message = fmt.Sprintf("%s...", message[:maxConditionMessageLength])
+	}
+	return reason, message
+}
+
+// generateRuleAnalysisCondition generates the condition reporting the findings for the
+// rules of a policy. nil is returned if there is no finding.
+func generateRuleAnalysisCondition(findings []*RuleAnalysisFinding) *crdv1beta1.NetworkPolicyCondition {
+	if len(findings) == 0 {
+		return nil
+	}
+	reason, message := ruleAnalysisReasonAndMessage(findings, false)
+	return &crdv1beta1.NetworkPolicyCondition{
+		Type:               crdv1beta1.NetworkPolicyConditionRuleConflict,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// generateGroupRuleAnalysisCondition generates the condition reporting the findings for the
+// rules referencing a ClusterGroup. nil is returned if there is no finding.
+func generateGroupRuleAnalysisCondition(findings []*RuleAnalysisFinding) *crdv1beta1.GroupCondition {
+	if len(findings) == 0 {
+		return nil
+	}
+	reason, message := ruleAnalysisReasonAndMessage(findings, true)
+	return &crdv1beta1.GroupCondition{
+		Type:               crdv1beta1.GroupRuleConflict,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+var (
+	analyzedPodA = &controlplane.GroupMember{
+		Pod: &controlplane.PodReference{Namespace: "ns1", Name: "podA"},
+		IPs: []controlplane.IPAddress{controlplane.IPAddress(net.ParseIP("10.0.0.1"))},
+	}
+	analyzedPodB = &controlplane.GroupMember{
+		Pod: &controlplane.PodReference{Namespace: "ns1", Name: "podB"},
+		IPs: []controlplane.IPAddress{controlplane.IPAddress(net.ParseIP("10.0.1.1"))},
+	}
+)
+
+func newAnalyzedIPBlock(cidr string, excepts ...string) analyzedIPBlock {
+	_, ipNet, _ := net.ParseCIDR(cidr)
+	block := analyzedIPBlock{cidr: ipNet}
+	for _, except := range excepts {
+		_, exceptNet, _ := net.ParseCIDR(except)
+		block.except = append(block.except, exceptNet)
+	}
+	return block
+}
+
+type analyzedRuleBuilder struct {
+	rule *analyzedRule
+}
+
+func newAnalyzedRule(policyName string, tierPriority int32, policyPriority float64, action crdv1beta1.RuleAction) *analyzedRuleBuilder {
+	policy := &antreatypes.NetworkPolicy{
+		SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: policyName},
+		Name:      policyName,
+	}
+	return &analyzedRuleBuilder{rule: &analyzedRule{
+		info: &antreatypes.RuleInfo{
+			Policy: policy,
+			Rule:   &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Action: &action},
+		},
+		tierPriority:   tierPriority,
+		policyPriority: policyPriority,
+		appliedTo:      controlplane.NewGroupMemberSet(analyzedPodA),
+		peer:           analyzedPeer{members: controlplane.NewGroupMemberSet(analyzedPodB)},
+	}}
+}
+
+func (b *analyzedRuleBuilder) rulePriority(priority int32) *analyzedRuleBuilder {
+	b.rule.info.Rule.Priority = priority
+	return b
+}
+
+func (b *analyzedRuleBuilder) samePolicyAs(o *analyzedRule) *analyzedRuleBuilder {
+	b.rule.info.Policy = o.info.Policy
+	return b
+}
+
+func (b *analyzedRuleBuilder) appliedTo(members ...*controlplane.GroupMember) *analyzedRuleBuilder {
+	b.rule.appliedTo = controlplane.NewGroupMemberSet(members...)
+	return b
+}
+
+func (b *analyzedRuleBuilder) peer(peer analyzedPeer) *analyzedRuleBuilder {
+	b.rule.peer = peer
+	return b
+}
+
+func (b *analyzedRuleBuilder) services(services ...controlplane.Service) *analyzedRuleBuilder {
+	b.rule.services = services
+	return b
+}
+
+func (b *analyzedRuleBuilder) scheduled() *analyzedRuleBuilder {
+	b.rule.scheduled = true
+	return b
+}
+
+func TestAnalyzeRule(t *testing.T) {
+	protocolUDP := controlplane.ProtocolUDP
+	allowAll := newAnalyzedRule("acnp-allow", 1, 1, crdv1beta1.RuleActionAllow).rule
+	tests := []struct {
+		name            string
+		rule            *analyzedRule
+		precedingRules  []*analyzedRule
+		expectedType    RuleAnalysisFindingType
+		expectedRuleIdx int
+	}{
+		{
+			name:           "shadowed by a rule of a higher-priority policy",
+			rule:           newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).services(controlplane.Service{Protocol: &protocolTCP, Port: &int80}).rule,
+			precedingRules: []*analyzedRule{allowAll},
+			expectedType:   RuleShadowed,
+		},
+		{
+			name:           "redundant with a rule of the same policy",
+			rule:           newAnalyzedRule("", 1, 1, crdv1beta1.RuleActionAllow).samePolicyAs(allowAll).rulePriority(1).rule,
+			precedingRules: []*analyzedRule{allowAll},
+			expectedType:   RuleRedundant,
+		},
+		{
+			name:           "policies with the same priority are not ordered",
+			rule:           newAnalyzedRule("acnp-drop", 1, 1, crdv1beta1.RuleActionDrop).rule,
+			precedingRules: []*analyzedRule{allowAll},
+		},
+		{
+			name:           "preceding rule applied to fewer members",
+			rule:           newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).appliedTo(analyzedPodA, analyzedPodB).rule,
+			precedingRules: []*analyzedRule{allowAll},
+		},
+		{
+			name: "peer covered by IPBlock",
+			rule: newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).rule,
+			precedingRules: []*analyzedRule{
+				newAnalyzedRule("acnp-allow", 1, 1, crdv1beta1.RuleActionAllow).peer(analyzedPeer{ipBlocks: []analyzedIPBlock{newAnalyzedIPBlock("10.0.0.0/16")}}).rule,
+			},
+			expectedType: RuleShadowed,
+		},
+		{
+			name: "peer excepted from IPBlock",
+			rule: newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).rule,
+			precedingRules: []*analyzedRule{
+				newAnalyzedRule("acnp-allow", 1, 1, crdv1beta1.RuleActionAllow).peer(analyzedPeer{ipBlocks: []analyzedIPBlock{newAnalyzedIPBlock("10.0.0.0/16", "10.0.1.0/24")}}).rule,
+			},
+		},
+		{
+			name:           "preceding rule with Schedules",
+			rule:           newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).rule,
+			precedingRules: []*analyzedRule{newAnalyzedRule("acnp-allow", 1, 1, crdv1beta1.RuleActionAllow).scheduled().rule},
+		},
+		{
+			name: "Pass rule does not cover Baseline rules",
+			rule: newAnalyzedRule("acnp-baseline", crdv1beta1.BaselineTierPriority, 1, crdv1beta1.RuleActionDrop).rule,
+			precedingRules: []*analyzedRule{
+				newAnalyzedRule("acnp-pass", 1, 1, crdv1beta1.RuleActionPass).rule,
+			},
+		},
+		{
+			name: "conflicting with a rule of a higher-priority Tier",
+			rule: newAnalyzedRule("acnp-drop", 2, 1, crdv1beta1.RuleActionDrop).appliedTo(analyzedPodA, analyzedPodB).services(controlplane.Service{Protocol: &protocolTCP, Port: &int80}).rule,
+			precedingRules: []*analyzedRule{
+				newAnalyzedRule("acnp-udp", 1, 1, crdv1beta1.RuleActionAllow).services(controlplane.Service{Protocol: &protocolUDP}).rule,
+				allowAll,
+			},
+			expectedType:    RuleConflicting,
+			expectedRuleIdx: 1,
+		},
+		{
+			name: "overlap in the same Tier is not a conflict",
+			rule: newAnalyzedRule("acnp-drop", 1, 2, crdv1beta1.RuleActionDrop).appliedTo(analyzedPodA, analyzedPodB).rule,
+			precedingRules: []*analyzedRule{
+				allowAll,
+			},
+		},
+		{
+			name: "no overlap",
+			rule: newAnalyzedRule("acnp-drop", 2, 1, crdv1beta1.RuleActionDrop).services(controlplane.Service{Protocol: &protocolTCP, Port: &int81}).rule,
+			precedingRules: []*analyzedRule{
+				newAnalyzedRule("acnp-allow", 1, 1, crdv1beta1.RuleActionAllow).services(controlplane.Service{Protocol: &protocolTCP, Port: &int80}).rule,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finding := analyzeRule(tt.rule, tt.precedingRules)
+			if tt.expectedType == "" {
+				assert.Nil(t, finding)
+				return
+			}
+			require.NotNil(t, finding)
+			assert.Equal(t, tt.expectedType, finding.Type)
+			assert.Same(t, tt.rule.info, finding.Rule)
+			assert.Same(t, tt.precedingRules[tt.expectedRuleIdx].info, finding.PrecedingRule)
+		})
+	}
+}
+
+func TestServicesCover(t *testing.T) {
+	protocolUDP := controlplane.ProtocolUDP
+	tests := []struct {
+		name      string
+		services1 []controlplane.Service
+		services2 []controlplane.Service
+		covers    bool
+		overlaps  bool
+	}{
+		{
+			name:      "no services",
+			services2: []controlplane.Service{{Protocol: &protocolTCP, Port: &int80}},
+			covers:    true,
+			overlaps:  true,
+		},
+		{
+			name:      "all ports of a protocol",
+			services1: []controlplane.Service{{Protocol: &protocolTCP, Port: &int80}},
+			services2: []controlplane.Service{{Protocol: &protocolTCP}},
+			covers:    false,
+			overlaps:  true,
+		},
+		{
+			name:      "port range",
+			services1: []controlplane.Service{{Protocol: &protocolTCP, Port: &int80, EndPort: &int32For1999}},
+			services2: []controlplane.Service{{Port: &int81}},
+			covers:    true,
+			overlaps:  true,
+		},
+		{
+			name:      "different protocols",
+			services1: []controlplane.Service{{Protocol: &protocolUDP}},
+			services2: []controlplane.Service{{Protocol: &protocolTCP}},
+			covers:    false,
+			overlaps:  false,
+		},
+		{
+			name:      "named port and numbered port",
+			services1: []controlplane.Service{{Protocol: &protocolTCP, Port: &strHTTP}},
+			services2: []controlplane.Service{{Protocol: &protocolTCP, Port: &int80}},
+			covers:    false,
+			overlaps:  false,
+		},
+		{
+			name:      "same named port",
+			services1: []controlplane.Service{{Protocol: &protocolTCP, Port: &strHTTP}},
+			services2: []controlplane.Service{{Protocol: &protocolTCP, Port: &strHTTP}},
+			covers:    true,
+			overlaps:  true,
+		}, {
+			name:      "ICMP type range",
+			services1: []controlplane.Service{{Protocol: &protocolICMP, ICMPType: &icmpType3, EndICMPType: &icmpType8}},
+			services2: []controlplane.Service{{Protocol: &protocolICMP, ICMPType: &icmpType5, ICMPCode: &icmpCode0}},
+			covers:    true,
+			overlaps:  true,
+		},
+		{
+			name:      "different ICMP type names",
+			services1: []controlplane.Service{{Protocol: &protocolICMP, ICMPTypeName: crdv1beta1.ICMPTypeRedirect}},
+			services2: []controlplane.Service{{Protocol: &protocolICMP, ICMPTypeName: crdv1beta1.ICMPTypeEchoRequest}},
+			covers:    false,
+			overlaps:  false,
+		},
+		{
+			name:      "same IPv6 extension headers",
+			services1: []controlplane.Service{{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderESP}},
+			services2: []controlplane.Service{{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderESP}},
+			covers:    true,
+			overlaps:  true,
+		},
+		{
+			name:      "different IPv6 extension headers",
+			services1: []controlplane.Service{{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderESP}},
+			services2: []controlplane.Service{{Protocol: &protocolIPv6ExtensionHeader, IPv6ExtensionHeader: crdv1beta1.IPv6ExtensionHeaderShim6}},
+			covers:    false,
+			overlaps:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.covers, servicesCover(tt.services1, tt.services2))
+			assert.Equal(t, tt.overlaps, servicesOverlap(tt.services1, tt.services2))
+		})
+	}
+}
+
+func TestAnalyzedPeerCovers(t *testing.T) {
+	matchAll := analyzedPeer{ipBlocks: []analyzedIPBlock{newAnalyzedIPBlock("0.0.0.0/0"), newAnalyzedIPBlock("::/0")}}
+	fqdnPeer := analyzedPeer{unresolved: true}
+	podPeer := analyzedPeer{members: controlplane.NewGroupMemberSet(analyzedPodA)}
+	cidrPeer := analyzedPeer{ipBlocks: []analyzedIPBlock{newAnalyzedIPBlock("10.0.0.0/24")}}
+	assert.True(t, matchAll.covers(&fqdnPeer))
+	assert.False(t, cidrPeer.covers(&fqdnPeer))
+	assert.True(t, cidrPeer.covers(&podPeer))
+	assert.False(t, podPeer.covers(&cidrPeer))
+	assert.True(t, podPeer.overlaps(&cidrPeer))
+	assert.True(t, fqdnPeer.overlaps(&matchAll))
+	assert.False(t, fqdnPeer.overlaps(&cidrPeer))
+}
+
+func TestGenerateRuleAnalysisCondition(t *testing.T) {
+	assert.Nil(t, generateRuleAnalysisCondition(nil))
+
+	policy1 := &antreatypes.NetworkPolicy{SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"}}
+	policy2 := &antreatypes.NetworkPolicy{SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp2"}}
+	findings := []*RuleAnalysisFinding{
+		{
+			Type:          RuleConflicting,
+			Rule:          &antreatypes.RuleInfo{Policy: policy2, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "drop-web"}},
+			PrecedingRule: &antreatypes.RuleInfo{Policy: policy1, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "allow-web"}},
+		},
+		// The internal rules expanded from a rule applied per Namespace are reported once.
+		{
+			Type:          RuleRedundant,
+			Rule:          &antreatypes.RuleInfo{Policy: policy2, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "allow-dns-ns"}},
+			PrecedingRule: &antreatypes.RuleInfo{Policy: policy2, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "allow-dns"}},
+		},
+		{
+			Type:          RuleRedundant,
+			Rule:          &antreatypes.RuleInfo{Policy: policy2, Index: 2, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "allow-dns-ns"}},
+			PrecedingRule: &antreatypes.RuleInfo{Policy: policy2, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "allow-dns"}},
+		},
+	}
+	condition := generateRuleAnalysisCondition(findings)
+	require.NotNil(t, condition)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionRuleConflict, condition.Type)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "RulesRedundant", condition.Reason)
+	assert.Equal(t, "ingress rule drop-web conflicts with ingress rule allow-web of AntreaClusterNetworkPolicy:acnp1; egress rule allow-dns-ns is redundant with egress rule allow-dns", condition.Message)
+
+	assert.Nil(t, generateGroupRuleAnalysisCondition(nil))
+	// The policies of the rules are included in the message for a ClusterGroup.
+	groupCondition := generateGroupRuleAnalysisCondition(findings[:1])
+	require.NotNil(t, groupCondition)
+	assert.Equal(t, crdv1beta1.GroupRuleConflict, groupCondition.Type)
+	assert.Equal(t, corev1.ConditionTrue, groupCondition.Status)
+	assert.Equal(t, "RulesConflicting", groupCondition.Reason)
+	assert.Equal(t, "ingress rule drop-web of AntreaClusterNetworkPolicy:acnp2 conflicts with ingress rule allow-web of AntreaClusterNetworkPolicy:acnp1", groupCondition.Message)
+}
+
+func TestUpdateClusterGroupStatuses(t *testing.T) {
+	membersComputed := crdv1beta1.GroupCondition{Type: crdv1beta1.GroupMembersComputed, Status: corev1.ConditionTrue}
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cg-web", UID: "uid-cg-web"},
+		Status:     crdv1beta1.GroupStatus{Conditions: []crdv1beta1.GroupCondition{membersComputed}},
+	}
+	_, c := newController(nil, []runtime.Object{cg})
+	c.cgStore.Add(cg)
+	analyzer := NewRuleAnalyzer(c.NetworkPolicyController)
+	getConditions := func() []crdv1beta1.GroupCondition {
+		updated, err := c.crdClient.CrdV1beta1().ClusterGroups().Get(context.TODO(), cg.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		c.cgStore.Update(updated)
+		for i := range updated.Status.Conditions {
+			updated.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+		return updated.Status.Conditions
+	}
+
+	policy := &antreatypes.NetworkPolicy{SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"}}
+	findings := []*RuleAnalysisFinding{{
+		Type:          RuleShadowed,
+		Rule:          &antreatypes.RuleInfo{Policy: policy, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "drop-web"}},
+		PrecedingRule: &antreatypes.RuleInfo{Policy: policy, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "allow-web"}},
+	}}
+	ruleConflict := crdv1beta1.GroupCondition{
+		Type:    crdv1beta1.GroupRuleConflict,
+		Status:  corev1.ConditionTrue,
+		Reason:  "RulesShadowed",
+		Message: "ingress rule drop-web of AntreaClusterNetworkPolicy:acnp1 is shadowed by ingress rule allow-web of AntreaClusterNetworkPolicy:acnp1",
+	}
+	analyzer.updateClusterGroupStatuses(map[string][]*RuleAnalysisFinding{cg.Name: findings})
+	assert.Equal(t, []crdv1beta1.GroupCondition{membersComputed, ruleConflict}, getConditions())
+	// The condition is removed when there is no finding, and the other conditions are kept.
+	analyzer.updateClusterGroupStatuses(map[string][]*RuleAnalysisFinding{})
+	assert.Equal(t, []crdv1beta1.GroupCondition{membersComputed}, getConditions())
+}
+
+func TestQueryRuleAnalysis(t *testing.T) {
+	allowAction := crdv1beta1.RuleActionAllow
+	dropAction := crdv1beta1.RuleActionDrop
+	selectFooBar := &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+	newACNP := func(name string, priority float64, action *crdv1beta1.RuleAction, ports []crdv1beta1.NetworkPolicyPort) *crdv1beta1.ClusterNetworkPolicy {
+		return &crdv1beta1.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID("uid-" + name)},
+			Spec: crdv1beta1.ClusterNetworkPolicySpec{
+				Priority:  priority,
+				AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: selectFooBar}},
+				Ingress: []crdv1beta1.Rule{{
+					Action: action,
+					From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: selectFooBar, NamespaceSelector: &metav1.LabelSelector{}}},
+					Ports:  ports,
+				}},
+			},
+		}
+	}
+	acnpAllow := newACNP("acnp-allow", 1, &allowAction, nil)
+	acnpDrop := newACNP("acnp-drop", 2, &dropAction, []crdv1beta1.NetworkPolicyPort{{Protocol: &k8sProtocolTCP, Port: &int80}})
+	// The finding for the rule is also reported for the ClusterGroup referenced by the rule.
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cg-foo-bar", UID: "uid-cg-foo-bar"},
+		Spec:       crdv1beta1.GroupSpec{PodSelector: selectFooBar, NamespaceSelector: &metav1.LabelSelector{}},
+	}
+	acnpDrop.Spec.Ingress[0].From = []crdv1beta1.NetworkPolicyPeer{{Group: cg.Name}}
+	eq := makeControllerAndEndpointQuerierWithCRDs(append([]runtime.Object{namespaces[0]}, podsWithIPs(pods[0], pods[1])...), []runtime.Object{acnpAllow, acnpDrop, cg})
+	analyzer := NewRuleAnalyzer(eq.networkPolicyController)
+
+	findings := analyzer.QueryRuleAnalysis()
+	require.Len(t, findings, 1)
+	assert.Equal(t, RuleShadowed, findings[0].Type)
+	assert.Equal(t, acnpDrop.Name, findings[0].Rule.Policy.SourceRef.Name)
+	assert.Equal(t, acnpAllow.Name, findings[0].PrecedingRule.Policy.SourceRef.Name)
+
+	var updatedPolicies []string
+	analyzer.AddEventHandler(func(policyName string) {
+		updatedPolicies = append(updatedPolicies, policyName)
+	})
+	policyFindings, groupFindings := analyzer.analyze()
+	assert.Equal(t, map[string][]*RuleAnalysisFinding{cg.Name: policyFindings[findings[0].Rule.Policy.Name]}, groupFindings)
+	analyzer.updateFindings(policyFindings)
+	assert.Equal(t, []string{findings[0].Rule.Policy.Name}, updatedPolicies)
+	assert.Len(t, analyzer.getPolicyFindings(findings[0].Rule.Policy.Name), 1)
+	// The handlers are not called again if the findings are unchanged.
+	policyFindings, _ = analyzer.analyze()
+	analyzer.updateFindings(policyFindings)
+	assert.Len(t, updatedPolicies, 1)
+}
@@ -0,0 +1,246 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database, as the antrea-controller image doesn't include
+	// it and time zones of Schedules are loaded when validating policies.
+	_ "time/tzdata"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/cron"
+)
+
+// maxScheduleSearchSteps bounds the number of windows examined when computing
+// the next transition of Schedules. It only matters for Schedules whose windows
+// keep overlapping, e.g. a window of 2h starting every hour. In that case, the
+// returned transition time is merely a point at which the Schedules need to be
+// evaluated again.
+const maxScheduleSearchSteps = 100
+
+// scheduleWindow is the parsed form of a crdv1beta1.PolicySchedule.
+type scheduleWindow struct {
+	schedule *cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+func parseSchedule(s *crdv1beta1.PolicySchedule) (*scheduleWindow, error) {
+	schedule, err := cron.Parse(s.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", s.Schedule, err)
+	}
+	if s.Duration.Duration <= 0 {
+		return nil, fmt.Errorf("invalid duration %s: must be positive", s.Duration.Duration)
+	}
+	location := time.UTC
+	if s.TimeZone != "" {
+		if location, err = time.LoadLocation(s.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid timeZone %q: %w", s.TimeZone, err)
+		}
+	}
+	return &scheduleWindow{schedule: schedule, duration: s.Duration.Duration, location: location}, nil
+}
+
+// stateAt returns whether the window is active at the provided time, and the
+// time at which this will change next. The returned time is zero if the state
+// is never expected to change.
+func (w *scheduleWindow) stateAt(t time.Time) (bool, time.Time) {
+	t = t.In(w.location)
+	// The window is active if it started in (t-duration, t].
+	start := w.schedule.Next(t.Add(-w.duration))
+	if start.IsZero() {
+		return false, time.Time{}
+	}
+	if start.After(t) {
+		return false, start
+	}
+	// Extend the end of the window as long as the following windows overlap
+	// with it.
+	end := start.Add(w.duration)
+	for i := 0; i < maxScheduleSearchSteps; i++ {
+		next := w.schedule.Next(start)
+		if next.IsZero() || next.After(end) {
+			break
+		}
+		start = next
+		if nextEnd := next.Add(w.duration); nextEnd.After(end) {
+			end = nextEnd
+		}
+	}
+	return true, end
+}
+
+// windowsStateAt returns whether any of the windows is active at the provided
+// time, and the time at which this will change next.
+func windowsStateAt(windows []*scheduleWindow, t time.Time) (bool, time.Time) {
+	union := func(t time.Time) (bool, time.Time) {
+		var active bool
+		var next time.Time
+		for _, w := range windows {
+			a, n := w.stateAt(t)
+			active = active || a
+			next = earliestTime(next, n)
+		}
+		return active, next
+	}
+	active, next := union(t)
+	// The state of the union may not change at the earliest transition of one
+	// window if another window is active at that time.
+	for i := 0; i < maxScheduleSearchSteps && !next.IsZero(); i++ {
+		a, n := union(next)
+		if a != active {
+			break
+		}
+		next = n
+	}
+	return active, next
+}
+
+// earliestTime returns the earlier of two times, ignoring zero values.
+func earliestTime(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// schedulesStateAt returns whether the provided Schedules are active at the
+// provided time, and the time at which this will change next. Invalid
+// Schedules, which should have been rejected by validation, never activate.
+func schedulesStateAt(schedules []crdv1beta1.PolicySchedule, t time.Time) (bool, time.Time) {
+	windows := make([]*scheduleWindow, 0, len(schedules))
+	for i := range schedules {
+		w, err := parseSchedule(&schedules[i])
+		if err != nil {
+			klog.ErrorS(err, "Ignoring invalid policy schedule")
+			continue
+		}
+		windows = append(windows, w)
+	}
+	return windowsStateAt(windows, t)
+}
+
+// policyScheduleState tracks the activation state of an Antrea-native policy
+// and its rules. A nil *policyScheduleState means neither the policy nor any
+// of its rules has Schedules, i.e. everything is always active.
+type policyScheduleState struct {
+	policyActive  bool
+	inactiveRules map[controlplane.Direction]sets.Set[int]
+	state         antreatypes.ScheduleState
+}
+
+// evaluateSchedules computes the activation state of an Antrea-native policy
+// and its rules at the provided time.
+func evaluateSchedules(schedules []crdv1beta1.PolicySchedule, ingress, egress []crdv1beta1.Rule, now func() time.Time) *policyScheduleState {
+	hasRuleSchedules := false
+	for _, rules := range [][]crdv1beta1.Rule{ingress, egress} {
+		for _, rule := range rules {
+			if len(rule.Schedules) > 0 {
+				hasRuleSchedules = true
+			}
+		}
+	}
+	if len(schedules) == 0 && !hasRuleSchedules {
+		return nil
+	}
+	t := now()
+	s := &policyScheduleState{
+		policyActive: true,
+		inactiveRules: map[controlplane.Direction]sets.Set[int]{
+			controlplane.DirectionIn:  sets.New[int](),
+			controlplane.DirectionOut: sets.New[int](),
+		},
+	}
+	var next time.Time
+	if len(schedules) > 0 {
+		s.policyActive, next = schedulesStateAt(schedules, t)
+	}
+	var activeRules int32
+	evaluateRules := func(rules []crdv1beta1.Rule, direction controlplane.Direction) {
+		for idx, rule := range rules {
+			if len(rule.Schedules) == 0 {
+				continue
+			}
+			active, ruleNext := schedulesStateAt(rule.Schedules, t)
+			next = earliestTime(next, ruleNext)
+			if active {
+				activeRules++
+			} else {
+				s.inactiveRules[direction].Insert(idx)
+			}
+		}
+	}
+	evaluateRules(ingress, controlplane.DirectionIn)
+	evaluateRules(egress, controlplane.DirectionOut)
+	if !s.policyActive {
+		activeRules = 0
+	}
+	s.state = antreatypes.ScheduleState{
+		Active:             s.policyActive,
+		ActiveRules:        activeRules,
+		NextTransitionTime: next,
+	}
+	// Without policy-level Schedules, the policy is considered active when any
+	// of its scheduled rules is active.
+	if len(schedules) == 0 {
+		s.state.Active = activeRules > 0
+	}
+	return s
+}
+
+// ruleActive returns whether the rule at the provided index of the provided
+// direction should be enforced.
+func (s *policyScheduleState) ruleActive(direction controlplane.Direction, idx int) bool {
+	if s == nil {
+		return true
+	}
+	return s.policyActive && !s.inactiveRules[direction].Has(idx)
+}
+
+// scheduleState returns the activation state to be stored in the internal
+// NetworkPolicy.
+func (s *policyScheduleState) scheduleState() *antreatypes.ScheduleState {
+	if s == nil {
+		return nil
+	}
+	state := s.state
+	return &state
+}
+
+// toNetworkPolicyScheduleStatus converts the activation state of an internal
+// NetworkPolicy to its representation in NetworkPolicyStatus.
+func toNetworkPolicyScheduleStatus(state *antreatypes.ScheduleState) *crdv1beta1.NetworkPolicyScheduleStatus {
+	if state == nil {
+		return nil
+	}
+	status := &crdv1beta1.NetworkPolicyScheduleStatus{
+		Active:      state.Active,
+		ActiveRules: state.ActiveRules,
+	}
+	if !state.NextTransitionTime.IsZero() {
+		nextTransitionTime := metav1.NewTime(state.NextTransitionTime).Rfc3339Copy()
+		status.NextTransitionTime = &nextTransitionTime
+	}
+	return status
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newPolicySchedule(schedule string, duration time.Duration, timeZone string) crdv1beta1.PolicySchedule {
+	return crdv1beta1.PolicySchedule{
+		Schedule: schedule,
+		Duration: metav1.Duration{Duration: duration},
+		TimeZone: timeZone,
+	}
+}
+
+func TestParseScheduleTimeZone(t *testing.T) {
+	// The time zone database is embedded in the binary, so time zones can be
+	// loaded even if it is not installed on the host.
+	tests := []struct {
+		name           string
+		timeZone       string
+		expectedOffset int
+		expectedErr    string
+	}{
+		{
+			name:           "default",
+			expectedOffset: 0,
+		},
+		{
+			name:           "time zone",
+			timeZone:       "Asia/Kolkata",
+			expectedOffset: 5*3600 + 30*60,
+		},
+		{
+			name:        "unknown time zone",
+			timeZone:    "Mars/Olympus_Mons",
+			expectedErr: `invalid timeZone "Mars/Olympus_Mons"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newPolicySchedule("0 0 * * *", time.Hour, tt.timeZone)
+			window, err := parseSchedule(&schedule)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			_, offset := time.Date(2024, 3, 9, 0, 0, 0, 0, window.location).Zone()
+			assert.Equal(t, tt.expectedOffset, offset)
+		})
+	}
+}
+
+func TestSchedulesStateAt(t *testing.T) {
+	// 2024-03-09 is a Saturday.
+	saturday := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	weekend := newPolicySchedule("0 0 * * sat", 48*time.Hour, "")
+	tests := []struct {
+		name           string
+		schedules      []crdv1beta1.PolicySchedule
+		now            time.Time
+		expectedActive bool
+		expectedNext   time.Time
+	}{
+		{
+			name:           "before window",
+			schedules:      []crdv1beta1.PolicySchedule{weekend},
+			now:            saturday.Add(-time.Hour),
+			expectedActive: false,
+			expectedNext:   saturday,
+		},
+		{
+			name:           "start of window",
+			schedules:      []crdv1beta1.PolicySchedule{weekend},
+			now:            saturday,
+			expectedActive: true,
+			expectedNext:   saturday.Add(48 * time.Hour),
+		},
+		{
+			name:           "end of window",
+			schedules:      []crdv1beta1.PolicySchedule{weekend},
+			now:            saturday.Add(48 * time.Hour),
+			expectedActive: false,
+			expectedNext:   saturday.Add(7 * 24 * time.Hour),
+		},
+		{
+			name:           "time zone",
+			schedules:      []crdv1beta1.PolicySchedule{newPolicySchedule("0 0 * * sat", 48*time.Hour, "Asia/Shanghai")},
+			now:            saturday.Add(-time.Hour),
+			expectedActive: true,
+			expectedNext:   saturday.Add(40 * time.Hour),
+		},
+		{
+			name: "adjacent windows of different schedules",
+			schedules: []crdv1beta1.PolicySchedule{
+				newPolicySchedule("0 8 * * *", 4*time.Hour, ""),
+				newPolicySchedule("0 12 * * *", 2*time.Hour, ""),
+			},
+			now:            saturday.Add(9 * time.Hour),
+			expectedActive: true,
+			expectedNext:   saturday.Add(14 * time.Hour),
+		},
+		{
+			name:           "never",
+			schedules:      []crdv1beta1.PolicySchedule{newPolicySchedule("0 0 30 2 *", time.Hour, "")},
+			now:            saturday,
+			expectedActive: false,
+		},
+		{
+			name:           "invalid",
+			schedules:      []crdv1beta1.PolicySchedule{newPolicySchedule("0 0 * *", time.Hour, "")},
+			now:            saturday,
+			expectedActive: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active, next := schedulesStateAt(tt.schedules, tt.now)
+			assert.Equal(t, tt.expectedActive, active)
+			assert.True(t, tt.expectedNext.Equal(next), "expected next transition %v, got %v", tt.expectedNext, next)
+		})
+	}
+}
+
+func TestSchedulesStateAtWithOverlappingWindows(t *testing.T) {
+	now := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	// The windows never end, the returned time is only a point of re-evaluation.
+	active, next := schedulesStateAt([]crdv1beta1.PolicySchedule{newPolicySchedule("0 * * * *", 90*time.Minute, "")}, now)
+	assert.True(t, active)
+	assert.True(t, next.After(now.Add(maxScheduleSearchSteps*time.Hour)))
+}
+
+func TestEvaluateSchedules(t *testing.T) {
+	now := time.Date(2024, 3, 9, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	activeSchedule := newPolicySchedule("0 9 * * *", 2*time.Hour, "")
+	inactiveSchedule := newPolicySchedule("0 12 * * *", time.Hour, "")
+	tests := []struct {
+		name            string
+		schedules       []crdv1beta1.PolicySchedule
+		ingress         []crdv1beta1.Rule
+		egress          []crdv1beta1.Rule
+		expectedState   *antreatypes.ScheduleState
+		expectedIngress []bool
+		expectedEgress  []bool
+	}{
+		{
+			name:            "no schedules",
+			ingress:         []crdv1beta1.Rule{{}},
+			expectedIngress: []bool{true},
+		},
+		{
+			name:      "inactive policy",
+			schedules: []crdv1beta1.PolicySchedule{inactiveSchedule},
+			ingress:   []crdv1beta1.Rule{{}, {Schedules: []crdv1beta1.PolicySchedule{activeSchedule}}},
+			expectedState: &antreatypes.ScheduleState{
+				Active:             false,
+				ActiveRules:        0,
+				NextTransitionTime: now.Add(time.Hour),
+			},
+			expectedIngress: []bool{false, false},
+		},
+		{
+			name:      "active policy with inactive rule",
+			schedules: []crdv1beta1.PolicySchedule{activeSchedule},
+			ingress:   []crdv1beta1.Rule{{}},
+			egress:    []crdv1beta1.Rule{{Schedules: []crdv1beta1.PolicySchedule{inactiveSchedule}}},
+			expectedState: &antreatypes.ScheduleState{
+				Active:             true,
+				ActiveRules:        0,
+				NextTransitionTime: now.Add(time.Hour),
+			},
+			expectedIngress: []bool{true},
+			expectedEgress:  []bool{false},
+		},
+		{
+			name:    "rule schedules only",
+			ingress: []crdv1beta1.Rule{{Schedules: []crdv1beta1.PolicySchedule{inactiveSchedule}}, {}},
+			egress:  []crdv1beta1.Rule{{Schedules: []crdv1beta1.PolicySchedule{activeSchedule}}},
+			expectedState: &antreatypes.ScheduleState{
+				Active:             true,
+				ActiveRules:        1,
+				NextTransitionTime: now.Add(time.Hour),
+			},
+			expectedIngress: []bool{false, true},
+			expectedEgress:  []bool{true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := evaluateSchedules(tt.schedules, tt.ingress, tt.egress, clock)
+			assert.Equal(t, tt.expectedState, s.scheduleState())
+			for idx, expected := range tt.expectedIngress {
+				assert.Equal(t, expected, s.ruleActive(controlplane.DirectionIn, idx), "ingress rule %d", idx)
+			}
+			for idx, expected := range tt.expectedEgress {
+				assert.Equal(t, expected, s.ruleActive(controlplane.DirectionOut, idx), "egress rule %d", idx)
+			}
+		})
+	}
+}
+
+func TestProcessScheduledClusterNetworkPolicy(t *testing.T) {
+	allowAction := crdv1beta1.RuleActionAllow
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	windowStart := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	cnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpA", UID: "uidA"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &selectorA}},
+			Priority:  10,
+			Ingress: []crdv1beta1.Rule{
+				{
+					From:   []crdv1beta1.NetworkPolicyPeer{{PodSelector: &selectorB}},
+					Action: &allowAction,
+				},
+			},
+			Schedules: []crdv1beta1.PolicySchedule{newPolicySchedule("0 0 * * sat", 48*time.Hour, "")},
+		},
+	}
+	_, c := newController(nil, nil)
+	fakeClock := clocktesting.NewFakeClock(windowStart.Add(-time.Hour))
+	c.clock = fakeClock
+
+	policy, _, addressGroups := c.processClusterNetworkPolicy(cnp)
+	assert.Empty(t, policy.Rules)
+	assert.Empty(t, addressGroups)
+	require.NotNil(t, policy.ScheduleState)
+	assert.False(t, policy.ScheduleState.Active)
+	assert.Equal(t, windowStart, policy.ScheduleState.NextTransitionTime)
+
+	fakeClock.SetTime(windowStart)
+	policy, _, addressGroups = c.processClusterNetworkPolicy(cnp)
+	assert.Len(t, policy.Rules, 1)
+	assert.Len(t, addressGroups, 1)
+	require.NotNil(t, policy.ScheduleState)
+	assert.True(t, policy.ScheduleState.Active)
+	assert.Equal(t, windowStart.Add(48*time.Hour), policy.ScheduleState.NextTransitionTime)
+}
+
+func TestToNetworkPolicyScheduleStatus(t *testing.T) {
+	next := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, toNetworkPolicyScheduleStatus(nil))
+	status := toNetworkPolicyScheduleStatus(&antreatypes.ScheduleState{Active: true, ActiveRules: 2, NextTransitionTime: next})
+	require.NotNil(t, status.NextTransitionTime)
+	assert.True(t, status.Active)
+	assert.Equal(t, int32(2), status.ActiveRules)
+	assert.True(t, next.Equal(status.NextTransitionTime.Time))
+	assert.Nil(t, toNetworkPolicyScheduleStatus(&antreatypes.ScheduleState{}).NextTransitionTime)
+}
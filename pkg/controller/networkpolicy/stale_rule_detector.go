@@ -0,0 +1,220 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/metrics"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const (
+	staleRuleDetectorName = "StaleRuleDetector"
+	// staleRuleDetectionPeriod is the period at which the rules of Antrea-native policies are
+	// checked for staleness.
+	staleRuleDetectionPeriod = time.Minute
+)
+
+// RuleHitQuerier provides the last time the rules of Antrea-native policies matched traffic.
+type RuleHitQuerier interface {
+	GetRuleLastHitTime(policyUID types.UID, ruleName string) (time.Time, bool)
+}
+
+// policyStaleRules is the stale rules of an Antrea-native policy.
+type policyStaleRules struct {
+	sourceRef *controlplane.NetworkPolicyReference
+	// rules are the human-readable references to the stale rules.
+	rules []string
+}
+
+// StaleRuleDetector finds the rules of Antrea-native policies which have not matched any
+// traffic for a configurable window, based on the rule statistics collected from the
+// antrea-agents. As the last hit times are not persisted, the window of a rule starts when the
+// rule is first observed after the antrea-controller starts.
+type StaleRuleDetector struct {
+	networkPolicyController *NetworkPolicyController
+	ruleHitQuerier          RuleHitQuerier
+	// window is the duration without any traffic matched after which a rule is stale.
+	window time.Duration
+	clock  clock.Clock
+
+	// firstSeenTimes are the times the rules are first observed, keyed by the name of the
+	// internal NetworkPolicy and the name of the rule. It's only accessed by the detection
+	// routine.
+	firstSeenTimes map[string]map[string]time.Time
+
+	mutex sync.RWMutex
+	// staleRules are the stale rules found by the latest detection, keyed by the name of the
+	// internal NetworkPolicy of the rules.
+	staleRules map[string]*policyStaleRules
+	// eventHandlers are called with the name of an internal NetworkPolicy when its stale
+	// rules change.
+	eventHandlers []func(policyName string)
+}
+
+// NewStaleRuleDetector returns a new *StaleRuleDetector.
+func NewStaleRuleDetector(networkPolicyController *NetworkPolicyController, ruleHitQuerier RuleHitQuerier, window time.Duration) *StaleRuleDetector {
+	return &StaleRuleDetector{
+		networkPolicyController: networkPolicyController,
+		ruleHitQuerier:          ruleHitQuerier,
+		window:                  window,
+		clock:                   clock.RealClock{},
+		firstSeenTimes:          map[string]map[string]time.Time{},
+		staleRules:              map[string]*policyStaleRules{},
+	}
+}
+
+// AddEventHandler registers a handler called with the name of an internal NetworkPolicy
+// when its stale rules change.
+func (d *StaleRuleDetector) AddEventHandler(handler func(policyName string)) {
+	d.eventHandlers = append(d.eventHandlers, handler)
+}
+
+// Run detects the stale rules periodically until stopCh is closed.
+func (d *StaleRuleDetector) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting "+staleRuleDetectorName, "window", d.window)
+	defer klog.Infof("Shutting down %s", staleRuleDetectorName)
+
+	n := d.networkPolicyController
+	if !cache.WaitForNamedCacheSync(staleRuleDetectorName, stopCh, n.acnpListerSynced, n.annpListerSynced) {
+		return
+	}
+	wait.Until(func() { d.updateStaleRules(d.detect()) }, staleRuleDetectionPeriod, stopCh)
+}
+
+// detect returns the current stale rules, keyed by the name of the internal NetworkPolicy of
+// the rules.
+func (d *StaleRuleDetector) detect() map[string]*policyStaleRules {
+	now := d.clock.Now()
+	staleRules := map[string]*policyStaleRules{}
+	firstSeenTimes := map[string]map[string]time.Time{}
+	for _, obj := range d.networkPolicyController.internalNetworkPolicyStore.List() {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		if !controlplane.IsSourceAntreaNativePolicy(policy.SourceRef) {
+			continue
+		}
+		ruleTimes := map[string]time.Time{}
+		firstSeenTimes[policy.Name] = ruleTimes
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			// The statistics of rules are reported by name. The internal rules expanded
+			// from a rule applied per Namespace have the same name, and are only checked
+			// once.
+			if _, checked := ruleTimes[rule.Name]; rule.Name == "" || checked {
+				continue
+			}
+			firstSeen, exists := d.firstSeenTimes[policy.Name][rule.Name]
+			if !exists {
+				firstSeen = now
+			}
+			ruleTimes[rule.Name] = firstSeen
+			since := firstSeen
+			if lastHit, hit := d.ruleHitQuerier.GetRuleLastHitTime(policy.SourceRef.UID, rule.Name); hit && lastHit.After(since) {
+				since = lastHit
+			}
+			if now.Sub(since) < d.window {
+				continue
+			}
+			if staleRules[policy.Name] == nil {
+				staleRules[policy.Name] = &policyStaleRules{sourceRef: policy.SourceRef}
+			}
+			ruleInfo := &antreatypes.RuleInfo{Policy: policy, Rule: rule}
+			staleRules[policy.Name].rules = append(staleRules[policy.Name].rules, RuleInfoString(ruleInfo))
+		}
+	}
+	d.firstSeenTimes = firstSeenTimes
+	return staleRules
+}
+
+func (d *StaleRuleDetector) updateStaleRules(staleRules map[string]*policyStaleRules) {
+	d.mutex.Lock()
+	var updated []string
+	for name, policyRules := range staleRules {
+		if oldRules, exists := d.staleRules[name]; !exists || !reflect.DeepEqual(oldRules.rules, policyRules.rules) {
+			updated = append(updated, name)
+		}
+		metrics.NetworkPolicyStaleRules.With(staleRuleMetricLabels(policyRules.sourceRef)).Set(float64(len(policyRules.rules)))
+	}
+	for name, policyRules := range d.staleRules {
+		if _, exists := staleRules[name]; !exists {
+			updated = append(updated, name)
+			metrics.NetworkPolicyStaleRules.Delete(staleRuleMetricLabels(policyRules.sourceRef))
+		}
+	}
+	d.staleRules = staleRules
+	d.mutex.Unlock()
+
+	for _, name := range updated {
+		for _, handler := range d.eventHandlers {
+			handler(name)
+		}
+	}
+}
+
+func staleRuleMetricLabels(sourceRef *controlplane.NetworkPolicyReference) map[string]string {
+	return map[string]string{
+		"policy_type":      string(sourceRef.Type),
+		"policy_namespace": sourceRef.Namespace,
+		"policy_name":      sourceRef.Name,
+	}
+}
+
+// getStaleRules returns the stale rules of an internal NetworkPolicy found by the latest
+// detection.
+func (d *StaleRuleDetector) getStaleRules(policyName string) []string {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	if policyRules, exists := d.staleRules[policyName]; exists {
+		return policyRules.rules
+	}
+	return nil
+}
+
+// generateStaleRuleCondition generates the condition reporting the stale rules of a policy.
+// nil is returned if there is no stale rule.
+func (d *StaleRuleDetector) generateStaleRuleCondition(staleRules []string) *crdv1beta1.NetworkPolicyCondition {
+	if len(staleRules) == 0 {
+		return nil
+	}
+	verb := "have"
+	if len(staleRules) == 1 {
+		verb = "has"
+	}
+	message := fmt.Sprintf("%s %s not matched any traffic for %s", strings.Join(staleRules, ", "), verb, d.window)
+	if len(message) > maxConditionMessageLength {
+		message = fmt.Sprintf("%s...", message[:maxConditionMessageLength])
+	}
+	return &crdv1beta1.NetworkPolicyCondition{
+		Type:               crdv1beta1.NetworkPolicyConditionStaleRule,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             "NoTrafficMatched",
+		Message:            message,
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+type fakeRuleHitQuerier struct {
+	lastHitTimes map[types.UID]map[string]time.Time
+}
+
+func (q *fakeRuleHitQuerier) GetRuleLastHitTime(policyUID types.UID, ruleName string) (time.Time, bool) {
+	hitTime, exists := q.lastHitTimes[policyUID][ruleName]
+	return hitTime, exists
+}
+
+func TestStaleRuleDetectorDetect(t *testing.T) {
+	networkPolicyStore := store.NewNetworkPolicyStore()
+	querier := &fakeRuleHitQuerier{lastHitTimes: map[types.UID]map[string]time.Time{}}
+	detector := NewStaleRuleDetector(&NetworkPolicyController{internalNetworkPolicyStore: networkPolicyStore}, querier, time.Hour)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	detector.clock = fakeClock
+	var updatedPolicies []string
+	detector.AddEventHandler(func(policyName string) {
+		updatedPolicies = append(updatedPolicies, policyName)
+	})
+
+	acnp := &antreatypes.NetworkPolicy{
+		Name:      "uid-acnp1",
+		SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1", UID: "uid-acnp1"},
+		Rules: []controlplane.NetworkPolicyRule{
+			{Direction: controlplane.DirectionIn, Name: "allow-web"},
+			{Direction: controlplane.DirectionIn, Name: "allow-db"},
+			// The internal rules expanded from a rule applied per Namespace have the same name.
+			{Direction: controlplane.DirectionIn, Name: "allow-db"},
+			{Direction: controlplane.DirectionOut, Name: "drop-all"},
+		},
+	}
+	knp := &antreatypes.NetworkPolicy{
+		Name:      "uid-knp1",
+		SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "knp1", UID: "uid-knp1"},
+		Rules:     []controlplane.NetworkPolicyRule{{Direction: controlplane.DirectionIn}},
+	}
+	networkPolicyStore.Create(acnp)
+	networkPolicyStore.Create(knp)
+
+	// The window of all rules starts when they are first observed.
+	detector.updateStaleRules(detector.detect())
+	assert.Empty(t, updatedPolicies)
+	assert.Nil(t, detector.getStaleRules(acnp.Name))
+
+	fakeClock.Step(30 * time.Minute)
+	querier.lastHitTimes["uid-acnp1"] = map[string]time.Time{"allow-web": fakeClock.Now()}
+	fakeClock.Step(40 * time.Minute)
+	detector.updateStaleRules(detector.detect())
+	assert.Equal(t, []string{acnp.Name}, updatedPolicies)
+	assert.Equal(t, []string{"ingress rule allow-db", "egress rule drop-all"}, detector.getStaleRules(acnp.Name))
+	assert.Nil(t, detector.getStaleRules(knp.Name))
+
+	// The handlers are not called again if the stale rules are unchanged.
+	detector.updateStaleRules(detector.detect())
+	assert.Len(t, updatedPolicies, 1)
+
+	querier.lastHitTimes["uid-acnp1"]["allow-db"] = fakeClock.Now()
+	detector.updateStaleRules(detector.detect())
+	assert.Equal(t, []string{acnp.Name, acnp.Name}, updatedPolicies)
+	assert.Equal(t, []string{"egress rule drop-all"}, detector.getStaleRules(acnp.Name))
+
+	// A rule added to the policy is not stale until the window elapses.
+	acnp.Rules = append(acnp.Rules, controlplane.NetworkPolicyRule{Direction: controlplane.DirectionOut, Name: "allow-dns"})
+	networkPolicyStore.Update(acnp)
+	detector.updateStaleRules(detector.detect())
+	assert.Equal(t, []string{"egress rule drop-all"}, detector.getStaleRules(acnp.Name))
+
+	networkPolicyStore.Delete(acnp.Name)
+	detector.updateStaleRules(detector.detect())
+	assert.Equal(t, []string{acnp.Name, acnp.Name, acnp.Name}, updatedPolicies)
+	assert.Nil(t, detector.getStaleRules(acnp.Name))
+	assert.Empty(t, detector.firstSeenTimes)
+}
+
+func TestGenerateStaleRuleCondition(t *testing.T) {
+	detector := NewStaleRuleDetector(nil, nil, 720*time.Hour)
+	assert.Nil(t, detector.generateStaleRuleCondition(nil))
+
+	condition := detector.generateStaleRuleCondition([]string{"ingress rule allow-db"})
+	require.NotNil(t, condition)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionStaleRule, condition.Type)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "NoTrafficMatched", condition.Reason)
+	assert.Equal(t, "ingress rule allow-db has not matched any traffic for 720h0m0s", condition.Message)
+
+	condition = detector.generateStaleRuleCondition([]string{"ingress rule allow-db", "egress rule drop-all"})
+	require.NotNil(t, condition)
+	assert.Equal(t, "ingress rule allow-db, egress rule drop-all have not matched any traffic for 720h0m0s", condition.Message)
+}
@@ -75,9 +75,16 @@ type StatusController struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+
+	// ruleAnalyzer provides the rules found shadowed, redundant or conflicting, which are reported as a condition.
+	// It can be nil.
+	ruleAnalyzer *RuleAnalyzer
+	// staleRuleDetector provides the rules which have not matched any traffic for a while, which are reported as a
+	// condition. It can be nil.
+	staleRuleDetector *StaleRuleDetector
 }
 
-func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *StatusController {
+func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, ruleAnalyzer *RuleAnalyzer, staleRuleDetector *StaleRuleDetector) *StatusController {
 	c := &StatusController{
 		npControlInterface: &networkPolicyControl{
 			antreaClient: antreaClient,
@@ -89,6 +96,8 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		ruleAnalyzer:               ruleAnalyzer,
+		staleRuleDetector:          staleRuleDetector,
 	}
 	// To save a "GET" query before each update, UpdateAntreaClusterNetworkPolicyStatus treats the cache of Lister as
 	// the state of kube-apiserver. In some cases the cache may not be in sync, then we might skip updating a policy's
@@ -109,6 +118,18 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		},
 		resyncPeriod,
 	)
+	if ruleAnalyzer != nil {
+		// Resync the status of a policy when the findings for its rules change.
+		ruleAnalyzer.AddEventHandler(func(policyName string) {
+			c.queue.Add(policyName)
+		})
+	}
+	if staleRuleDetector != nil {
+		// Resync the status of a policy when its stale rules change.
+		staleRuleDetector.AddEventHandler(func(policyName string) {
+			c.queue.Add(policyName)
+		})
+	}
 	return c
 }
 
@@ -279,6 +300,7 @@ func (c *StatusController) syncHandler(key string) error {
 			CurrentNodesRealized: int32(currentNodes),
 			DesiredNodesRealized: int32(desiredNodes),
 			Conditions:           conditions,
+			Schedule:             toNetworkPolicyScheduleStatus(internalNP.ScheduleState),
 		}
 		klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
@@ -288,6 +310,16 @@ func (c *StatusController) syncHandler(key string) error {
 	}
 
 	conditions := GenerateNetworkPolicyCondition(internalNP.SyncError)
+	if c.ruleAnalyzer != nil {
+		if condition := generateRuleAnalysisCondition(c.ruleAnalyzer.getPolicyFindings(key)); condition != nil {
+			conditions = append(conditions, *condition)
+		}
+	}
+	if c.staleRuleDetector != nil {
+		if condition := c.staleRuleDetector.generateStaleRuleCondition(c.staleRuleDetector.getStaleRules(key)); condition != nil {
+			conditions = append(conditions, *condition)
+		}
+	}
 	// It means the NetworkPolicy has been processed, and marked as unrealizable. It will enter unrealizable phase
 	// instead of being further realized. Antrea-agents will not process further.
 	if internalNP.SyncError != nil {
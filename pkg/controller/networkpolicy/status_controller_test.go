@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -389,6 +390,46 @@ func TestDeleteAntreaNetworkPolicy(t *testing.T) {
 	assert.Empty(t, statusController.getNodeStatuses(initialNetworkPolicy.Name))
 }
 
+func TestSyncHandlerWithRuleAnalysis(t *testing.T) {
+	networkPolicy := newInternalNetworkPolicy("annp1", 1, []string{"node1"}, newAntreaNetworkPolicyReference("ns1", "annp1"))
+	statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController()
+	statusController.ruleAnalyzer = &RuleAnalyzer{findings: map[string][]*RuleAnalysisFinding{
+		"annp1": {{
+			Type:          RuleShadowed,
+			Rule:          &types.RuleInfo{Policy: networkPolicy, Index: 1, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "drop-web"}},
+			PrecedingRule: &types.RuleInfo{Policy: networkPolicy, Index: 0, Rule: &controlplane.NetworkPolicyRule{Direction: controlplane.DirectionIn, Name: "allow-all"}},
+		}},
+	}}
+	networkPolicyStore.Create(networkPolicy)
+	statusController.UpdateStatus(newNetworkPolicyStatus("annp1", "node1", 1, ""))
+
+	require.NoError(t, statusController.syncHandler("annp1"))
+	conditions := networkPolicyControl.getAntreaNetworkPolicyStatus().Conditions
+	require.Len(t, conditions, 2)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionRuleConflict, conditions[1].Type)
+	assert.Equal(t, "RulesShadowed", conditions[1].Reason)
+	assert.Equal(t, "ingress rule drop-web is shadowed by ingress rule allow-all", conditions[1].Message)
+}
+
+func TestSyncHandlerWithStaleRules(t *testing.T) {
+	networkPolicy := newInternalNetworkPolicy("annp1", 1, []string{"node1"}, newAntreaNetworkPolicyReference("ns1", "annp1"))
+	statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController()
+	statusController.staleRuleDetector = &StaleRuleDetector{
+		window: time.Hour,
+		staleRules: map[string]*policyStaleRules{
+			"annp1": {sourceRef: networkPolicy.SourceRef, rules: []string{"ingress rule allow-web"}},
+		},
+	}
+	networkPolicyStore.Create(networkPolicy)
+	statusController.UpdateStatus(newNetworkPolicyStatus("annp1", "node1", 1, ""))
+
+	require.NoError(t, statusController.syncHandler("annp1"))
+	conditions := networkPolicyControl.getAntreaNetworkPolicyStatus().Conditions
+	require.Len(t, conditions, 2)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionStaleRule, conditions[1].Type)
+	assert.Equal(t, "ingress rule allow-web has not matched any traffic for 1h0m0s", conditions[1].Message)
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy spans 1000 Nodes. Its current result is:
 // 70024 ns/op            8338 B/op          8 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
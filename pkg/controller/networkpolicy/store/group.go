@@ -31,6 +31,7 @@ const (
 	ChildGroupIndex   = "childGroup"
 	IPBlockGroupIndex = "hasIPBlocks"
 	HasIPBlocks       = "true"
+	IPSetIndex        = "ipSet"
 )
 
 // GroupKeyFunc knows how to get the key of a Group.
@@ -80,6 +81,13 @@ func NewGroupStore() storage.Interface {
 			}
 			return []string{HasIPBlocks}, nil
 		},
+		IPSetIndex: func(obj interface{}) ([]string, error) {
+			g, ok := obj.(*antreatypes.Group)
+			if !ok || g.IPSet == "" {
+				return []string{}, nil
+			}
+			return []string{g.IPSet}, nil
+		},
 	}
 	// genEventFunc is set to nil, thus watchers of this store will not be created.
 	return ram.NewStore(GroupKeyFunc, indexers, nil, keyAndSpanSelectFunc, func() runtime.Object { return nil })
@@ -120,6 +120,8 @@ func ToNetworkPolicyMsg(in *types.NetworkPolicy, out *controlplane.NetworkPolicy
 	}
 	out.Priority = in.Priority
 	out.TierPriority = in.TierPriority
+	out.Tier = in.Tier
+	out.EnforcementMode = in.EnforcementMode
 }
 
 // NetworkPolicyKeyFunc knows how to get the key of a NetworkPolicy.
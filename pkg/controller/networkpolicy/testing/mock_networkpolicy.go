@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyRuleQuerier)
+// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: ConnectivityMatrixQuerier,EndpointQuerier,PolicyRuleQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy ConnectivityMatrixQuerier,EndpointQuerier,PolicyRuleQuerier
 //
 // Package testing is a generated GoMock package.
 package testing
@@ -31,6 +31,44 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockConnectivityMatrixQuerier is a mock of ConnectivityMatrixQuerier interface.
+type MockConnectivityMatrixQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectivityMatrixQuerierMockRecorder
+}
+
+// MockConnectivityMatrixQuerierMockRecorder is the mock recorder for MockConnectivityMatrixQuerier.
+type MockConnectivityMatrixQuerierMockRecorder struct {
+	mock *MockConnectivityMatrixQuerier
+}
+
+// NewMockConnectivityMatrixQuerier creates a new mock instance.
+func NewMockConnectivityMatrixQuerier(ctrl *gomock.Controller) *MockConnectivityMatrixQuerier {
+	mock := &MockConnectivityMatrixQuerier{ctrl: ctrl}
+	mock.recorder = &MockConnectivityMatrixQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnectivityMatrixQuerier) EXPECT() *MockConnectivityMatrixQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryConnectivityMatrix mocks base method.
+func (m *MockConnectivityMatrixQuerier) QueryConnectivityMatrix(arg0 *controlplane.ConnectivityMatrixRequest) (*controlplane.ConnectivityMatrixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryConnectivityMatrix", arg0)
+	ret0, _ := ret[0].(*controlplane.ConnectivityMatrixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryConnectivityMatrix indicates an expected call of QueryConnectivityMatrix.
+func (mr *MockConnectivityMatrixQuerierMockRecorder) QueryConnectivityMatrix(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryConnectivityMatrix", reflect.TypeOf((*MockConnectivityMatrixQuerier)(nil).QueryConnectivityMatrix), arg0)
+}
+
 // MockEndpointQuerier is a mock of EndpointQuerier interface.
 type MockEndpointQuerier struct {
 	ctrl     *gomock.Controller
@@ -69,6 +107,21 @@ func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicyRules(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicyRules", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicyRules), arg0, arg1)
 }
 
+// QueryProposedNetworkPolicyRules mocks base method.
+func (m *MockEndpointQuerier) QueryProposedNetworkPolicyRules(arg0 []string, arg1 []controlplane.PodReference) ([]*types.EndpointNetworkPolicyRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProposedNetworkPolicyRules", arg0, arg1)
+	ret0, _ := ret[0].([]*types.EndpointNetworkPolicyRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryProposedNetworkPolicyRules indicates an expected call of QueryProposedNetworkPolicyRules.
+func (mr *MockEndpointQuerierMockRecorder) QueryProposedNetworkPolicyRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProposedNetworkPolicyRules", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryProposedNetworkPolicyRules), arg0, arg1)
+}
+
 // MockPolicyRuleQuerier is a mock of PolicyRuleQuerier interface.
 type MockPolicyRuleQuerier struct {
 	ctrl     *gomock.Controller
@@ -19,11 +19,14 @@ package networkpolicy
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	secv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -198,3 +201,175 @@ func (n *NetworkPolicyController) updateTier(t *secv1beta1.Tier) {
 		return
 	}
 }
+
+// updateTierEvent receives Tier UPDATE events and enqueues the Antrea-native
+// policies in the Tier when its EnforcementMode changes, as it applies to all
+// of them, and to the Antrea NetworkPolicies in the NamespacedTiers of the
+// priority band delegated by the Tier.
+func (n *NetworkPolicyController) updateTierEvent(old, cur interface{}) {
+	oldTier := old.(*secv1beta1.Tier)
+	curTier := cur.(*secv1beta1.Tier)
+	if oldTier.Spec.EnforcementMode == curTier.Spec.EnforcementMode {
+		return
+	}
+	defer n.heartbeat("updateTier")
+	klog.InfoS("Processing Tier UPDATE event", "tier", curTier.Name, "enforcementMode", curTier.Spec.EnforcementMode)
+	// Policies may refer to a system generated Tier by its static name, and to
+	// the default Tier with an empty name.
+	tierNames := []string{curTier.Name}
+	for name := range staticTierSet {
+		if strings.ToLower(name) == curTier.Name {
+			tierNames = append(tierNames, name)
+		}
+	}
+	if curTier.Name == defaultTierName {
+		tierNames = append(tierNames, "")
+	}
+	for _, tierName := range tierNames {
+		acnps, _ := n.acnpInformer.Informer().GetIndexer().ByIndex(TierIndex, tierName)
+		for _, obj := range acnps {
+			n.enqueueInternalNetworkPolicy(getACNPReference(obj.(*secv1beta1.ClusterNetworkPolicy)))
+		}
+		annps, _ := n.annpInformer.Informer().GetIndexer().ByIndex(TierIndex, tierName)
+		for _, obj := range annps {
+			n.enqueueInternalNetworkPolicy(getANNPReference(obj.(*secv1beta1.NetworkPolicy)))
+		}
+	}
+	if curTier.Spec.Delegation == nil || n.namespacedTierLister == nil {
+		return
+	}
+	namespacedTiers, _ := n.namespacedTierLister.List(labels.Everything())
+	for _, nt := range namespacedTiers {
+		if inDelegatedBand(curTier, nt.Spec.Priority) {
+			n.enqueueNamespacedTierPolicies(nt.Namespace, nt.Name)
+		}
+	}
+}
+
+// addNamespacedTier receives NamespacedTier ADD events and enqueues the Antrea
+// NetworkPolicies in the Namespace referencing it, as it takes precedence over
+// the Tier with the same name.
+func (n *NetworkPolicyController) addNamespacedTier(obj interface{}) {
+	defer n.heartbeat("addNamespacedTier")
+	nt := obj.(*secv1beta1.NamespacedTier)
+	klog.InfoS("Processing NamespacedTier ADD event", "namespacedTier", klog.KObj(nt), "priority", nt.Spec.Priority)
+	n.enqueueNamespacedTierPolicies(nt.Namespace, nt.Name)
+}
+
+// updateNamespacedTier receives NamespacedTier UPDATE events and enqueues the
+// Antrea NetworkPolicies referencing it when its priority changes.
+func (n *NetworkPolicyController) updateNamespacedTier(old, cur interface{}) {
+	oldNT := old.(*secv1beta1.NamespacedTier)
+	curNT := cur.(*secv1beta1.NamespacedTier)
+	if oldNT.Spec.Priority == curNT.Spec.Priority {
+		return
+	}
+	defer n.heartbeat("updateNamespacedTier")
+	klog.InfoS("Processing NamespacedTier UPDATE event", "namespacedTier", klog.KObj(curNT), "priority", curNT.Spec.Priority)
+	n.enqueueNamespacedTierPolicies(curNT.Namespace, curNT.Name)
+}
+
+// deleteNamespacedTier receives NamespacedTier DELETE events and enqueues the
+// Antrea NetworkPolicies in the Namespace referencing it.
+func (n *NetworkPolicyController) deleteNamespacedTier(old interface{}) {
+	nt, ok := old.(*secv1beta1.NamespacedTier)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting NamespacedTier, invalid type: %v", old)
+			return
+		}
+		nt, ok = tombstone.Obj.(*secv1beta1.NamespacedTier)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting NamespacedTier, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	defer n.heartbeat("deleteNamespacedTier")
+	klog.InfoS("Processing NamespacedTier DELETE event", "namespacedTier", klog.KObj(nt))
+	n.enqueueNamespacedTierPolicies(nt.Namespace, nt.Name)
+}
+
+// enqueueNamespacedTierPolicies enqueues the Antrea NetworkPolicies in the given
+// Namespace which reference the Tier with the given name.
+func (n *NetworkPolicyController) enqueueNamespacedTierPolicies(namespace, name string) {
+	annps, _ := n.annpInformer.Informer().GetIndexer().ByIndex(TierIndex, name)
+	for _, obj := range annps {
+		if np := obj.(*secv1beta1.NetworkPolicy); np.Namespace == namespace {
+			n.enqueueInternalNetworkPolicy(getANNPReference(np))
+		}
+	}
+}
+
+// enqueueNamespacedTiersPolicies enqueues the Antrea NetworkPolicies in the given
+// Namespace which reference a NamespacedTier.
+func (n *NetworkPolicyController) enqueueNamespacedTiersPolicies(namespace string) {
+	if n.namespacedTierLister == nil {
+		return
+	}
+	namespacedTiers, _ := n.namespacedTierLister.NamespacedTiers(namespace).List(labels.Everything())
+	for _, nt := range namespacedTiers {
+		n.enqueueNamespacedTierPolicies(nt.Namespace, nt.Name)
+	}
+}
+
+// getNamespacedTier returns the NamespacedTier with the given name in the given
+// Namespace, or nil if there is no such NamespacedTier.
+func (n *NetworkPolicyController) getNamespacedTier(namespace, name string) *secv1beta1.NamespacedTier {
+	if namespace == "" || n.namespacedTierLister == nil {
+		return nil
+	}
+	nt, err := n.namespacedTierLister.NamespacedTiers(namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return nt
+}
+
+// getDelegatingTier returns the Tier which delegates the priority band of the
+// given NamespacedTier to its Namespace, or nil if there is no such Tier.
+func (n *NetworkPolicyController) getDelegatingTier(nt *secv1beta1.NamespacedTier) *secv1beta1.Tier {
+	tiers, err := n.getDelegatingTiers(nt.Namespace)
+	if err != nil {
+		return nil
+	}
+	for _, t := range tiers {
+		if inDelegatedBand(t, nt.Spec.Priority) {
+			return t
+		}
+	}
+	return nil
+}
+
+// getDelegatingTiers returns the Tiers which delegate their priority bands to the
+// given Namespace.
+func (n *NetworkPolicyController) getDelegatingTiers(namespace string) ([]*secv1beta1.Tier, error) {
+	ns, err := n.namespaceLister.Get(namespace)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := n.tierLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var delegatingTiers []*secv1beta1.Tier
+	for _, t := range tiers {
+		if t.Spec.Delegation == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(t.Spec.Delegation.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			delegatingTiers = append(delegatingTiers, t)
+		}
+	}
+	return delegatingTiers, nil
+}
+
+// inDelegatedBand returns whether the given priority is in the priority band
+// delegated by the Tier. The priority of the Tier itself is not part of the band.
+func inDelegatedBand(t *secv1beta1.Tier, priority int32) bool {
+	return t.Spec.Delegation != nil && priority > t.Spec.Priority && priority <= t.Spec.Delegation.MaxPriority
+}
@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -85,3 +87,118 @@ func TestInitTier(t *testing.T) {
 	}
 
 }
+
+func TestUpdateTierEvent(t *testing.T) {
+	tier := &secv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultTierName},
+		Spec:       secv1beta1.TierSpec{Priority: secv1beta1.DefaultTierPriority},
+	}
+	auditTier := tier.DeepCopy()
+	auditTier.Spec.EnforcementMode = secv1beta1.EnforcementModeAudit
+	// The ACNP refers to the default Tier by its static name, the ANNP refers
+	// to it with an empty name.
+	acnp := getACNP()
+	acnp.Spec.Tier = "Application"
+	annp := getANNP()
+	annp.Spec.Tier = ""
+	otherACNP := getACNP()
+	otherACNP.Name = "other-cnp"
+	otherACNP.Spec.Tier = "securityops"
+
+	_, npc := newController(nil, nil)
+	npc.acnpStore.Add(acnp)
+	npc.acnpStore.Add(otherACNP)
+	npc.annpStore.Add(annp)
+
+	npc.updateTierEvent(tier, tier.DeepCopy())
+	assert.Equal(t, 0, npc.internalNetworkPolicyQueue.Len())
+
+	npc.updateTierEvent(tier, auditTier)
+	require.Equal(t, 2, npc.internalNetworkPolicyQueue.Len())
+	var keys []interface{}
+	for i := 0; i < 2; i++ {
+		key, _ := npc.internalNetworkPolicyQueue.Get()
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []interface{}{*getACNPReference(acnp), *getANNPReference(annp)}, keys)
+}
+
+func TestNamespacedTierEvents(t *testing.T) {
+	delegatingTier := &secv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+		Spec: secv1beta1.TierSpec{
+			Priority: 10,
+			Delegation: &secv1beta1.TierDelegation{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				MaxPriority:       20,
+			},
+		},
+	}
+	auditDelegatingTier := delegatingTier.DeepCopy()
+	auditDelegatingTier.Spec.EnforcementMode = secv1beta1.EnforcementModeAudit
+	nt := &secv1beta1.NamespacedTier{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "team"},
+		Spec:       secv1beta1.NamespacedTierSpec{Priority: 11},
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns", Labels: map[string]string{"tenant": "a"}}}
+	// Only the ANNP referencing the NamespacedTier in its Namespace is affected.
+	annp := getANNP()
+	annp.Spec.Tier = nt.Name
+	otherNamespaceANNP := getANNP()
+	otherNamespaceANNP.Namespace = "other-ns"
+	otherNamespaceANNP.Spec.Tier = nt.Name
+	otherTierANNP := getANNP()
+	otherTierANNP.Name = "other-annp"
+
+	_, npc := newController(nil, nil)
+	npc.namespaceStore.Add(ns)
+	npc.tierStore.Add(delegatingTier)
+	npc.namespacedTierStore.Add(nt)
+	npc.annpStore.Add(annp)
+	npc.annpStore.Add(otherNamespaceANNP)
+	npc.annpStore.Add(otherTierANNP)
+
+	expectEnqueued := func(t *testing.T, expected ...*secv1beta1.NetworkPolicy) {
+		require.Equal(t, len(expected), npc.internalNetworkPolicyQueue.Len())
+		var keys, expectedKeys []interface{}
+		for i := range expected {
+			key, _ := npc.internalNetworkPolicyQueue.Get()
+			npc.internalNetworkPolicyQueue.Done(key)
+			keys = append(keys, key)
+			expectedKeys = append(expectedKeys, *getANNPReference(expected[i]))
+		}
+		assert.ElementsMatch(t, expectedKeys, keys)
+	}
+
+	t.Run("add NamespacedTier", func(t *testing.T) {
+		npc.addNamespacedTier(nt)
+		expectEnqueued(t, annp)
+	})
+	t.Run("update NamespacedTier", func(t *testing.T) {
+		npc.updateNamespacedTier(nt, nt.DeepCopy())
+		expectEnqueued(t)
+		updatedNT := nt.DeepCopy()
+		updatedNT.Spec.Priority = 12
+		npc.updateNamespacedTier(nt, updatedNT)
+		expectEnqueued(t, annp)
+	})
+	t.Run("delete NamespacedTier", func(t *testing.T) {
+		npc.deleteNamespacedTier(nt)
+		expectEnqueued(t, annp)
+	})
+	t.Run("update delegating Tier", func(t *testing.T) {
+		assert.Empty(t, npc.getEnforcementMode("", nt.Name, nt.Namespace))
+		npc.tierStore.Update(auditDelegatingTier)
+		npc.updateTierEvent(delegatingTier, auditDelegatingTier)
+		expectEnqueued(t, annp)
+		assert.Equal(t, secv1beta1.EnforcementModeAudit, npc.getEnforcementMode("", nt.Name, nt.Namespace))
+	})
+	t.Run("update Namespace labels", func(t *testing.T) {
+		updatedNS := ns.DeepCopy()
+		updatedNS.Labels = map[string]string{"tenant": "b"}
+		npc.namespaceStore.Update(updatedNS)
+		npc.updateNamespace(ns, updatedNS)
+		expectEnqueued(t, annp)
+		assert.Empty(t, npc.getEnforcementMode("", nt.Name, nt.Namespace))
+	})
+}
@@ -17,16 +17,21 @@ package networkpolicy
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/miekg/dns"
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -65,7 +70,7 @@ type resourceValidator struct {
 // policies.
 type antreaPolicyValidator resourceValidator
 
-// tierValidator implements the validator interface for Tier resources.
+// tierValidator implements the validator interface for Tier and NamespacedTier resources.
 type tierValidator resourceValidator
 
 // groupValidator implements the validator interface for the ClusterGroup resource.
@@ -74,6 +79,15 @@ type groupValidator resourceValidator
 // adminPolicyValidator implements the validator interface for the AdminNetworkPolicy resource.
 type adminPolicyValidator resourceValidator
 
+// maxRulePacketCapturePackets is the maximum number of packets which can be captured for a
+// policy rule on each Node.
+const maxRulePacketCapturePackets = 1000
+
+// maxBandwidthRateLimit is the maximum rate and burst of a bandwidth rate limit
+// in bits per second, as OVS meters are configured with 32-bit values in
+// kilobits per second.
+const maxBandwidthRateLimit = int64(math.MaxUint32) * 1000
+
 var (
 	// reservedTierPriorities stores the reserved priority range from 251, 252, 254 and 255.
 	// The priority 250 is reserved for default Tier but not part of this set in order to be
@@ -120,7 +134,7 @@ type NetworkPolicyValidator struct {
 	// implement the validator interface for Antrea-native policies.
 	antreaPolicyValidators []validator
 	// tierValidators maintains a list of validator objects which
-	// implement the validator interface for Tier resources.
+	// implement the validator interface for Tier and NamespacedTier resources.
 	tierValidators []validator
 	// groupValidators maintains a list of validator objects which
 	// implement the validator interface for ClusterGroup resources.
@@ -140,7 +154,8 @@ func NewNetworkPolicyValidator(networkPolicyController *NetworkPolicyController)
 	apv := antreaPolicyValidator{
 		networkPolicyController: networkPolicyController,
 	}
-	// tv is an instance of tierValidator to validate Tier resource events.
+	// tv is an instance of tierValidator to validate Tier and NamespacedTier
+	// resource events.
 	tv := tierValidator{
 		networkPolicyController: networkPolicyController,
 	}
@@ -159,7 +174,7 @@ func NewNetworkPolicyValidator(networkPolicyController *NetworkPolicyController)
 	return &vr
 }
 
-// Validate function validates a Group, ClusterGroup, Tier or Antrea Policy object
+// Validate function validates a Group, ClusterGroup, Tier, NamespacedTier or Antrea Policy object
 func (v *NetworkPolicyValidator) Validate(ar *admv1.AdmissionReview) *admv1.AdmissionResponse {
 	var result *metav1.Status
 	var msg string
@@ -188,6 +203,22 @@ func (v *NetworkPolicyValidator) Validate(ar *admv1.AdmissionReview) *admv1.Admi
 			}
 		}
 		msg, allowed = v.validateTier(&curTier, &oldTier, op, ui)
+	case "NamespacedTier":
+		klog.V(2).Info("Validating NamespacedTier CRD")
+		var curTier, oldTier crdv1beta1.NamespacedTier
+		if curRaw != nil {
+			if err := json.Unmarshal(curRaw, &curTier); err != nil {
+				klog.Errorf("Error de-serializing current NamespacedTier")
+				return GetAdmissionResponseForErr(err)
+			}
+		}
+		if oldRaw != nil {
+			if err := json.Unmarshal(oldRaw, &oldTier); err != nil {
+				klog.Errorf("Error de-serializing old NamespacedTier")
+				return GetAdmissionResponseForErr(err)
+			}
+		}
+		msg, allowed = v.validateTier(&curTier, &oldTier, op, ui)
 	case "ClusterGroup":
 		klog.V(2).Info("Validating ClusterGroup CRD")
 		// Current serving versions of ClusterGroup are v1alpha3 and v1beta1. They have
@@ -433,8 +464,8 @@ func (v *NetworkPolicyValidator) validateAntreaGroup(curAG, oldAG interface{}, o
 	return reason, allowed
 }
 
-// validateTier validates the admission of a Tier resource
-func (v *NetworkPolicyValidator) validateTier(curTier, oldTier *crdv1beta1.Tier, op admv1.Operation, userInfo authenticationv1.UserInfo) (string, bool) {
+// validateTier validates the admission of a Tier, NamespacedTier resource
+func (v *NetworkPolicyValidator) validateTier(curTier, oldTier interface{}, op admv1.Operation, userInfo authenticationv1.UserInfo) (string, bool) {
 	allowed := true
 	reason := ""
 	switch op {
@@ -495,6 +526,8 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 	var tier string
 	var ingress, egress []crdv1beta1.Rule
 	var specAppliedTo []crdv1beta1.AppliedTo
+	var schedules []crdv1beta1.PolicySchedule
+	var namespace string
 	switch curObj.(type) {
 	case *crdv1beta1.ClusterNetworkPolicy:
 		curACNP := curObj.(*crdv1beta1.ClusterNetworkPolicy)
@@ -502,14 +535,17 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 		ingress = curACNP.Spec.Ingress
 		egress = curACNP.Spec.Egress
 		specAppliedTo = curACNP.Spec.AppliedTo
+		schedules = curACNP.Spec.Schedules
 	case *crdv1beta1.NetworkPolicy:
 		curANNP := curObj.(*crdv1beta1.NetworkPolicy)
 		tier = curANNP.Spec.Tier
 		ingress = curANNP.Spec.Ingress
 		egress = curANNP.Spec.Egress
 		specAppliedTo = curANNP.Spec.AppliedTo
+		schedules = curANNP.Spec.Schedules
+		namespace = curANNP.Namespace
 	}
-	reason, allowed := v.validateTierForPolicy(tier)
+	reason, allowed := v.validateTierForPolicy(tier, namespace)
 	if !allowed {
 		return reason, allowed
 	}
@@ -532,6 +568,10 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateServiceAccounts(namespace, ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
 	reason, allowed = v.validateFQDNSelectors(egress)
 	if !allowed {
 		return reason, allowed
@@ -544,13 +584,33 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateProtocols(ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
 	reason, allowed = v.validateL7Protocols(ingress, egress)
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateRateLimit(ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validatePacketCapture(ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateRejectResponse(ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
+	reason, allowed = v.validateSchedules(schedules, ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
 	return "", true
 }
 
@@ -618,7 +678,10 @@ func (v *antreaPolicyValidator) validateAppliedTo(ingress, egress []crdv1beta1.R
 				}
 				appliedToSvcNum++
 			}
-			if reason, allowed := checkSelectorsLabels(eachAppliedTo.PodSelector, eachAppliedTo.NamespaceSelector, eachAppliedTo.ExternalEntitySelector); !allowed {
+			if eachAppliedTo.PodNodeSelector != nil && (eachAppliedTo.ExternalEntitySelector != nil || eachAppliedTo.NodeSelector != nil) {
+				return "podNodeSelector can only be set with podSelector or namespaceSelector in appliedTo", false
+			}
+			if reason, allowed := checkSelectorsLabels(eachAppliedTo.PodSelector, eachAppliedTo.NamespaceSelector, eachAppliedTo.ExternalEntitySelector, eachAppliedTo.PodNodeSelector); !allowed {
 				return reason, allowed
 			}
 		}
@@ -676,7 +739,15 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1beta1.Rule)
 			if peer.NodeSelector != nil && peerFieldsNum > 1 {
 				return "nodeSelector cannot be set with other peers in rules", false
 			}
-			if reason, allowed := checkSelectorsLabels(peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, peer.NodeSelector); !allowed {
+			if peer.PodNodeSelector != nil {
+				if peer.ExternalEntitySelector != nil || peer.IPBlock != nil || peer.FQDN != "" {
+					return "podNodeSelector can only be set with podSelector, namespaceSelector or namespaces in rules", false
+				}
+				if peer.Scope == crdv1beta1.ScopeClusterSet {
+					return "podNodeSelector cannot be set for a ClusterSet scoped peer", false
+				}
+			}
+			if reason, allowed := checkSelectorsLabels(peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, peer.NodeSelector, peer.PodNodeSelector); !allowed {
 				return reason, allowed
 			}
 		}
@@ -702,6 +773,76 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1beta1.Rule)
 	return "", true
 }
 
+// validateServiceAccounts ensures that the ServiceAccounts referred to by a policy are valid. The
+// namespace is empty for a ClusterNetworkPolicy. The ServiceAccounts in the appliedTo of an Antrea
+// NetworkPolicy must be in the Namespace of the policy, while the wildcard Namespace can be used in
+// its peers and in ClusterNetworkPolicies to select the ServiceAccounts in all Namespaces.
+func (v *antreaPolicyValidator) validateServiceAccounts(namespace string, ingress, egress []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	checkServiceAccount := func(sa *crdv1beta1.NamespacedName) (string, bool) {
+		if errs := validation.IsDNS1123Subdomain(sa.Name); len(errs) > 0 {
+			return fmt.Sprintf("invalid serviceAccount name %s: %s", sa.Name, strings.Join(errs, ", ")), false
+		}
+		if sa.Namespace == "" {
+			if namespace == "" {
+				return "the Namespace of serviceAccount must be set in ClusterNetworkPolicy", false
+			}
+			return "", true
+		}
+		if sa.Namespace == serviceAccountNamespaceAll {
+			return "", true
+		}
+		if errs := validation.IsDNS1123Label(sa.Namespace); len(errs) > 0 {
+			return fmt.Sprintf("invalid serviceAccount Namespace %s: %s", sa.Namespace, strings.Join(errs, ", ")), false
+		}
+		return "", true
+	}
+	checkAppliedTo := func(appliedTo []crdv1beta1.AppliedTo) (string, bool) {
+		for _, at := range appliedTo {
+			if at.ServiceAccount == nil {
+				continue
+			}
+			if reason, allowed := checkServiceAccount(at.ServiceAccount); !allowed {
+				return reason, allowed
+			}
+			if namespace != "" && at.ServiceAccount.Namespace != "" && at.ServiceAccount.Namespace != namespace {
+				return fmt.Sprintf("serviceAccount in appliedTo must be in the Namespace %s of the policy", namespace), false
+			}
+		}
+		return "", true
+	}
+	checkPeers := func(peers []crdv1beta1.NetworkPolicyPeer) (string, bool) {
+		for _, peer := range peers {
+			if peer.ServiceAccount == nil {
+				continue
+			}
+			if reason, allowed := checkServiceAccount(peer.ServiceAccount); !allowed {
+				return reason, allowed
+			}
+		}
+		return "", true
+	}
+	if reason, allowed := checkAppliedTo(specAppliedTo); !allowed {
+		return reason, allowed
+	}
+	for _, rule := range ingress {
+		if reason, allowed := checkAppliedTo(rule.AppliedTo); !allowed {
+			return reason, allowed
+		}
+		if reason, allowed := checkPeers(rule.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, rule := range egress {
+		if reason, allowed := checkAppliedTo(rule.AppliedTo); !allowed {
+			return reason, allowed
+		}
+		if reason, allowed := checkPeers(rule.To); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
 // validateAppliedToServiceIngressPeer ensures that if a policy or an ingress rule
 // is applied to Services, the ingress rule can only use ipBlock to select workloads.
 func (v *antreaPolicyValidator) validateAppliedToServiceIngressPeer(specAppliedTo []crdv1beta1.AppliedTo, ingress []crdv1beta1.Rule) (string, bool) {
@@ -761,8 +902,27 @@ func checkSelectorsLabels(selectors ...*metav1.LabelSelector) (string, bool) {
 	return "", true
 }
 
-// validateTierForPolicy validates whether a referenced Tier exists.
-func (v *antreaPolicyValidator) validateTierForPolicy(tier string) (string, bool) {
+// validateTierForPolicy validates whether a referenced Tier exists. namespace is
+// the Namespace of the policy, empty for cluster-scoped policies. Policies in a
+// Namespace to which priority bands are delegated must reference a NamespacedTier
+// in one of the bands, so that they cannot override the policies in other Tiers.
+func (v *antreaPolicyValidator) validateTierForPolicy(tier, namespace string) (string, bool) {
+	if namespace != "" {
+		delegatingTiers, err := v.networkPolicyController.getDelegatingTiers(namespace)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Sprintf("failed to get the Tiers delegating to Namespace %s: %v", namespace, err), false
+		}
+		namespacedTier := v.networkPolicyController.getNamespacedTier(namespace, tier)
+		if len(delegatingTiers) > 0 && namespacedTier == nil {
+			return fmt.Sprintf("policies in Namespace %s must reference a NamespacedTier in the priority bands delegated to the Namespace", namespace), false
+		}
+		if namespacedTier != nil {
+			if getDelegatingTier(delegatingTiers, namespacedTier.Spec.Priority) == nil {
+				return fmt.Sprintf("namespacedtier %s priority %d is not in any priority band delegated to Namespace %s", tier, namespacedTier.Spec.Priority, namespace), false
+			}
+			return "", true
+		}
+	}
 	// "tier" must exist before referencing
 	if tier == "" || staticTierSet.Has(tier) {
 		// Empty Tier name corresponds to default Tier.
@@ -812,7 +972,7 @@ func (v *antreaPolicyValidator) validateEgressMulticastAddress(egressRule []crdv
 				unicast = true
 			}
 			if to.PodSelector != nil || to.NamespaceSelector != nil || to.Namespaces != nil ||
-				to.ExternalEntitySelector != nil || to.ServiceAccount != nil || to.NodeSelector != nil {
+				to.ExternalEntitySelector != nil || to.ServiceAccount != nil || to.NodeSelector != nil || to.PodNodeSelector != nil {
 				otherSelectors = true
 			}
 			if multicast && (*r.Action == crdv1beta1.RuleActionPass || *r.Action == crdv1beta1.RuleActionReject) {
@@ -867,6 +1027,210 @@ func (v *antreaPolicyValidator) validateMulticastIGMP(ingressRules, egressRules
 	return "", true
 }
 
+func validateICMPProtocol(icmp *crdv1beta1.ICMPProtocol) (string, bool) {
+	if icmp.ICMPTypeName != "" {
+		if icmp.ICMPType != nil {
+			return "`icmpTypeName` can not be specified with `icmpType`", false
+		}
+		if !icmp.ICMPTypeName.IsValid() {
+			return fmt.Sprintf("invalid `icmpTypeName` %s", icmp.ICMPTypeName), false
+		}
+	}
+	if icmp.EndICMPType != nil {
+		if icmp.ICMPType == nil {
+			return "if `endIcmpType` is specified `icmpType` must be specified", false
+		}
+		if *icmp.EndICMPType < *icmp.ICMPType {
+			return "`endIcmpType` should be greater than or equal to `icmpType`", false
+		}
+	}
+	if icmp.EndICMPCode != nil {
+		if icmp.ICMPCode == nil {
+			return "if `endIcmpCode` is specified `icmpCode` must be specified", false
+		}
+		if *icmp.EndICMPCode < *icmp.ICMPCode {
+			return "`endIcmpCode` should be greater than or equal to `icmpCode`", false
+		}
+		if icmp.EndICMPType != nil && *icmp.EndICMPType > *icmp.ICMPType && *icmp.EndICMPCode > *icmp.ICMPCode {
+			return "an ICMP type range and an ICMP code range can not be specified at the same time", false
+		}
+	}
+	return "", true
+}
+
+// validateProtocols validates the ICMP and IPv6ExtensionHeader
+// protocols set in Antrea-native policy rules.
+func (v *antreaPolicyValidator) validateProtocols(ingressRules, egressRules []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		isNodeRule := appliedToNodes(specAppliedTo) || appliedToNodes(r.AppliedTo)
+		for _, protocol := range r.Protocols {
+			if protocol.ICMP != nil {
+				if reason, allowed := validateICMPProtocol(protocol.ICMP); !allowed {
+					return reason, allowed
+				}
+				// Policies applied to Nodes are realized with iptables, whose ICMP match only supports a single type and code.
+				if isNodeRule && (protocol.ICMP.EndICMPType != nil || protocol.ICMP.EndICMPCode != nil || protocol.ICMP.ICMPTypeName != "") {
+					return "ICMP type ranges, code ranges and type names are not supported for policies applied to Nodes", false
+				}
+			}
+			if isNodeRule && protocol.IPv6ExtensionHeader != nil {
+				return "protocol IPv6ExtensionHeader is not supported for policies applied to Nodes", false
+			}
+			if protocol.IPv6ExtensionHeader != nil && !protocol.IPv6ExtensionHeader.Type.IsValid() {
+				return fmt.Sprintf("unsupported IPv6ExtensionHeader type %s", protocol.IPv6ExtensionHeader.Type), false
+			}
+			if protocol.IPv6ExtensionHeader != nil && len(r.L7Protocols) != 0 {
+				return "layer 7 protocols can not be used with protocol IPv6ExtensionHeader", false
+			}
+		}
+	}
+	return "", true
+}
+
+// appliedToNodes returns whether any of the appliedTos selects Nodes.
+func appliedToNodes(appliedTos []crdv1beta1.AppliedTo) bool {
+	for _, at := range appliedTos {
+		if at.NodeSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRateLimit validates the RateLimit field set in Antrea-native policy
+// rules is valid, and is set if and only if the action of the rule is RateLimit.
+func (v *antreaPolicyValidator) validateRateLimit(ingressRules, egressRules []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.Action == nil || *r.Action != crdv1beta1.RuleActionRateLimit {
+			if r.RateLimit != nil {
+				return "rateLimit can only be set when the action is RateLimit", false
+			}
+			continue
+		}
+		if r.RateLimit == nil {
+			return "rateLimit must be set when the action is RateLimit", false
+		}
+		// Policies applied to Nodes are realized with iptables, which do not support rate limiting.
+		if appliedToNodes(specAppliedTo) || appliedToNodes(r.AppliedTo) {
+			return "action RateLimit is not supported for policies applied to Nodes", false
+		}
+		if (r.RateLimit.PacketRate == nil) == (r.RateLimit.Bandwidth == nil) {
+			return "exactly one of packetRate and bandwidth must be set in rateLimit", false
+		}
+		if packetRate := r.RateLimit.PacketRate; packetRate != nil {
+			if packetRate.Rate <= 0 {
+				return "rate of packetRate must be positive", false
+			}
+			if packetRate.Burst < 0 {
+				return "burst of packetRate must not be negative", false
+			}
+		}
+		if bandwidth := r.RateLimit.Bandwidth; bandwidth != nil {
+			rate, err := resource.ParseQuantity(bandwidth.Rate)
+			if err != nil {
+				return fmt.Sprintf("rate %s of bandwidth is invalid: %v", bandwidth.Rate, err), false
+			}
+			// OVS meters are configured in kilobits per second.
+			if rate.Value() < 1000 {
+				return fmt.Sprintf("rate %s of bandwidth must be at least 1k", bandwidth.Rate), false
+			}
+			if rate.Value() > maxBandwidthRateLimit {
+				return fmt.Sprintf("rate %s of bandwidth must be at most %dk", bandwidth.Rate, maxBandwidthRateLimit/1000), false
+			}
+			burst, err := resource.ParseQuantity(bandwidth.Burst)
+			if err != nil {
+				return fmt.Sprintf("burst %s of bandwidth is invalid: %v", bandwidth.Burst, err), false
+			}
+			if burst.Sign() < 0 || burst.Value() > maxBandwidthRateLimit {
+				return fmt.Sprintf("burst %s of bandwidth must be between 0 and %dk", bandwidth.Burst, maxBandwidthRateLimit/1000), false
+			}
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return "protocol IGMP does not support RateLimit", false
+			}
+		}
+		for _, to := range r.To {
+			if to.IPBlock == nil {
+				continue
+			}
+			if ip, _, err := net.ParseCIDR(to.IPBlock.CIDR); err == nil && ip.IsMulticast() {
+				return "multicast does not support action RateLimit", false
+			}
+		}
+	}
+	return "", true
+}
+
+// validatePacketCapture validates the PacketCapture field set in Antrea-native
+// policy rules is valid, and is only set for rules dropping or rejecting traffic.
+func (v *antreaPolicyValidator) validatePacketCapture(ingressRules, egressRules []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.PacketCapture == nil {
+			continue
+		}
+		if *r.Action != crdv1beta1.RuleActionDrop && *r.Action != crdv1beta1.RuleActionReject {
+			return "packetCapture can only be set when the action is Drop or Reject", false
+		}
+		// Policies applied to Nodes are realized with iptables, from which packets are not sent to the agent.
+		if appliedToNodes(specAppliedTo) || appliedToNodes(r.AppliedTo) {
+			return "packetCapture is not supported for policies applied to Nodes", false
+		}
+		if r.PacketCapture.MaxPackets < 1 || r.PacketCapture.MaxPackets > maxRulePacketCapturePackets {
+			return fmt.Sprintf("maxPackets of packetCapture must be between 1 and %d", maxRulePacketCapturePackets), false
+		}
+	}
+	return "", true
+}
+
+// validateRejectResponse validates the RejectResponse field set in Antrea-native
+// policy rules is only set for rules rejecting traffic, and is supported by the
+// way the rules are enforced.
+func (v *antreaPolicyValidator) validateRejectResponse(ingressRules, egressRules []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.RejectResponse == "" {
+			continue
+		}
+		switch r.RejectResponse {
+		case crdv1beta1.RejectResponseTCPReset, crdv1beta1.RejectResponseICMPAdminProhibited,
+			crdv1beta1.RejectResponseICMPHostUnreachable, crdv1beta1.RejectResponseHTTPForbidden:
+		default:
+			return fmt.Sprintf("unsupported rejectResponse %s", r.RejectResponse), false
+		}
+		// Policies applied to Nodes are realized with iptables, which rejects traffic with its default response.
+		if appliedToNodes(specAppliedTo) || appliedToNodes(r.AppliedTo) {
+			return "rejectResponse is not supported for policies applied to Nodes", false
+		}
+		if len(r.L7Protocols) != 0 {
+			// Traffic not matching the layer 7 criteria is rejected by the application-aware engine, which
+			// can only reset the TCP connection or respond to HTTP requests.
+			if r.RejectResponse != crdv1beta1.RejectResponseTCPReset && r.RejectResponse != crdv1beta1.RejectResponseHTTPForbidden {
+				return "rejectResponse can only be TCPReset or HTTPForbidden for rules with layer 7 protocols", false
+			}
+			if r.RejectResponse == crdv1beta1.RejectResponseHTTPForbidden && !hasHTTPProtocol(r.L7Protocols) {
+				return "rejectResponse HTTPForbidden can only be set for rules with layer 7 protocol HTTP", false
+			}
+			continue
+		}
+		if *r.Action != crdv1beta1.RuleActionReject {
+			return "rejectResponse can only be set when the action is Reject or for rules with layer 7 protocols", false
+		}
+		if r.RejectResponse == crdv1beta1.RejectResponseHTTPForbidden {
+			return "rejectResponse HTTPForbidden can only be set for rules with layer 7 protocol HTTP", false
+		}
+	}
+	return "", true
+}
+
+func hasHTTPProtocol(l7Protocols []crdv1beta1.L7Protocol) bool {
+	for _, p := range l7Protocols {
+		if p.HTTP != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // validateL7Protocols validates the L7Protocols field set in Antrea-native policy
 // rules are valid, and compatible with the ports or protocols fields.
 func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
@@ -883,22 +1247,156 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		// Both HTTP and gRPC are carried over TCP.
+		var tcpL7Protocol string
+		haveDNS := false
 		for _, p := range r.L7Protocols {
 			if p.HTTP != nil {
-				haveHTTP = true
+				tcpL7Protocol = "HTTP"
+				if reason, allowed := validateHTTPProtocol(p.HTTP); !allowed {
+					return reason, false
+				}
+			}
+			if p.GRPC != nil {
+				if tcpL7Protocol == "" {
+					tcpL7Protocol = "gRPC"
+				}
+				if reason, allowed := validateGRPCProtocol(p.GRPC); !allowed {
+					return reason, false
+				}
+			}
+			if p.DNS != nil {
+				haveDNS = true
+				if reason, allowed := validateDNSProtocol(p.DNS); !allowed {
+					return reason, false
+				}
 			}
 		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
-				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+			if port.Protocol == nil {
+				continue
+			}
+			if tcpL7Protocol != "" && *port.Protocol != v1.ProtocolTCP {
+				return fmt.Sprintf("%s protocol can only be used when layer 4 protocol is TCP or unset", tcpL7Protocol), false
+			}
+			if haveDNS && *port.Protocol == v1.ProtocolSCTP {
+				return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
 			}
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
-				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+			if protocol.IGMP == nil && protocol.ICMP == nil {
+				continue
+			}
+			if tcpL7Protocol != "" {
+				return fmt.Sprintf("%s protocol can not be used with protocol IGMP or ICMP", tcpL7Protocol), false
+			}
+			if haveDNS {
+				return "DNS protocol can not be used with protocol IGMP or ICMP", false
+			}
+		}
+	}
+	return "", true
+}
+
+var (
+	grpcServiceRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	grpcMethodRegex  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// dnsNameRegex matches domain names, which may start or end with a wildcard.
+	dnsNameRegex = regexp.MustCompile(`^\*?[A-Za-z0-9_.-]*\*?$`)
+)
+
+// validateStringMatch validates the match type and the value of a header or
+// query parameter match.
+func validateStringMatch(matchType crdv1beta1.StringMatchType, value string) error {
+	switch matchType {
+	case "", crdv1beta1.StringMatchExact, crdv1beta1.StringMatchPrefix:
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("value must not contain CR or LF")
+		}
+	case crdv1beta1.StringMatchRegex:
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf("invalid regular expression: %v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported match type %s", matchType)
+	}
+	return nil
+}
+
+// validateHTTPHeaders validates the names and the matches of HTTP headers.
+func validateHTTPHeaders(headers []crdv1beta1.HTTPHeaderMatch) (string, bool) {
+	for _, header := range headers {
+		if errs := validation.IsHTTPHeaderName(header.Name); len(errs) != 0 {
+			return fmt.Sprintf("invalid HTTP header name %q: %s", header.Name, strings.Join(errs, "; ")), false
+		}
+		if err := validateStringMatch(header.Type, header.Value); err != nil {
+			return fmt.Sprintf("invalid match of HTTP header %s: %v", header.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateHTTPProtocol validates the headers and query parameters to match in
+// an HTTP protocol.
+func validateHTTPProtocol(http *crdv1beta1.HTTPProtocol) (string, bool) {
+	if reason, allowed := validateHTTPHeaders(http.Headers); !allowed {
+		return reason, false
+	}
+	for _, param := range http.QueryParams {
+		if param.Name == "" || strings.ContainsAny(param.Name, "?&=#") {
+			return fmt.Sprintf("invalid HTTP query parameter name %q", param.Name), false
+		}
+		if err := validateStringMatch(param.Type, param.Value); err != nil {
+			return fmt.Sprintf("invalid match of HTTP query parameter %s: %v", param.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateDNSProtocol validates the name and record types to match in a DNS
+// protocol.
+func validateDNSProtocol(dnsProtocol *crdv1beta1.DNSProtocol) (string, bool) {
+	if !dnsNameRegex.MatchString(dnsProtocol.Name) {
+		return fmt.Sprintf("invalid DNS name %q, wildcards are only supported at the start and the end", dnsProtocol.Name), false
+	}
+	for _, recordType := range dnsProtocol.RecordTypes {
+		if _, ok := dns.StringToType[strings.ToUpper(recordType)]; !ok {
+			return fmt.Sprintf("invalid DNS record type %q", recordType), false
+		}
+	}
+	return "", true
+}
+
+// validateGRPCProtocol validates the service, method and headers to match in a
+// gRPC protocol.
+func validateGRPCProtocol(grpc *crdv1beta1.GRPCProtocol) (string, bool) {
+	if grpc.Service != "" && !grpcServiceRegex.MatchString(grpc.Service) {
+		return fmt.Sprintf("invalid gRPC service %q, must be a fully-qualified name like package.Service", grpc.Service), false
+	}
+	if grpc.Method != "" && !grpcMethodRegex.MatchString(grpc.Method) {
+		return fmt.Sprintf("invalid gRPC method %q", grpc.Method), false
+	}
+	return validateHTTPHeaders(grpc.Headers)
+}
+
+// validateSchedules validates the Schedules set in Antrea-native policies and
+// their rules have a valid cron expression, duration and time zone.
+func (v *antreaPolicyValidator) validateSchedules(schedules []crdv1beta1.PolicySchedule, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	validate := func(schedules []crdv1beta1.PolicySchedule) (string, bool) {
+		for i := range schedules {
+			if _, err := parseSchedule(&schedules[i]); err != nil {
+				return err.Error(), false
 			}
 		}
+		return "", true
+	}
+	if reason, allowed := validate(schedules); !allowed {
+		return reason, allowed
+	}
+	for _, r := range append(ingressRules, egressRules...) {
+		if reason, allowed := validate(r.Schedules); !allowed {
+			return fmt.Sprintf("rule %q: %s", r.Name, reason), allowed
+		}
 	}
 	return "", true
 }
@@ -925,8 +1423,11 @@ func (v *antreaPolicyValidator) deleteValidate(oldObj interface{}, userInfo auth
 	return "", true
 }
 
-// createValidate validates the CREATE events of Tier resources.
+// createValidate validates the CREATE events of Tier, NamespacedTier resources.
 func (t *tierValidator) createValidate(curObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
+	if curNamespacedTier, ok := curObj.(*crdv1beta1.NamespacedTier); ok {
+		return t.createValidateNamespacedTier(curNamespacedTier)
+	}
 	if len(t.networkPolicyController.tierInformer.Informer().GetIndexer().ListIndexFuncValues(PriorityIndex)) >= maxSupportedTiers {
 		return fmt.Sprintf("maximum number of Tiers supported: %d", maxSupportedTiers), false
 	}
@@ -940,11 +1441,97 @@ func (t *tierValidator) createValidate(curObj interface{}, userInfo authenticati
 	if err != nil || len(trs) > 0 {
 		return fmt.Sprintf("tier %s priority %d overlaps with existing Tier", curTier.Name, curTier.Spec.Priority), false
 	}
+	tiers, err := t.networkPolicyController.tierLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Sprintf("failed to list Tiers: %v", err), false
+	}
+	// Tier priority must not be in the priority band delegated by an existing Tier.
+	if delegatingTier := getDelegatingTier(tiers, curTier.Spec.Priority); delegatingTier != nil {
+		return fmt.Sprintf("tier %s priority %d is in the priority band delegated by Tier %s", curTier.Name, curTier.Spec.Priority, delegatingTier.Name), false
+	}
+	if curTier.Spec.Delegation != nil {
+		return validateTierDelegation(curTier, tiers)
+	}
+	return "", true
+}
+
+// validateTierDelegation validates that the priority band delegated by a Tier
+// doesn't overlap reserved priorities and the priorities of existing Tiers. As
+// the priority of the Tier is not in any existing band, the band cannot overlap
+// the bands delegated by existing Tiers either.
+func validateTierDelegation(curTier *crdv1beta1.Tier, tiers []*crdv1beta1.Tier) (string, bool) {
+	delegation := curTier.Spec.Delegation
+	if delegation.NamespaceSelector == nil {
+		return fmt.Sprintf("tier %s delegation must set namespaceSelector", curTier.Name), false
+	}
+	if _, err := metav1.LabelSelectorAsSelector(delegation.NamespaceSelector); err != nil {
+		return fmt.Sprintf("tier %s delegation has invalid namespaceSelector: %v", curTier.Name, err), false
+	}
+	if delegation.MaxPriority <= curTier.Spec.Priority {
+		return fmt.Sprintf("tier %s delegation maxPriority %d must be greater than its priority %d", curTier.Name, delegation.MaxPriority, curTier.Spec.Priority), false
+	}
+	for p := range reservedTierPriorities {
+		if inDelegatedBand(curTier, p) {
+			return fmt.Sprintf("tier %s delegated priority band overlaps with reserved priority %d", curTier.Name, p), false
+		}
+	}
+	for _, tier := range tiers {
+		if tier.Name == curTier.Name {
+			continue
+		}
+		if inDelegatedBand(curTier, tier.Spec.Priority) {
+			return fmt.Sprintf("tier %s delegated priority band overlaps with the priority of Tier %s", curTier.Name, tier.Name), false
+		}
+	}
+	return "", true
+}
+
+// getDelegatingTier returns the Tier delegating the priority band the given
+// priority is in, or nil if the priority is not in any band delegated by the Tiers.
+func getDelegatingTier(tiers []*crdv1beta1.Tier, priority int32) *crdv1beta1.Tier {
+	for _, tier := range tiers {
+		if inDelegatedBand(tier, priority) {
+			return tier
+		}
+	}
+	return nil
+}
+
+// createValidateNamespacedTier validates the CREATE events of NamespacedTier resources.
+func (t *tierValidator) createValidateNamespacedTier(curTier *crdv1beta1.NamespacedTier) (string, bool) {
+	// NamespacedTiers take precedence over Tiers with the same names, the names of
+	// the system generated Tiers are reserved so that they cannot be shadowed.
+	if reservedTierNames.Has(curTier.Name) || staticTierSet.Has(curTier.Name) {
+		return fmt.Sprintf("namespacedtier name %s is reserved", curTier.Name), false
+	}
+	delegatingTiers, err := t.networkPolicyController.getDelegatingTiers(curTier.Namespace)
+	if err != nil {
+		return fmt.Sprintf("failed to get the Tiers delegating to Namespace %s: %v", curTier.Namespace, err), false
+	}
+	if getDelegatingTier(delegatingTiers, curTier.Spec.Priority) == nil {
+		return fmt.Sprintf("namespacedtier %s priority %d is not in any priority band delegated to Namespace %s", curTier.Name, curTier.Spec.Priority, curTier.Namespace), false
+	}
+	// NamespacedTier priority must not overlap existing NamespacedTier's priority in the same Namespace.
+	namespacedTiers, err := t.networkPolicyController.namespacedTierLister.NamespacedTiers(curTier.Namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Sprintf("failed to list NamespacedTiers: %v", err), false
+	}
+	for _, namespacedTier := range namespacedTiers {
+		if namespacedTier.Spec.Priority == curTier.Spec.Priority {
+			return fmt.Sprintf("namespacedtier %s priority %d overlaps with existing NamespacedTier %s", curTier.Name, curTier.Spec.Priority, namespacedTier.Name), false
+		}
+	}
 	return "", true
 }
 
-// updateValidate validates the UPDATE events of Tier resources.
+// updateValidate validates the UPDATE events of Tier, NamespacedTier resources.
 func (t *tierValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
+	if curNamespacedTier, ok := curObj.(*crdv1beta1.NamespacedTier); ok {
+		if curNamespacedTier.Spec.Priority != oldObj.(*crdv1beta1.NamespacedTier).Spec.Priority {
+			return "update to NamespacedTier priority is not allowed", false
+		}
+		return "", true
+	}
 	allowed := true
 	reason := ""
 	curTier := curObj.(*crdv1beta1.Tier)
@@ -958,12 +1545,18 @@ func (t *tierValidator) updateValidate(curObj, oldObj interface{}, userInfo auth
 	if curTier.Spec.Priority != oldTier.Spec.Priority {
 		allowed = false
 		reason = "update to Tier priority is not allowed"
+	} else if !reflect.DeepEqual(curTier.Spec.Delegation, oldTier.Spec.Delegation) {
+		allowed = false
+		reason = "update to Tier delegation is not allowed"
 	}
 	return reason, allowed
 }
 
-// deleteValidate validates the DELETE events of Tier resources.
+// deleteValidate validates the DELETE events of Tier, NamespacedTier resources.
 func (t *tierValidator) deleteValidate(oldObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
+	if oldNamespacedTier, ok := oldObj.(*crdv1beta1.NamespacedTier); ok {
+		return t.deleteValidateNamespacedTier(oldNamespacedTier)
+	}
 	oldTier := oldObj.(*crdv1beta1.Tier)
 	if reservedTierNames.Has(oldTier.Name) {
 		return fmt.Sprintf("cannot delete reserved tier %s", oldTier.Name), false
@@ -974,16 +1567,61 @@ func (t *tierValidator) deleteValidate(oldObj interface{}, userInfo authenticati
 		return fmt.Sprintf("tier %s is referenced by %d Antrea ClusterNetworkPolicies", oldTier.Name, len(acnps)), false
 	}
 	annps, err := t.networkPolicyController.annpInformer.Informer().GetIndexer().ByIndex(TierIndex, oldTier.Name)
-	if err != nil || len(annps) > 0 {
+	if err != nil {
 		return fmt.Sprintf("tier %s is referenced by %d Antrea NetworkPolicies", oldTier.Name, len(annps)), false
 	}
+	// ANNPs referencing a NamespacedTier with the same name don't reference the Tier.
+	var numANNPs int
+	for _, obj := range annps {
+		if t.networkPolicyController.getNamespacedTier(obj.(*crdv1beta1.NetworkPolicy).Namespace, oldTier.Name) == nil {
+			numANNPs++
+		}
+	}
+	if numANNPs > 0 {
+		return fmt.Sprintf("tier %s is referenced by %d Antrea NetworkPolicies", oldTier.Name, numANNPs), false
+	}
+	// Tier delegating the priority band of existing NamespacedTiers cannot be deleted.
+	if oldTier.Spec.Delegation != nil {
+		namespacedTiers, err := t.networkPolicyController.namespacedTierLister.List(labels.Everything())
+		if err != nil {
+			return fmt.Sprintf("failed to list NamespacedTiers: %v", err), false
+		}
+		var numNamespacedTiers int
+		for _, namespacedTier := range namespacedTiers {
+			if inDelegatedBand(oldTier, namespacedTier.Spec.Priority) {
+				numNamespacedTiers++
+			}
+		}
+		if numNamespacedTiers > 0 {
+			return fmt.Sprintf("tier %s delegates the priority band of %d NamespacedTiers", oldTier.Name, numNamespacedTiers), false
+		}
+	}
+	return "", true
+}
+
+// deleteValidateNamespacedTier validates the DELETE events of NamespacedTier resources.
+func (t *tierValidator) deleteValidateNamespacedTier(oldTier *crdv1beta1.NamespacedTier) (string, bool) {
+	// NamespacedTier with existing ANNPs in the same Namespace cannot be deleted.
+	annps, err := t.networkPolicyController.annpInformer.Informer().GetIndexer().ByIndex(TierIndex, oldTier.Name)
+	if err != nil {
+		return fmt.Sprintf("failed to get the Antrea NetworkPolicies referencing NamespacedTier %s: %v", oldTier.Name, err), false
+	}
+	var numANNPs int
+	for _, obj := range annps {
+		if obj.(*crdv1beta1.NetworkPolicy).Namespace == oldTier.Namespace {
+			numANNPs++
+		}
+	}
+	if numANNPs > 0 {
+		return fmt.Sprintf("namespacedtier %s is referenced by %d Antrea NetworkPolicies", oldTier.Name, numANNPs), false
+	}
 	return "", true
 }
 
 // validateAntreaClusterGroupSpec ensures that an IPBlock is not set along with namespaceSelector and/or a
 // podSelector. Similarly, ExternalEntitySelector cannot be set with PodSelector.
 func validateAntreaClusterGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
-	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup"
+	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipSet or childGroups can be set for a ClusterGroup"
 	setFieldNum := numFieldsSetInStruct(s)
 	if setFieldNum > 2 {
 		return errMsg, false
@@ -1018,6 +1656,9 @@ func validateAntreaClusterGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
 }
 
 func validateAntreaGroupSpec(s crdv1beta1.GroupSpec) (string, bool) {
+	if s.IPSet != "" {
+		return "ipSet can only be set for a ClusterGroup", false
+	}
 	errMsg := "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group"
 	setFieldNum := numFieldsSetInStruct(s)
 	if setFieldNum > 2 {
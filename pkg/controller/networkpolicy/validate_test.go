@@ -17,10 +17,12 @@ package networkpolicy
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
@@ -31,12 +33,16 @@ import (
 )
 
 var (
-	query       = crdv1beta1.IGMPQuery
-	report      = crdv1beta1.IGMPReportV1
-	allowAction = crdv1beta1.RuleActionAllow
-	dropAction  = crdv1beta1.RuleActionDrop
-	passAction  = crdv1beta1.RuleActionPass
-	portNum80   = int32(80)
+	query           = crdv1beta1.IGMPQuery
+	report          = crdv1beta1.IGMPReportV1
+	allowAction     = crdv1beta1.RuleActionAllow
+	dropAction      = crdv1beta1.RuleActionDrop
+	passAction      = crdv1beta1.RuleActionPass
+	rejectAction    = crdv1beta1.RuleActionReject
+	rateLimitAction = crdv1beta1.RuleActionRateLimit
+	portNum80       = int32(80)
+	icmpType3       = int32(3)
+	icmpType5       = int32(5)
 )
 
 func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
@@ -703,6 +709,137 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "namespaces and namespaceSelector cannot be set at the same time for a single NetworkPolicyPeer",
 		},
+		{
+			name: "acnp-appliedto-podnodeselector-set-with-eesel",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-appliedto-podnodeselector-set-with-eesel",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ExternalEntitySelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+							PodNodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "podNodeSelector can only be set with podSelector or namespaceSelector in appliedTo",
+		},
+		{
+			name: "acnp-rule-podnodeselector-set-with-ipblock",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-podnodeselector-set-with-ipblock",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{
+										CIDR: "10.0.0.0/24",
+									},
+									PodNodeSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "podNodeSelector can only be set with podSelector, namespaceSelector or namespaces in rules",
+		},
+		{
+			name: "acnp-rule-podnodeselector-clusterset-scope",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-podnodeselector-clusterset-scope",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"foo2": "bar2"},
+									},
+									PodNodeSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+									},
+									Scope: crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "podNodeSelector cannot be set for a ClusterSet scoped peer",
+		},
+		{
+			name: "acnp-podnodeselector-with-namespaces-match-self",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-podnodeselector-with-namespaces-match-self",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "frontend"},
+							},
+							PodNodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "cache"},
+									},
+									PodNodeSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+									},
+									Namespaces: &crdv1beta1.PeerNamespaces{
+										Match: crdv1beta1.NamespaceMatchSelf,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
 		{
 			name: "acnp-double-peer-namespace-field",
 			policy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1540,35 +1677,77 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
 		},
 		{
-			name:         "acnp-l7protocols-used-with-toService",
+			name:         "acnp-l7protocols-gRPC-used-with-UDP",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "egress-rule-l7protocols",
+					Name: "ingress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{"foo1": "bar1"},
+							PodSelector: &selectorA,
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+									},
+								},
 							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "gRPC protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-headers-query-params-and-gRPC",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &selectorA,
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
 									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+										Path: "/api/*",
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant", Value: "tenant1"},
+											{Name: "User-Agent", Type: crdv1beta1.StringMatchRegex, Value: "curl/[0-9.]+"},
+										},
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+											{Name: "version", Type: crdv1beta1.StringMatchPrefix, Value: "v1"},
+										},
 									},
 								},
-							},
-							ToServices: []crdv1beta1.PeerService{
 								{
-									Name:      "foo",
-									Namespace: "bar",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "SayHello",
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "x-tenant", Type: crdv1beta1.StringMatchExact, Value: "tenant1"},
+										},
+									},
 								},
 							},
 						},
@@ -1576,27 +1755,31 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can not be used with toServices",
+			expectedReason: "",
 		},
 		{
-			name:         "L7NetworkPolicy-disabled",
-			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			name:         "acnp-l7protocols-HTTP-invalid-header-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "egress-rule-l7protocols",
+					Name: "ingress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &selectorA,
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: nil,
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X Tenant", Value: "tenant1"},
+										},
+									},
 								},
 							},
 						},
@@ -1604,122 +1787,1407 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+			expectedReason: "invalid HTTP header name \"X Tenant\": a valid HTTP header must consist of alphanumeric characters or '-' (e.g. 'X-Header-Name', regex used for validation is '[-A-Za-z0-9]+')",
 		},
 		{
-			name: "igmp-icmp-both-specified",
+			name:         "acnp-l7protocols-HTTP-invalid-header-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &selectorA,
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{
-										ICMPType: &icmpType8,
-										ICMPCode: &icmpCode0,
-									},
-									IGMP: &crdv1beta1.IGMPProtocol{
-										IGMPType:     &query,
-										GroupAddress: "224.0.0.1",
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant", Type: crdv1beta1.StringMatchRegex, Value: "tenant("},
+										},
 									},
 								},
 							},
-							Action: &allowAction,
 						},
 					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "protocol IGMP can not be used with other protocols or other properties like from, to",
+			expectedReason: "invalid match of HTTP header X-Tenant: invalid regular expression: error parsing regexp: missing closing ): `tenant(`",
 		},
 		{
-			name: "only-icmp-specified",
+			name:         "acnp-l7protocols-HTTP-unsupported-query-param-match-type",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &selectorA,
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Name: "ingressType8",
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{
-										ICMPType: &icmpType8,
-										ICMPCode: &icmpCode0,
+									HTTP: &crdv1beta1.HTTPProtocol{
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+											{Name: "version", Type: "Suffix", Value: "1"},
+										},
 									},
 								},
 							},
 						},
 					},
-					Egress: []crdv1beta1.Rule{
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid match of HTTP query parameter version: unsupported match type Suffix",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-invalid-service",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Name: "egressWithICMP",
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							PodSelector: &selectorA,
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{},
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld/Greeter",
+									},
 								},
 							},
 						},
 					},
 				},
 			},
-			operation: admv1.Create,
+			operation:      admv1.Create,
+			expectedReason: "invalid gRPC service \"helloworld/Greeter\", must be a fully-qualified name like package.Service",
 		},
 		{
-			name: "icmp-specified-and-action-set-to-pass",
+			name:         "acnp-l7protocols-DNS-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &selectorA,
 						},
 					},
 					Egress: []crdv1beta1.Rule{
 						{
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
 								{
-									IGMP: &crdv1beta1.IGMPProtocol{
-										IGMPType:     &report,
-										GroupAddress: "225.1.2.3",
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										Name:        "*.cluster.local",
+										RecordTypes: []string{"A", "AAAA"},
 									},
 								},
 							},
-							Action: &passAction,
 						},
 					},
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "protocol IGMP does not support Pass or Reject",
+			expectedReason: "",
 		},
-		// Update use same validate function as create. Only provide one update case here.
 		{
-			name: "acnp-non-existent-tier",
+			name:         "acnp-l7protocols-DNS-used-with-SCTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "non-existent-tier",
+					Name: "egress-rule-l7protocols",
 				},
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							PodSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{"foo": "bar"},
-							},
+							PodSelector: &selectorA,
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										Name: "*.cluster.local",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-invalid-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &selectorA,
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										Name: "foo.*.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid DNS name \"foo.*.com\", wildcards are only supported at the start and the end",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-invalid-record-type",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &selectorA,
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										RecordTypes: []string{"A", "FOO"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid DNS record type \"FOO\"",
+		},
+		{
+			name:         "acnp-l7protocols-used-with-toService",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can not be used with toServices",
+		},
+		{
+			name:         "L7NetworkPolicy-disabled",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: nil,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+		},
+		{
+			name: "igmp-icmp-both-specified",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType: &icmpType8,
+										ICMPCode: &icmpCode0,
+									},
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType:     &query,
+										GroupAddress: "224.0.0.1",
+									},
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP can not be used with other protocols or other properties like from, to",
+		},
+		{
+			name: "only-icmp-specified",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name: "ingressType8",
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType: &icmpType8,
+										ICMPCode: &icmpCode0,
+									},
+								},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Name: "egressWithICMP",
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation: admv1.Create,
+		},
+		{
+			name: "icmp-specified-and-action-set-to-pass",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType:     &report,
+										GroupAddress: "225.1.2.3",
+									},
+								},
+							},
+							Action: &passAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP does not support Pass or Reject",
+		},
+		{
+			name: "acnp-invalid-schedule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "invalid-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Schedules: []crdv1beta1.PolicySchedule{
+						{
+							Schedule: "0 22 * * sun-sat-mon",
+							Duration: metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `invalid schedule "0 22 * * sun-sat-mon": invalid value "sat-mon" in day-of-week field`,
+		},
+		{
+			name: "acnp-rule-invalid-schedule-time-zone",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "invalid-schedule-time-zone",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Name:   "weekend-access",
+							Schedules: []crdv1beta1.PolicySchedule{
+								{
+									Schedule: "0 0 * * sat",
+									Duration: metav1.Duration{Duration: 48 * time.Hour},
+									TimeZone: "Mars/Olympus_Mons",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `rule "weekend-access": invalid timeZone "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons`,
+		},
+		{
+			name: "acnp-rule-invalid-schedule-duration",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "invalid-schedule-duration",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Name:   "nightly-backup",
+							Schedules: []crdv1beta1.PolicySchedule{
+								{
+									Schedule: "0 2 * * *",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `rule "nightly-backup": invalid duration 0s: must be positive`,
+		},
+		{
+			name: "acnp-valid-schedules",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "valid-schedules",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Schedules: []crdv1beta1.PolicySchedule{
+								{
+									Schedule: "0 0 * * sat",
+									Duration: metav1.Duration{Duration: 48 * time.Hour},
+									TimeZone: "America/Los_Angeles",
+								},
+							},
+						},
+					},
+					Schedules: []crdv1beta1.PolicySchedule{
+						{
+							Schedule: "@daily",
+							Duration: metav1.Duration{Duration: 12 * time.Hour},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		// Update use same validate function as create. Only provide one update case here.
+		{
+			name: "acnp-non-existent-tier",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "non-existent-tier",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Tier: "non-existent-tier",
+				},
+			},
+			operation:      admv1.Update,
+			expectedReason: "tier non-existent-tier does not exist",
+		},
+		{
+			name: "acnp-rate-limit-packet-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rate-limit-bandwidth",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rate-limit-without-rateLimit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &rateLimitAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit must be set when the action is RateLimit",
+		},
+		{
+			name: "acnp-rateLimit-with-allow",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &allowAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit can only be set when the action is RateLimit",
+		},
+		{
+			name: "acnp-rate-limit-with-both-rates",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}, Bandwidth: &crdv1beta1.Bandwidth{Rate: "10M", Burst: "20M"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "exactly one of packetRate and bandwidth must be set in rateLimit",
+		},
+		{
+			name: "acnp-rate-limit-zero-packet-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rate of packetRate must be positive",
+		},
+		{
+			name: "acnp-rate-limit-invalid-bandwidth",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "10Mbps", Burst: "20M"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rate 10Mbps of bandwidth is invalid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name: "acnp-rate-limit-too-low-bandwidth",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "500", Burst: "1k"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rate 500 of bandwidth must be at least 1k",
+		},
+		{
+			name: "acnp-rate-limit-too-high-bandwidth",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "5T", Burst: "1k"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rate 5T of bandwidth must be at most 4294967295k",
+		},
+		{
+			name: "acnp-rate-limit-too-high-bandwidth-burst",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{Bandwidth: &crdv1beta1.Bandwidth{Rate: "1G", Burst: "5T"}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "burst 5T of bandwidth must be between 0 and 4294967295k",
+		},
+		{
+			name: "acnp-rate-limit-with-igmp",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}},
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType: &query,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP does not support RateLimit",
+		},
+		{
+			name: "acnp-rate-limit-applied-to-nodes",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1beta1.RateLimit{PacketRate: &crdv1beta1.PacketRate{Rate: 100}},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "action RateLimit is not supported for policies applied to Nodes",
+		},
+		{
+			name: "acnp-icmp-type-range",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-icmp-type-range",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType:    &icmpType3,
+										EndICMPType: &icmpType5,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-icmp-type-name-with-type",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-icmp-type-name-with-type",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType:     &icmpType3,
+										ICMPTypeName: crdv1beta1.ICMPTypeRedirect,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "`icmpTypeName` can not be specified with `icmpType`",
+		},
+		{
+			name: "acnp-icmp-end-type-without-type",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-icmp-end-type-without-type",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										EndICMPType: &icmpType5,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "if `endIcmpType` is specified `icmpType` must be specified",
+		},
+		{
+			name: "acnp-icmp-end-type-less-than-type",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-icmp-end-type-less-than-type",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType:    &icmpType5,
+										EndICMPType: &icmpType3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "`endIcmpType` should be greater than or equal to `icmpType`",
+		},
+		{
+			name: "acnp-icmp-type-and-code-ranges",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-icmp-type-and-code-ranges",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{
+										ICMPType:    &icmpType3,
+										EndICMPType: &icmpType5,
+										ICMPCode:    &icmpCode0,
+										EndICMPCode: &icmpCode3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "an ICMP type range and an ICMP code range can not be specified at the same time",
+		},
+		{
+			name: "acnp-ip-fragment-applied-to-nodes",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-ip-fragment-applied-to-nodes",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IPv6ExtensionHeader: &crdv1beta1.IPv6ExtensionHeaderProtocol{Type: crdv1beta1.IPv6ExtensionHeaderESP},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IPv6ExtensionHeader is not supported for policies applied to Nodes",
+		},
+		{
+			name: "acnp-ipv6-fragment-header",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-ipv6-fragment-header",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IPv6ExtensionHeader: &crdv1beta1.IPv6ExtensionHeaderProtocol{Type: "Fragment"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "unsupported IPv6ExtensionHeader type Fragment",
+		},
+		{
+			name: "acnp-unsupported-ipv6-extension-header",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-unsupported-ipv6-extension-header",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IPv6ExtensionHeader: &crdv1beta1.IPv6ExtensionHeaderProtocol{Type: "Routing"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "unsupported IPv6ExtensionHeader type Routing",
+		},
+		{
+			name: "acnp-serviceaccount-without-namespace",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sa-without-namespace",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{Name: "ledger-db"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "the Namespace of serviceAccount must be set in ClusterNetworkPolicy",
+		},
+		{
+			name: "acnp-serviceaccount-wildcard-namespace",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sa-wildcard-namespace",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{Name: "ledger-db", Namespace: "*"},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									ServiceAccount: &crdv1beta1.NamespacedName{Name: "payments-api", Namespace: "payments"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-packetcapture-allow-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "packetcapture-allow",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							PacketCapture: &crdv1beta1.RulePacketCapture{MaxPackets: 10},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "packetCapture can only be set when the action is Drop or Reject",
+		},
+		{
+			name: "acnp-packetcapture-nodes",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "packetcapture-nodes",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &dropAction,
+							PacketCapture: &crdv1beta1.RulePacketCapture{MaxPackets: 10},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "packetCapture is not supported for policies applied to Nodes",
+		},
+		{
+			name: "acnp-packetcapture-too-many-packets",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "packetcapture-too-many-packets",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action:        &rejectAction,
+							PacketCapture: &crdv1beta1.RulePacketCapture{MaxPackets: 1001},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "maxPackets of packetCapture must be between 1 and 1000",
+		},
+		{
+			name: "acnp-packetcapture-drop",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "packetcapture-drop",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &dropAction,
+							PacketCapture: &crdv1beta1.RulePacketCapture{MaxPackets: 100},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rejectresponse-drop-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-drop-action",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:         &dropAction,
+							RejectResponse: crdv1beta1.RejectResponseTCPReset,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse can only be set when the action is Reject or for rules with layer 7 protocols",
+		},
+		{
+			name: "acnp-rejectresponse-nodes",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-nodes",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:         &rejectAction,
+							RejectResponse: crdv1beta1.RejectResponseICMPHostUnreachable,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse is not supported for policies applied to Nodes",
+		},
+		{
+			name: "acnp-rejectresponse-http-without-l7protocols",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-http-without-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:         &rejectAction,
+							RejectResponse: crdv1beta1.RejectResponseHTTPForbidden,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse HTTPForbidden can only be set for rules with layer 7 protocol HTTP",
+		},
+		{
+			name:         "acnp-rejectresponse-http-with-tls",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-http-with-tls",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									TLS: &crdv1beta1.TLSProtocol{SNI: "test.com"},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseHTTPForbidden,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse HTTPForbidden can only be set for rules with layer 7 protocol HTTP",
+		},
+		{
+			name:         "acnp-rejectresponse-icmp-with-l7protocols",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-icmp-with-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{Path: "/admin"},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseICMPAdminProhibited,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rejectResponse can only be TCPReset or HTTPForbidden for rules with layer 7 protocols",
+		},
+		{
+			name:         "acnp-rejectresponse-http",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-http",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{Path: "/admin"},
+								},
+							},
+							RejectResponse: crdv1beta1.RejectResponseHTTPForbidden,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rejectresponse-icmp",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rejectresponse-icmp",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:         &rejectAction,
+							RejectResponse: crdv1beta1.RejectResponseICMPAdminProhibited,
 						},
 					},
-					Tier: "non-existent-tier",
 				},
 			},
-			operation:      admv1.Update,
-			expectedReason: "tier non-existent-tier does not exist",
+			operation:      admv1.Create,
+			expectedReason: "",
 		}}
 
 	for _, tt := range tests {
@@ -1792,6 +3260,52 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 			operation:      admv1.Update,
 			expectedReason: "tier non-existent-tier does not exist",
 		},
+		{
+			name: "annp-appliedto-serviceaccount-in-other-namespace",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sa-other-namespace",
+					Namespace: "x",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{Name: "ledger-db", Namespace: "y"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "serviceAccount in appliedTo must be in the Namespace x of the policy",
+		},
+		{
+			name: "annp-serviceaccount-wildcard-namespace-peer",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sa-wildcard-peer",
+					Namespace: "x",
+				},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{Name: "ledger-db"},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									ServiceAccount: &crdv1beta1.NamespacedName{Name: "payments-api", Namespace: "*"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1870,7 +3384,7 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipSet or childGroups can be set for a ClusterGroup",
 		},
 		{
 			name: "cg-set-with-psel-and-nssel",
@@ -1922,7 +3436,7 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipSet or childGroups can be set for a ClusterGroup",
 		},
 		{
 			name: "cg-set-with-podselector-and-ipblock",
@@ -1940,7 +3454,35 @@ func TestValidateAntreaClusterGroup(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup",
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipSet or childGroups can be set for a ClusterGroup",
+		},
+		{
+			name: "cg-set-with-ipset-and-ipblock",
+			curCG: &crdv1beta1.ClusterGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cg-set-with-ipset-and-ipblock",
+				},
+				Spec: crdv1beta1.GroupSpec{
+					IPSet: "blocklist",
+					IPBlocks: []crdv1beta1.IPBlock{
+						{CIDR: "10.0.0.10/32"},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks, ipSet or childGroups can be set for a ClusterGroup",
+		},
+		{
+			name: "cg-set-with-ipset",
+			curCG: &crdv1beta1.ClusterGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cg-set-with-ipset",
+				},
+				Spec: crdv1beta1.GroupSpec{
+					IPSet: "blocklist",
+				},
+			},
+			operation: admv1.Create,
 		},
 		{
 			name: "cg-set-with-ipblock",
@@ -2204,6 +3746,20 @@ func TestValidateAntreaGroup(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "At most one of podSelector, externalEntitySelector, serviceReference, ipBlocks or childGroups can be set for a Group",
 		},
+		{
+			name: "annp-group-set-with-ipset",
+			curGroup: &crdv1beta1.Group{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "annp-group-set-with-ipset",
+					Namespace: "x",
+				},
+				Spec: crdv1beta1.GroupSpec{
+					IPSet: "blocklist",
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "ipSet can only be set for a ClusterGroup",
+		},
 		{
 			name: "annp-group-set-with-ipblock",
 			curGroup: &crdv1beta1.Group{
@@ -2357,16 +3913,28 @@ func TestValidateAntreaGroup(t *testing.T) {
 }
 
 func TestValidateTier(t *testing.T) {
+	tenantSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+	delegatingTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-a",
+		},
+		Spec: crdv1beta1.TierSpec{
+			Priority:   10,
+			Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 20},
+		},
+	}
 	tests := []struct {
-		name           string
-		curTier        *crdv1beta1.Tier
-		oldTier        *crdv1beta1.Tier
-		existTierNum   int
-		existACNP      *crdv1beta1.ClusterNetworkPolicy
-		existANNP      *crdv1beta1.NetworkPolicy
-		operation      admv1.Operation
-		user           authenticationv1.UserInfo
-		expectedReason string
+		name                string
+		curTier             *crdv1beta1.Tier
+		oldTier             *crdv1beta1.Tier
+		existTierNum        int
+		existTiers          []*crdv1beta1.Tier
+		existNamespacedTier *crdv1beta1.NamespacedTier
+		existACNP           *crdv1beta1.ClusterNetworkPolicy
+		existANNP           *crdv1beta1.NetworkPolicy
+		operation           admv1.Operation
+		user                authenticationv1.UserInfo
+		expectedReason      string
 	}{
 		{
 			name: "create-tier-pass",
@@ -2543,6 +4111,134 @@ func TestValidateTier(t *testing.T) {
 			operation:      admv1.Delete,
 			expectedReason: "tier tier-acnp-ref is referenced by 1 Antrea ClusterNetworkPolicies",
 		},
+		{
+			name: "create-tier-in-delegated-band",
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tier-priority-15",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority: 15,
+				},
+			},
+			existTiers:     []*crdv1beta1.Tier{delegatingTier},
+			operation:      admv1.Create,
+			expectedReason: "tier tier-priority-15 priority 15 is in the priority band delegated by Tier tenant-a",
+		},
+		{
+			name: "create-delegating-tier-pass",
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-b",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority:   21,
+					Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 30},
+				},
+			},
+			existTiers: []*crdv1beta1.Tier{delegatingTier},
+			operation:  admv1.Create,
+		},
+		{
+			name: "create-delegating-tier-overlap-tier",
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-b",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority:   5,
+					Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 15},
+				},
+			},
+			existTiers:     []*crdv1beta1.Tier{delegatingTier},
+			operation:      admv1.Create,
+			expectedReason: "tier tenant-b delegated priority band overlaps with the priority of Tier tenant-a",
+		},
+		{
+			name: "create-delegating-tier-overlap-reserved-priority",
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-b",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority:   240,
+					Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 251},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "tier tenant-b delegated priority band overlaps with reserved priority 251",
+		},
+		{
+			name: "create-delegating-tier-invalid-max-priority",
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-b",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority:   30,
+					Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 30},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "tier tenant-b delegation maxPriority 30 must be greater than its priority 30",
+		},
+		{
+			name: "update-tier-delegation-not-allowed",
+			oldTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tier-priority-3",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority: 3,
+				},
+			},
+			curTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tier-priority-3",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority:   3,
+					Delegation: &crdv1beta1.TierDelegation{NamespaceSelector: tenantSelector, MaxPriority: 5},
+				},
+			},
+			operation: admv1.Update,
+			user: authenticationv1.UserInfo{
+				Username: "default",
+			},
+			expectedReason: "update to Tier delegation is not allowed",
+		},
+		{
+			name:    "delete-delegating-tier-with-namespaced-tier",
+			oldTier: delegatingTier,
+			existNamespacedTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-tier"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 15},
+			},
+			operation:      admv1.Delete,
+			expectedReason: "tier tenant-a delegates the priority band of 1 NamespacedTiers",
+		},
+		{
+			name: "delete-tier-ref-by-namespaced-tier-name",
+			oldTier: &crdv1beta1.Tier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tier-annp-ref",
+				},
+				Spec: crdv1beta1.TierSpec{
+					Priority: 0,
+				},
+			},
+			existNamespacedTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tier-annp-ref"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 15},
+			},
+			existANNP: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "npA", UID: "uidA"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					Tier: "tier-annp-ref",
+				},
+			},
+			operation: admv1.Delete,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2558,6 +4254,12 @@ func TestValidateTier(t *testing.T) {
 					},
 				})
 			}
+			for _, tier := range tt.existTiers {
+				controller.tierStore.Add(tier)
+			}
+			if tt.existNamespacedTier != nil {
+				controller.namespacedTierStore.Add(tt.existNamespacedTier)
+			}
 			if tt.existACNP != nil {
 				controller.acnpStore.Add(tt.existACNP)
 			}
@@ -2781,3 +4483,196 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNamespacedTier(t *testing.T) {
+	delegatingTier := &crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a"},
+		Spec: crdv1beta1.TierSpec{
+			Priority: 10,
+			Delegation: &crdv1beta1.TierDelegation{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				MaxPriority:       20,
+			},
+		},
+	}
+	tenantNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nsA", Labels: map[string]string{"tenant": "a"}}}
+	otherNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nsB"}}
+	existNamespacedTier := &crdv1beta1.NamespacedTier{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-high"},
+		Spec:       crdv1beta1.NamespacedTierSpec{Priority: 11},
+	}
+	tests := []struct {
+		name           string
+		curTier        *crdv1beta1.NamespacedTier
+		oldTier        *crdv1beta1.NamespacedTier
+		existANNP      *crdv1beta1.NetworkPolicy
+		operation      admv1.Operation
+		expectedReason string
+	}{
+		{
+			name: "create-namespaced-tier-pass",
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-low"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 20},
+			},
+			operation: admv1.Create,
+		},
+		{
+			name: "create-namespaced-tier-reserved-name",
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "application"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 15},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespacedtier name application is reserved",
+		},
+		{
+			name: "create-namespaced-tier-out-of-band",
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-low"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 10},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespacedtier tenant-low priority 10 is not in any priority band delegated to Namespace nsA",
+		},
+		{
+			name: "create-namespaced-tier-in-not-delegated-namespace",
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsB", Name: "tenant-low"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 15},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespacedtier tenant-low priority 15 is not in any priority band delegated to Namespace nsB",
+		},
+		{
+			name: "create-namespaced-tier-overlap",
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-low"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 11},
+			},
+			operation:      admv1.Create,
+			expectedReason: "namespacedtier tenant-low priority 11 overlaps with existing NamespacedTier tenant-high",
+		},
+		{
+			name:    "update-namespaced-tier-not-allowed",
+			oldTier: existNamespacedTier,
+			curTier: &crdv1beta1.NamespacedTier{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-high"},
+				Spec:       crdv1beta1.NamespacedTierSpec{Priority: 12},
+			},
+			operation:      admv1.Update,
+			expectedReason: "update to NamespacedTier priority is not allowed",
+		},
+		{
+			name:    "delete-annp-ref-namespaced-tier",
+			oldTier: existNamespacedTier,
+			existANNP: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "npA", UID: "uidA"},
+				Spec:       crdv1beta1.NetworkPolicySpec{Tier: "tenant-high"},
+			},
+			operation:      admv1.Delete,
+			expectedReason: "namespacedtier tenant-high is referenced by 1 Antrea NetworkPolicies",
+		},
+		{
+			name:    "delete-namespaced-tier-pass",
+			oldTier: existNamespacedTier,
+			existANNP: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsB", Name: "npA", UID: "uidA"},
+				Spec:       crdv1beta1.NetworkPolicySpec{Tier: "tenant-high"},
+			},
+			operation: admv1.Delete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, controller := newController(nil, nil)
+			controller.namespaceStore.Add(tenantNamespace)
+			controller.namespaceStore.Add(otherNamespace)
+			controller.tierStore.Add(delegatingTier)
+			controller.namespacedTierStore.Add(existNamespacedTier)
+			if tt.existANNP != nil {
+				controller.annpStore.Add(tt.existANNP)
+			}
+			validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
+			actualReason, allowed := validator.validateTier(tt.curTier, tt.oldTier, tt.operation, authenticationv1.UserInfo{Username: "default"})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
+func TestValidateTierForPolicyWithDelegation(t *testing.T) {
+	_, controller := newController(nil, nil)
+	controller.namespaceStore.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nsA", Labels: map[string]string{"tenant": "a"}}})
+	controller.namespaceStore.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nsB"}})
+	controller.tierStore.Add(&crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-a"},
+		Spec: crdv1beta1.TierSpec{
+			Priority: 10,
+			Delegation: &crdv1beta1.TierDelegation{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				MaxPriority:       20,
+			},
+		},
+	})
+	controller.tierStore.Add(&crdv1beta1.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: "application"},
+		Spec:       crdv1beta1.TierSpec{Priority: crdv1beta1.DefaultTierPriority},
+	})
+	controller.namespacedTierStore.Add(&crdv1beta1.NamespacedTier{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "tenant-high"},
+		Spec:       crdv1beta1.NamespacedTierSpec{Priority: 11},
+	})
+	// The Namespace is no longer delegated any priority band.
+	controller.namespacedTierStore.Add(&crdv1beta1.NamespacedTier{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "nsB", Name: "tenant-high"},
+		Spec:       crdv1beta1.NamespacedTierSpec{Priority: 11},
+	})
+	tests := []struct {
+		name           string
+		tier           string
+		namespace      string
+		expectedReason string
+	}{
+		{
+			name:      "namespaced-tier-in-delegated-namespace",
+			tier:      "tenant-high",
+			namespace: "nsA",
+		},
+		{
+			name:           "tier-in-delegated-namespace",
+			tier:           "application",
+			namespace:      "nsA",
+			expectedReason: "policies in Namespace nsA must reference a NamespacedTier in the priority bands delegated to the Namespace",
+		},
+		{
+			name:           "delegating-tier-in-delegated-namespace",
+			tier:           "tenant-a",
+			namespace:      "nsA",
+			expectedReason: "policies in Namespace nsA must reference a NamespacedTier in the priority bands delegated to the Namespace",
+		},
+		{
+			name:      "tier-in-not-delegated-namespace",
+			tier:      "application",
+			namespace: "nsB",
+		},
+		{
+			name:           "namespaced-tier-in-not-delegated-namespace",
+			tier:           "tenant-high",
+			namespace:      "nsB",
+			expectedReason: "namespacedtier tenant-high priority 11 is not in any priority band delegated to Namespace nsB",
+		},
+		{
+			name: "tier-for-cluster-scoped-policy",
+			tier: "tenant-a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := antreaPolicyValidator{networkPolicyController: controller.NetworkPolicyController}
+			actualReason, allowed := validator.validateTierForPolicy(tt.tier, tt.namespace)
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
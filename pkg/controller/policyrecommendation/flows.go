@@ -0,0 +1,238 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// Flow is the subset of a flow record which is relevant for policy
+// recommendation.
+type Flow struct {
+	SourceIP                   string            `json:"sourceIP,omitempty"`
+	DestinationIP              string            `json:"destinationIP,omitempty"`
+	SourcePodNamespace         string            `json:"sourcePodNamespace,omitempty"`
+	SourcePodName              string            `json:"sourcePodName,omitempty"`
+	SourcePodLabels            map[string]string `json:"sourcePodLabels,omitempty"`
+	DestinationPodNamespace    string            `json:"destinationPodNamespace,omitempty"`
+	DestinationPodName         string            `json:"destinationPodName,omitempty"`
+	DestinationPodLabels       map[string]string `json:"destinationPodLabels,omitempty"`
+	DestinationServicePortName string            `json:"destinationServicePortName,omitempty"`
+	DestinationPort            uint16            `json:"destinationPort,omitempty"`
+	Protocol                   uint8             `json:"protocol"`
+}
+
+// hasSourcePod returns whether the flow originates from a Pod.
+func (f *Flow) hasSourcePod() bool {
+	return f.SourcePodName != ""
+}
+
+// hasDestinationPod returns whether the flow is destined to a Pod.
+func (f *Flow) hasDestinationPod() bool {
+	return f.DestinationPodName != ""
+}
+
+// destinationService returns the Namespace and name of the Service the flow
+// is destined to, if any. DestinationServicePortName is formatted as
+// "<Namespace>/<name>:<port name>".
+func (f *Flow) destinationService() (string, string, bool) {
+	if f.DestinationServicePortName == "" {
+		return "", "", false
+	}
+	namespacedName, _, _ := strings.Cut(f.DestinationServicePortName, ":")
+	namespace, name, ok := strings.Cut(namespacedName, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", "", false
+	}
+	return namespace, name, true
+}
+
+// parseLabels parses Pod labels as encoded in flow records, i.e. a JSON
+// object. An empty string means that labels were not included in the record,
+// in which case nil is returned.
+func parseLabels(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	if err := json.Unmarshal([]byte(s), &labels); err != nil {
+		return nil, fmt.Errorf("invalid Pod labels %q: %w", s, err)
+	}
+	return labels, nil
+}
+
+func stringValue(record map[string]interface{}, key string) string {
+	v, ok := record[key]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func uintValue(record map[string]interface{}, key string) (uint64, error) {
+	switch v := record[key].(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return uint64(v), nil
+	case json.Number:
+		i, err := v.Int64()
+		return uint64(i), err
+	default:
+		return 0, fmt.Errorf("unexpected type %T for %s", v, key)
+	}
+}
+
+// FlowFromRecord converts a flow record, as returned by the "/flowrecords" API
+// of the Flow Aggregator, to a Flow.
+func FlowFromRecord(record map[string]interface{}) (*Flow, error) {
+	f := &Flow{
+		SourcePodNamespace:         stringValue(record, "sourcePodNamespace"),
+		SourcePodName:              stringValue(record, "sourcePodName"),
+		DestinationPodNamespace:    stringValue(record, "destinationPodNamespace"),
+		DestinationPodName:         stringValue(record, "destinationPodName"),
+		DestinationServicePortName: stringValue(record, "destinationServicePortName"),
+	}
+	if f.SourceIP = stringValue(record, "sourceIPv4Address"); f.SourceIP == "" {
+		f.SourceIP = stringValue(record, "sourceIPv6Address")
+	}
+	if f.DestinationIP = stringValue(record, "destinationIPv4Address"); f.DestinationIP == "" {
+		f.DestinationIP = stringValue(record, "destinationIPv6Address")
+	}
+	port, err := uintValue(record, "destinationTransportPort")
+	if err != nil {
+		return nil, err
+	}
+	f.DestinationPort = uint16(port)
+	protocol, err := uintValue(record, "protocolIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	f.Protocol = uint8(protocol)
+	if f.SourcePodLabels, err = parseLabels(stringValue(record, "sourcePodLabels")); err != nil {
+		return nil, err
+	}
+	if f.DestinationPodLabels, err = parseLabels(stringValue(record, "destinationPodLabels")); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ReadFlowRecords reads flow records in the JSON format of the "/flowrecords"
+// API of the Flow Aggregator, e.g. the output of "antctl get flowrecords -o
+// json".
+func ReadFlowRecords(r io.Reader) ([]*Flow, error) {
+	var records []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("error when decoding flow records: %w", err)
+	}
+	flows := make([]*Flow, 0, len(records))
+	for _, record := range records {
+		f, err := FlowFromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		flows = append(flows, f)
+	}
+	return flows, nil
+}
+
+// clickHouseQuery selects the distinct connections stored in the "flows" table
+// of the ClickHouse exporter. The source port is omitted on purpose, since it
+// is irrelevant for policies and would prevent deduplication.
+const clickHouseQuery = `SELECT DISTINCT
+    sourceIP,
+    destinationIP,
+    sourcePodNamespace,
+    sourcePodName,
+    sourcePodLabels,
+    destinationPodNamespace,
+    destinationPodName,
+    destinationPodLabels,
+    destinationServicePortName,
+    destinationTransportPort,
+    protocolIdentifier
+FROM flows
+WHERE flowEndSeconds >= ? AND flowStartSeconds <= ?`
+
+// QueryClickHouse retrieves the flows exported to ClickHouse by the Flow
+// Aggregator which were active during the provided time range.
+func QueryClickHouse(ctx context.Context, db *sql.DB, start, end time.Time) ([]*Flow, error) {
+	rows, err := db.QueryContext(ctx, clickHouseQuery, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error when querying flows from ClickHouse: %w", err)
+	}
+	defer rows.Close()
+	var flows []*Flow
+	for rows.Next() {
+		f := &Flow{}
+		var sourceLabels, destinationLabels string
+		if err := rows.Scan(
+			&f.SourceIP,
+			&f.DestinationIP,
+			&f.SourcePodNamespace,
+			&f.SourcePodName,
+			&sourceLabels,
+			&f.DestinationPodNamespace,
+			&f.DestinationPodName,
+			&destinationLabels,
+			&f.DestinationServicePortName,
+			&f.DestinationPort,
+			&f.Protocol,
+		); err != nil {
+			return nil, fmt.Errorf("error when scanning flow from ClickHouse: %w", err)
+		}
+		if f.SourcePodLabels, err = parseLabels(sourceLabels); err != nil {
+			return nil, err
+		}
+		if f.DestinationPodLabels, err = parseLabels(destinationLabels); err != nil {
+			return nil, err
+		}
+		flows = append(flows, f)
+	}
+	return flows, rows.Err()
+}
+
+// FillMissingLabels sets the labels of the Pods for which labels were not
+// included in the flow records, which is the case when the Flow Aggregator is
+// not configured to include them. Pods which no longer exist are left as is.
+func FillMissingLabels(flows []*Flow, podLister corelisters.PodLister) {
+	getLabels := func(namespace, name string) map[string]string {
+		pod, err := podLister.Pods(namespace).Get(name)
+		if err != nil {
+			return nil
+		}
+		return pod.Labels
+	}
+	for _, f := range flows {
+		if f.hasSourcePod() && f.SourcePodLabels == nil {
+			f.SourcePodLabels = getLabels(f.SourcePodNamespace, f.SourcePodName)
+		}
+		if f.hasDestinationPod() && f.DestinationPodLabels == nil {
+			f.DestinationPodLabels = getLabels(f.DestinationPodNamespace, f.DestinationPodName)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestReadFlowRecords(t *testing.T) {
+	input := `[
+  {
+    "sourceIPv4Address": "10.10.0.1",
+    "destinationIPv4Address": "10.10.1.2",
+    "sourceTransportPort": 34567,
+    "destinationTransportPort": 8080,
+    "protocolIdentifier": 6,
+    "sourcePodName": "client-5d8f7c-abcde",
+    "sourcePodNamespace": "ns1",
+    "sourcePodLabels": "{\"app\":\"client\",\"pod-template-hash\":\"5d8f7c\"}",
+    "destinationPodName": "server-0",
+    "destinationPodNamespace": "ns2",
+    "destinationPodLabels": "",
+    "destinationServicePortName": "ns2/server:http"
+  },
+  {
+    "sourceIPv6Address": "fd00:10:10::1",
+    "destinationIPv6Address": "2001:db8::1",
+    "destinationTransportPort": 443,
+    "protocolIdentifier": 6,
+    "sourcePodName": "client-5d8f7c-abcde",
+    "sourcePodNamespace": "ns1"
+  }
+]`
+	flows, err := ReadFlowRecords(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []*Flow{
+		{
+			SourceIP:                   "10.10.0.1",
+			DestinationIP:              "10.10.1.2",
+			SourcePodNamespace:         "ns1",
+			SourcePodName:              "client-5d8f7c-abcde",
+			SourcePodLabels:            map[string]string{"app": "client", "pod-template-hash": "5d8f7c"},
+			DestinationPodNamespace:    "ns2",
+			DestinationPodName:         "server-0",
+			DestinationServicePortName: "ns2/server:http",
+			DestinationPort:            8080,
+			Protocol:                   6,
+		},
+		{
+			SourceIP:           "fd00:10:10::1",
+			DestinationIP:      "2001:db8::1",
+			SourcePodNamespace: "ns1",
+			SourcePodName:      "client-5d8f7c-abcde",
+			DestinationPort:    443,
+			Protocol:           6,
+		},
+	}, flows)
+
+	_, err = ReadFlowRecords(strings.NewReader(`[{"sourcePodLabels": "app=client"}]`))
+	assert.ErrorContains(t, err, "invalid Pod labels")
+	_, err = ReadFlowRecords(strings.NewReader(`{}`))
+	assert.ErrorContains(t, err, "error when decoding flow records")
+}
+
+func TestDestinationService(t *testing.T) {
+	tests := []struct {
+		portName          string
+		expectedNamespace string
+		expectedName      string
+		expectedOK        bool
+	}{
+		{portName: "ns1/svc1:http", expectedNamespace: "ns1", expectedName: "svc1", expectedOK: true},
+		{portName: "ns1/svc1:", expectedNamespace: "ns1", expectedName: "svc1", expectedOK: true},
+		{portName: "svc1:http"},
+		{portName: ""},
+	}
+	for _, tt := range tests {
+		namespace, name, ok := (&Flow{DestinationServicePortName: tt.portName}).destinationService()
+		assert.Equal(t, tt.expectedNamespace, namespace, tt.portName)
+		assert.Equal(t, tt.expectedName, name, tt.portName)
+		assert.Equal(t, tt.expectedOK, ok, tt.portName)
+	}
+}
+
+func TestQueryClickHouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	start := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	columns := []string{
+		"sourceIP", "destinationIP", "sourcePodNamespace", "sourcePodName", "sourcePodLabels",
+		"destinationPodNamespace", "destinationPodName", "destinationPodLabels",
+		"destinationServicePortName", "destinationTransportPort", "protocolIdentifier",
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(clickHouseQuery)).WithArgs(start, end).WillReturnRows(
+		sqlmock.NewRows(columns).
+			AddRow("10.10.0.1", "10.10.1.2", "ns1", "client", `{"app":"client"}`, "ns2", "server", `{"app":"server"}`, "", 80, 6).
+			AddRow("10.10.0.1", "8.8.8.8", "ns1", "client", `{"app":"client"}`, "", "", "", "", 53, 17),
+	)
+	flows, err := QueryClickHouse(context.TODO(), db, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, []*Flow{
+		{
+			SourceIP:                "10.10.0.1",
+			DestinationIP:           "10.10.1.2",
+			SourcePodNamespace:      "ns1",
+			SourcePodName:           "client",
+			SourcePodLabels:         map[string]string{"app": "client"},
+			DestinationPodNamespace: "ns2",
+			DestinationPodName:      "server",
+			DestinationPodLabels:    map[string]string{"app": "server"},
+			DestinationPort:         80,
+			Protocol:                6,
+		},
+		{
+			SourceIP:           "10.10.0.1",
+			DestinationIP:      "8.8.8.8",
+			SourcePodNamespace: "ns1",
+			SourcePodName:      "client",
+			SourcePodLabels:    map[string]string{"app": "client"},
+			DestinationPort:    53,
+			Protocol:           17,
+		},
+	}, flows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFillMissingLabels(t *testing.T) {
+	clientPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "client",
+			Labels:    map[string]string{"app": "client", "pod-template-hash": "5d8f7c"},
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(clientPod))
+	flows := []*Flow{
+		{SourcePodNamespace: "ns1", SourcePodName: "client", DestinationPodNamespace: "ns1", DestinationPodName: "deleted"},
+		{SourcePodNamespace: "ns1", SourcePodName: "client", DestinationIP: "8.8.8.8"},
+		{SourcePodNamespace: "ns1", SourcePodName: "other", SourcePodLabels: map[string]string{"app": "other"}},
+	}
+	FillMissingLabels(flows, corelisters.NewPodLister(indexer))
+	assert.Equal(t, clientPod.Labels, flows[0].SourcePodLabels)
+	assert.Nil(t, flows[0].DestinationPodLabels)
+	assert.Equal(t, clientPod.Labels, flows[1].SourcePodLabels)
+	assert.Equal(t, map[string]string{"app": "other"}, flows[2].SourcePodLabels)
+}
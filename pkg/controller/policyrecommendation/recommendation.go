@@ -0,0 +1,536 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyrecommendation generates Antrea-native policies which allow
+// exactly the traffic observed in flow records, so that zero-trust policies can
+// be bootstrapped for existing applications.
+package policyrecommendation
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// PolicyType is the kind of policies to recommend.
+type PolicyType string
+
+const (
+	// PolicyTypeANNP recommends one Antrea NetworkPolicy per workload, in the
+	// Namespace of the workload.
+	PolicyTypeANNP PolicyType = "annp"
+	// PolicyTypeACNP recommends one Antrea ClusterNetworkPolicy per workload.
+	PolicyTypeACNP PolicyType = "acnp"
+)
+
+// IsolationMethod defines how traffic which was not observed is handled by the
+// recommended policies.
+type IsolationMethod string
+
+const (
+	// IsolationApplied appends a rule dropping all other traffic to each
+	// direction for which a recommended policy has rules.
+	IsolationApplied IsolationMethod = "applied"
+	// IsolationNone only recommends rules allowing the observed traffic.
+	IsolationNone IsolationMethod = "none"
+)
+
+const (
+	DefaultTier     = "application"
+	DefaultPriority = 5
+
+	policyNamePrefix = "recommend-"
+)
+
+// workloadLabelsToIgnore are labels set by controllers which differ between
+// Pods of the same workload, and are therefore not used to select workloads.
+var workloadLabelsToIgnore = sets.New[string](
+	"pod-template-hash",
+	"controller-revision-hash",
+	"pod-template-generation",
+	"statefulset.kubernetes.io/pod-name",
+	"apps.kubernetes.io/pod-index",
+	"controller-uid",
+	"batch.kubernetes.io/controller-uid",
+)
+
+// Options configures policy recommendation.
+type Options struct {
+	PolicyType PolicyType
+	Isolation  IsolationMethod
+	// Tier and Priority are set in all recommended policies.
+	Tier     string
+	Priority float64
+	// Namespaces restricts the recommendation to workloads in the provided
+	// Namespaces. If empty, policies are recommended for all Namespaces.
+	Namespaces []string
+}
+
+// Recommendation is the result of policy recommendation. Policies are sorted
+// by Namespace and workload labels.
+type Recommendation struct {
+	NetworkPolicies        []*crdv1beta1.NetworkPolicy        `json:"networkPolicies,omitempty"`
+	ClusterNetworkPolicies []*crdv1beta1.ClusterNetworkPolicy `json:"clusterNetworkPolicies,omitempty"`
+	// Warnings describe flows which could not be taken into account.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// workload is a group of Pods sharing a Namespace and the same workload
+// labels.
+type workload struct {
+	namespace string
+	labels    map[string]string
+}
+
+func (w workload) key() string {
+	return w.namespace + "/" + labels.Set(w.labels).String()
+}
+
+func (w workload) podSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: w.labels}
+}
+
+func namespaceSelector(namespace string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: namespace}}
+}
+
+// peer is the other end of the observed traffic of a workload. Exactly one of
+// its fields is set.
+type peer struct {
+	workload *workload
+	cidr     string
+	service  *crdv1beta1.PeerService
+}
+
+func (p peer) key() string {
+	switch {
+	case p.workload != nil:
+		return "pod:" + p.workload.key()
+	case p.service != nil:
+		return "service:" + p.service.Namespace + "/" + p.service.Name
+	default:
+		return "ipBlock:" + p.cidr
+	}
+}
+
+// toNetworkPolicyPeer converts the peer to a NetworkPolicyPeer of a policy in
+// the provided Namespace, which is empty for ClusterNetworkPolicies.
+func (p peer) toNetworkPolicyPeer(policyNamespace string) crdv1beta1.NetworkPolicyPeer {
+	if p.workload == nil {
+		return crdv1beta1.NetworkPolicyPeer{IPBlock: &crdv1beta1.IPBlock{CIDR: p.cidr}}
+	}
+	npPeer := crdv1beta1.NetworkPolicyPeer{PodSelector: p.workload.podSelector()}
+	if p.workload.namespace != policyNamespace {
+		npPeer.NamespaceSelector = namespaceSelector(p.workload.namespace)
+	}
+	return npPeer
+}
+
+type portKey struct {
+	protocol uint8
+	port     uint16
+}
+
+const (
+	protocolICMP   uint8 = 1
+	protocolTCP    uint8 = 6
+	protocolUDP    uint8 = 17
+	protocolICMPv6 uint8 = 58
+	protocolSCTP   uint8 = 132
+)
+
+var protocolNames = map[uint8]corev1.Protocol{
+	protocolTCP:  corev1.ProtocolTCP,
+	protocolUDP:  corev1.ProtocolUDP,
+	protocolSCTP: corev1.ProtocolSCTP,
+}
+
+// peerTraffic is the traffic observed between a workload and one of its
+// peers in one direction.
+type peerTraffic struct {
+	peer  peer
+	ports sets.Set[portKey]
+	icmp  bool
+}
+
+type workloadTraffic struct {
+	workload workload
+	ingress  map[string]*peerTraffic
+	egress   map[string]*peerTraffic
+	// incompleteIngress and incompleteEgress are set when some flows of the
+	// workload in the direction were ignored, in which case the direction is
+	// not isolated, so that the traffic of the ignored flows is not dropped.
+	incompleteIngress bool
+	incompleteEgress  bool
+}
+
+type recommender struct {
+	options    Options
+	namespaces sets.Set[string]
+	workloads  map[string]*workloadTraffic
+	warnings   sets.Set[string]
+}
+
+// Recommend generates policies allowing the provided flows.
+func Recommend(flows []*Flow, options Options) *Recommendation {
+	if options.PolicyType == "" {
+		options.PolicyType = PolicyTypeANNP
+	}
+	if options.Isolation == "" {
+		options.Isolation = IsolationApplied
+	}
+	if options.Tier == "" {
+		options.Tier = DefaultTier
+	}
+	if options.Priority == 0 {
+		options.Priority = DefaultPriority
+	}
+	r := &recommender{
+		options:    options,
+		namespaces: sets.New[string](options.Namespaces...),
+		workloads:  map[string]*workloadTraffic{},
+		warnings:   sets.New[string](),
+	}
+	for _, f := range flows {
+		r.addFlow(f)
+	}
+	return r.recommendation()
+}
+
+// toWorkload returns the workload of a Pod, or nil if the Pod can't be selected
+// by labels.
+func (r *recommender) toWorkload(namespace, name string, podLabels map[string]string) *workload {
+	workloadLabels := map[string]string{}
+	for k, v := range podLabels {
+		if !workloadLabelsToIgnore.Has(k) {
+			workloadLabels[k] = v
+		}
+	}
+	if len(workloadLabels) == 0 {
+		r.warnings.Insert(fmt.Sprintf("Ignored flows of Pod %s/%s which has no workload labels", namespace, name))
+		return nil
+	}
+	return &workload{namespace: namespace, labels: workloadLabels}
+}
+
+func (r *recommender) toIPBlockPeer(ip string) *peer {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		r.warnings.Insert(fmt.Sprintf("Ignored flows with invalid IP address %q", ip))
+		return nil
+	}
+	prefixLength := 32
+	if parsedIP.To4() == nil {
+		prefixLength = 128
+	}
+	return &peer{cidr: fmt.Sprintf("%s/%d", parsedIP.String(), prefixLength)}
+}
+
+func (r *recommender) addFlow(f *Flow) {
+	if _, ok := protocolNames[f.Protocol]; !ok && f.Protocol != protocolICMP && f.Protocol != protocolICMPv6 {
+		r.warnings.Insert(fmt.Sprintf("Ignored flows with unsupported protocol %d", f.Protocol))
+		r.addIncompleteFlow(f)
+		return
+	}
+	var source, destination *peer
+	var sourceWorkload, destinationWorkload *workload
+	if f.hasSourcePod() {
+		if sourceWorkload = r.toWorkload(f.SourcePodNamespace, f.SourcePodName, f.SourcePodLabels); sourceWorkload != nil {
+			source = &peer{workload: sourceWorkload}
+		}
+	} else {
+		source = r.toIPBlockPeer(f.SourceIP)
+	}
+	if f.hasDestinationPod() {
+		if destinationWorkload = r.toWorkload(f.DestinationPodNamespace, f.DestinationPodName, f.DestinationPodLabels); destinationWorkload != nil {
+			destination = &peer{workload: destinationWorkload}
+		}
+	} else {
+		destination = r.toIPBlockPeer(f.DestinationIP)
+	}
+	if sourceWorkload != nil {
+		// Traffic to Services is allowed by Service reference, independently of
+		// the selected Endpoints.
+		egressPeer := destination
+		if namespace, name, ok := f.destinationService(); ok {
+			egressPeer = &peer{service: &crdv1beta1.PeerService{Namespace: namespace, Name: name}}
+		}
+		if egressPeer != nil {
+			r.addTraffic(sourceWorkload, true, egressPeer, f)
+		} else {
+			r.setIncomplete(sourceWorkload, true)
+		}
+	}
+	if destinationWorkload != nil {
+		if source != nil {
+			r.addTraffic(destinationWorkload, false, source, f)
+		} else {
+			r.setIncomplete(destinationWorkload, false)
+		}
+	}
+}
+
+// addIncompleteFlow records that a flow was ignored for the workloads of its
+// Pods which can be selected by labels.
+func (r *recommender) addIncompleteFlow(f *Flow) {
+	if f.hasSourcePod() {
+		if w := r.toWorkload(f.SourcePodNamespace, f.SourcePodName, f.SourcePodLabels); w != nil {
+			r.setIncomplete(w, true)
+		}
+	}
+	if f.hasDestinationPod() {
+		if w := r.toWorkload(f.DestinationPodNamespace, f.DestinationPodName, f.DestinationPodLabels); w != nil {
+			r.setIncomplete(w, false)
+		}
+	}
+}
+
+// setIncomplete records that some flows of a workload were ignored in a
+// direction, which is then not isolated.
+func (r *recommender) setIncomplete(w *workload, egress bool) {
+	wt := r.getWorkloadTraffic(w)
+	if wt == nil {
+		return
+	}
+	direction := "ingress"
+	if egress {
+		wt.incompleteEgress = true
+		direction = "egress"
+	} else {
+		wt.incompleteIngress = true
+	}
+	if r.options.Isolation == IsolationApplied {
+		r.warnings.Insert(fmt.Sprintf("Did not isolate %s traffic of Pods with labels %s in Namespace %s as some of their flows were ignored", direction, labels.Set(w.labels).String(), w.namespace))
+	}
+}
+
+// getWorkloadTraffic returns the traffic of a workload, or nil if policies are
+// not recommended for its Namespace.
+func (r *recommender) getWorkloadTraffic(w *workload) *workloadTraffic {
+	if r.namespaces.Len() > 0 && !r.namespaces.Has(w.namespace) {
+		return nil
+	}
+	wt, ok := r.workloads[w.key()]
+	if !ok {
+		wt = &workloadTraffic{
+			workload: *w,
+			ingress:  map[string]*peerTraffic{},
+			egress:   map[string]*peerTraffic{},
+		}
+		r.workloads[w.key()] = wt
+	}
+	return wt
+}
+
+func (r *recommender) addTraffic(w *workload, egress bool, p *peer, f *Flow) {
+	wt := r.getWorkloadTraffic(w)
+	if wt == nil {
+		return
+	}
+	peers := wt.ingress
+	if egress {
+		peers = wt.egress
+	}
+	pt, ok := peers[p.key()]
+	if !ok {
+		pt = &peerTraffic{peer: *p, ports: sets.New[portKey]()}
+		peers[p.key()] = pt
+	}
+	// Ports can't be used together with Service references.
+	if p.service != nil {
+		return
+	}
+	if f.Protocol == protocolICMP || f.Protocol == protocolICMPv6 {
+		pt.icmp = true
+	} else {
+		pt.ports.Insert(portKey{protocol: f.Protocol, port: f.DestinationPort})
+	}
+}
+
+// ruleGroup is a set of peers allowed for the same ports, which translates
+// to a single rule.
+type ruleGroup struct {
+	key      string
+	peers    []peer
+	ports    []portKey
+	icmp     bool
+	services []crdv1beta1.PeerService
+}
+
+func sortedPorts(ports sets.Set[portKey]) []portKey {
+	sorted := ports.UnsortedList()
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].protocol != sorted[j].protocol {
+			return sorted[i].protocol < sorted[j].protocol
+		}
+		return sorted[i].port < sorted[j].port
+	})
+	return sorted
+}
+
+func portsKey(ports []portKey) string {
+	keys := make([]string, 0, len(ports))
+	for _, p := range ports {
+		keys = append(keys, fmt.Sprintf("%d/%d", p.protocol, p.port))
+	}
+	return strings.Join(keys, ",")
+}
+
+// groupRules merges the peers which are allowed for the same set of ports, so
+// that a minimal number of rules is recommended.
+func groupRules(peers map[string]*peerTraffic) []*ruleGroup {
+	groups := map[string]*ruleGroup{}
+	getGroup := func(key string) *ruleGroup {
+		g, ok := groups[key]
+		if !ok {
+			g = &ruleGroup{key: key}
+			groups[key] = g
+		}
+		return g
+	}
+	peerKeys := make([]string, 0, len(peers))
+	for k := range peers {
+		peerKeys = append(peerKeys, k)
+	}
+	sort.Strings(peerKeys)
+	for _, k := range peerKeys {
+		pt := peers[k]
+		if pt.peer.service != nil {
+			g := getGroup("services")
+			g.services = append(g.services, *pt.peer.service)
+			continue
+		}
+		if pt.icmp {
+			g := getGroup("icmp")
+			g.icmp = true
+			g.peers = append(g.peers, pt.peer)
+		}
+		if pt.ports.Len() > 0 {
+			ports := sortedPorts(pt.ports)
+			g := getGroup("ports:" + portsKey(ports))
+			g.ports = ports
+			g.peers = append(g.peers, pt.peer)
+		}
+	}
+	sorted := make([]*ruleGroup, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].key < sorted[j].key })
+	return sorted
+}
+
+func (g *ruleGroup) toRule(egress bool, policyNamespace string) crdv1beta1.Rule {
+	allow := crdv1beta1.RuleActionAllow
+	rule := crdv1beta1.Rule{Action: &allow, ToServices: g.services}
+	for _, p := range g.peers {
+		if egress {
+			rule.To = append(rule.To, p.toNetworkPolicyPeer(policyNamespace))
+		} else {
+			rule.From = append(rule.From, p.toNetworkPolicyPeer(policyNamespace))
+		}
+	}
+	for _, p := range g.ports {
+		protocol := protocolNames[p.protocol]
+		port := intstr.FromInt32(int32(p.port))
+		rule.Ports = append(rule.Ports, crdv1beta1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
+	}
+	if g.icmp {
+		rule.Protocols = []crdv1beta1.NetworkPolicyProtocol{{ICMP: &crdv1beta1.ICMPProtocol{}}}
+	}
+	return rule
+}
+
+func (r *recommender) rules(peers map[string]*peerTraffic, egress, incomplete bool, policyNamespace string) []crdv1beta1.Rule {
+	var rules []crdv1beta1.Rule
+	for _, g := range groupRules(peers) {
+		rules = append(rules, g.toRule(egress, policyNamespace))
+	}
+	if len(rules) > 0 && r.options.Isolation == IsolationApplied && !incomplete {
+		drop := crdv1beta1.RuleActionDrop
+		rules = append(rules, crdv1beta1.Rule{Action: &drop})
+	}
+	return rules
+}
+
+// policyName generates a stable name for the policy of a workload.
+func policyName(w workload) string {
+	h := fnv.New32a()
+	h.Write([]byte(w.key()))
+	return policyNamePrefix + strconv.FormatUint(uint64(h.Sum32()), 16)
+}
+
+func (r *recommender) recommendation() *Recommendation {
+	result := &Recommendation{Warnings: sets.List(r.warnings)}
+	keys := make([]string, 0, len(r.workloads))
+	for k := range r.workloads {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		wt := r.workloads[k]
+		w := wt.workload
+		policyNamespace := w.namespace
+		if r.options.PolicyType == PolicyTypeACNP {
+			policyNamespace = ""
+		}
+		ingress := r.rules(wt.ingress, false, wt.incompleteIngress, policyNamespace)
+		egress := r.rules(wt.egress, true, wt.incompleteEgress, policyNamespace)
+		// A policy without rules has no effect.
+		if len(ingress) == 0 && len(egress) == 0 {
+			continue
+		}
+		switch r.options.PolicyType {
+		case PolicyTypeACNP:
+			result.ClusterNetworkPolicies = append(result.ClusterNetworkPolicies, &crdv1beta1.ClusterNetworkPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: crdv1beta1.SchemeGroupVersion.String(), Kind: "ClusterNetworkPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Name: policyName(w)},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					Tier:     r.options.Tier,
+					Priority: r.options.Priority,
+					AppliedTo: []crdv1beta1.AppliedTo{{
+						PodSelector:       w.podSelector(),
+						NamespaceSelector: namespaceSelector(w.namespace),
+					}},
+					Ingress: ingress,
+					Egress:  egress,
+				},
+			})
+		default:
+			result.NetworkPolicies = append(result.NetworkPolicies, &crdv1beta1.NetworkPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: crdv1beta1.SchemeGroupVersion.String(), Kind: "NetworkPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: w.namespace, Name: policyName(w)},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					Tier:      r.options.Tier,
+					Priority:  r.options.Priority,
+					AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: w.podSelector()}},
+					Ingress:   ingress,
+					Egress:    egress,
+				},
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,251 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+var (
+	allowAction = crdv1beta1.RuleActionAllow
+	dropAction  = crdv1beta1.RuleActionDrop
+	tcp         = corev1.ProtocolTCP
+	udp         = corev1.ProtocolUDP
+
+	clientLabels = map[string]string{"app": "client"}
+	serverLabels = map[string]string{"app": "server"}
+	dbLabels     = map[string]string{"app": "db"}
+)
+
+func podToPodFlow(srcNamespace string, srcLabels map[string]string, dstNamespace string, dstLabels map[string]string, protocol uint8, port uint16) *Flow {
+	return &Flow{
+		SourceIP:                "10.10.0.1",
+		DestinationIP:           "10.10.1.1",
+		SourcePodNamespace:      srcNamespace,
+		SourcePodName:           "src",
+		SourcePodLabels:         srcLabels,
+		DestinationPodNamespace: dstNamespace,
+		DestinationPodName:      "dst",
+		DestinationPodLabels:    dstLabels,
+		DestinationPort:         port,
+		Protocol:                protocol,
+	}
+}
+
+func port(protocol corev1.Protocol, p int32) crdv1beta1.NetworkPolicyPort {
+	portValue := intstr.FromInt32(p)
+	return crdv1beta1.NetworkPolicyPort{Protocol: &protocol, Port: &portValue}
+}
+
+func podPeer(labels map[string]string) crdv1beta1.NetworkPolicyPeer {
+	return crdv1beta1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: labels}}
+}
+
+func podPeerInNamespace(labels map[string]string, namespace string) crdv1beta1.NetworkPolicyPeer {
+	peer := podPeer(labels)
+	peer.NamespaceSelector = namespaceSelector(namespace)
+	return peer
+}
+
+func TestRecommend(t *testing.T) {
+	serviceFlow := podToPodFlow("ns1", clientLabels, "ns2", serverLabels, 6, 8080)
+	serviceFlow.DestinationServicePortName = "ns2/server:http"
+	externalFlow := &Flow{
+		SourceIP:           "10.10.0.1",
+		DestinationIP:      "192.168.1.1",
+		SourcePodNamespace: "ns1",
+		SourcePodName:      "src",
+		SourcePodLabels:    map[string]string{"app": "client", "pod-template-hash": "5d8f7c"},
+		DestinationPort:    443,
+		Protocol:           6,
+	}
+	flows := []*Flow{
+		serviceFlow,
+		externalFlow,
+		podToPodFlow("ns1", clientLabels, "ns1", dbLabels, 6, 5432),
+		podToPodFlow("ns1", clientLabels, "ns1", dbLabels, 17, 5432),
+		podToPodFlow("ns1", clientLabels, "ns1", dbLabels, 1, 0),
+		// Same port as the other client.
+		podToPodFlow("ns3", map[string]string{"app": "other"}, "ns2", serverLabels, 6, 8080),
+	}
+
+	recommendation := Recommend(flows, Options{})
+	assert.Empty(t, recommendation.Warnings)
+	assert.Empty(t, recommendation.ClusterNetworkPolicies)
+	require.Len(t, recommendation.NetworkPolicies, 4)
+	policies := map[string]*crdv1beta1.NetworkPolicy{}
+	for _, np := range recommendation.NetworkPolicies {
+		assert.Equal(t, DefaultTier, np.Spec.Tier)
+		assert.Equal(t, float64(DefaultPriority), np.Spec.Priority)
+		require.Len(t, np.Spec.AppliedTo, 1)
+		policies[np.Namespace+"/"+np.Spec.AppliedTo[0].PodSelector.MatchLabels["app"]] = np
+	}
+
+	client := policies["ns1/client"]
+	require.NotNil(t, client)
+	assert.Equal(t, policyName(workload{namespace: "ns1", labels: clientLabels}), client.Name)
+	assert.Empty(t, client.Spec.Ingress)
+	assert.Equal(t, []crdv1beta1.Rule{
+		{
+			Action:    &allowAction,
+			To:        []crdv1beta1.NetworkPolicyPeer{podPeer(dbLabels)},
+			Protocols: []crdv1beta1.NetworkPolicyProtocol{{ICMP: &crdv1beta1.ICMPProtocol{}}},
+		},
+		{
+			Action: &allowAction,
+			To:     []crdv1beta1.NetworkPolicyPeer{{IPBlock: &crdv1beta1.IPBlock{CIDR: "192.168.1.1/32"}}},
+			Ports:  []crdv1beta1.NetworkPolicyPort{port(tcp, 443)},
+		},
+		{
+			Action: &allowAction,
+			To:     []crdv1beta1.NetworkPolicyPeer{podPeer(dbLabels)},
+			Ports:  []crdv1beta1.NetworkPolicyPort{port(tcp, 5432), port(udp, 5432)},
+		},
+		{
+			Action:     &allowAction,
+			ToServices: []crdv1beta1.PeerService{{Namespace: "ns2", Name: "server"}},
+		},
+		{Action: &dropAction},
+	}, client.Spec.Egress)
+
+	server := policies["ns2/server"]
+	require.NotNil(t, server)
+	assert.Empty(t, server.Spec.Egress)
+	assert.Equal(t, []crdv1beta1.Rule{
+		{
+			Action: &allowAction,
+			From: []crdv1beta1.NetworkPolicyPeer{
+				podPeerInNamespace(clientLabels, "ns1"),
+				podPeerInNamespace(map[string]string{"app": "other"}, "ns3"),
+			},
+			Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 8080)},
+		},
+		{Action: &dropAction},
+	}, server.Spec.Ingress)
+
+	db := policies["ns1/db"]
+	require.NotNil(t, db)
+	assert.Len(t, db.Spec.Ingress, 3)
+}
+
+func TestRecommendClusterNetworkPolicies(t *testing.T) {
+	flows := []*Flow{
+		podToPodFlow("ns1", clientLabels, "ns1", serverLabels, 6, 80),
+		{
+			SourceIP:                "192.168.1.1",
+			DestinationIP:           "10.10.1.1",
+			DestinationPodNamespace: "ns1",
+			DestinationPodName:      "dst",
+			DestinationPodLabels:    serverLabels,
+			DestinationPort:         80,
+			Protocol:                6,
+		},
+	}
+	recommendation := Recommend(flows, Options{
+		PolicyType: PolicyTypeACNP,
+		Isolation:  IsolationNone,
+		Tier:       "securityops",
+		Priority:   10,
+		Namespaces: []string{"ns1"},
+	})
+	assert.Empty(t, recommendation.NetworkPolicies)
+	require.Len(t, recommendation.ClusterNetworkPolicies, 2)
+	server := recommendation.ClusterNetworkPolicies[1]
+	assert.Equal(t, crdv1beta1.ClusterNetworkPolicySpec{
+		Tier:     "securityops",
+		Priority: 10,
+		AppliedTo: []crdv1beta1.AppliedTo{{
+			PodSelector:       &metav1.LabelSelector{MatchLabels: serverLabels},
+			NamespaceSelector: namespaceSelector("ns1"),
+		}},
+		Ingress: []crdv1beta1.Rule{
+			{
+				Action: &allowAction,
+				From: []crdv1beta1.NetworkPolicyPeer{
+					{IPBlock: &crdv1beta1.IPBlock{CIDR: "192.168.1.1/32"}},
+					podPeerInNamespace(clientLabels, "ns1"),
+				},
+				Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 80)},
+			},
+		},
+	}, server.Spec)
+}
+
+func TestRecommendWarnings(t *testing.T) {
+	flows := []*Flow{
+		podToPodFlow("ns1", nil, "ns1", serverLabels, 6, 80),
+		podToPodFlow("ns1", map[string]string{"pod-template-hash": "abc"}, "ns1", serverLabels, 6, 80),
+		podToPodFlow("ns1", clientLabels, "ns1", serverLabels, 47, 0),
+		{SourceIP: "invalid", DestinationPodNamespace: "ns1", DestinationPodName: "dst", DestinationPodLabels: serverLabels, Protocol: 6},
+		// Filtered out by Namespace.
+		podToPodFlow("ns2", clientLabels, "ns2", serverLabels, 6, 80),
+	}
+	recommendation := Recommend(flows, Options{Namespaces: []string{"ns1"}})
+	assert.Empty(t, recommendation.NetworkPolicies)
+	assert.Equal(t, []string{
+		"Did not isolate egress traffic of Pods with labels app=client in Namespace ns1 as some of their flows were ignored",
+		"Did not isolate ingress traffic of Pods with labels app=server in Namespace ns1 as some of their flows were ignored",
+		"Ignored flows of Pod ns1/src which has no workload labels",
+		`Ignored flows with invalid IP address "invalid"`,
+		"Ignored flows with unsupported protocol 47",
+	}, recommendation.Warnings)
+}
+
+func TestRecommendIncompleteFlows(t *testing.T) {
+	flows := []*Flow{
+		podToPodFlow("ns1", clientLabels, "ns1", serverLabels, 6, 80),
+		podToPodFlow("ns1", serverLabels, "ns1", dbLabels, 6, 5432),
+		// The client connects to a Pod which can't be selected, its egress traffic must not be isolated.
+		podToPodFlow("ns1", clientLabels, "ns1", nil, 6, 8080),
+		// The server receives traffic from a Pod which can't be selected, its ingress traffic must not be isolated.
+		podToPodFlow("ns1", nil, "ns1", serverLabels, 6, 80),
+	}
+	recommendation := Recommend(flows, Options{})
+	require.Len(t, recommendation.NetworkPolicies, 3)
+	policies := map[string]crdv1beta1.NetworkPolicySpec{}
+	for _, np := range recommendation.NetworkPolicies {
+		policies[np.Spec.AppliedTo[0].PodSelector.MatchLabels["app"]] = np.Spec
+	}
+	assert.Equal(t, []crdv1beta1.Rule{
+		{Action: &allowAction, To: []crdv1beta1.NetworkPolicyPeer{podPeer(serverLabels)}, Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 80)}},
+	}, policies["client"].Egress)
+	assert.Equal(t, []crdv1beta1.Rule{
+		{Action: &allowAction, From: []crdv1beta1.NetworkPolicyPeer{podPeer(clientLabels)}, Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 80)}},
+	}, policies["server"].Ingress)
+	// The other directions are isolated.
+	assert.Equal(t, []crdv1beta1.Rule{
+		{Action: &allowAction, To: []crdv1beta1.NetworkPolicyPeer{podPeer(dbLabels)}, Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 5432)}},
+		{Action: &dropAction},
+	}, policies["server"].Egress)
+	assert.Equal(t, []crdv1beta1.Rule{
+		{Action: &allowAction, From: []crdv1beta1.NetworkPolicyPeer{podPeer(serverLabels)}, Ports: []crdv1beta1.NetworkPolicyPort{port(tcp, 5432)}},
+		{Action: &dropAction},
+	}, policies["db"].Ingress)
+
+	// No warning about isolation is reported when isolation is not applied.
+	recommendation = Recommend(flows, Options{Isolation: IsolationNone})
+	assert.Equal(t, []string{
+		"Ignored flows of Pod ns1/dst which has no workload labels",
+		"Ignored flows of Pod ns1/src which has no workload labels",
+	}, recommendation.Warnings)
+}
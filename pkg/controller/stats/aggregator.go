@@ -16,12 +16,14 @@ package stats
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/tools/cache"
@@ -38,6 +40,9 @@ import (
 const (
 	uidIndex           = "uid"
 	GroupNameIndexName = "groupName"
+	// maxPeersPerStats is the maximum number of peers whose stats are kept for a NetworkPolicy or
+	// a rule. When it's exceeded, the stats of the peers with the least traffic are discarded.
+	maxPeersPerStats = 1000
 )
 
 // Aggregator collects the stats from the antrea-agents, aggregates them, caches the result, and provides interfaces
@@ -47,6 +52,7 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/controller/networkpolicy.RuleHitQuerier
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -59,6 +65,11 @@ type Aggregator struct {
 	// map[IP of multicast group]map[name of node]list of PodReference.
 	groupNodePodsMap      map[string]map[string][]statsv1alpha1.PodReference
 	groupNodePodsMapMutex sync.RWMutex
+	// ruleLastHitTimes caches the last time the rules of Antrea-native policies matched traffic.
+	// The map can be interpreted as
+	// map[UID of policy]map[name of rule]last time the rule matched traffic.
+	ruleLastHitTimes      map[types.UID]map[string]time.Time
+	ruleLastHitTimesMutex sync.RWMutex
 	// dataCh is the channel that buffers the NodeSummaries sent by antrea-agents.
 	dataCh chan *controlplane.NodeStatsSummary
 	// npListerSynced is a function which returns true if the K8s NetworkPolicy shared informer has been synced at least once.
@@ -99,6 +110,7 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 	// They are the source of truth of the ClusterNetworkPolicyStats, i.e., a ClusterNetworkPolicyStats is present
 	// only if the corresponding ClusterNetworkPolicy is present.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		aggregator.ruleLastHitTimes = make(map[types.UID]map[string]time.Time)
 		aggregator.antreaClusterNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
 		aggregator.acnpListerSynced = acnpInformer.Informer().HasSynced
 		acnpInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -205,6 +217,7 @@ func (a *Aggregator) deleteACNP(obj interface{}) {
 		},
 	}
 	a.antreaClusterNetworkPolicyStats.Delete(stats)
+	a.deleteRuleLastHitTimes(acnp.UID)
 }
 
 // addANNP handles Antrea NetworkPolicy ADD events and creates corresponding AntreaNetworkPolicyStats objects.
@@ -246,6 +259,40 @@ func (a *Aggregator) deleteANNP(obj interface{}) {
 		},
 	}
 	a.antreaNetworkPolicyStats.Delete(stats)
+	a.deleteRuleLastHitTimes(annp.UID)
+}
+
+func (a *Aggregator) deleteRuleLastHitTimes(policyUID types.UID) {
+	a.ruleLastHitTimesMutex.Lock()
+	defer a.ruleLastHitTimesMutex.Unlock()
+	delete(a.ruleLastHitTimes, policyUID)
+}
+
+// updateRuleLastHitTimes records the given time as the last time the rules with traffic in
+// ruleStats matched traffic.
+func (a *Aggregator) updateRuleLastHitTimes(policyUID types.UID, ruleStats []statsv1alpha1.RuleTrafficStats, hitTime time.Time) {
+	a.ruleLastHitTimesMutex.Lock()
+	defer a.ruleLastHitTimesMutex.Unlock()
+	for _, stats := range ruleStats {
+		if stats.TrafficStats.Packets == 0 {
+			continue
+		}
+		ruleTimes, exists := a.ruleLastHitTimes[policyUID]
+		if !exists {
+			ruleTimes = make(map[string]time.Time)
+			a.ruleLastHitTimes[policyUID] = ruleTimes
+		}
+		ruleTimes[stats.Name] = hitTime
+	}
+}
+
+// GetRuleLastHitTime returns the last time the given rule of an Antrea-native policy matched
+// traffic since the antrea-controller started. false is returned if it has not matched any.
+func (a *Aggregator) GetRuleLastHitTime(policyUID types.UID, ruleName string) (time.Time, bool) {
+	a.ruleLastHitTimesMutex.RLock()
+	defer a.ruleLastHitTimesMutex.RUnlock()
+	hitTime, exists := a.ruleLastHitTimes[policyUID][ruleName]
+	return hitTime, exists
 }
 
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
@@ -378,6 +425,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 			// The object returned by cache is supposed to be read only, create a new object and update it.
 			curStats := objs[0].(*statsv1alpha1.NetworkPolicyStats).DeepCopy()
 			addUp(&curStats.TrafficStats, &stats.TrafficStats)
+			addPeersUp(&curStats.PeerTrafficStats, stats.PeerTrafficStats)
 			a.networkPolicyStats.Update(curStats)
 		}
 	}
@@ -410,6 +458,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 		a.groupNodePodsMapMutex.Unlock()
 	}
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		now := time.Now()
 		for _, stats := range summary.AntreaClusterNetworkPolicies {
 			// The policy have might been removed, skip processing it if missing.
 			objs, _ := a.antreaClusterNetworkPolicyStats.ByIndex(uidIndex, string(stats.NetworkPolicy.UID))
@@ -421,6 +470,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 					addUp(&curStats.TrafficStats, &stats.TrafficStats)
 				} else {
 					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					a.updateRuleLastHitTimes(curStats.UID, stats.RuleTrafficStats, now)
 				}
 				a.antreaClusterNetworkPolicyStats.Update(curStats)
 			}
@@ -438,6 +488,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 					addUp(&curStats.TrafficStats, &stats.TrafficStats)
 				} else {
 					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					a.updateRuleLastHitTimes(curStats.UID, stats.RuleTrafficStats, now)
 				}
 				a.antreaNetworkPolicyStats.Update(curStats)
 			}
@@ -452,23 +503,24 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
 }
 
 func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *statsv1alpha1.TrafficStats, inc []statsv1alpha1.RuleTrafficStats) {
-	incMap := make(map[string]*statsv1alpha1.TrafficStats)
+	incMap := make(map[string]*statsv1alpha1.RuleTrafficStats)
 	for i, v := range inc {
-		incMap[v.Name] = &inc[i].TrafficStats
+		incMap[v.Name] = &inc[i]
 	}
 	// accumulate incMap traffics stats to the current traffic stats
 	for _, v := range incMap {
-		addUp(ruleSumStats, v)
+		addUp(ruleSumStats, &v.TrafficStats)
 	}
 	// accumulate the rule traffic stats as the rule has already 'existed' in the ruleStats
 	for i, v := range *ruleStats {
 		stats, exist := incMap[v.Name]
 		if exist {
 			(*ruleStats)[i].TrafficStats = statsv1alpha1.TrafficStats{
-				Packets:  v.TrafficStats.Packets + stats.Packets,
-				Bytes:    v.TrafficStats.Bytes + stats.Bytes,
-				Sessions: v.TrafficStats.Sessions + stats.Sessions,
+				Packets:  v.TrafficStats.Packets + stats.TrafficStats.Packets,
+				Bytes:    v.TrafficStats.Bytes + stats.TrafficStats.Bytes,
+				Sessions: v.TrafficStats.Sessions + stats.TrafficStats.Sessions,
 			}
+			addPeersUp(&(*ruleStats)[i].PeerTrafficStats, stats.PeerTrafficStats)
 		}
 		delete(incMap, v.Name)
 	}
@@ -476,8 +528,40 @@ func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *stats
 	for k, v := range incMap {
 		rs := statsv1alpha1.RuleTrafficStats{
 			Name:         k,
-			TrafficStats: *v,
+			TrafficStats: v.TrafficStats,
 		}
+		addPeersUp(&rs.PeerTrafficStats, v.PeerTrafficStats)
 		*ruleStats = append(*ruleStats, rs)
 	}
 }
+
+// addPeersUp accumulates the traffic stats of the peers to the current stats of the peers. The peers
+// with the least traffic are discarded if there are more than maxPeersPerStats peers.
+func addPeersUp(peerStats *[]statsv1alpha1.PeerTrafficStats, inc []statsv1alpha1.PeerTrafficStats) {
+	if len(inc) == 0 {
+		return
+	}
+	peerKey := func(p *statsv1alpha1.PeerTrafficStats) string {
+		if p.Pod != nil {
+			return k8s.NamespacedName(p.Pod.Namespace, p.Pod.Name)
+		}
+		return p.IP
+	}
+	indexes := make(map[string]int, len(*peerStats))
+	for i := range *peerStats {
+		indexes[peerKey(&(*peerStats)[i])] = i
+	}
+	for i := range inc {
+		if index, exists := indexes[peerKey(&inc[i])]; exists {
+			addUp(&(*peerStats)[index].TrafficStats, &inc[i].TrafficStats)
+		} else {
+			*peerStats = append(*peerStats, *inc[i].DeepCopy())
+		}
+	}
+	if len(*peerStats) > maxPeersPerStats {
+		sort.SliceStable(*peerStats, func(i, j int) bool {
+			return (*peerStats)[i].TrafficStats.Bytes > (*peerStats)[j].TrafficStats.Bytes
+		})
+		*peerStats = (*peerStats)[:maxPeersPerStats]
+	}
+}
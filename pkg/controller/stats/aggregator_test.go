@@ -16,6 +16,7 @@ package stats
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -511,6 +512,127 @@ func TestAggregatorCollectListGet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "peer traffic stats",
+			summaries: []*controlplane.NodeStatsSummary{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-1",
+					},
+					NetworkPolicies: []controlplane.NetworkPolicyStats{
+						{
+							NetworkPolicy: controlplane.NetworkPolicyReference{UID: np1.UID},
+							TrafficStats:  statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+							PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+								{
+									Pod:          &statsv1alpha1.PodReference{Namespace: "foo", Name: "pod1"},
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+								},
+							},
+						},
+					},
+					AntreaClusterNetworkPolicies: []controlplane.NetworkPolicyStats{
+						{
+							NetworkPolicy: controlplane.NetworkPolicyReference{UID: acnp1.UID},
+							RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+								{
+									Name:         "rule1",
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 5, Sessions: 2},
+									PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+										{
+											Pod:          &statsv1alpha1.PodReference{Namespace: "foo", Name: "pod1"},
+											TrafficStats: statsv1alpha1.TrafficStats{Bytes: 15, Packets: 3, Sessions: 1},
+										},
+										{
+											IP:           "192.168.1.1",
+											TrafficStats: statsv1alpha1.TrafficStats{Bytes: 5, Packets: 2, Sessions: 1},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-2",
+					},
+					NetworkPolicies: []controlplane.NetworkPolicyStats{
+						{
+							NetworkPolicy: controlplane.NetworkPolicyReference{UID: np1.UID},
+							TrafficStats:  statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3, Sessions: 2},
+							PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+								{
+									Pod:          &statsv1alpha1.PodReference{Namespace: "foo", Name: "pod1"},
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+								},
+								{
+									Pod:          &statsv1alpha1.PodReference{Namespace: "bar", Name: "pod1"},
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 1},
+								},
+							},
+						},
+					},
+					AntreaClusterNetworkPolicies: []controlplane.NetworkPolicyStats{
+						{
+							NetworkPolicy: controlplane.NetworkPolicyReference{UID: acnp1.UID},
+							RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+								{
+									Name:         "rule1",
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+									PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+										{
+											IP:           "192.168.1.1",
+											TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			existingNetworkPolicies:              []runtime.Object{np1},
+			existingAntreaClusterNetworkPolicies: []runtime.Object{acnp1},
+			expectedNetworkPolicyStats: []statsv1alpha1.NetworkPolicyStats{
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: np1.Name, Namespace: np1.Namespace},
+					TrafficStats: statsv1alpha1.TrafficStats{Bytes: 40, Packets: 4, Sessions: 3},
+					PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+						{
+							Pod:          &statsv1alpha1.PodReference{Namespace: "foo", Name: "pod1"},
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 2},
+						},
+						{
+							Pod:          &statsv1alpha1.PodReference{Namespace: "bar", Name: "pod1"},
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 1},
+						},
+					},
+				},
+			},
+			expectedAntreaClusterNetworkPolicyStats: []statsv1alpha1.AntreaClusterNetworkPolicyStats{
+				{
+					ObjectMeta:   metav1.ObjectMeta{Name: acnp1.Name},
+					TrafficStats: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 6, Sessions: 3},
+					RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+						{
+							Name:         "rule1",
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 6, Sessions: 3},
+							PeerTrafficStats: []statsv1alpha1.PeerTrafficStats{
+								{
+									Pod:          &statsv1alpha1.PodReference{Namespace: "foo", Name: "pod1"},
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 15, Packets: 3, Sessions: 1},
+								},
+								{
+									IP:           "192.168.1.1",
+									TrafficStats: statsv1alpha1.TrafficStats{Bytes: 15, Packets: 3, Sessions: 2},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -533,6 +655,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 				actualStats, exists := a.GetNetworkPolicyStats(stats.Namespace, stats.Name)
 				require.True(t, exists)
 				require.Equal(t, stats.TrafficStats, actualStats.TrafficStats)
+				require.ElementsMatch(t, stats.PeerTrafficStats, actualStats.PeerTrafficStats)
 			}
 			assert.Equal(t, len(tt.expectedAntreaClusterNetworkPolicyStats), len(a.ListAntreaClusterNetworkPolicyStats()))
 			for _, Stats := range tt.expectedAntreaClusterNetworkPolicyStats {
@@ -612,11 +735,19 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	}
 
 	expectedPolicyCount := 3
+	startTime := time.Now()
 	runWrapper(t, a, expectedPolicyCount, []*controlplane.NodeStatsSummary{summary})
 
 	require.Equal(t, 1, len(a.ListNetworkPolicyStats("")))
 	require.Equal(t, 1, len(a.ListAntreaClusterNetworkPolicyStats()))
 	require.Equal(t, 1, len(a.ListAntreaNetworkPolicyStats("")))
+	hitTime, hit := a.GetRuleLastHitTime(acnp1.UID, "rule1")
+	assert.True(t, hit)
+	assert.False(t, hitTime.Before(startTime))
+	_, hit = a.GetRuleLastHitTime(annp1.UID, "rule2")
+	assert.True(t, hit)
+	_, hit = a.GetRuleLastHitTime(annp1.UID, "rule1")
+	assert.False(t, hit)
 
 	client.NetworkingV1().NetworkPolicies(np1.Namespace).Delete(context.TODO(), np1.Name, metav1.DeleteOptions{})
 	crdClient.CrdV1beta1().ClusterNetworkPolicies().Delete(context.TODO(), acnp1.Name, metav1.DeleteOptions{})
@@ -626,4 +757,33 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 		return len(a.ListNetworkPolicyStats("")) == 0 && len(a.ListAntreaClusterNetworkPolicyStats()) == 0 && len(a.ListAntreaNetworkPolicyStats("")) == 0, nil
 	})
 	assert.NoError(t, err)
+	_, hit = a.GetRuleLastHitTime(acnp1.UID, "rule1")
+	assert.False(t, hit)
+	_, hit = a.GetRuleLastHitTime(annp1.UID, "rule2")
+	assert.False(t, hit)
+}
+
+func TestAddPeersUp(t *testing.T) {
+	var peerStats []statsv1alpha1.PeerTrafficStats
+	inc := make([]statsv1alpha1.PeerTrafficStats, 0, maxPeersPerStats+1)
+	for i := 0; i <= maxPeersPerStats; i++ {
+		inc = append(inc, statsv1alpha1.PeerTrafficStats{
+			IP:           fmt.Sprintf("10.0.%d.%d", i/256, i%256),
+			TrafficStats: statsv1alpha1.TrafficStats{Bytes: int64(i + 1), Packets: 1, Sessions: 1},
+		})
+	}
+	addPeersUp(&peerStats, inc)
+	// The peer with the least traffic is discarded.
+	require.Len(t, peerStats, maxPeersPerStats)
+	assert.Equal(t, "10.0.3.232", peerStats[0].IP)
+	assert.Equal(t, "10.0.0.1", peerStats[maxPeersPerStats-1].IP)
+
+	addPeersUp(&peerStats, []statsv1alpha1.PeerTrafficStats{
+		{
+			IP:           "10.0.0.1",
+			TrafficStats: statsv1alpha1.TrafficStats{Bytes: 2000, Packets: 1, Sessions: 1},
+		},
+	})
+	require.Len(t, peerStats, maxPeersPerStats)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 2002, Packets: 2, Sessions: 2}, peerStats[maxPeersPerStats-1].TrafficStats)
 }
@@ -64,6 +64,10 @@ const (
 
 	// Traceflow timeout period.
 	defaultTimeoutDuration = time.Second * time.Duration(crdv1beta1.DefaultTraceflowTimeout)
+
+	tcpFlagSYN           int32 = 0b10
+	tcpFlagRST           int32 = 0b100
+	icmpv6ProtocolNumber int32 = 58
 )
 
 var (
@@ -75,6 +79,7 @@ type Controller struct {
 	client                 versioned.Interface
 	podInformer            coreinformers.PodInformer
 	podLister              corelisters.PodLister
+	nodeLister             corelisters.NodeLister
 	traceflowInformer      crdinformers.TraceflowInformer
 	traceflowLister        crdlisters.TraceflowLister
 	traceflowListerSynced  cache.InformerSynced
@@ -84,11 +89,12 @@ type Controller struct {
 }
 
 // NewTraceflowController creates a new traceflow controller and adds podIP indexer to podInformer.
-func NewTraceflowController(client versioned.Interface, podInformer coreinformers.PodInformer, traceflowInformer crdinformers.TraceflowInformer) *Controller {
+func NewTraceflowController(client versioned.Interface, podInformer coreinformers.PodInformer, nodeInformer coreinformers.NodeInformer, traceflowInformer crdinformers.TraceflowInformer) *Controller {
 	c := &Controller{
 		client:                client,
 		podInformer:           podInformer,
 		podLister:             podInformer.Lister(),
+		nodeLister:            nodeInformer.Lister(),
 		traceflowInformer:     traceflowInformer,
 		traceflowLister:       traceflowInformer.Lister(),
 		traceflowListerSynced: traceflowInformer.Informer().HasSynced,
@@ -127,8 +133,8 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		klog.Errorf("Failed to list all Antrea Traceflows")
 	}
 	for _, tf := range tfs {
-		if tf.Status.Phase == crdv1beta1.Running {
-			if err := c.occupyTag(tf); err != nil {
+		if tf.Status.Phase == crdv1beta1.Running || tf.Status.Phase == crdv1beta1.Pending {
+			if err := c.occupyTag(tf.Name, uint8(tf.Status.DataplaneTag)); err != nil {
 				klog.Errorf("Load Traceflow data plane tag failed %v+: %v", tf, err)
 			}
 		}
@@ -238,6 +244,10 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 	switch tf.Status.Phase {
 	case "":
 		err = c.startTraceflow(tf)
+	case crdv1beta1.Pending:
+		// The Antrea Multi-cluster Controller moves the Traceflow to the
+		// Running phase when the peer member clusters are ready.
+		err = c.checkTimeout(tf)
 	case crdv1beta1.Running:
 		err = c.checkTraceflowStatus(tf)
 	case crdv1beta1.Failed:
@@ -248,6 +258,9 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 }
 
 func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
+	if tf.Spec.RemoteSource != nil {
+		return c.startRemoteSourceTraceflow(tf)
+	}
 	// Allocate data plane tag.
 	tag, err := c.allocateTag(tf.Name)
 	if err != nil {
@@ -257,7 +270,28 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 		return nil
 	}
 
-	err = c.updateTraceflowStatus(tf, crdv1beta1.Running, "", tag)
+	phase := crdv1beta1.Running
+	if tf.Spec.Multicluster {
+		// The packet is not injected until the peer member clusters use
+		// the tag too.
+		phase = crdv1beta1.Pending
+	}
+	err = c.updateTraceflowStatus(tf, phase, "", tag)
+	if err != nil {
+		c.deallocateTag(tf.Name, tag)
+	}
+	return err
+}
+
+// startRemoteSourceTraceflow starts a Traceflow tracing, in a peer member cluster, the packet of a
+// multi-cluster Traceflow. The data plane tag of the multi-cluster Traceflow is used, and the
+// Traceflow fails if the tag is already used by another Traceflow.
+func (c *Controller) startRemoteSourceTraceflow(tf *crdv1beta1.Traceflow) error {
+	tag := uint8(tf.Spec.RemoteSource.DataplaneTag)
+	if err := c.occupyTag(tf.Name, tag); err != nil {
+		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, fmt.Sprintf("Failed to use data plane tag %d: %v", tag, err), 0)
+	}
+	err := c.updateTraceflowStatus(tf, crdv1beta1.Running, "", tag)
 	if err != nil {
 		c.deallocateTag(tf.Name, tag)
 	}
@@ -278,16 +312,15 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 		receiver := false
 		for i, nodeResult := range tf.Status.Results {
 			for j, ob := range nodeResult.Observations {
-				if ob.Component == crdv1beta1.ComponentSpoofGuard {
+				if ob.Component == crdv1beta1.ComponentSpoofGuard || ob.Component == crdv1beta1.ComponentClassifier {
 					sender = true
 				}
-				if ob.Action == crdv1beta1.ActionDelivered ||
-					ob.Action == crdv1beta1.ActionDropped ||
-					ob.Action == crdv1beta1.ActionRejected ||
-					ob.Action == crdv1beta1.ActionForwardedOutOfOverlay {
+				if isFinalAction(ob.Action) {
 					receiver = true
 				}
-				if ob.TranslatedDstIP != "" {
+				// The Pods of the results reported in a peer member cluster
+				// are set by the Antrea Controller of that cluster.
+				if ob.TranslatedDstIP != "" && nodeResult.Cluster == "" {
 					// Add Pod ns/name to observation if TranslatedDstIP (a.k.a. Service Endpoint address) is Pod IP.
 					pods, err := c.podInformer.Informer().GetIndexer().ByIndex(grouping.PodIPsIndex, ob.TranslatedDstIP)
 					if err != nil {
@@ -303,17 +336,25 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 				}
 			}
 		}
-		// When the Source Pod is specified, the Traceflow should receive
-		// results from both the sender and the receiver. When the Source
-		// Pod is not specified (in live-traffic Traceflow), only the
+		// When the Source Pod or Node is specified, the Traceflow should
+		// receive results from both the sender and the receiver. When
+		// neither is specified (in live-traffic Traceflow), only the
 		// receiver Node will report the results.
-		succeeded = (sender && receiver) || (receiver && tf.Spec.Source.Pod == "")
+		if tf.Spec.Session != nil {
+			succeeded = sessionCompleted(tf)
+		} else {
+			succeeded = (sender && receiver) || (receiver && tf.Spec.Source.Pod == "" && tf.Spec.Source.Node == "")
+		}
 	}
 	if succeeded {
 		c.deallocateTagForTF(tf)
 		return c.updateTraceflowStatus(tf, crdv1beta1.Succeeded, "", 0)
 	}
+	return c.checkTimeout(tf)
+}
 
+// checkTimeout fails the Traceflow if it is not completed within its timeout.
+func (c *Controller) checkTimeout(tf *crdv1beta1.Traceflow) error {
 	var timeout time.Duration
 	if tf.Spec.Timeout != 0 {
 		timeout = time.Duration(tf.Spec.Timeout) * time.Second
@@ -336,6 +377,61 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	return nil
 }
 
+// isFinalAction returns whether the Observation action ends the path of a traced packet.
+func isFinalAction(action crdv1beta1.TraceflowAction) bool {
+	return action == crdv1beta1.ActionDelivered ||
+		action == crdv1beta1.ActionDropped ||
+		action == crdv1beta1.ActionRejected ||
+		action == crdv1beta1.ActionForwardedOutOfOverlay
+}
+
+// sessionCompleted returns whether all the packets of a session Traceflow have been traced to the end of their paths.
+// The reply to a request packet is expected only if the request packet is delivered or forwarded out of the overlay.
+// In TCPHandshake mode, the ACK is sent by the source Pod only if the SYN-ACK is delivered to it, and no reply is
+// expected for the ACK.
+func sessionCompleted(tf *crdv1beta1.Traceflow) bool {
+	requestActions := map[int32]crdv1beta1.TraceflowAction{}
+	replyCompleted := map[int32]bool{}
+	for _, nodeResult := range tf.Status.Results {
+		for _, ob := range nodeResult.Observations {
+			if !isFinalAction(ob.Action) {
+				continue
+			}
+			if nodeResult.Reply {
+				replyCompleted[nodeResult.Packet] = true
+			} else {
+				requestActions[nodeResult.Packet] = ob.Action
+			}
+		}
+	}
+	isTCPHandshake := tf.Spec.Session.Mode == crdv1beta1.TraceflowSessionTCPHandshake
+	packets := tf.Spec.Session.Packets
+	if isTCPHandshake {
+		packets = 1
+		// The reply delivered to the source Pod is reported as the captured packet.
+		if capturedPacket := tf.Status.CapturedPacket; capturedPacket != nil && capturedPacket.TransportHeader.TCP != nil {
+			if flags := capturedPacket.TransportHeader.TCP.Flags; flags != nil && *flags&tcpFlagSYN != 0 && *flags&tcpFlagRST == 0 {
+				packets = 2
+			}
+		}
+	} else if packets == 0 {
+		packets = crdv1beta1.DefaultTraceflowSessionPackets
+	}
+	for i := int32(1); i <= packets; i++ {
+		action, ok := requestActions[i]
+		if !ok {
+			return false
+		}
+		if isTCPHandshake && i == 2 {
+			continue
+		}
+		if (action == crdv1beta1.ActionDelivered || action == crdv1beta1.ActionForwardedOutOfOverlay) && !replyCompleted[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1beta1.TraceflowPhase, reason string, dataPlaneTag uint8) error {
 	update := tf.DeepCopy()
 	update.Status.Phase = phase
@@ -351,8 +447,7 @@ func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1
 	return err
 }
 
-func (c *Controller) occupyTag(tf *crdv1beta1.Traceflow) error {
-	tag := uint8(tf.Status.DataplaneTag)
+func (c *Controller) occupyTag(name string, tag uint8) error {
 	if tag < minTagNum || tag > maxTagNum {
 		return errors.New("this Traceflow CRD's data plane tag is out of range")
 	}
@@ -360,13 +455,13 @@ func (c *Controller) occupyTag(tf *crdv1beta1.Traceflow) error {
 	c.runningTraceflowsMutex.Lock()
 	defer c.runningTraceflowsMutex.Unlock()
 	if existingTraceflowName, ok := c.runningTraceflows[tag]; ok {
-		if tf.Name == existingTraceflowName {
+		if name == existingTraceflowName {
 			return nil
 		}
 		return errors.New("this Traceflow's CRD data plane tag is already taken")
 	}
 
-	c.runningTraceflows[tag] = tf.Name
+	c.runningTraceflows[tag] = name
 	return nil
 }
 
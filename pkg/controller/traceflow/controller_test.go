@@ -55,6 +55,7 @@ func newController(k8sObjects ...runtime.Object) *traceflowController {
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
 	controller := NewTraceflowController(crdClient,
 		informerFactory.Core().V1().Pods(),
+		informerFactory.Core().V1().Nodes(),
 		crdInformerFactory.Crd().V1beta1().Traceflows())
 	controller.traceflowListerSynced = alwaysReady
 	return &traceflowController{
@@ -145,11 +146,174 @@ func TestTraceflow(t *testing.T) {
 		assert.Equal(t, res.Status.Reason, traceflowTimeout)
 		assert.True(t, res.Status.DataplaneTag == 0)
 		assert.Equal(t, numRunningTraceflows(), 0)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf1", metav1.DeleteOptions{})
+	})
+
+	t.Run("multiclusterTraceflow", func(t *testing.T) {
+		tf2 := tf1.DeepCopy()
+		tf2.Name = "tf2"
+		tf2.Spec.Multicluster = true
+		tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf2, metav1.CreateOptions{})
+		// The Traceflow should wait in the Pending phase until the peer member clusters are ready.
+		res, _ := tfc.waitForTraceflow("tf2", crdv1beta1.Pending, time.Second)
+		require.NotNil(t, res)
+		assert.True(t, res.Status.DataplaneTag > 0)
+		assert.Equal(t, numRunningTraceflows(), 1)
+
+		res.Status.Phase = crdv1beta1.Running
+		res.Status.Results = []crdv1beta1.NodeResult{
+			{
+				Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentSpoofGuard}, {Action: crdv1beta1.ActionForwardedToRemoteCluster}},
+			},
+		}
+		res, _ = tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), res, metav1.UpdateOptions{})
+		require.NotNil(t, res)
+		// The packet is not traced to the end of its path until the results of the peer member cluster are merged.
+		_, err := tfc.waitForTraceflow("tf2", crdv1beta1.Succeeded, 500*time.Millisecond)
+		assert.Error(t, err)
+
+		res.Status.Results = append(res.Status.Results, crdv1beta1.NodeResult{
+			Cluster:      "cluster-b",
+			Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+		})
+		tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("tf2", crdv1beta1.Succeeded, time.Second)
+		require.NotNil(t, res)
+		assert.True(t, res.Status.DataplaneTag == 0)
+		assert.Equal(t, numRunningTraceflows(), 0)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf2", metav1.DeleteOptions{})
+	})
+
+	t.Run("remoteSourceTraceflow", func(t *testing.T) {
+		tf3 := crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf3", UID: "uid3"},
+			Spec: crdv1beta1.TraceflowSpec{
+				RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: "cluster-a", Traceflow: "tf", DataplaneTag: 7},
+				Timeout:      2,
+			},
+		}
+		tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), &tf3, metav1.CreateOptions{})
+		// The data plane tag of the source Traceflow should be used.
+		res, _ := tfc.waitForTraceflow("tf3", crdv1beta1.Running, time.Second)
+		require.NotNil(t, res)
+		assert.Equal(t, int8(7), res.Status.DataplaneTag)
+
+		// Another Traceflow using the same data plane tag should fail.
+		tf4 := tf3.DeepCopy()
+		tf4.Name = "tf4"
+		tf4.UID = "uid4"
+		tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf4, metav1.CreateOptions{})
+		res, _ = tfc.waitForTraceflow("tf4", crdv1beta1.Failed, time.Second)
+		require.NotNil(t, res)
+		assert.Equal(t, "Failed to use data plane tag 7: this Traceflow's CRD data plane tag is already taken", res.Status.Reason)
+		assert.Equal(t, numRunningTraceflows(), 1)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf4", metav1.DeleteOptions{})
+
+		// There is no sender Node in the peer member cluster.
+		res, _ = tfc.waitForTraceflow("tf3", crdv1beta1.Running, time.Second)
+		require.NotNil(t, res)
+		res.Status.Results = []crdv1beta1.NodeResult{
+			{
+				Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+			},
+		}
+		tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("tf3", crdv1beta1.Succeeded, time.Second)
+		require.NotNil(t, res)
+		assert.Equal(t, numRunningTraceflows(), 0)
 	})
 
 	close(stopCh)
 }
 
+func TestSessionCompleted(t *testing.T) {
+	requestResult := func(packet int32, action crdv1beta1.TraceflowAction) crdv1beta1.NodeResult {
+		return crdv1beta1.NodeResult{Packet: packet, Observations: []crdv1beta1.Observation{{Action: action}}}
+	}
+	replyResult := func(packet int32, action crdv1beta1.TraceflowAction) crdv1beta1.NodeResult {
+		return crdv1beta1.NodeResult{Packet: packet, Reply: true, Observations: []crdv1beta1.Observation{{Action: action}}}
+	}
+	tcpReply := func(flags int32) *crdv1beta1.Packet {
+		return &crdv1beta1.Packet{TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{Flags: &flags}}}
+	}
+	tests := []struct {
+		name           string
+		session        crdv1beta1.TraceflowSession
+		results        []crdv1beta1.NodeResult
+		capturedPacket *crdv1beta1.Packet
+		expected       bool
+	}{
+		{
+			name:     "SYN dropped",
+			session:  crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			results:  []crdv1beta1.NodeResult{requestResult(1, crdv1beta1.ActionDropped)},
+			expected: true,
+		},
+		{
+			name:     "SYN-ACK not received",
+			session:  crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			results:  []crdv1beta1.NodeResult{requestResult(1, crdv1beta1.ActionDelivered)},
+			expected: false,
+		},
+		{
+			name:           "ACK not received",
+			session:        crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			results:        []crdv1beta1.NodeResult{requestResult(1, crdv1beta1.ActionDelivered), replyResult(1, crdv1beta1.ActionDelivered)},
+			capturedPacket: tcpReply(0x12),
+			expected:       false,
+		},
+		{
+			name:    "handshake completed",
+			session: crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			results: []crdv1beta1.NodeResult{
+				requestResult(1, crdv1beta1.ActionDelivered),
+				replyResult(1, crdv1beta1.ActionDelivered),
+				requestResult(2, crdv1beta1.ActionDelivered),
+			},
+			capturedPacket: tcpReply(0x12),
+			expected:       true,
+		},
+		{
+			name:           "connection reset",
+			session:        crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			results:        []crdv1beta1.NodeResult{requestResult(1, crdv1beta1.ActionDelivered), replyResult(1, crdv1beta1.ActionDelivered)},
+			capturedPacket: tcpReply(0x14),
+			expected:       true,
+		},
+		{
+			name:    "echo reply dropped",
+			session: crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho, Packets: 2},
+			results: []crdv1beta1.NodeResult{
+				requestResult(1, crdv1beta1.ActionDelivered),
+				replyResult(1, crdv1beta1.ActionDropped),
+				requestResult(2, crdv1beta1.ActionDelivered),
+				replyResult(2, crdv1beta1.ActionDropped),
+			},
+			expected: true,
+		},
+		{
+			name:    "default number of echo requests",
+			session: crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+			results: []crdv1beta1.NodeResult{
+				requestResult(1, crdv1beta1.ActionDelivered),
+				replyResult(1, crdv1beta1.ActionDelivered),
+				requestResult(2, crdv1beta1.ActionDelivered),
+				replyResult(2, crdv1beta1.ActionDelivered),
+			},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := &crdv1beta1.Traceflow{
+				Spec:   crdv1beta1.TraceflowSpec{Session: &tt.session},
+				Status: crdv1beta1.TraceflowStatus{Results: tt.results, CapturedPacket: tt.capturedPacket},
+			}
+			assert.Equal(t, tt.expected, sessionCompleted(tf))
+		})
+	}
+}
+
 func (tfc *traceflowController) waitForPodInNamespace(ns string, name string, timeout time.Duration) (*corev1.Pod, error) {
 	var pod *corev1.Pod
 	var err error
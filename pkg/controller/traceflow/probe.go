@@ -0,0 +1,418 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/client/clientset/versioned"
+	crdv1alpha1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdv1alpha1listers "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/metrics"
+)
+
+const (
+	probeControllerName = "TraceflowProbeController"
+
+	// Default number of workers processing TraceflowProbes.
+	defaultProbeWorkers = 2
+
+	liveTrafficProbeReason = "live-traffic Traceflow is not supported in TraceflowProbe"
+)
+
+var probeKind = crdv1alpha1.SchemeGroupVersion.WithKind("TraceflowProbe")
+
+// metricVec is implemented by the Prometheus metric vectors reported for TraceflowProbes.
+type metricVec interface {
+	Delete(labels map[string]string) bool
+}
+
+type probeMetric struct {
+	vec    metricVec
+	labels map[string]string
+}
+
+// ProbeController periodically starts a Traceflow for each TraceflowProbe. When a Traceflow completes, its outcome is
+// recorded in the status of the TraceflowProbe and reported as Prometheus metrics. The Traceflow of the last completed
+// run is kept for inspection, and is deleted when the next run completes. All the Traceflows of a TraceflowProbe are
+// owned by it, and are garbage-collected when it is deleted.
+type ProbeController struct {
+	client                versioned.Interface
+	probeInformer         crdv1alpha1informers.TraceflowProbeInformer
+	probeLister           crdv1alpha1listers.TraceflowProbeLister
+	probeListerSynced     cache.InformerSynced
+	traceflowLister       crdlisters.TraceflowLister
+	traceflowListerSynced cache.InformerSynced
+	queue                 workqueue.RateLimitingInterface
+	clock                 clock.Clock
+	probeMetricsMutex     sync.Mutex
+	probeMetrics          map[string]map[string]probeMetric // probeName->metric key->metric
+}
+
+// NewProbeController creates a new TraceflowProbe controller.
+func NewProbeController(client versioned.Interface, probeInformer crdv1alpha1informers.TraceflowProbeInformer, traceflowInformer crdinformers.TraceflowInformer) *ProbeController {
+	c := &ProbeController{
+		client:                client,
+		probeInformer:         probeInformer,
+		probeLister:           probeInformer.Lister(),
+		probeListerSynced:     probeInformer.Informer().HasSynced,
+		traceflowLister:       traceflowInformer.Lister(),
+		traceflowListerSynced: traceflowInformer.Informer().HasSynced,
+		queue:                 workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "traceflowProbe"),
+		clock:                 clock.RealClock{},
+		probeMetrics:          map[string]map[string]probeMetric{},
+	}
+	probeInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addProbe,
+			UpdateFunc: c.updateProbe,
+			DeleteFunc: c.deleteProbe,
+		},
+		resyncPeriod,
+	)
+	traceflowInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueTraceflowOwner,
+			UpdateFunc: func(_, curObj interface{}) { c.enqueueTraceflowOwner(curObj) },
+		},
+		resyncPeriod,
+	)
+	return c
+}
+
+func (c *ProbeController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.Infof("Starting %s", probeControllerName)
+	defer klog.Infof("Shutting down %s", probeControllerName)
+
+	if !cache.WaitForNamedCacheSync(probeControllerName, stopCh, c.probeListerSynced, c.traceflowListerSynced) {
+		return
+	}
+
+	for i := 0; i < defaultProbeWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *ProbeController) addProbe(obj interface{}) {
+	probe := obj.(*crdv1alpha1.TraceflowProbe)
+	klog.V(2).InfoS("Processing TraceflowProbe ADD event", "probe", probe.Name)
+	c.queue.Add(probe.Name)
+}
+
+func (c *ProbeController) updateProbe(oldObj, curObj interface{}) {
+	oldProbe := oldObj.(*crdv1alpha1.TraceflowProbe)
+	curProbe := curObj.(*crdv1alpha1.TraceflowProbe)
+	// Status updates are made by this controller and need no processing.
+	if oldProbe.Generation == curProbe.Generation {
+		return
+	}
+	klog.V(2).InfoS("Processing TraceflowProbe UPDATE event", "probe", curProbe.Name)
+	c.queue.Add(curProbe.Name)
+}
+
+func (c *ProbeController) deleteProbe(old interface{}) {
+	probe, ok := old.(*crdv1alpha1.TraceflowProbe)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting TraceflowProbe, invalid type: %v", old)
+			return
+		}
+		probe, ok = tombstone.Obj.(*crdv1alpha1.TraceflowProbe)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting TraceflowProbe, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.V(2).InfoS("Processing TraceflowProbe DELETE event", "probe", probe.Name)
+	c.queue.Add(probe.Name)
+}
+
+// enqueueTraceflowOwner enqueues the TraceflowProbe owning a Traceflow, if any.
+func (c *ProbeController) enqueueTraceflowOwner(obj interface{}) {
+	tf := obj.(*crdv1beta1.Traceflow)
+	if owner := metav1.GetControllerOf(tf); owner != nil && owner.APIVersion == probeKind.GroupVersion().String() && owner.Kind == probeKind.Kind {
+		c.queue.Add(owner.Name)
+	}
+}
+
+func (c *ProbeController) worker() {
+	for c.processProbeItem() {
+	}
+}
+
+func (c *ProbeController) processProbeItem() bool {
+	obj, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(obj)
+
+	key := obj.(string)
+	if err := c.syncProbe(key); err != nil {
+		klog.ErrorS(err, "Error syncing TraceflowProbe", "probe", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *ProbeController) syncProbe(name string) error {
+	probe, err := c.probeLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.deleteProbeMetrics(name)
+			return nil
+		}
+		return err
+	}
+	status := probe.Status.DeepCopy()
+	if probe.Spec.Traceflow.LiveTraffic {
+		status.Result = crdv1alpha1.TraceflowProbeFailed
+		status.Reason = liveTrafficProbeReason
+		return c.updateProbeStatus(probe, status)
+	}
+
+	allTraceflows, err := c.traceflowLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var traceflows []*crdv1beta1.Traceflow
+	for _, tf := range allTraceflows {
+		if metav1.IsControlledBy(tf, probe) {
+			traceflows = append(traceflows, tf)
+		}
+	}
+	sort.Slice(traceflows, func(i, j int) bool {
+		return traceflows[i].CreationTimestamp.Before(&traceflows[j].CreationTimestamp)
+	})
+
+	// Record the outcome of the completed Traceflows in order. The Traceflow of the last recorded run is kept, and the
+	// ones of the previous runs are deleted. The metrics are only updated once the status is updated, so that the runs
+	// are not counted again if the status update fails and the TraceflowProbe is synced again.
+	var last *crdv1beta1.Traceflow
+	var recordedRuns []*crdv1beta1.Traceflow
+	var staleTraceflows []string
+	running := false
+	for _, tf := range traceflows {
+		if tf.Name == status.LastTraceflow {
+			last = tf
+			continue
+		}
+		if tf.Status.Phase != crdv1beta1.Succeeded && tf.Status.Phase != crdv1beta1.Failed {
+			running = true
+			continue
+		}
+		if last != nil && !last.CreationTimestamp.Before(&tf.CreationTimestamp) {
+			// Already recorded before the last run.
+			staleTraceflows = append(staleTraceflows, tf.Name)
+			continue
+		}
+		c.recordRun(probe.Name, tf, status)
+		recordedRuns = append(recordedRuns, tf)
+		if last != nil {
+			staleTraceflows = append(staleTraceflows, last.Name)
+		}
+		last = tf
+	}
+
+	lastRunTime := time.Time{}
+	if status.LastRunTime != nil {
+		lastRunTime = status.LastRunTime.Time
+	}
+	if len(traceflows) > 0 && traceflows[len(traceflows)-1].CreationTimestamp.After(lastRunTime) {
+		lastRunTime = traceflows[len(traceflows)-1].CreationTimestamp.Time
+	}
+	interval := time.Duration(probe.Spec.IntervalSeconds) * time.Second
+	now := c.clock.Now()
+	if !running {
+		if nextRunTime := lastRunTime.Add(interval); nextRunTime.After(now) {
+			c.queue.AddAfter(name, nextRunTime.Sub(now))
+		} else {
+			if failedRun := c.startRun(probe, now, status); failedRun != nil {
+				recordedRuns = append(recordedRuns, failedRun)
+			}
+			c.queue.AddAfter(name, interval)
+		}
+	}
+
+	if err := c.updateProbeStatus(probe, status); err != nil {
+		return err
+	}
+	for _, tf := range recordedRuns {
+		c.recordRunMetrics(probe.Name, tf)
+	}
+	for _, tfName := range staleTraceflows {
+		if err := c.client.CrdV1beta1().Traceflows().Delete(context.TODO(), tfName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// startRun creates the Traceflow of a new run of a TraceflowProbe. A failure to create the Traceflow is recorded as a
+// failed run, and the Traceflow which could not be created is returned.
+func (c *ProbeController) startRun(probe *crdv1alpha1.TraceflowProbe, now time.Time, status *crdv1alpha1.TraceflowProbeStatus) *crdv1beta1.Traceflow {
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%d", probe.Name, now.Unix()),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(probe, probeKind)},
+		},
+		Spec: *probe.Spec.Traceflow.DeepCopy(),
+	}
+	status.LastRunTime = &metav1.Time{Time: now}
+	if _, err := c.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf, metav1.CreateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to start Traceflow for TraceflowProbe", "probe", probe.Name)
+		tf.Status.Phase = crdv1beta1.Failed
+		tf.Status.Reason = fmt.Sprintf("failed to create Traceflow: %v", err)
+		// The Traceflow does not exist, so the one of the previous run is kept.
+		lastTraceflow := status.LastTraceflow
+		c.recordRun(probe.Name, tf, status)
+		status.LastTraceflow = lastTraceflow
+		return tf
+	}
+	klog.V(2).InfoS("Started Traceflow for TraceflowProbe", "probe", probe.Name, "traceflow", tf.Name)
+	return nil
+}
+
+// getProbeResult returns the outcome of a completed Traceflow, and where the Traceflow packet was dropped if it was.
+func getProbeResult(tf *crdv1beta1.Traceflow) (crdv1alpha1.TraceflowProbeResult, string, string, *crdv1beta1.Observation) {
+	if tf.Status.Phase == crdv1beta1.Failed {
+		return crdv1alpha1.TraceflowProbeFailed, tf.Status.Reason, "", nil
+	}
+	for _, nodeResult := range tf.Status.Results {
+		for i := range nodeResult.Observations {
+			ob := &nodeResult.Observations[i]
+			if ob.Action != crdv1beta1.ActionDropped && ob.Action != crdv1beta1.ActionRejected {
+				continue
+			}
+			reason := fmt.Sprintf("%s by %s", ob.Action, ob.Component)
+			if ob.NetworkPolicy != "" {
+				reason = fmt.Sprintf("%s (%s)", reason, ob.NetworkPolicy)
+			}
+			return crdv1alpha1.TraceflowProbeDropped, reason, nodeResult.Node, ob
+		}
+	}
+	return crdv1alpha1.TraceflowProbeSucceeded, "", "", nil
+}
+
+// recordRun records the outcome of a completed Traceflow in the status of its TraceflowProbe.
+func (c *ProbeController) recordRun(probeName string, tf *crdv1beta1.Traceflow, status *crdv1alpha1.TraceflowProbeStatus) {
+	result, reason, dropNode, dropOb := getProbeResult(tf)
+	klog.V(2).InfoS("TraceflowProbe run completed", "probe", probeName, "traceflow", tf.Name, "result", result, "reason", reason)
+	status.LastTraceflow = tf.Name
+	status.Result = result
+	status.Reason = reason
+	status.DropNode = dropNode
+	status.DropComponent = ""
+	if dropOb != nil {
+		status.DropComponent = dropOb.Component
+	}
+	if result == crdv1alpha1.TraceflowProbeSucceeded {
+		status.ConsecutiveFailures = 0
+	} else {
+		status.ConsecutiveFailures++
+	}
+}
+
+// recordRunMetrics records the outcome of a completed Traceflow in the metrics of its TraceflowProbe.
+func (c *ProbeController) recordRunMetrics(probeName string, tf *crdv1beta1.Traceflow) {
+	result, _, dropNode, dropOb := getProbeResult(tf)
+	success := 0.0
+	if result == crdv1alpha1.TraceflowProbeSucceeded {
+		success = 1
+	}
+
+	c.probeMetricsMutex.Lock()
+	defer c.probeMetricsMutex.Unlock()
+	probeLabels := map[string]string{"probe": probeName}
+	metrics.TraceflowProbeSuccess.With(c.trackMetric(metrics.TraceflowProbeSuccess, probeLabels)).Set(success)
+	runLabels := map[string]string{"probe": probeName, "result": string(result)}
+	metrics.TraceflowProbeRuns.With(c.trackMetric(metrics.TraceflowProbeRuns, runLabels)).Inc()
+	if dropOb != nil {
+		dropLabels := map[string]string{"probe": probeName, "node": dropNode, "component": string(dropOb.Component), "component_info": dropOb.ComponentInfo}
+		metrics.TraceflowProbeDrops.With(c.trackMetric(metrics.TraceflowProbeDrops, dropLabels)).Inc()
+	}
+	for _, nodeResult := range tf.Status.Results {
+		for _, ob := range nodeResult.Observations {
+			obLabels := map[string]string{"probe": probeName, "node": nodeResult.Node, "component": string(ob.Component), "action": string(ob.Action)}
+			metrics.TraceflowProbeObservations.With(c.trackMetric(metrics.TraceflowProbeObservations, obLabels)).Inc()
+		}
+	}
+}
+
+// trackMetric remembers the labels of a metric reported for a TraceflowProbe, so that the metric can be deleted with
+// the probe. It must be called with probeMetricsMutex held.
+func (c *ProbeController) trackMetric(vec metricVec, metricLabels map[string]string) map[string]string {
+	probeName := metricLabels["probe"]
+	if c.probeMetrics[probeName] == nil {
+		c.probeMetrics[probeName] = map[string]probeMetric{}
+	}
+	// fmt prints maps sorted by key.
+	c.probeMetrics[probeName][fmt.Sprintf("%p%v", vec, metricLabels)] = probeMetric{vec: vec, labels: metricLabels}
+	return metricLabels
+}
+
+func (c *ProbeController) deleteProbeMetrics(probeName string) {
+	c.probeMetricsMutex.Lock()
+	defer c.probeMetricsMutex.Unlock()
+	for _, m := range c.probeMetrics[probeName] {
+		m.vec.Delete(m.labels)
+	}
+	delete(c.probeMetrics, probeName)
+}
+
+func (c *ProbeController) updateProbeStatus(probe *crdv1alpha1.TraceflowProbe, status *crdv1alpha1.TraceflowProbeStatus) error {
+	if probeStatusEqual(&probe.Status, status) {
+		return nil
+	}
+	update := probe.DeepCopy()
+	update.Status = *status
+	_, err := c.client.CrdV1alpha1().TraceflowProbes().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{})
+	return err
+}
+
+func probeStatusEqual(a, b *crdv1alpha1.TraceflowProbeStatus) bool {
+	if (a.LastRunTime == nil) != (b.LastRunTime == nil) || (a.LastRunTime != nil && !a.LastRunTime.Equal(b.LastRunTime)) {
+		return false
+	}
+	return a.LastTraceflow == b.LastTraceflow &&
+		a.Result == b.Result &&
+		a.Reason == b.Reason &&
+		a.DropNode == b.DropNode &&
+		a.DropComponent == b.DropComponent &&
+		a.ConsecutiveFailures == b.ConsecutiveFailures
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	"antrea.io/antrea/pkg/controller/metrics"
+)
+
+var initMetricsOnce sync.Once
+
+func newProbeController(t *testing.T, now time.Time, probe *crdv1alpha1.TraceflowProbe, traceflows ...*crdv1beta1.Traceflow) (*ProbeController, *fakeversioned.Clientset) {
+	objects := []runtime.Object{probe}
+	for _, tf := range traceflows {
+		objects = append(objects, tf)
+	}
+	crdClient := fakeversioned.NewSimpleClientset(objects...)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
+	probeInformer := crdInformerFactory.Crd().V1alpha1().TraceflowProbes()
+	traceflowInformer := crdInformerFactory.Crd().V1beta1().Traceflows()
+	c := NewProbeController(crdClient, probeInformer, traceflowInformer)
+	c.clock = clocktesting.NewFakeClock(now)
+	require.NoError(t, probeInformer.Informer().GetStore().Add(probe))
+	for _, tf := range traceflows {
+		require.NoError(t, traceflowInformer.Informer().GetStore().Add(tf))
+	}
+	return c, crdClient
+}
+
+func TestSyncProbe(t *testing.T) {
+	initMetricsOnce.Do(metrics.InitializePrometheusMetrics)
+
+	startTime := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	newProbe := func(name string, status crdv1alpha1.TraceflowProbeStatus) *crdv1alpha1.TraceflowProbe {
+		return &crdv1alpha1.TraceflowProbe{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: "probe-uid"},
+			Spec: crdv1alpha1.TraceflowProbeSpec{
+				IntervalSeconds: 60,
+				Traceflow: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "frontend"},
+					Destination: crdv1beta1.Destination{Namespace: "ns2", Service: "payments"},
+				},
+			},
+			Status: status,
+		}
+	}
+	newTraceflow := func(probe *crdv1alpha1.TraceflowProbe, name string, created time.Time, phase crdv1beta1.TraceflowPhase, results ...crdv1beta1.NodeResult) *crdv1beta1.Traceflow {
+		return &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				OwnerReferences:   []metav1.OwnerReference{*metav1.NewControllerRef(probe, probeKind)},
+			},
+			Spec:   probe.Spec.Traceflow,
+			Status: crdv1beta1.TraceflowStatus{Phase: phase, Results: results},
+		}
+	}
+	deliveredResults := []crdv1beta1.NodeResult{
+		{Node: "node1", Observations: []crdv1beta1.Observation{
+			{Component: crdv1beta1.ComponentSpoofGuard, Action: crdv1beta1.ActionForwarded},
+			{Component: crdv1beta1.ComponentForwarding, ComponentInfo: "Output", Action: crdv1beta1.ActionDelivered},
+		}},
+	}
+	droppedResults := []crdv1beta1.NodeResult{
+		{Node: "node1", Observations: []crdv1beta1.Observation{
+			{Component: crdv1beta1.ComponentSpoofGuard, Action: crdv1beta1.ActionForwarded},
+			{Component: crdv1beta1.ComponentNetworkPolicy, ComponentInfo: "EgressRule", Action: crdv1beta1.ActionDropped, NetworkPolicy: "AntreaClusterNetworkPolicy:deny-payments"},
+		}},
+	}
+	lastRunTime := metav1.NewTime(startTime)
+
+	t.Run("start first run", func(t *testing.T) {
+		probe := newProbe("probe1", crdv1alpha1.TraceflowProbeStatus{})
+		c, client := newProbeController(t, startTime, probe)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		tf, err := client.CrdV1beta1().Traceflows().Get(context.TODO(), "probe1-1714521600", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, probe.Spec.Traceflow, tf.Spec)
+		assert.True(t, metav1.IsControlledBy(tf, probe))
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, crdv1alpha1.TraceflowProbeStatus{LastRunTime: &lastRunTime}, updatedProbe.Status)
+	})
+
+	t.Run("wait for running Traceflow", func(t *testing.T) {
+		probe := newProbe("probe2", crdv1alpha1.TraceflowProbeStatus{LastRunTime: &lastRunTime})
+		tf := newTraceflow(probe, "probe2-1714521600", startTime, crdv1beta1.Running)
+		c, client := newProbeController(t, startTime.Add(90*time.Second), probe, tf)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		tfs, err := client.CrdV1beta1().Traceflows().List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, tfs.Items, 1)
+	})
+
+	t.Run("record succeeded run", func(t *testing.T) {
+		probe := newProbe("probe3", crdv1alpha1.TraceflowProbeStatus{LastRunTime: &lastRunTime, ConsecutiveFailures: 2})
+		tf := newTraceflow(probe, "probe3-1714521600", startTime, crdv1beta1.Succeeded, deliveredResults...)
+		c, client := newProbeController(t, startTime.Add(10*time.Second), probe, tf)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, crdv1alpha1.TraceflowProbeStatus{
+			LastRunTime:   &lastRunTime,
+			LastTraceflow: tf.Name,
+			Result:        crdv1alpha1.TraceflowProbeSucceeded,
+		}, updatedProbe.Status)
+		// The next run is not due yet.
+		tfs, err := client.CrdV1beta1().Traceflows().List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, tfs.Items, 1)
+		c.deleteProbeMetrics(probe.Name)
+	})
+
+	t.Run("record dropped run and start next run", func(t *testing.T) {
+		previousRunTime := startTime.Add(-60 * time.Second)
+		probe := newProbe("probe4", crdv1alpha1.TraceflowProbeStatus{
+			LastRunTime:   &lastRunTime,
+			LastTraceflow: "probe4-1714521540",
+			Result:        crdv1alpha1.TraceflowProbeSucceeded,
+		})
+		previousTF := newTraceflow(probe, "probe4-1714521540", previousRunTime, crdv1beta1.Succeeded, deliveredResults...)
+		tf := newTraceflow(probe, "probe4-1714521600", startTime, crdv1beta1.Succeeded, droppedResults...)
+		now := startTime.Add(60 * time.Second)
+		c, client := newProbeController(t, now, probe, previousTF, tf)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, crdv1alpha1.TraceflowProbeStatus{
+			LastRunTime:         &metav1.Time{Time: now},
+			LastTraceflow:       tf.Name,
+			Result:              crdv1alpha1.TraceflowProbeDropped,
+			Reason:              "Dropped by NetworkPolicy (AntreaClusterNetworkPolicy:deny-payments)",
+			DropNode:            "node1",
+			DropComponent:       crdv1beta1.ComponentNetworkPolicy,
+			ConsecutiveFailures: 1,
+		}, updatedProbe.Status)
+		// The Traceflow of the previous run is deleted, and the one of the next run is created.
+		tfs, err := client.CrdV1beta1().Traceflows().List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, item := range tfs.Items {
+			names = append(names, item.Name)
+		}
+		assert.ElementsMatch(t, []string{"probe4-1714521600", "probe4-1714521660"}, names)
+
+		expected := `
+# HELP antrea_controller_traceflow_probe_drops_total [ALPHA] The total number of runs of a TraceflowProbe in which the packet was dropped or rejected, by drop location
+# TYPE antrea_controller_traceflow_probe_drops_total counter
+antrea_controller_traceflow_probe_drops_total{component="NetworkPolicy",component_info="EgressRule",node="node1",probe="probe4"} 1
+# HELP antrea_controller_traceflow_probe_observations_total [ALPHA] The total number of Observations reported in the runs of a TraceflowProbe, by hop
+# TYPE antrea_controller_traceflow_probe_observations_total counter
+antrea_controller_traceflow_probe_observations_total{action="Dropped",component="NetworkPolicy",node="node1",probe="probe4"} 1
+antrea_controller_traceflow_probe_observations_total{action="Forwarded",component="SpoofGuard",node="node1",probe="probe4"} 1
+# HELP antrea_controller_traceflow_probe_runs_total [ALPHA] The total number of completed runs of a TraceflowProbe, by result
+# TYPE antrea_controller_traceflow_probe_runs_total counter
+antrea_controller_traceflow_probe_runs_total{probe="probe4",result="Dropped"} 1
+# HELP antrea_controller_traceflow_probe_success [ALPHA] Whether the last completed run of a TraceflowProbe succeeded (1) or not (0)
+# TYPE antrea_controller_traceflow_probe_success gauge
+antrea_controller_traceflow_probe_success{probe="probe4"} 0
+`
+		metricNames := []string{
+			"antrea_controller_traceflow_probe_drops_total",
+			"antrea_controller_traceflow_probe_observations_total",
+			"antrea_controller_traceflow_probe_runs_total",
+			"antrea_controller_traceflow_probe_success",
+		}
+		assert.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), metricNames...))
+
+		// The metrics are deleted with the probe.
+		require.NoError(t, c.probeInformer.Informer().GetStore().Delete(probe))
+		require.NoError(t, c.syncProbe(probe.Name))
+		assert.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(""), metricNames...))
+	})
+
+	t.Run("retry failed status update", func(t *testing.T) {
+		probe := newProbe("probe7", crdv1alpha1.TraceflowProbeStatus{LastRunTime: &lastRunTime})
+		tf := newTraceflow(probe, "probe7-1714521600", startTime, crdv1beta1.Succeeded, deliveredResults...)
+		c, client := newProbeController(t, startTime.Add(10*time.Second), probe, tf)
+		failedUpdates := 0
+		client.PrependReactor("update", "traceflowprobes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "status" || failedUpdates > 0 {
+				return false, nil, nil
+			}
+			failedUpdates++
+			return true, nil, fmt.Errorf("status update error")
+		})
+		require.Error(t, c.syncProbe(probe.Name))
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, tf.Name, updatedProbe.Status.LastTraceflow)
+		// The run is only counted once the status is updated.
+		expected := `
+# HELP antrea_controller_traceflow_probe_runs_total [ALPHA] The total number of completed runs of a TraceflowProbe, by result
+# TYPE antrea_controller_traceflow_probe_runs_total counter
+antrea_controller_traceflow_probe_runs_total{probe="probe7",result="Succeeded"} 1
+`
+		assert.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_controller_traceflow_probe_runs_total"))
+		c.deleteProbeMetrics(probe.Name)
+	})
+
+	t.Run("record failed Traceflow creation", func(t *testing.T) {
+		probe := newProbe("probe5", crdv1alpha1.TraceflowProbeStatus{})
+		// The Traceflow to create already exists but is not owned by the probe.
+		tf := &crdv1beta1.Traceflow{ObjectMeta: metav1.ObjectMeta{Name: "probe5-1714521600"}}
+		c, client := newProbeController(t, startTime, probe, tf)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, crdv1alpha1.TraceflowProbeFailed, updatedProbe.Status.Result)
+		assert.Contains(t, updatedProbe.Status.Reason, "failed to create Traceflow")
+		assert.Equal(t, int32(1), updatedProbe.Status.ConsecutiveFailures)
+		assert.Empty(t, updatedProbe.Status.LastTraceflow)
+		c.deleteProbeMetrics(probe.Name)
+	})
+
+	t.Run("live-traffic Traceflow is not supported", func(t *testing.T) {
+		probe := newProbe("probe6", crdv1alpha1.TraceflowProbeStatus{})
+		probe.Spec.Traceflow.LiveTraffic = true
+		c, client := newProbeController(t, startTime, probe)
+		require.NoError(t, c.syncProbe(probe.Name))
+
+		updatedProbe, err := client.CrdV1alpha1().TraceflowProbes().Get(context.TODO(), probe.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, crdv1alpha1.TraceflowProbeStatus{Result: crdv1alpha1.TraceflowProbeFailed, Reason: liveTrafficProbeReason}, updatedProbe.Status)
+		tfs, err := client.CrdV1beta1().Traceflows().List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, tfs.Items)
+	})
+}
+
+func TestGetProbeResult(t *testing.T) {
+	tcs := []struct {
+		name           string
+		status         crdv1beta1.TraceflowStatus
+		expectedResult crdv1alpha1.TraceflowProbeResult
+		expectedReason string
+		expectedNode   string
+	}{
+		{
+			name:           "failed",
+			status:         crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Failed, Reason: traceflowTimeout},
+			expectedResult: crdv1alpha1.TraceflowProbeFailed,
+			expectedReason: traceflowTimeout,
+		},
+		{
+			name: "forwarded out of overlay",
+			status: crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Succeeded, Results: []crdv1beta1.NodeResult{
+				{Node: "node1", Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionForwardedOutOfOverlay}}},
+			}},
+			expectedResult: crdv1alpha1.TraceflowProbeSucceeded,
+		},
+		{
+			name: "rejected on receiver",
+			status: crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Succeeded, Results: []crdv1beta1.NodeResult{
+				{Node: "node1", Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionForwarded}}},
+				{Node: "node2", Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentNetworkPolicy, Action: crdv1beta1.ActionRejected}}},
+			}},
+			expectedResult: crdv1alpha1.TraceflowProbeDropped,
+			expectedReason: "Rejected by NetworkPolicy",
+			expectedNode:   "node2",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			result, reason, node, _ := getProbeResult(&crdv1beta1.Traceflow{Status: tc.status})
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReason, reason)
+			assert.Equal(t, tc.expectedNode, node)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package traceflow
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 
 	admv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,6 +25,7 @@ import (
 	"k8s.io/klog/v2"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 )
 
@@ -68,9 +70,25 @@ func (c *Controller) Validate(review *admv1.AdmissionReview) *admv1.AdmissionRes
 }
 
 func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if tf.Spec.RemoteSource != nil {
+		return validateRemoteSource(tf)
+	}
+	if tf.Spec.Multicluster {
+		if allowed, deniedReason := validateMulticluster(tf); !allowed {
+			return allowed, deniedReason
+		}
+	}
+	if tf.Spec.Session != nil {
+		if allowed, deniedReason := validateSession(tf); !allowed {
+			return allowed, deniedReason
+		}
+	}
+	if tf.Spec.Source.Node != "" {
+		return c.validateNodeSource(tf)
+	}
 	if !tf.Spec.LiveTraffic {
 		if tf.Spec.Source.Namespace == "" || tf.Spec.Source.Pod == "" {
-			return false, "source Pod must be specified in non-live-traffic Traceflow"
+			return false, "source Pod or Node must be specified in non-live-traffic Traceflow"
 		}
 		srcPod, err := c.podLister.Pods(tf.Spec.Source.Namespace).Get(tf.Spec.Source.Pod)
 		if err != nil {
@@ -88,3 +106,104 @@ func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedRea
 	}
 	return true, ""
 }
+
+// validateNodeSource validates a Traceflow sending a packet from the host network of a Node, or
+// from an external client through a Node.
+func (c *Controller) validateNodeSource(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if tf.Spec.LiveTraffic {
+		return false, "source Node is not supported in live-traffic Traceflow"
+	}
+	if tf.Spec.Source.Pod != "" {
+		return false, "source Pod and source Node cannot be specified together"
+	}
+	if tf.Spec.Source.IP != "" {
+		srcIP := net.ParseIP(tf.Spec.Source.IP)
+		if srcIP == nil {
+			return false, fmt.Sprintf("invalid source IP %s", tf.Spec.Source.IP)
+		}
+		if (srcIP.To4() == nil) != (tf.Spec.Packet.IPv6Header != nil) {
+			return false, "source IP does not match the IP header family"
+		}
+	}
+	if tf.Spec.Destination.Pod == "" && tf.Spec.Destination.Service == "" && tf.Spec.Destination.IP == "" {
+		return false, "destination must be specified when the source is a Node"
+	}
+	if _, err := c.nodeLister.Get(tf.Spec.Source.Node); err != nil {
+		if apierrors.IsNotFound(err) {
+			err = fmt.Errorf("requested source Node %s not found", tf.Spec.Source.Node)
+		}
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// validateMulticluster validates a Traceflow following the packet into the peer member clusters
+// of a ClusterSet.
+func validateMulticluster(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if !features.DefaultFeatureGate.Enabled(features.Multicluster) {
+		return false, "multicluster Traceflow requires the Multicluster feature gate to be enabled"
+	}
+	if tf.Spec.LiveTraffic {
+		return false, "multicluster is not supported in live-traffic Traceflow"
+	}
+	if tf.Spec.Session != nil {
+		return false, "multicluster is not supported in session Traceflow"
+	}
+	return true, ""
+}
+
+// validateRemoteSource validates a Traceflow tracing in a peer member cluster the packet of a
+// multi-cluster Traceflow.
+func validateRemoteSource(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if tf.Spec.Source != (crdv1beta1.Source{}) || tf.Spec.LiveTraffic || tf.Spec.Session != nil || tf.Spec.Multicluster {
+		return false, "remoteSource cannot be specified together with source, liveTraffic, session or multicluster"
+	}
+	remoteSource := tf.Spec.RemoteSource
+	if remoteSource.ClusterID == "" || remoteSource.Traceflow == "" {
+		return false, "clusterID and traceflow must be specified in remoteSource"
+	}
+	tag := uint8(remoteSource.DataplaneTag)
+	if tag < minTagNum || tag > maxTagNum || (tag-minTagNum)%tagStep != 0 {
+		return false, fmt.Sprintf("invalid data plane tag %d in remoteSource", remoteSource.DataplaneTag)
+	}
+	return true, ""
+}
+
+// validateSession validates a Traceflow tracing the packets of a connection in both directions.
+func validateSession(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if tf.Spec.LiveTraffic {
+		return false, "session is not supported in live-traffic Traceflow"
+	}
+	if tf.Spec.Source.Pod == "" {
+		return false, "source Pod must be specified in session Traceflow"
+	}
+	transportHeader := tf.Spec.Packet.TransportHeader
+	if transportHeader.UDP != nil {
+		return false, "UDP is not supported in session Traceflow"
+	}
+	switch tf.Spec.Session.Mode {
+	case crdv1beta1.TraceflowSessionTCPHandshake:
+		if transportHeader.TCP == nil {
+			return false, "TCP header must be specified in TCPHandshake session Traceflow"
+		}
+		if transportHeader.TCP.Flags != nil && *transportHeader.TCP.Flags != tcpFlagSYN {
+			return false, "TCP flags other than SYN cannot be specified in TCPHandshake session Traceflow"
+		}
+	case crdv1beta1.TraceflowSessionICMPEcho:
+		if transportHeader.TCP != nil {
+			return false, "TCP header cannot be specified in ICMPEcho session Traceflow"
+		}
+		if ipHeader := tf.Spec.Packet.IPHeader; ipHeader != nil && ipHeader.Protocol != 0 && ipHeader.Protocol != crdv1beta1.ICMPProtocolNumber {
+			return false, "only ICMP is supported in ICMPEcho session Traceflow"
+		}
+		if ipv6Header := tf.Spec.Packet.IPv6Header; ipv6Header != nil && ipv6Header.NextHeader != nil && *ipv6Header.NextHeader != icmpv6ProtocolNumber {
+			return false, "only ICMPv6 is supported in ICMPEcho session Traceflow"
+		}
+		if tf.Spec.Session.Packets < 0 || tf.Spec.Session.Packets > crdv1beta1.MaxTraceflowSessionPackets {
+			return false, fmt.Sprintf("number of packets in session Traceflow must be between 1 and %d", crdv1beta1.MaxTraceflowSessionPackets)
+		}
+	default:
+		return false, fmt.Sprintf("unsupported session mode %s", tf.Spec.Session.Mode)
+	}
+	return true, ""
+}
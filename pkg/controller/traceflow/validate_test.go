@@ -23,8 +23,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/features"
 )
 
 func TestControllerValidate(t *testing.T) {
@@ -32,7 +35,9 @@ func TestControllerValidate(t *testing.T) {
 		name string
 
 		// environment
-		pods []*v1.Pod
+		pods               []*v1.Pod
+		nodes              []*v1.Node
+		enableMulticluster bool
 
 		// input
 		oldSpec *crdv1beta1.TraceflowSpec
@@ -43,11 +48,11 @@ func TestControllerValidate(t *testing.T) {
 		deniedReason string
 	}{
 		{
-			name: "Source Pod must be specified in non-live-traffic Traceflow",
+			name: "Source Pod or Node must be specified in non-live-traffic Traceflow",
 			newSpec: &crdv1beta1.TraceflowSpec{
 				Destination: crdv1beta1.Destination{IP: "10.0.0.2"},
 			},
-			deniedReason: "source Pod must be specified in non-live-traffic Traceflow",
+			deniedReason: "source Pod or Node must be specified in non-live-traffic Traceflow",
 		},
 		{
 			name: "Traceflow should have either source or destination Pod assigned",
@@ -82,6 +87,193 @@ func TestControllerValidate(t *testing.T) {
 			},
 			deniedReason: "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported",
 		},
+		{
+			name: "Source Node is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Node: "node1"},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod"},
+				LiveTraffic: true,
+			},
+			deniedReason: "source Node is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "Source Pod and source Node cannot be specified together",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod", Node: "node1"},
+				Destination: crdv1beta1.Destination{IP: "10.0.0.2"},
+			},
+			deniedReason: "source Pod and source Node cannot be specified together",
+		},
+		{
+			name: "External source IP must match the IP header family",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Node: "node1", IP: "fd00::1"},
+				Destination: crdv1beta1.Destination{IP: "10.0.0.2"},
+			},
+			deniedReason: "source IP does not match the IP header family",
+		},
+		{
+			name: "Destination must be specified with source Node",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Node: "node1"},
+			},
+			deniedReason: "destination must be specified when the source is a Node",
+		},
+		{
+			name: "Source Node not found",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Node: "node1", IP: "192.168.1.10"},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Service: "test-svc"},
+			},
+			deniedReason: "requested source Node node1 not found",
+		},
+		{
+			name: "Valid request from external source",
+			nodes: []*v1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Node: "node1", IP: "192.168.1.10"},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Service: "test-svc"},
+			},
+			allowed: true,
+		},
+		{
+			name: "Session is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				LiveTraffic: true,
+				Session:     &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+			},
+			deniedReason: "session is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "Source Pod must be specified in session Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Node: "node1"},
+				Destination: crdv1beta1.Destination{IP: "10.0.0.2"},
+				Session:     &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+			},
+			deniedReason: "source Pod must be specified in session Traceflow",
+		},
+		{
+			name: "UDP is not supported in session Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{UDP: &crdv1beta1.UDPHeader{DstPort: 53}},
+				},
+				Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+			},
+			deniedReason: "UDP is not supported in session Traceflow",
+		},
+		{
+			name: "TCP header must be specified in TCPHandshake session Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:  crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			},
+			deniedReason: "TCP header must be specified in TCPHandshake session Traceflow",
+		},
+		{
+			name: "TCP flags other than SYN cannot be specified in TCPHandshake session Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{DstPort: 80, Flags: ptr.To[int32](16)}},
+				},
+				Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			},
+			deniedReason: "TCP flags other than SYN cannot be specified in TCPHandshake session Traceflow",
+		},
+		{
+			name: "Only ICMP is supported in ICMPEcho session Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					IPHeader: &crdv1beta1.IPHeader{Protocol: crdv1beta1.SCTPProtocolNumber},
+				},
+				Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionICMPEcho},
+			},
+			deniedReason: "only ICMP is supported in ICMPEcho session Traceflow",
+		},
+		{
+			name: "Valid TCPHandshake session request",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Service: "test-svc"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{DstPort: 80}},
+				},
+				Session: &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+			},
+			allowed: true,
+		},
+		{
+			name: "Multicluster feature gate must be enabled in multicluster Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:       crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Multicluster: true,
+			},
+			deniedReason: "multicluster Traceflow requires the Multicluster feature gate to be enabled",
+		},
+		{
+			name:               "Multicluster is not supported in session Traceflow",
+			enableMulticluster: true,
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{DstPort: 80}},
+				},
+				Session:      &crdv1beta1.TraceflowSession{Mode: crdv1beta1.TraceflowSessionTCPHandshake},
+				Multicluster: true,
+			},
+			deniedReason: "multicluster is not supported in session Traceflow",
+		},
+		{
+			name:               "Valid multicluster request",
+			enableMulticluster: true,
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:       crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Destination:  crdv1beta1.Destination{Namespace: "test-ns", Service: "antrea-mc-test-svc"},
+				Multicluster: true,
+			},
+			allowed: true,
+		},
+		{
+			name: "RemoteSource cannot be specified together with source",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:       crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: "cluster-a", Traceflow: "tf", DataplaneTag: 7},
+			},
+			deniedReason: "remoteSource cannot be specified together with source, liveTraffic, session or multicluster",
+		},
+		{
+			name: "Invalid data plane tag in remoteSource",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: "cluster-a", Traceflow: "tf", DataplaneTag: 8},
+			},
+			deniedReason: "invalid data plane tag 8 in remoteSource",
+		},
+		{
+			name: "Valid remoteSource request",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				RemoteSource: &crdv1beta1.TraceflowRemoteSource{ClusterID: "cluster-a", Traceflow: "tf", DataplaneTag: 7},
+			},
+			allowed: true,
+		},
 		{
 			name: "Valid request",
 			pods: []*v1.Pod{
@@ -100,13 +292,17 @@ func TestControllerValidate(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.Multicluster, tc.enableMulticluster)()
 			stopCh := make(chan struct{})
 			defer close(stopCh)
-			pods := make([]runtime.Object, 0)
+			objects := make([]runtime.Object, 0)
 			for _, p := range tc.pods {
-				pods = append(pods, p)
+				objects = append(objects, p)
+			}
+			for _, n := range tc.nodes {
+				objects = append(objects, n)
 			}
-			controller := newController(pods...)
+			controller := newController(objects...)
 			controller.informerFactory.Start(stopCh)
 			controller.crdInformerFactory.Start(stopCh)
 			// Must wait for cache sync, otherwise resource creation events will be missing if the resources are created
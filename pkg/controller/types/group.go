@@ -54,6 +54,11 @@ type GroupSelector struct {
 	// This is a label selector which selects certain Node IPs. Within a group NodeSelector cannot be set together with
 	// other selectors: Namespace/NamespaceSelector/PodSelector/ExternalEntitySelector.
 	NodeSelector labels.Selector
+
+	// This is a label selector which selects Nodes. If set, only the Pods running on the selected Nodes are matched
+	// by the other selectors. If it's the only selector set and Namespace is empty, it selects the Pods running on the
+	// selected Nodes in all Namespaces. It cannot be set with ExternalEntitySelector or NodeSelector.
+	PodNodeSelector labels.Selector
 }
 
 // NewGroupSelector converts the podSelector, namespaceSelector, externalEntitySelector and nodeSelector
@@ -84,6 +89,22 @@ func NewGroupSelector(namespace string, podSelector, nsSelector, extEntitySelect
 	return &groupSelector
 }
 
+// SetPodNodeSelector restricts the GroupSelector to the Pods running on the Nodes selected by podNodeSelector and
+// updates its NormalizedName accordingly. It does nothing if podNodeSelector is nil.
+func (gs *GroupSelector) SetPodNodeSelector(podNodeSelector *metav1.LabelSelector) {
+	if podNodeSelector == nil {
+		return
+	}
+	gs.PodNodeSelector, _ = metav1.LabelSelectorAsSelector(podNodeSelector)
+	var normalizedName []string
+	if gs.NormalizedName != "" {
+		normalizedName = strings.Split(gs.NormalizedName, " And ")
+	}
+	normalizedName = append(normalizedName, fmt.Sprintf("podNodeSelector=%s", gs.PodNodeSelector.String()))
+	sort.Strings(normalizedName)
+	gs.NormalizedName = strings.Join(normalizedName, " And ")
+}
+
 // GenerateNormalizedName generates a string, based on the selectors, in
 // the following format: "namespace=NamespaceName And podSelector=normalizedPodSelector".
 // Note: Namespace and nsSelector may or may not be set depending on the
@@ -135,4 +156,7 @@ type Group struct {
 	ServiceReference *controlplane.ServiceReference
 	// ChildGroups is the list of Group names that belong to this Group.
 	ChildGroups []string
+	// IPSet is the name of the IPSet whose CIDRs are used as the IPBlocks of
+	// this Group.
+	IPSet string
 }
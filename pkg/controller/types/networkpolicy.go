@@ -15,10 +15,13 @@
 package types
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -113,11 +116,33 @@ type NetworkPolicy struct {
 	// TierPriority represents the priority of the Tier associated with this Network
 	// Policy.
 	TierPriority *int32
+	// Tier is the name of the Tier associated with this Network Policy. Empty means
+	// the default Application Tier for Antrea-native policies.
+	Tier string
+	// EnforcementMode represents how the rules of this Network Policy are enforced,
+	// taking the Tier of the Network Policy into account. Empty means the rules are
+	// enforced.
+	EnforcementMode crdv1beta1.EnforcementMode
 	// AppliedToPerRule tracks if appliedTo is set per rule basis rather than in policy spec.
 	// Must be false for K8s NetworkPolicy.
 	AppliedToPerRule bool
 	// SyncError is the Error encountered when syncing this NetworkPolicy.
 	SyncError error
+	// ScheduleState is the activation state of this NetworkPolicy. It's only set
+	// when the original NetworkPolicy or any of its rules has Schedules.
+	ScheduleState *ScheduleState
+}
+
+// ScheduleState describes the activation state of a NetworkPolicy with Schedules.
+type ScheduleState struct {
+	// Active indicates whether the NetworkPolicy is enforced at the moment.
+	Active bool
+	// ActiveRules is the number of rules with Schedules enforced at the moment.
+	ActiveRules int32
+	// NextTransitionTime is the time at which the activation state of the
+	// NetworkPolicy or any of its rules is expected to change next. It's zero
+	// if no transition is expected.
+	NextTransitionTime time.Time
 }
 
 // GetAddressGroups returns AddressGroups used by this NetworkPolicy.
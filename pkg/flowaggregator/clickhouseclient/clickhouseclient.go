@@ -37,8 +37,8 @@ const (
 	ProtocolUnknown   = -1
 	maxQueueSize      = 1 << 19 // 524288. ~500MB assuming 1KB per record
 	queueFlushTimeout = 10 * time.Second
-	insertQuery       = `INSERT INTO flows (
-                   flowStartSeconds,
+	// flowsColumns are the columns of the flows table which are always inserted.
+	flowsColumns = `flowStartSeconds,
                    flowEndSeconds,
                    flowEndSecondsFromSourceNode,
                    flowEndSecondsFromDestinationNode,
@@ -90,10 +90,27 @@ const (
                    egressIP,
                    appProtocolName,
                    httpVals,
-				   egressNodeName)
-                   VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 
+				   egressNodeName`
+	// l7ViolationColumns are the columns added to the flows table for L7 NetworkPolicy violations. They are only
+	// inserted if the flows table has them, so that the flows tables created with an older schema keep working.
+	l7ViolationColumns = `,
+                   l7NetworkPolicyName,
+                   l7NetworkPolicyNamespace,
+                   l7NetworkPolicyRuleName,
+                   l7NetworkPolicyRuleAction,
+                   l7NetworkPolicyType,
+                   l7ViolationVals`
+	insertQuery = `INSERT INTO flows (` + flowsColumns + `)
+                   VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
                            ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
                            ?, ?, ?, ?, ?)`
+	insertQueryWithL7Violations = `INSERT INTO flows (` + flowsColumns + l7ViolationColumns + `)
+                   VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+                           ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+                           ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	// l7ViolationColumnsQuery returns 1 if the flows table has the L7 NetworkPolicy violation columns.
+	l7ViolationColumnsQuery = `SELECT count() FROM system.columns
+                   WHERE database = currentDatabase() AND table = 'flows' AND name = 'l7ViolationVals'`
 )
 
 // PrepareClickHouseConnection is used for unit testing
@@ -123,6 +140,9 @@ type ClickHouseExportProcess struct {
 	// mutex protects configuration state from concurrent access
 	mutex       sync.Mutex
 	clusterUUID string
+	// hasL7ViolationColumns caches whether the flows table of the current connection has the L7
+	// NetworkPolicy violation columns. It is nil until detected and reset when the connection changes.
+	hasL7ViolationColumns *bool
 }
 
 type ClickHouseConfig struct {
@@ -253,12 +273,22 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 		return 0, nil
 	}
 
+	withL7Violations, err := ch.detectL7ViolationColumns(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Error when checking the schema of the flows table")
+		return 0, err
+	}
+	query := insertQuery
+	if withL7Violations {
+		query = insertQueryWithL7Violations
+	}
+
 	var stmt *sql.Stmt
 
 	// start new connection
 	tx, err := ch.db.BeginTx(ctx, nil)
 	if err == nil {
-		stmt, err = tx.PrepareContext(ctx, insertQuery)
+		stmt, err = tx.PrepareContext(ctx, query)
 	}
 	if err != nil {
 		klog.ErrorS(err, "Error when preparing insert statement")
@@ -281,8 +311,7 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	ch.dequeMutex.Unlock()
 
 	for _, record := range recordsToExport {
-		_, err := stmt.ExecContext(
-			ctx,
+		args := []interface{}{
 			record.FlowStartSeconds,
 			record.FlowEndSeconds,
 			record.FlowEndSecondsFromSourceNode,
@@ -336,7 +365,18 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 			record.AppProtocolName,
 			record.HttpVals,
 			record.EgressNodeName,
-		)
+		}
+		if withL7Violations {
+			args = append(args,
+				record.L7NetworkPolicyName,
+				record.L7NetworkPolicyNamespace,
+				record.L7NetworkPolicyRuleName,
+				record.L7NetworkPolicyRuleAction,
+				record.L7NetworkPolicyType,
+				record.L7ViolationVals,
+			)
+		}
+		_, err := stmt.ExecContext(ctx, args...)
 
 		if err != nil {
 			klog.ErrorS(err, "Error when adding record")
@@ -355,6 +395,25 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	return len(recordsToExport), nil
 }
 
+// detectL7ViolationColumns returns whether the flows table has the L7 NetworkPolicy violation columns.
+// The flows table may have been created with an older schema, in which case these columns are not
+// inserted. The result is cached until the connection changes.
+func (ch *ClickHouseExportProcess) detectL7ViolationColumns(ctx context.Context) (bool, error) {
+	if ch.hasL7ViolationColumns != nil {
+		return *ch.hasL7ViolationColumns, nil
+	}
+	var count uint64
+	if err := ch.db.QueryRowContext(ctx, l7ViolationColumnsQuery).Scan(&count); err != nil {
+		return false, err
+	}
+	hasColumns := count > 0
+	if !hasColumns {
+		klog.InfoS("The flows table has no L7 NetworkPolicy violation columns, they will not be exported")
+	}
+	ch.hasL7ViolationColumns = &hasColumns
+	return hasColumns, nil
+}
+
 // pushRecordsToFrontOfQueue pushes records to the front of deque without exceeding its capacity.
 // Items with lower index (older records) will be dropped first if deque is to be filled.
 func (ch *ClickHouseExportProcess) pushRecordsToFrontOfQueue(records []*flowrecord.FlowRecord) {
@@ -393,6 +452,7 @@ func (ch *ClickHouseExportProcess) UpdateCH(config ClickHouseConfig, connect *sq
 	defer ch.mutex.Unlock()
 	ch.config = config
 	ch.db = connect
+	ch.hasL7ViolationColumns = nil
 }
 
 func (ch *ClickHouseExportProcess) GetCommitInterval() time.Duration {
@@ -29,16 +29,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
-	"github.com/vmware/go-ipfix/pkg/registry"
 	"go.uber.org/mock/gomock"
 
 	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	flowrecordtesting "antrea.io/antrea/pkg/flowaggregator/flowrecord/testing"
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 func init() {
-	registry.LoadRegistry()
+	ipfix.NewIPFIXRegistry().LoadRegistry()
 }
 
 var fakeClusterUUID = uuid.New().String()
@@ -66,6 +66,14 @@ func TestCacheRecord(t *testing.T) {
 	assert.Equal(t, "2001:0:3238:dfe1:63::fefb", chExportProc.deque.At(0).(*flowrecord.FlowRecord).SourceIP)
 }
 
+func expectL7ViolationColumns(mock sqlmock.Sqlmock, hasColumns bool) {
+	count := 0
+	if hasColumns {
+		count = 1
+	}
+	mock.ExpectQuery(l7ViolationColumnsQuery).WillReturnRows(sqlmock.NewRows([]string{"count()"}).AddRow(count))
+}
+
 func TestBatchCommitAll(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err, "error when opening a stub database connection")
@@ -82,8 +90,9 @@ func TestBatchCommitAll(t *testing.T) {
 
 	chExportProc.deque.PushBack(recordRow)
 
+	expectL7ViolationColumns(mock, true)
 	mock.ExpectBegin()
-	mock.ExpectPrepare(insertQuery).ExpectExec().
+	mock.ExpectPrepare(insertQueryWithL7Violations).ExpectExec().
 		WithArgs(
 			time.Unix(int64(1637706961), 0),
 			time.Unix(int64(1637706973), 0),
@@ -137,7 +146,13 @@ func TestBatchCommitAll(t *testing.T) {
 			"172.18.0.1",
 			"http",
 			"mockHttpString",
-			"test-egress-node").
+			"test-egress-node",
+			"test-flow-aggregator-networkpolicy-l7",
+			"test-flow-aggregator-networkpolicy-l7-ns",
+			"test-flow-aggregator-networkpolicy-rule-l7",
+			3,
+			2,
+			"mockL7ViolationString").
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
 
@@ -165,8 +180,9 @@ func TestBatchCommitAllMultiRecord(t *testing.T) {
 		argList[i] = sqlmock.AnyArg()
 	}
 
+	expectL7ViolationColumns(mock, true)
 	mock.ExpectBegin()
-	expected := mock.ExpectPrepare(insertQuery)
+	expected := mock.ExpectPrepare(insertQueryWithL7Violations)
 	for i := 0; i < 10; i++ {
 		chExportProc.deque.PushBack(&recordRow)
 		expected.ExpectExec().WithArgs(argList...).WillReturnResult(sqlmock.NewResult(int64(i), 1))
@@ -180,6 +196,48 @@ func TestBatchCommitAllMultiRecord(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet(), "unfulfilled expectations for db sql operation")
 }
 
+func TestBatchCommitAllWithoutL7ViolationColumns(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, "error when opening a stub database connection")
+	defer db.Close()
+
+	chExportProc := ClickHouseExportProcess{
+		db:        db,
+		deque:     deque.New(),
+		queueSize: maxQueueSize,
+	}
+	recordRow := flowrecord.FlowRecord{}
+	// The 6 L7 NetworkPolicy violation fields are not inserted.
+	fieldCount := reflect.TypeOf(recordRow).NumField() + 1 - 6
+	argList := make([]driver.Value, fieldCount)
+	for i := 0; i < len(argList); i++ {
+		argList[i] = sqlmock.AnyArg()
+	}
+
+	expectL7ViolationColumns(mock, false)
+	mock.ExpectBegin()
+	expected := mock.ExpectPrepare(insertQuery)
+	for i := 0; i < 2; i++ {
+		chExportProc.deque.PushBack(&recordRow)
+		expected.ExpectExec().WithArgs(argList...).WillReturnResult(sqlmock.NewResult(int64(i), 1))
+	}
+	mock.ExpectCommit()
+
+	count, err := chExportProc.batchCommitAll(context.Background())
+	assert.NoError(t, err, "error occurred when committing record with mock sql db")
+	assert.Equal(t, 2, count)
+
+	// The result of the schema check is cached for the connection.
+	mock.ExpectBegin()
+	mock.ExpectPrepare(insertQuery).ExpectExec().WithArgs(argList...).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	chExportProc.deque.PushBack(&recordRow)
+	count, err = chExportProc.batchCommitAll(context.Background())
+	assert.NoError(t, err, "error occurred when committing record with mock sql db")
+	assert.Equal(t, 1, count)
+	assert.NoError(t, mock.ExpectationsWereMet(), "unfulfilled expectations for db sql operation")
+}
+
 func TestBatchCommitAllError(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err, "error when opening a stub database connection")
@@ -198,8 +256,9 @@ func TestBatchCommitAllError(t *testing.T) {
 		argList[i] = sqlmock.AnyArg()
 	}
 
+	expectL7ViolationColumns(mock, true)
 	mock.ExpectBegin()
-	mock.ExpectPrepare(insertQuery).ExpectExec().WithArgs(argList...).WillReturnError(
+	mock.ExpectPrepare(insertQueryWithL7Violations).ExpectExec().WithArgs(argList...).WillReturnError(
 		fmt.Errorf("mock error for sql stmt exec"))
 	mock.ExpectRollback()
 
@@ -265,8 +324,9 @@ func TestFlushCacheOnStop(t *testing.T) {
 	recordRow := flowrecordtesting.PrepareTestFlowRecord()
 	chExportProc.deque.PushBack(recordRow)
 
+	expectL7ViolationColumns(mock, true)
 	mock.ExpectBegin()
-	mock.ExpectPrepare(insertQuery).ExpectExec().WillDelayFor(time.Second).
+	mock.ExpectPrepare(insertQueryWithL7Violations).ExpectExec().WillDelayFor(time.Second).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
 
@@ -306,8 +366,9 @@ func TestUpdateCH(t *testing.T) {
 		chExportProc.deque.PushBack(recordRow)
 	}()
 
+	expectL7ViolationColumns(mock1, true)
 	mock1.ExpectBegin()
-	mock1.ExpectPrepare(insertQuery).ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	mock1.ExpectPrepare(insertQueryWithL7Violations).ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
 	mock1.ExpectCommit()
 
 	chExportProc.Start()
@@ -318,6 +379,8 @@ func TestUpdateCH(t *testing.T) {
 		return err == nil
 	}, time.Second, commitInterval, "timeout while waiting for first flow record to be committed (before DB connection update)")
 
+	// The schema of the flows table is checked again for the new connection.
+	expectL7ViolationColumns(mock2, false)
 	mock2.ExpectBegin()
 	mock2.ExpectPrepare(insertQuery).ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
 	mock2.ExpectCommit()
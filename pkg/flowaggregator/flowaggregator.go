@@ -73,6 +73,12 @@ var (
 		"egressNetworkPolicyRuleAction",
 		"egressNetworkPolicyType",
 		"egressNetworkPolicyRuleName",
+		"l7NetworkPolicyName",
+		"l7NetworkPolicyNamespace",
+		"l7NetworkPolicyRuleAction",
+		"l7NetworkPolicyType",
+		"l7NetworkPolicyRuleName",
+		"l7ViolationVals",
 	}
 )
 
@@ -55,7 +55,7 @@ const (
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.NewIPFIXRegistry().LoadRegistry()
 }
 
 func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
@@ -73,18 +73,23 @@ func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) er
 	var protocolID string
 	var ingressNetworkPolicyRuleAction, ingressNetworkPolicyType string
 	var egressNetworkPolicyRuleAction, egressNetworkPolicyType string
+	var l7NetworkPolicyRuleAction, l7NetworkPolicyType string
 	if prettyPrint {
 		protocolID = PrettyPrintProtocolIdentifier(r.ProtocolIdentifier)
 		ingressNetworkPolicyRuleAction = PrettyPrintRuleAction(r.IngressNetworkPolicyRuleAction)
 		ingressNetworkPolicyType = PrettyPrintPolicyType(r.IngressNetworkPolicyType)
 		egressNetworkPolicyRuleAction = PrettyPrintRuleAction(r.EgressNetworkPolicyRuleAction)
 		egressNetworkPolicyType = PrettyPrintPolicyType(r.EgressNetworkPolicyType)
+		l7NetworkPolicyRuleAction = PrettyPrintRuleAction(r.L7NetworkPolicyRuleAction)
+		l7NetworkPolicyType = PrettyPrintPolicyType(r.L7NetworkPolicyType)
 	} else {
 		protocolID = fmt.Sprintf("%d", r.ProtocolIdentifier)
 		ingressNetworkPolicyRuleAction = fmt.Sprintf("%d", r.IngressNetworkPolicyRuleAction)
 		ingressNetworkPolicyType = fmt.Sprintf("%d", r.IngressNetworkPolicyType)
 		egressNetworkPolicyRuleAction = fmt.Sprintf("%d", r.EgressNetworkPolicyRuleAction)
 		egressNetworkPolicyType = fmt.Sprintf("%d", r.EgressNetworkPolicyType)
+		l7NetworkPolicyRuleAction = fmt.Sprintf("%d", r.L7NetworkPolicyRuleAction)
+		l7NetworkPolicyType = fmt.Sprintf("%d", r.L7NetworkPolicyType)
 	}
 
 	fields := []string{
@@ -119,6 +124,12 @@ func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) er
 		r.AppProtocolName,
 		r.HttpVals,
 		r.EgressNodeName,
+		r.L7NetworkPolicyName,
+		r.L7NetworkPolicyNamespace,
+		r.L7NetworkPolicyRuleName,
+		l7NetworkPolicyRuleAction,
+		l7NetworkPolicyType,
+		r.L7ViolationVals,
 	}
 
 	str := strings.Join(fields, ",")
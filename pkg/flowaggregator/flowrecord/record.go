@@ -73,6 +73,12 @@ type FlowRecord struct {
 	AppProtocolName                      string
 	HttpVals                             string
 	EgressNodeName                       string
+	L7NetworkPolicyName                  string
+	L7NetworkPolicyNamespace             string
+	L7NetworkPolicyType                  uint8
+	L7NetworkPolicyRuleName              string
+	L7NetworkPolicyRuleAction            uint8
+	L7ViolationVals                      string
 }
 
 // GetFlowRecord converts ipfixentities.Record to FlowRecord
@@ -240,6 +246,24 @@ func GetFlowRecord(record ipfixentities.Record) *FlowRecord {
 	if egressNodeName, _, ok := record.GetInfoElementWithValue("egressNodeName"); ok {
 		r.EgressNodeName = egressNodeName.GetStringValue()
 	}
+	if l7NetworkPolicyName, _, ok := record.GetInfoElementWithValue("l7NetworkPolicyName"); ok {
+		r.L7NetworkPolicyName = l7NetworkPolicyName.GetStringValue()
+	}
+	if l7NetworkPolicyNamespace, _, ok := record.GetInfoElementWithValue("l7NetworkPolicyNamespace"); ok {
+		r.L7NetworkPolicyNamespace = l7NetworkPolicyNamespace.GetStringValue()
+	}
+	if l7NetworkPolicyType, _, ok := record.GetInfoElementWithValue("l7NetworkPolicyType"); ok {
+		r.L7NetworkPolicyType = l7NetworkPolicyType.GetUnsigned8Value()
+	}
+	if l7NetworkPolicyRuleName, _, ok := record.GetInfoElementWithValue("l7NetworkPolicyRuleName"); ok {
+		r.L7NetworkPolicyRuleName = l7NetworkPolicyRuleName.GetStringValue()
+	}
+	if l7NetworkPolicyRuleAction, _, ok := record.GetInfoElementWithValue("l7NetworkPolicyRuleAction"); ok {
+		r.L7NetworkPolicyRuleAction = l7NetworkPolicyRuleAction.GetUnsigned8Value()
+	}
+	if l7ViolationVals, _, ok := record.GetInfoElementWithValue("l7ViolationVals"); ok {
+		r.L7ViolationVals = l7ViolationVals.GetStringValue()
+	}
 	return r
 }
 
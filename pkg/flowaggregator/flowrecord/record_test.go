@@ -20,14 +20,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
-	"github.com/vmware/go-ipfix/pkg/registry"
 	"go.uber.org/mock/gomock"
 
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 func init() {
-	registry.LoadRegistry()
+	ipfix.NewIPFIXRegistry().LoadRegistry()
 }
 
 func TestGetFlowRecord(t *testing.T) {
@@ -92,6 +92,12 @@ func TestGetFlowRecord(t *testing.T) {
 		assert.Equal(t, "172.18.0.1", flowRecord.EgressIP)
 		assert.Equal(t, "http", flowRecord.AppProtocolName)
 		assert.Equal(t, "mockHttpString", flowRecord.HttpVals)
+		assert.Equal(t, "test-flow-aggregator-networkpolicy-l7", flowRecord.L7NetworkPolicyName)
+		assert.Equal(t, "test-flow-aggregator-networkpolicy-l7-ns", flowRecord.L7NetworkPolicyNamespace)
+		assert.Equal(t, uint8(2), flowRecord.L7NetworkPolicyType)
+		assert.Equal(t, "test-flow-aggregator-networkpolicy-rule-l7", flowRecord.L7NetworkPolicyRuleName)
+		assert.Equal(t, uint8(3), flowRecord.L7NetworkPolicyRuleAction)
+		assert.Equal(t, "mockL7ViolationString", flowRecord.L7ViolationVals)
 
 		if tc.isIPv4 {
 			assert.Equal(t, "10.10.0.79", flowRecord.SourceIP)
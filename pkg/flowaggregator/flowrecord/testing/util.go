@@ -75,5 +75,11 @@ func PrepareTestFlowRecord() *flowrecord.FlowRecord {
 		AppProtocolName:                      "http",
 		HttpVals:                             "mockHttpString",
 		EgressNodeName:                       "test-egress-node",
+		L7NetworkPolicyName:                  "test-flow-aggregator-networkpolicy-l7",
+		L7NetworkPolicyNamespace:             "test-flow-aggregator-networkpolicy-l7-ns",
+		L7NetworkPolicyType:                  2,
+		L7NetworkPolicyRuleName:              "test-flow-aggregator-networkpolicy-rule-l7",
+		L7NetworkPolicyRuleAction:            3,
+		L7ViolationVals:                      "mockL7ViolationString",
 	}
 }
@@ -62,6 +62,12 @@ var (
 		"appProtocolName",
 		"httpVals",
 		"egressNodeName",
+		"l7NetworkPolicyName",
+		"l7NetworkPolicyNamespace",
+		"l7NetworkPolicyType",
+		"l7NetworkPolicyRuleName",
+		"l7NetworkPolicyRuleAction",
+		"l7ViolationVals",
 	}
 	AntreaInfoElementsIPv4 = append(AntreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	AntreaInfoElementsIPv6 = append(AntreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -227,6 +227,30 @@ func PrepareMockIpfixRecord(mockRecord *ipfixentitiestesting.MockRecord, isIPv4
 	egressNodeNameElem.SetStringValue("test-egress-node")
 	mockRecord.EXPECT().GetInfoElementWithValue("egressNodeName").Return(egressNodeNameElem, 0, true)
 
+	l7NetworkPolicyNameElem := createElement("l7NetworkPolicyName", ipfixregistry.AntreaEnterpriseID)
+	l7NetworkPolicyNameElem.SetStringValue("test-flow-aggregator-networkpolicy-l7")
+	mockRecord.EXPECT().GetInfoElementWithValue("l7NetworkPolicyName").Return(l7NetworkPolicyNameElem, 0, true)
+
+	l7NetworkPolicyNamespaceElem := createElement("l7NetworkPolicyNamespace", ipfixregistry.AntreaEnterpriseID)
+	l7NetworkPolicyNamespaceElem.SetStringValue("test-flow-aggregator-networkpolicy-l7-ns")
+	mockRecord.EXPECT().GetInfoElementWithValue("l7NetworkPolicyNamespace").Return(l7NetworkPolicyNamespaceElem, 0, true)
+
+	l7NetworkPolicyTypeElem := createElement("l7NetworkPolicyType", ipfixregistry.AntreaEnterpriseID)
+	l7NetworkPolicyTypeElem.SetUnsigned8Value(uint8(2))
+	mockRecord.EXPECT().GetInfoElementWithValue("l7NetworkPolicyType").Return(l7NetworkPolicyTypeElem, 0, true)
+
+	l7NetworkPolicyRuleNameElem := createElement("l7NetworkPolicyRuleName", ipfixregistry.AntreaEnterpriseID)
+	l7NetworkPolicyRuleNameElem.SetStringValue("test-flow-aggregator-networkpolicy-rule-l7")
+	mockRecord.EXPECT().GetInfoElementWithValue("l7NetworkPolicyRuleName").Return(l7NetworkPolicyRuleNameElem, 0, true)
+
+	l7NetworkPolicyRuleActionElem := createElement("l7NetworkPolicyRuleAction", ipfixregistry.AntreaEnterpriseID)
+	l7NetworkPolicyRuleActionElem.SetUnsigned8Value(uint8(3))
+	mockRecord.EXPECT().GetInfoElementWithValue("l7NetworkPolicyRuleAction").Return(l7NetworkPolicyRuleActionElem, 0, true)
+
+	l7ViolationValsElem := createElement("l7ViolationVals", ipfixregistry.AntreaEnterpriseID)
+	l7ViolationValsElem.SetStringValue("mockL7ViolationString")
+	mockRecord.EXPECT().GetInfoElementWithValue("l7ViolationVals").Return(l7ViolationValsElem, 0, true)
+
 	if isIPv4 {
 		sourceIPv4Elem := createElement("sourceIPv4Address", ipfixregistry.IANAEnterpriseID)
 		sourceIPv4Elem.SetIPAddressValue(net.ParseIP("10.10.0.79"))
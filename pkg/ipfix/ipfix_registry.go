@@ -17,10 +17,22 @@ package ipfix
 import (
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"k8s.io/klog/v2"
 )
 
 var _ IPFIXRegistry = new(ipfixRegistry)
 
+// antreaInfoElements are the Antrea information elements which are not part of the registry of the go-ipfix library
+// yet. They describe the layer 7 NetworkPolicy rule which rejected the layer 7 traffic of a connection.
+var antreaInfoElements = []*ipfixentities.InfoElement{
+	ipfixentities.NewInfoElement("l7NetworkPolicyName", 158, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("l7NetworkPolicyNamespace", 159, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("l7NetworkPolicyType", 160, ipfixentities.Unsigned8, ipfixregistry.AntreaEnterpriseID, 1),
+	ipfixentities.NewInfoElement("l7NetworkPolicyRuleName", 161, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("l7NetworkPolicyRuleAction", 162, ipfixentities.Unsigned8, ipfixregistry.AntreaEnterpriseID, 1),
+	ipfixentities.NewInfoElement("l7ViolationVals", 163, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+}
+
 // IPFIXRegistry interface is added to facilitate unit testing without involving the code from go-ipfix library.
 type IPFIXRegistry interface {
 	LoadRegistry()
@@ -35,6 +47,11 @@ func NewIPFIXRegistry() *ipfixRegistry {
 
 func (reg *ipfixRegistry) LoadRegistry() {
 	ipfixregistry.LoadRegistry()
+	for _, ie := range antreaInfoElements {
+		if err := ipfixregistry.PutInfoElement(*ie, ipfixregistry.AntreaEnterpriseID); err != nil {
+			klog.ErrorS(err, "Failed to register information element", "name", ie.Name)
+		}
+	}
 }
 
 func (reg *ipfixRegistry) GetInfoElement(name string, enterpriseID uint32) (*ipfixentities.InfoElement, error) {
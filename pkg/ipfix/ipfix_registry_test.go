@@ -42,6 +42,13 @@ func TestGetInfoElement(t *testing.T) {
 			expectedElementID: 100,
 			expectedError:     "",
 		},
+		{
+			testname:          "Information element registered by Antrea exists in registry",
+			name:              "l7NetworkPolicyRuleName",
+			enterpriseID:      56506,
+			expectedElementID: 161,
+			expectedError:     "",
+		},
 		{
 			testname:      "Information element with given name does not exist in registry",
 			name:          "sourcePod",
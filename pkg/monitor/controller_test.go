@@ -70,8 +70,10 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 	adminNPInformer := policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies()
 	banpInformer := policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies()
 	tierInformer := crdInformerFactory.Crd().V1beta1().Tiers()
+	namespacedTierInformer := crdInformerFactory.Crd().V1beta1().NamespacedTiers()
 	cgInformer := crdInformerFactory.Crd().V1beta1().ClusterGroups()
 	grpInformer := crdInformerFactory.Crd().V1beta1().Groups()
+	ipSetInformer := crdInformerFactory.Crd().V1alpha1().IPSets()
 	externalNodeInformer := crdInformerFactory.Crd().V1alpha1().ExternalNodes()
 
 	addressGroupStore := store.NewAddressGroupStore()
@@ -94,8 +96,10 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 		adminNPInformer,
 		banpInformer,
 		tierInformer,
+		namespacedTierInformer,
 		cgInformer,
 		grpInformer,
+		ipSetInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
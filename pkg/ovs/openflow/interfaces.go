@@ -458,6 +458,8 @@ type Packet struct {
 	DestinationPort uint16
 	SourcePort      uint16
 	TCPFlags        uint8
+	TCPSeqNum       uint32
+	TCPAckNum       uint32
 	ICMPType        uint8
 	ICMPCode        uint8
 	ICMPEchoID      uint16
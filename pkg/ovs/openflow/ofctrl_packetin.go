@@ -26,7 +26,9 @@ import (
 
 const (
 	icmpEchoRequestType  uint8 = 8
+	icmpEchoReplyType    uint8 = 0
 	icmp6EchoRequestType uint8 = 128
+	icmp6EchoReplyType   uint8 = 129
 	// tcpStandardHdrLen is the TCP header length without options.
 	tcpStandardHdrLen uint8 = 5
 )
@@ -94,7 +96,7 @@ func getICMPHeaderData(ipPkt util.Message) (icmpType, icmpCode uint8, icmpEchoID
 	switch typedIPPkt := ipPkt.(type) {
 	case *protocol.IPv4:
 		icmpIn := typedIPPkt.Data.(*protocol.ICMP)
-		if icmpIn.Type == icmpEchoRequestType {
+		if icmpIn.Type == icmpEchoRequestType || icmpIn.Type == icmpEchoReplyType {
 			if len(icmpIn.Data) < 4 {
 				return 0, 0, 0, 0, errors.New("ICMP payload is too short to unmarshal an ICMP echo message")
 			}
@@ -105,7 +107,7 @@ func getICMPHeaderData(ipPkt util.Message) (icmpType, icmpCode uint8, icmpEchoID
 		icmpCode = icmpIn.Code
 	case *protocol.IPv6:
 		icmpIn := typedIPPkt.Data.(*protocol.ICMPv6EchoReqRpl)
-		if icmpIn.Type == icmp6EchoRequestType {
+		if icmpIn.Type == icmp6EchoRequestType || icmpIn.Type == icmp6EchoReplyType {
 			icmpEchoID = icmpIn.Identifier
 			icmpEchoSeq = icmpIn.SeqNum
 		}
@@ -150,11 +152,11 @@ func ParsePacketIn(pktIn *ofctrl.PacketIn) (*Packet, error) {
 
 	var err error
 	if packet.IPProto == protocol.Type_TCP {
-		packet.SourcePort, packet.DestinationPort, _, _, _, packet.TCPFlags, _, err = GetTCPHeaderData(ethernetData.Data)
+		packet.SourcePort, packet.DestinationPort, packet.TCPSeqNum, packet.TCPAckNum, _, packet.TCPFlags, _, err = GetTCPHeaderData(ethernetData.Data)
 	} else if packet.IPProto == protocol.Type_UDP {
 		packet.SourcePort, packet.DestinationPort, err = GetUDPHeaderData(ethernetData.Data)
 	} else if packet.IPProto == protocol.Type_ICMP || packet.IPProto == protocol.Type_IPv6ICMP {
-		_, _, packet.ICMPEchoID, packet.ICMPEchoSeq, err = getICMPHeaderData(ethernetData.Data)
+		packet.ICMPType, packet.ICMPCode, packet.ICMPEchoID, packet.ICMPEchoSeq, err = getICMPHeaderData(ethernetData.Data)
 	}
 	if err != nil {
 		return nil, err
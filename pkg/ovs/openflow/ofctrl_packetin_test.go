@@ -149,6 +149,20 @@ func TestGetICMPHeaderData(t *testing.T) {
 				expectICMPEchoSeq: 2,
 			},
 		},
+		{
+			name: "GetICMPHeader-ipv4-reply",
+			args: args{
+				icmp: protocol.ICMP{
+					Type: icmpEchoReplyType,
+					Code: 0,
+					Data: testEcho,
+				},
+				expectICMPType:    icmpEchoReplyType,
+				expectICMPCode:    0,
+				expectICMPEchoID:  1,
+				expectICMPEchoSeq: 2,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
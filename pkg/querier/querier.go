@@ -15,10 +15,14 @@
 package querier
 
 import (
+	"io"
+	"net"
+
 	v1 "k8s.io/api/core/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 
 	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/multicast"
 	"antrea.io/antrea/pkg/agent/types"
@@ -42,6 +46,15 @@ type AgentNetworkPolicyInfoQuerier interface {
 	GetAppliedNetworkPolicies(pod, namespace string, npFilter *NetworkPolicyQueryFilter) []cpv1beta.NetworkPolicy
 	GetNetworkPolicyByRuleFlowID(ruleFlowID uint32) *cpv1beta.NetworkPolicyReference
 	GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule
+	// GetPodByIP returns the Namespace and name of the Pod with the IP, among the Pods running
+	// on the Node and the Pods in the AddressGroups received by the Node. It returns empty
+	// strings if no such Pod is found.
+	GetPodByIP(ip net.IP) (string, string)
+	// GetPolicyPacketCaptures returns the packet capture files of the policy rules with packet
+	// capture enabled.
+	GetPolicyPacketCaptures() ([]packetcapture.CaptureInfo, error)
+	// OpenPolicyPacketCapture opens a packet capture file returned by GetPolicyPacketCaptures.
+	OpenPolicyPacketCapture(name string) (io.ReadCloser, error)
 }
 
 type AgentMulticastInfoQuerier interface {
@@ -66,6 +79,12 @@ type EgressQuerier interface {
 	GetEgress(podNamespace, podName string) (string, string, string, error)
 }
 
+type MulticlusterGatewayQuerier interface {
+	// ForwardsToPeerCluster returns whether packets to dstIP are forwarded from the Node to a peer
+	// member cluster through the Multi-cluster Gateway.
+	ForwardsToPeerCluster(dstIP net.IP) bool
+}
+
 // GetSelfPod gets current pod.
 func GetSelfPod() v1.ObjectReference {
 	podName := env.GetPodName()
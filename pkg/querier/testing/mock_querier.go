@@ -24,8 +24,11 @@
 package testing
 
 import (
+	io "io"
+	net "net"
 	reflect "reflect"
 
+	packetcapture "antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
 	interfacestore "antrea.io/antrea/pkg/agent/interfacestore"
 	multicast "antrea.io/antrea/pkg/agent/multicast"
 	types "antrea.io/antrea/pkg/agent/types"
@@ -184,6 +187,36 @@ func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetNetworkPolicyNum() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkPolicyNum", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetNetworkPolicyNum))
 }
 
+// GetPodByIP mocks base method.
+func (m *MockAgentNetworkPolicyInfoQuerier) GetPodByIP(arg0 net.IP) (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodByIP", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// GetPodByIP indicates an expected call of GetPodByIP.
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetPodByIP(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByIP", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetPodByIP), arg0)
+}
+
+// GetPolicyPacketCaptures mocks base method.
+func (m *MockAgentNetworkPolicyInfoQuerier) GetPolicyPacketCaptures() ([]packetcapture.CaptureInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyPacketCaptures")
+	ret0, _ := ret[0].([]packetcapture.CaptureInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyPacketCaptures indicates an expected call of GetPolicyPacketCaptures.
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetPolicyPacketCaptures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyPacketCaptures", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetPolicyPacketCaptures))
+}
+
 // GetRuleByFlowID mocks base method.
 func (m *MockAgentNetworkPolicyInfoQuerier) GetRuleByFlowID(arg0 uint32) *types.PolicyRule {
 	m.ctrl.T.Helper()
@@ -198,6 +231,21 @@ func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetRuleByFlowID(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleByFlowID", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetRuleByFlowID), arg0)
 }
 
+// OpenPolicyPacketCapture mocks base method.
+func (m *MockAgentNetworkPolicyInfoQuerier) OpenPolicyPacketCapture(arg0 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPolicyPacketCapture", arg0)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenPolicyPacketCapture indicates an expected call of OpenPolicyPacketCapture.
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) OpenPolicyPacketCapture(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPolicyPacketCapture", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).OpenPolicyPacketCapture), arg0)
+}
+
 // MockAgentMulticastInfoQuerier is a mock of AgentMulticastInfoQuerier interface.
 type MockAgentMulticastInfoQuerier struct {
 	ctrl     *gomock.Controller
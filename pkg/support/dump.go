@@ -56,7 +56,7 @@ type AgentDumper interface {
 	// under the basedir.
 	DumpAgentInfo(basedir string) error
 	// DumpNetworkPolicyResources should create files that contains networkpolicy
-	// resources on the agent Pod under the base dir.
+	// resources and packet capture files of policy rules on the agent Pod under the base dir.
 	DumpNetworkPolicyResources(basedir string) error
 	// DumpHeapPprof should create a pprof file of heap usage of the agent.
 	DumpHeapPprof(basedir string) error
@@ -321,7 +321,47 @@ func (d *agentDumper) DumpNetworkPolicyResources(basedir string) error {
 	if err := dump(d.npq.GetNetworkPolicies(&querier.NetworkPolicyQueryFilter{}), "networkpolicies"); err != nil {
 		return err
 	}
-	return dump(d.npq.GetAppliedToGroups(), "appliedtogroups")
+	if err := dump(d.npq.GetAppliedToGroups(), "appliedtogroups"); err != nil {
+		return err
+	}
+	return d.dumpPolicyPacketCaptures(basedir)
+}
+
+// dumpPolicyPacketCaptures copies the packet capture files of policy rules to the
+// networkpolicy-captures directory under the basedir.
+func (d *agentDumper) dumpPolicyPacketCaptures(basedir string) error {
+	captures, err := d.npq.GetPolicyPacketCaptures()
+	if err != nil {
+		return err
+	}
+	if len(captures) == 0 {
+		return nil
+	}
+	targetDir := filepath.Join(basedir, "networkpolicy-captures")
+	if err := d.fs.MkdirAll(targetDir, os.ModePerm); err != nil {
+		return fmt.Errorf("error when creating target dir: %w", err)
+	}
+	copyCapture := func(name string) error {
+		srcFile, err := d.npq.OpenPolicyPacketCapture(name)
+		if err != nil {
+			return fmt.Errorf("error when opening packet capture file %s: %w", name, err)
+		}
+		defer srcFile.Close()
+		targetPath := filepath.Join(targetDir, name)
+		targetFile, err := d.fs.Create(targetPath)
+		if err != nil {
+			return fmt.Errorf("error when creating target file %s: %w", targetPath, err)
+		}
+		defer targetFile.Close()
+		_, err = io.Copy(targetFile, srcFile)
+		return err
+	}
+	for _, capture := range captures {
+		if err := copyCapture(capture.Name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (d *agentDumper) DumpFlows(basedir string) error {
@@ -16,6 +16,7 @@ package support
 
 import (
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -24,9 +25,13 @@ import (
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"gopkg.in/yaml.v2"
 	"k8s.io/utils/exec"
 	exectesting "k8s.io/utils/exec/testing"
+
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/packetcapture"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
 )
 
 var baseDir = filepath.Join("dir1", "dir2")
@@ -172,6 +177,20 @@ func TestDumpNetworkPolicyResources(t *testing.T) {
 	}
 }
 
+func TestDumpPolicyPacketCaptures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	npq.EXPECT().GetPolicyPacketCaptures().Return([]packetcapture.CaptureInfo{{Name: "AntreaClusterNetworkPolicy_acnp1_rule1.pcap"}}, nil)
+	npq.EXPECT().OpenPolicyPacketCapture("AntreaClusterNetworkPolicy_acnp1_rule1.pcap").Return(io.NopCloser(strings.NewReader("pcap")), nil)
+	fs := afero.NewMemMapFs()
+	dumper := &agentDumper{fs: fs, npq: npq}
+	err := dumper.dumpPolicyPacketCaptures(baseDir)
+	require.NoError(t, err)
+	result, err := afero.ReadFile(fs, filepath.Join(baseDir, "networkpolicy-captures", "AntreaClusterNetworkPolicy_acnp1_rule1.pcap"))
+	require.NoError(t, err)
+	assert.Equal(t, "pcap", string(result))
+}
+
 func TestDumpControllerInfo(t *testing.T) {
 	exe := new(testExec)
 	fs := afero.NewMemMapFs()
@@ -0,0 +1,132 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		expectedErr string
+	}{
+		{name: "every minute", spec: "* * * * *"},
+		{name: "lists ranges and steps", spec: "0,30 8-18/2 1-15 * mon-fri"},
+		{name: "names", spec: "0 22 * JAN-Mar sat,sun"},
+		{name: "sunday as 7", spec: "0 0 * * 7"},
+		{name: "descriptor", spec: "@weekly"},
+		{name: "too few fields", spec: "0 0 * *", expectedErr: "expected exactly 5 fields"},
+		{name: "unknown descriptor", spec: "@every 1h", expectedErr: "unrecognized descriptor"},
+		{name: "out of range", spec: "60 * * * *", expectedErr: "value 60 out of range [0, 59] in minute field"},
+		{name: "invalid value", spec: "0 0 * foo *", expectedErr: `invalid value "foo" in month field`},
+		{name: "invalid range", spec: "0 18-8 * * *", expectedErr: "start is greater than end"},
+		{name: "invalid step", spec: "*/0 * * * *", expectedErr: `invalid step "0" in minute field`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.spec)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "next minute",
+			spec:     "* * * * *",
+			from:     time.Date(2024, 3, 1, 10, 0, 30, 0, time.UTC),
+			expected: time.Date(2024, 3, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "strictly after",
+			spec:     "0 10 * * *",
+			from:     time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekend",
+			spec:     "0 22 * * sat",
+			from:     time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 9, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "step",
+			spec:     "*/15 * * * *",
+			from:     time.Date(2024, 3, 1, 10, 16, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "end of year",
+			spec:     "0 0 1 1 *",
+			from:     time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			spec:     "0 0 29 2 *",
+			from:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day-of-month or day-of-week",
+			spec:     "0 0 13 * fri",
+			from:     time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "time zone",
+			spec:     "30 9 * * *",
+			from:     time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC).In(newYork),
+			expected: time.Date(2024, 3, 2, 9, 30, 0, 0, newYork),
+		},
+		{
+			name:     "skipped hour at DST transition",
+			spec:     "30 2 * * *",
+			from:     time.Date(2024, 3, 10, 0, 0, 0, 0, newYork),
+			expected: time.Date(2024, 3, 11, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:     "never",
+			spec:     "0 0 30 2 *",
+			from:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.spec)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(s.Next(tt.from)), "expected %v, got %v", tt.expected, s.Next(tt.from))
+		})
+	}
+}
@@ -142,6 +142,46 @@ func mergeCIDRs(cidrBlocks []*net.IPNet) []*net.IPNet {
 	return cidrBlocks
 }
 
+// AggregateCIDRs returns the smallest list of CIDRs covering exactly the same
+// addresses as the provided ones: duplicated CIDRs and CIDRs covered by other
+// CIDRs are removed, and sibling CIDRs (e.g. 10.0.0.0/25 and 10.0.0.128/25) are
+// merged into their parent CIDR, recursively. IPv4 and IPv6 CIDRs can be mixed.
+// The returned CIDRs are masked and sorted. Input array can be modified.
+func AggregateCIDRs(prefixes []netip.Prefix) []netip.Prefix {
+	for i := range prefixes {
+		prefixes[i] = prefixes[i].Masked()
+	}
+	// Sort the list by address, then by prefix length in ascending order, so
+	// that a CIDR can only be covered by the last CIDR kept before it.
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+	result := make([]netip.Prefix, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if n := len(result); n > 0 && result[n-1].Overlaps(prefix) {
+			continue
+		}
+		result = append(result, prefix)
+		// Merge the last two CIDRs as long as they are the two halves of the
+		// same parent CIDR.
+		for n := len(result); n >= 2; n = len(result) {
+			prev, last := result[n-2], result[n-1]
+			if prev.Bits() != last.Bits() || prev.Bits() == 0 {
+				break
+			}
+			parent := netip.PrefixFrom(prev.Addr(), prev.Bits()-1).Masked()
+			if parent.Addr() != prev.Addr() || !parent.Contains(last.Addr()) {
+				break
+			}
+			result = append(result[:n-2], parent)
+		}
+	}
+	return result
+}
+
 // IPNetToNetIPNet converts Antrea IPNet to *net.IPNet.
 // Note that K8s allows non-standard CIDRs to be specified (e.g. 10.0.1.1/16, fe80::7015:efff:fe9a:146b/64). However,
 // OVS will report OFPBMC_BAD_WILDCARDS error if using them in the OpenFlow messages. The function will normalize the
@@ -112,6 +112,69 @@ func TestMergeCIDRs(t *testing.T) {
 	assert.ElementsMatch(t, correctList4, ipNetList4)
 }
 
+func TestAggregateCIDRs(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes []string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			prefixes: []string{},
+			expected: []string{},
+		},
+		{
+			name:     "duplicated and covered",
+			prefixes: []string{"10.10.0.0/16", "10.10.1.0/24", "10.10.0.0/16", "10.10.2.3/32"},
+			expected: []string{"10.10.0.0/16"},
+		},
+		{
+			name:     "non-masked",
+			prefixes: []string{"10.10.1.1/24", "10.10.1.0/24"},
+			expected: []string{"10.10.1.0/24"},
+		},
+		{
+			name:     "siblings",
+			prefixes: []string{"10.0.0.128/25", "10.0.0.0/25"},
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "recursive siblings",
+			prefixes: []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/25", "10.0.1.0/24"},
+			expected: []string{"10.0.0.0/23"},
+		},
+		{
+			name:     "adjacent but not siblings",
+			prefixes: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			expected: []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:     "host addresses",
+			prefixes: []string{"192.168.0.3/32", "192.168.0.0/32", "192.168.0.2/32", "192.168.0.1/32", "192.168.0.5/32"},
+			expected: []string{"192.168.0.0/30", "192.168.0.5/32"},
+		},
+		{
+			name:     "dual-stack",
+			prefixes: []string{"2001:db8::/33", "10.0.0.0/9", "2001:db8:8000::/33", "10.128.0.0/9", "::/0"},
+			expected: []string{"10.0.0.0/8", "::/0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes := make([]netip.Prefix, 0, len(tt.prefixes))
+			for _, p := range tt.prefixes {
+				prefixes = append(prefixes, netip.MustParsePrefix(p))
+			}
+			actual := AggregateCIDRs(prefixes)
+			actualStrs := make([]string, 0, len(actual))
+			for _, p := range actual {
+				actualStrs = append(actualStrs, p.String())
+			}
+			assert.Equal(t, tt.expected, actualStrs)
+		})
+	}
+}
+
 func TestIPNetToNetIPNet(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -814,7 +814,7 @@ func expectedProxyServiceGroupAndFlows(svc *types.ServiceConfig, endpointList []
 	ctTable := "EgressRule"
 	if antreaPolicyEnabled {
 		loadGourpID = fmt.Sprintf("set_field:0x%x->reg7,", svc.ClusterGroupID)
-		ctTable = "AntreaPolicyEgressRule"
+		ctTable = "AntreaPolicyEgressAuditRule"
 	}
 	svcFlows := expectTableFlows{tableName: "ServiceLB", flows: []*ofTestUtils.ExpectFlow{
 		{